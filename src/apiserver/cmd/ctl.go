@@ -0,0 +1,315 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/common"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/open/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/ctl"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+)
+
+// ctlApplyOptions diff/apply 共用的参数
+type ctlApplyOptions struct {
+	file  string
+	owner string
+	prune bool
+	adopt bool
+}
+
+func (o *ctlApplyOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.file, "file", "f", "", "desired state file in yaml/json, - for stdin")
+	cmd.Flags().StringVar(&o.owner, "owner", "", "owner label value of applied resources, default gitops")
+	cmd.Flags().BoolVar(&o.prune, "prune", false, "delete owned resources not declared in file")
+	cmd.Flags().BoolVar(&o.adopt, "adopt", false, "take over existing resources not owned by owner")
+	_ = cmd.MarkFlagRequired("file")
+}
+
+func (o *ctlApplyOptions) request(cmd *cobra.Command) (*serializer.ResourceApplyRequest, error) {
+	doc, err := ctl.ReadDocument(o.file, cmd.InOrStdin())
+	if err != nil {
+		return nil, err
+	}
+	return &serializer.ResourceApplyRequest{
+		ApplyRequest: dto.ApplyRequest{
+			Resources: doc.Resources,
+			Owner:     o.owner,
+			Prune:     o.prune,
+			Adopt:     o.adopt,
+		},
+	}, nil
+}
+
+// ctlPublishOptions apply/publish 共用的发布参数
+type ctlPublishOptions struct {
+	changelog                 string
+	overrideMaintenanceWindow bool
+}
+
+func (o *ctlPublishOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.changelog, "changelog", "", "changelog of the publish")
+	cmd.Flags().BoolVar(&o.overrideMaintenanceWindow, "override-maintenance-window", false,
+		"publish even if outside the maintenance window")
+}
+
+// NewCtlCmd ...
+func NewCtlCmd() *cobra.Command {
+	var cfg ctl.Config
+
+	ctlCmd := cobra.Command{
+		Use:   "ctl",
+		Short: "client commands operating a remote control plane through openapi.",
+	}
+	// 默认从环境变量读取，便于在 CI 中通过 secret 注入 token
+	ctlCmd.PersistentFlags().StringVar(&cfg.Server, "server", os.Getenv("BK_APIGW_SERVER"),
+		"control plane address, env BK_APIGW_SERVER")
+	ctlCmd.PersistentFlags().StringVarP(&cfg.Gateway, "gateway", "g", os.Getenv("BK_APIGW_GATEWAY"),
+		"gateway name, env BK_APIGW_GATEWAY")
+	ctlCmd.PersistentFlags().StringVar(&cfg.Token, "token", os.Getenv("BK_APIGW_TOKEN"),
+		"gateway token, env BK_APIGW_TOKEN")
+	ctlCmd.PersistentFlags().DurationVar(&cfg.Timeout, "timeout", 30*time.Second, "request timeout")
+
+	ctlCmd.AddCommand(
+		newCtlGetCmd(&cfg),
+		newCtlExportCmd(&cfg),
+		newCtlDiffCmd(&cfg),
+		newCtlApplyCmd(&cfg),
+		newCtlPublishCmd(&cfg),
+	)
+	return &ctlCmd
+}
+
+// runCtl 执行子命令并以退出码结束进程
+func runCtl(cmd *cobra.Command, cfg *ctl.Config, run func(context.Context, *ctl.Client) (int, error)) {
+	code := ctl.ExitError
+	client, err := ctl.NewClient(*cfg)
+	if err == nil {
+		code, err = run(cmd.Context(), client)
+	}
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Error: %s\n", err)
+	}
+	os.Exit(code)
+}
+
+// writeOutput 输出到文件，file 为空时输出到标准输出
+func writeOutput(w io.Writer, file string, data []byte) error {
+	if file == "" {
+		_, err := w.Write(data)
+		return err
+	}
+	return os.WriteFile(file, data, 0o644)
+}
+
+// writePublishResult 输出发布结果，开启发布审批时输出发布变更请求
+func writePublishResult(w io.Writer, changeRequest *common.ChangeRequestOutputInfo) {
+	if changeRequest == nil {
+		fmt.Fprintln(w, "Published.")
+		return
+	}
+	fmt.Fprintf(w, "Publish change request #%d created, status: %s.\n", changeRequest.ID, changeRequest.Status)
+}
+
+func newCtlGetCmd(cfg *ctl.Config) *cobra.Command {
+	var format string
+
+	getCmd := cobra.Command{
+		Use:   "get <resource_type> [id]",
+		Short: "get resources of the gateway, resource_type such as routes/upstreams.",
+		Args:  cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runCtl(cmd, cfg, func(ctx context.Context, client *ctl.Client) (int, error) {
+				resourcePath := constant.ResourcePath(args[0])
+				var (
+					result any
+					err    error
+				)
+				if len(args) == 2 {
+					result, err = client.GetResource(ctx, resourcePath, args[1])
+				} else {
+					result, err = client.ListResources(ctx, resourcePath)
+				}
+				if err != nil {
+					return ctl.ExitError, err
+				}
+				data, err := ctl.Marshal(result, format)
+				if err != nil {
+					return ctl.ExitError, err
+				}
+				return ctl.ExitOK, writeOutput(cmd.OutOrStdout(), "", data)
+			})
+		},
+	}
+	getCmd.Flags().StringVarP(&format, "output", "o", ctl.FormatYAML, "output format: yaml/json")
+	return &getCmd
+}
+
+func newCtlExportCmd(cfg *ctl.Config) *cobra.Command {
+	var file, format, types string
+
+	exportCmd := cobra.Command{
+		Use:   "export",
+		Short: "export resources of the gateway as a desired state file for apply.",
+		Run: func(cmd *cobra.Command, args []string) {
+			runCtl(cmd, cfg, func(ctx context.Context, client *ctl.Client) (int, error) {
+				resourcePaths, err := ctl.ParseResourcePaths(types)
+				if err != nil {
+					return ctl.ExitError, err
+				}
+				doc, err := ctl.Export(ctx, client, resourcePaths)
+				if err != nil {
+					return ctl.ExitError, err
+				}
+				if format == "" {
+					format = ctl.FormatOfFile(file)
+				}
+				data, err := ctl.Marshal(doc, format)
+				if err != nil {
+					return ctl.ExitError, err
+				}
+				return ctl.ExitOK, writeOutput(cmd.OutOrStdout(), file, data)
+			})
+		},
+	}
+	exportCmd.Flags().StringVarP(&file, "file", "f", "", "output file, default stdout")
+	exportCmd.Flags().StringVarP(&format, "output", "o", "", "output format: yaml/json, default by file extension")
+	exportCmd.Flags().StringVar(&types, "types", "", "comma separated resource types, default all")
+	return &exportCmd
+}
+
+func newCtlDiffCmd(cfg *ctl.Config) *cobra.Command {
+	var opts ctlApplyOptions
+	var verbose bool
+
+	diffCmd := cobra.Command{
+		Use:   "diff",
+		Short: "show changes of applying the file, exit 2 if there are changes and 3 if there are conflicts.",
+		Run: func(cmd *cobra.Command, args []string) {
+			runCtl(cmd, cfg, func(ctx context.Context, client *ctl.Client) (int, error) {
+				req, err := opts.request(cmd)
+				if err != nil {
+					return ctl.ExitError, err
+				}
+				req.DryRun = true
+				resp, err := client.Apply(ctx, req)
+				if err != nil {
+					return ctl.ExitError, err
+				}
+				if err := ctl.WritePlan(cmd.OutOrStdout(), resp.Plan, verbose); err != nil {
+					return ctl.ExitError, err
+				}
+				return ctl.PlanExitCode(resp.Plan, true), nil
+			})
+		},
+	}
+	opts.addFlags(&diffCmd)
+	diffCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "also show unchanged resources")
+	return &diffCmd
+}
+
+func newCtlApplyCmd(cfg *ctl.Config) *cobra.Command {
+	var opts ctlApplyOptions
+	var publishOpts ctlPublishOptions
+	var publish bool
+
+	applyCmd := cobra.Command{
+		Use:   "apply",
+		Short: "apply the file as desired state of the gateway, exit 3 on conflicts and 4 if pending approval.",
+		Run: func(cmd *cobra.Command, args []string) {
+			runCtl(cmd, cfg, func(ctx context.Context, client *ctl.Client) (int, error) {
+				req, err := opts.request(cmd)
+				if err != nil {
+					return ctl.ExitError, err
+				}
+				req.Publish = publish
+				req.Changelog = publishOpts.changelog
+				req.OverrideMaintenanceWindow = publishOpts.overrideMaintenanceWindow
+				resp, err := client.Apply(ctx, req)
+				var apiErr *ctl.APIError
+				if errors.As(err, &apiErr) && apiErr.IsConflict() && resp != nil {
+					if err := ctl.WritePlan(cmd.OutOrStdout(), resp.Plan, false); err != nil {
+						return ctl.ExitError, err
+					}
+					return ctl.ExitConflict, err
+				}
+				if err != nil {
+					return ctl.ExitError, err
+				}
+				if err := ctl.WritePlan(cmd.OutOrStdout(), resp.Plan, false); err != nil {
+					return ctl.ExitError, err
+				}
+				if publish && len(resp.ChangeRequests) == 0 {
+					writePublishResult(cmd.OutOrStdout(), nil)
+				}
+				for i := range resp.ChangeRequests {
+					writePublishResult(cmd.OutOrStdout(), &resp.ChangeRequests[i])
+				}
+				if len(resp.ChangeRequests) > 0 {
+					return ctl.ExitPendingApproval, nil
+				}
+				return ctl.ExitOK, nil
+			})
+		},
+	}
+	opts.addFlags(&applyCmd)
+	publishOpts.addFlags(&applyCmd)
+	applyCmd.Flags().BoolVar(&publish, "publish", false, "publish the applied resources after apply")
+	return &applyCmd
+}
+
+func newCtlPublishCmd(cfg *ctl.Config) *cobra.Command {
+	var opts ctlPublishOptions
+
+	publishCmd := cobra.Command{
+		Use:   "publish",
+		Short: "publish all pending resources of the gateway, exit 4 if the publish is pending approval.",
+		Run: func(cmd *cobra.Command, args []string) {
+			runCtl(cmd, cfg, func(ctx context.Context, client *ctl.Client) (int, error) {
+				changeRequest, err := client.Publish(ctx, &serializer.GatewayPublishRequest{
+					Changelog:                 opts.changelog,
+					OverrideMaintenanceWindow: opts.overrideMaintenanceWindow,
+				})
+				if err != nil {
+					return ctl.ExitError, err
+				}
+				writePublishResult(cmd.OutOrStdout(), changeRequest)
+				if changeRequest != nil {
+					return ctl.ExitPendingApproval, nil
+				}
+				return ctl.ExitOK, nil
+			})
+		},
+	}
+	opts.addFlags(&publishCmd)
+	return &publishCmd
+}
+
+func init() {
+	rootCmd.AddCommand(NewCtlCmd())
+}
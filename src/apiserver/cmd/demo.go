@@ -59,7 +59,7 @@ func NewDemoCmd() *cobra.Command {
 			}
 
 			// init cryptography
-			if err = initCryptos(cfg.Crypto.Key, cfg.Crypto.Nonce); err != nil {
+			if err = initCryptos(cfg.Crypto); err != nil {
 				log.Fatalf("failed to init cryptography: %s", err)
 			}
 
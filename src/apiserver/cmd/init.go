@@ -97,23 +97,29 @@ func initLogger(cfg *config.LogConfig) error {
 	return nil
 }
 
-func initCryptos(key, nonce string) error {
-	if key == "" {
+func initCryptos(cfg config.Crypto) error {
+	if cfg.Key == "" {
 		return errors.New("cryptoKey should be configured")
 	}
 
-	if nonce == "" {
-		return errors.New("cryptoNonce should be configured")
-	}
-
 	validEncryptKeyRegex := regexp.MustCompile("^[a-zA-Z0-9]{32}$")
 	errInvalidEncryptKey := "invalid encrypt_key: encrypt_key should " +
 		"contains letters(a-z, A-Z), numbers(0-9), length should be 32 bit"
-	if !validEncryptKeyRegex.MatchString(key) {
+	if !validEncryptKeyRegex.MatchString(cfg.Key) {
 		return errors.New(errInvalidEncryptKey)
 	}
 
-	err := cryptography.Init(key, nonce)
+	// 其他密钥用于密钥轮换，格式要求与默认密钥一致
+	keys := make([]cryptography.Key, 0, len(cfg.Keys))
+	for _, key := range cfg.Keys {
+		if !validEncryptKeyRegex.MatchString(key.Key) {
+			return errors.Errorf("key[id=%s]: %s", key.ID, errInvalidEncryptKey)
+		}
+		keys = append(keys, cryptography.Key{ID: key.ID, Secret: key.Key})
+	}
+
+	// nonce 仅用于解密旧版本的密文，新数据使用随机 nonce 加密
+	err := cryptography.InitKeyring(cfg.Key, cfg.Nonce, cfg.KeyID, keys)
 	if err != nil {
 		return err
 	}
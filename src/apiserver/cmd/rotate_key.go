@@ -0,0 +1,114 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/keyrotation"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+)
+
+// NewRotateCryptoKeyCmd 用于创建密钥轮换命令：使用配置中的主密钥（crypto.keyID）重新加密数据库中存储的密文
+// 执行前需将新密钥配置为主密钥，并在 crypto.keys 中保留旧密钥用于解密；重新加密后会校验所有密文
+func NewRotateCryptoKeyCmd() *cobra.Command {
+	var (
+		cfgFile string
+		dryRun  bool
+	)
+
+	rotateCmd := cobra.Command{
+		Use:   "rotate-crypto-key",
+		Short: "re-encrypt all stored secrets with the primary crypto key.",
+		Run: func(cmd *cobra.Command, args []string) {
+			// 加载配置
+			cfg, err := config.Load(cfgFile)
+			if err != nil {
+				logging.Fatalf("failed to load config: %s", err)
+			}
+
+			// 初始化 Logger
+			if err = initLogger(&cfg.Service.Log); err != nil {
+				logging.Fatalf("failed to init logging: %s", err)
+			}
+
+			// init cryptography
+			if err = initCryptos(cfg.Crypto); err != nil {
+				logging.Fatalf("failed to init cryptography: %s", err)
+			}
+
+			// 初始化 DB Client
+			database.InitDBClient(cfg.MysqlConfig, logging.GetLogger("gorm"))
+			// 设置repo db
+			repo.SetDefault(database.Client())
+
+			ctx := context.Background()
+			mode := keyrotation.ModeRotate
+			if dryRun {
+				mode = keyrotation.ModeDryRun
+			}
+			report, err := keyrotation.Run(ctx, mode)
+			if err != nil {
+				logging.Fatalf("failed to %s crypto key: %s", mode, err)
+			}
+			logReport(report)
+			if dryRun {
+				return
+			}
+
+			// 校验所有密文均可解密且已使用主密钥加密
+			report, err = keyrotation.Run(ctx, keyrotation.ModeVerify)
+			if err != nil {
+				logging.Fatalf("failed to verify crypto key: %s", err)
+			}
+			logReport(report)
+			if report.Failed() > 0 || report.Pending() > 0 {
+				logging.Fatalf("verify crypto key failed: %d failed, %d not encrypted with key %s",
+					report.Failed(), report.Pending(), report.PrimaryKeyID)
+			}
+			logging.Infof("rotate crypto key success, all secrets are encrypted with key %s", report.PrimaryKeyID)
+		},
+	}
+
+	// 配置文件路径，如果未指定，会从环境变量读取各项配置
+	rotateCmd.Flags().StringVar(&cfgFile, "conf", "", "config file")
+	rotateCmd.Flags().BoolVar(&dryRun, "dry-run", false, "only count secrets to re-encrypt, do not write database")
+
+	return &rotateCmd
+}
+
+// logReport 输出每类数据的统计
+func logReport(report *keyrotation.Report) {
+	for _, stats := range report.Stats {
+		if stats.Values == 0 {
+			continue
+		}
+		logging.Infof("[%s] %s: values=%d, pending=%d, failed=%d, primary key=%s",
+			report.Mode, stats.Target, stats.Values, stats.Pending, stats.Failed, report.PrimaryKeyID)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(NewRotateCryptoKeyCmd())
+}
@@ -20,12 +20,15 @@
 package cmd
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/async"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 )
 
 // NewSchedulerCmd 用于创建定时任务调度器启动命令
@@ -49,11 +52,21 @@ func NewSchedulerCmd() *cobra.Command {
 				logging.Fatalf("failed to init logging: %s", err)
 			}
 
+			// init cryptography，定时发布需要解密网关的 etcd 配置
+			if err = initCryptos(cfg.Crypto); err != nil {
+				logging.Fatalf("failed to init cryptography: %s", err)
+			}
+
 			// 初始化 DB Client
 			database.InitDBClient(cfg.MysqlConfig, logging.GetLogger("gorm"))
+			// 设置repo db
+			repo.SetDefault(database.Client())
 			// 初始化 task server
 			async.InitTaskScheduler()
 
+			// 启动后台任务队列 worker，任务通过租约抢占，多实例运行时不会重复执行
+			go async.RunTaskWorker(context.Background())
+
 			srv := async.Scheduler()
 			// 加载周期任务
 			if err = srv.LoadTasks(); err != nil {
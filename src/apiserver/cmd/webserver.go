@@ -30,11 +30,13 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/async"
 	unifyopbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/unifyop"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/sentry"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/trace"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/router"
@@ -60,7 +62,7 @@ func NewWebServerCmd() *cobra.Command {
 			}
 
 			// init cryptography
-			if err = initCryptos(cfg.Crypto.Key, cfg.Crypto.Nonce); err != nil {
+			if err = initCryptos(cfg.Crypto); err != nil {
 				logging.Fatalf("failed to init cryptography: %s", err)
 			}
 
@@ -94,6 +96,10 @@ func NewWebServerCmd() *cobra.Command {
 			baseCtx := context.Background()
 			// 启动同步
 			unifyopbiz.SyncAll(baseCtx)
+			// 启动后台任务队列 worker
+			workerCtx, stopWorker := context.WithCancel(baseCtx)
+			defer stopWorker()
+			go async.RunTaskWorker(workerCtx)
 			ctx, cancel := context.WithTimeout(
 				baseCtx, time.Duration(cfg.Service.Server.GraceTimeout)*time.Second,
 			)
@@ -107,6 +113,8 @@ func NewWebServerCmd() *cobra.Command {
 			if err = srv.Shutdown(ctx); err != nil {
 				logging.Fatalf("Shutdown server failed: %s", err)
 			}
+			// 关闭 etcd 连接池中的连接
+			storage.DefaultClientPool().Close()
 			logging.Infof("Server exiting")
 		},
 	}
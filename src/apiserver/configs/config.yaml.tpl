@@ -71,6 +71,12 @@ biz:
     bkGuideLink: http://example.com/guide
     bkFeedBackLink: http://example.com/feedback
     bkApigatewayLink: http://apigw.example.com
+  # 配置漂移告警 webhook，url 为空时不发送通知
+  driftWebhook:
+    url: ""
+    token: ""
+    timeout: 10s
+    notifyDelay: 30s
 
 # MySQL 数据库配置
 mysqlconfig:
@@ -88,6 +94,14 @@ mysqlconfig:
     insecureSkipVerify: false
 
 crypto:
+  # 旧版本密文使用的固定 nonce，仅用于解密存量数据
   nonce: k2dbCGetyusW
+  # ID 为 default 的密钥
   key: jxi18GX5w2qgHwfZCFpn07q8FScXJOd3
+  # 当前用于加密的密钥 ID，为空时使用 key；轮换密钥后执行 `apiserver rotate-crypto-key` 重新加密存量数据
+  keyID: ""
+  # 其他可用密钥，轮换期间需保留旧密钥用于解密
+  keys: []
+  #  - id: key2026
+  #    key: xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
 
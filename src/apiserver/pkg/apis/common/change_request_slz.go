@@ -0,0 +1,130 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package common
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// ChangeRequestPathParam 发布变更请求路径参数
+type ChangeRequestPathParam struct {
+	ChangeRequestID int64 `json:"change_request_id" uri:"change_request_id" binding:"required"`
+}
+
+// ChangeRequestListRequest 发布变更请求列表查询参数
+type ChangeRequestListRequest struct {
+	// 状态：pending/approved/rejected/applied/expired
+	Status constant.ChangeRequestStatus `json:"status" form:"status"`
+}
+
+// ChangeRequestReviewRequest 发布变更请求审批/驳回/评论参数
+type ChangeRequestReviewRequest struct {
+	Comment string `json:"comment" binding:"max=1024"` // 审批意见
+}
+
+// ChangeRequestApplyRequest 发布变更请求执行参数
+type ChangeRequestApplyRequest struct {
+	// 是否在维护窗口外强制发布，web 接口需要网关管理员权限
+	OverrideMaintenanceWindow bool `json:"override_maintenance_window"`
+}
+
+// ChangeRequestOutputInfo 发布变更请求输出信息
+type ChangeRequestOutputInfo struct {
+	ID                int64                        `json:"id"`
+	GatewayID         int                          `json:"gateway_id"`
+	TriggerSource     constant.ReleaseTrigger      `json:"trigger_source"`
+	Changelog         string                       `json:"changelog"`
+	ResourceType      constant.APISIXResource      `json:"resource_type"` // 为空表示一键发布
+	ResourceIDs       []string                     `json:"resource_ids"`
+	RequiredApprovals int                          `json:"required_approvals"`
+	Reviewers         []string                     `json:"reviewers"`
+	Status            constant.ChangeRequestStatus `json:"status"`
+	ApplyError        string                       `json:"apply_error"`
+	ExpiredAt         int64                        `json:"expired_at"` // Unix timestamp
+	AppliedAt         int64                        `json:"applied_at"` // Unix timestamp，未执行时为 0
+	Creator           string                       `json:"creator"`
+	Updater           string                       `json:"updater"`
+	CreatedAt         int64                        `json:"created_at"`
+	UpdatedAt         int64                        `json:"updated_at"`
+}
+
+// ChangeRequestEventOutputInfo 发布变更请求操作记录
+type ChangeRequestEventOutputInfo struct {
+	// 操作：create/approve/reject/comment/apply/apply_failed/expire
+	Action    constant.ChangeRequestAction `json:"action"`
+	Operator  string                       `json:"operator"`
+	Comment   string                       `json:"comment"`
+	CreatedAt int64                        `json:"created_at"`
+}
+
+// ChangeRequestDetailOutputInfo 发布变更请求详情
+type ChangeRequestDetailOutputInfo struct {
+	ChangeRequestOutputInfo
+	Diff   []dto.ResourceChangeInfo       `json:"diff"` // 创建时待发布资源的变更
+	Events []ChangeRequestEventOutputInfo `json:"events"`
+}
+
+// ChangeRequestToOutputInfo 将模型转换为发布变更请求输出信息
+func ChangeRequestToOutputInfo(changeRequest *model.GatewayChangeRequest) ChangeRequestOutputInfo {
+	output := ChangeRequestOutputInfo{
+		ID:                changeRequest.ID,
+		GatewayID:         changeRequest.GatewayID,
+		TriggerSource:     changeRequest.TriggerSource,
+		Changelog:         changeRequest.Changelog,
+		ResourceType:      changeRequest.ResourceType,
+		ResourceIDs:       changeRequest.ResourceIDs,
+		RequiredApprovals: changeRequest.RequiredApprovals,
+		Reviewers:         changeRequest.Reviewers,
+		Status:            changeRequest.Status,
+		ApplyError:        changeRequest.ApplyError,
+		ExpiredAt:         changeRequest.ExpiredAt.Unix(),
+		Creator:           changeRequest.Creator,
+		Updater:           changeRequest.Updater,
+		CreatedAt:         changeRequest.CreatedAt.Unix(),
+		UpdatedAt:         changeRequest.UpdatedAt.Unix(),
+	}
+	if changeRequest.AppliedAt != nil {
+		output.AppliedAt = changeRequest.AppliedAt.Unix()
+	}
+	return output
+}
+
+// ChangeRequestToDetailOutputInfo 将模型转换为发布变更请求详情
+func ChangeRequestToDetailOutputInfo(
+	changeRequest *model.GatewayChangeRequest,
+	diff []dto.ResourceChangeInfo,
+	events []*model.GatewayChangeRequestEvent,
+) ChangeRequestDetailOutputInfo {
+	output := ChangeRequestDetailOutputInfo{
+		ChangeRequestOutputInfo: ChangeRequestToOutputInfo(changeRequest),
+		Diff:                    diff,
+		Events:                  make([]ChangeRequestEventOutputInfo, 0, len(events)),
+	}
+	for _, event := range events {
+		output.Events = append(output.Events, ChangeRequestEventOutputInfo{
+			Action:    event.Action,
+			Operator:  event.Operator,
+			Comment:   event.Comment,
+			CreatedAt: event.CreatedAt.Unix(),
+		})
+	}
+	return output
+}
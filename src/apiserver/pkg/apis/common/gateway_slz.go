@@ -252,7 +252,7 @@ func CheckEtcdConnAndAPISIXInstance(gatewayID int, etcdConf EtcdConfig) (string,
 		CertKey:  etcdConf.EtcdCertKey,
 	}
 
-	// 检查 etcd 连接
+	// 检查 etcd 连接：校验的是待保存的配置，不使用连接池
 	etcdStore, err := storage.NewEtcdStorage(etcdStoreConfig)
 	if err != nil {
 		return "", "", err
@@ -444,7 +444,8 @@ Common references:
 1. Route -> service/upstream/plugin_config
 2. Service -> upstream
 3. Consumer -> consumer_group
-4. Stream Route -> service/upstream
+4. Credential -> consumer
+5. Stream Route -> service/upstream
 
 ---
 
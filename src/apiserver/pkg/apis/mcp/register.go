@@ -50,6 +50,9 @@ func registerTools(server *mcp.Server) {
 
 	// Schema tools
 	tools.RegisterSchemaTools(server)
+
+	// Route match tools
+	tools.RegisterRouteMatchTools(server)
 }
 
 // registerResources registers all MCP resources
@@ -466,6 +466,7 @@ delete_resource(resource_type="route", resource_ids=["old-route"])
 validate_resource_config(resource_type="route", config={...})
 diff_resources()
 diff_detail(resource_type="route", resource_id="route-1")
+simulate_route_match(method="GET", host="api.example.com", path="/users/1")
 publish_preview()
 ` + "```" + `
 
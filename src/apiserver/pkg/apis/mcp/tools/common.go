@@ -43,12 +43,14 @@ var ValidResourceTypes = []string{
 	constant.Upstream.String(),
 	constant.Consumer.String(),
 	constant.ConsumerGroup.String(),
+	constant.Credential.String(),
 	constant.PluginConfig.String(),
 	constant.GlobalRule.String(),
 	constant.PluginMetadata.String(),
 	constant.Proto.String(),
 	constant.SSL.String(),
 	constant.StreamRoute.String(),
+	constant.Secret.String(),
 }
 
 // ValidResourceStatuses lists all valid resource statuses
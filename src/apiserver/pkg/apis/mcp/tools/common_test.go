@@ -328,19 +328,21 @@ func TestAPISIXVersionDescription(t *testing.T) {
 func TestValidResourceTypes(t *testing.T) {
 	t.Parallel()
 
-	// Verify all 11 resource types are present
-	assert.Len(t, ValidResourceTypes, 11)
+	// Verify all 13 resource types are present
+	assert.Len(t, ValidResourceTypes, 13)
 	assert.Contains(t, ValidResourceTypes, "route")
 	assert.Contains(t, ValidResourceTypes, "service")
 	assert.Contains(t, ValidResourceTypes, "upstream")
 	assert.Contains(t, ValidResourceTypes, "consumer")
 	assert.Contains(t, ValidResourceTypes, "consumer_group")
+	assert.Contains(t, ValidResourceTypes, "credential")
 	assert.Contains(t, ValidResourceTypes, "plugin_config")
 	assert.Contains(t, ValidResourceTypes, "global_rule")
 	assert.Contains(t, ValidResourceTypes, "plugin_metadata")
 	assert.Contains(t, ValidResourceTypes, "proto")
 	assert.Contains(t, ValidResourceTypes, "ssl")
 	assert.Contains(t, ValidResourceTypes, "stream_route")
+	assert.Contains(t, ValidResourceTypes, "secret")
 }
 
 func TestValidResourceStatuses(t *testing.T) {
@@ -23,8 +23,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	resourcevalidationbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resourcevalidation"
 	schemabiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/schema"
+	variablebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/variable"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 )
 
@@ -47,9 +49,16 @@ func validateMCPDatabaseResourceConfig(
 	if err != nil {
 		return err
 	}
+	rawConfig, err = variablebiz.ResolveForGateway(ctx, rawConfig)
+	if err != nil {
+		return err
+	}
 	if err = databaseValidator.Validate(rawConfig); err != nil {
 		return err
 	}
+	if err = resourcebiz.CheckSecretReferences(ctx, rawConfig); err != nil {
+		return err
+	}
 
 	return nil
 }
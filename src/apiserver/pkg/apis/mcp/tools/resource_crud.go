@@ -32,6 +32,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 // ============================================================================
@@ -211,6 +212,7 @@ func listResourceHandler(
 	if err != nil {
 		return errorResult(err), nil, nil
 	}
+	maskListedResourceConfigs(resourceType, resources)
 
 	result := map[string]any{
 		"total":         total,
@@ -223,6 +225,22 @@ func listResourceHandler(
 	return successResult(result), nil, nil
 }
 
+// maskListedResourceConfigs masks sensitive plugin fields in the config column of listed resource rows
+func maskListedResourceConfigs(resourceType constant.APISIXResource, resources []any) {
+	for _, resource := range resources {
+		row, ok := resource.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch config := row["config"].(type) {
+		case string:
+			row["config"] = string(sensitive.Mask(resourceType, []byte(config)))
+		case []byte:
+			row["config"] = sensitive.Mask(resourceType, config)
+		}
+	}
+}
+
 // getResourceHandler handles the get_resource tool call
 func getResourceHandler(
 	ctx context.Context,
@@ -251,6 +269,7 @@ func getResourceHandler(
 	if err != nil {
 		return errorResult(err), nil, nil
 	}
+	resource.Config = sensitive.Mask(resourceType, resource.Config)
 
 	return successResult(resource), nil, nil
 }
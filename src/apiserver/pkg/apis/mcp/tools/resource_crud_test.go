@@ -33,6 +33,7 @@ import (
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
@@ -265,6 +266,7 @@ func newMCPToolTestContext(t *testing.T) (context.Context, *model.Gateway) {
 	t.Helper()
 
 	util.InitEmbedDb()
+	assert.NoError(t, cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW"))
 
 	ctx := context.Background()
 	gateway := &model.Gateway{
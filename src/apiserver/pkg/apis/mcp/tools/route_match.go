@@ -0,0 +1,104 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	exportflowbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/exportflow"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ============================================================================
+// 路由匹配模拟工具的输入类型
+// ============================================================================
+
+// SimulateRouteMatchInput simulate_route_match 工具的输入
+type SimulateRouteMatchInput struct {
+	State      string            `json:"state,omitempty" jsonschema:"Optional. draft (default) or published."`
+	Method     string            `json:"method,omitempty" jsonschema:"Optional HTTP method, defaults to GET."`
+	Host       string            `json:"host,omitempty" jsonschema:"Optional request host, may include a port."`
+	Path       string            `json:"path" jsonschema:"Required. Request path, may include a query string."`
+	Headers    map[string]string `json:"headers,omitempty" jsonschema:"Optional request headers."`
+	Query      map[string]string `json:"query,omitempty" jsonschema:"Optional query arguments."`
+	RemoteAddr string            `json:"remote_addr,omitempty" jsonschema:"Optional client IP address."`
+}
+
+// RegisterRouteMatchTools 注册路由匹配模拟相关的 MCP 工具
+func RegisterRouteMatchTools(server *mcp.Server) {
+	// simulate_route_match
+	mcp.AddTool(server, &mcp.Tool{
+		Name: "simulate_route_match",
+		Description: "Simulate which draft or published route APISIX would match for a request " +
+			"(method, host, path, headers, query, remote address). " +
+			"Returns the matched route, the other candidate routes in match order and why each of them lost.",
+	}, simulateRouteMatchHandler)
+}
+
+// simulateRouteMatchHandler 处理 simulate_route_match 工具调用
+func simulateRouteMatchHandler(
+	ctx context.Context,
+	req *mcp.CallToolRequest,
+	input SimulateRouteMatchInput,
+) (*mcp.CallToolResult, any, error) {
+	if input.Path == "" {
+		return errorResult(fmt.Errorf("path 不能为空")), nil, nil
+	}
+	state := constant.ExportState(input.State)
+	switch state {
+	case "":
+		state = constant.ExportStateDraft
+	case constant.ExportStateDraft, constant.ExportStatePublished:
+	default:
+		return errorResult(fmt.Errorf("state %s 不合法，只能为 draft 或 published", input.State)), nil, nil
+	}
+
+	// 网关已由中间件设置到 context 中
+	gateway, err := getGatewayFromContext(ctx)
+	if err != nil {
+		return errorResult(err), nil, nil
+	}
+
+	// 下游 biz 函数通过 ginx.GetGatewayInfoFromContext 获取网关
+	ctx = ginx.SetGatewayInfoToContext(ctx, gateway)
+
+	result, err := exportflowbiz.SimulateRouteMatch(ctx, &dto.RouteMatchOptions{
+		State:      state,
+		Method:     input.Method,
+		Host:       input.Host,
+		Path:       input.Path,
+		Headers:    input.Headers,
+		Query:      input.Query,
+		RemoteAddr: input.RemoteAddr,
+	})
+	if err != nil {
+		return errorResult(fmt.Errorf("路由匹配模拟失败: %w", err)), nil, nil
+	}
+
+	return successResult(map[string]any{
+		"gateway_id": gateway.ID,
+		"state":      state,
+		"result":     result,
+	}), nil, nil
+}
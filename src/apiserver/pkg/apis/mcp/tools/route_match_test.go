@@ -0,0 +1,59 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestSimulateRouteMatchHandler(t *testing.T) {
+	ctx, gateway := newMCPToolTestContext(t)
+	route := data.Route1WithNoRelationResource(gateway, constant.ResourceStatusCreateDraft)
+	assert.NoError(t, resourcebiz.CreateRoute(ctx, *route))
+
+	result, _, err := simulateRouteMatchHandler(ctx, nil, SimulateRouteMatchInput{Method: "POST", Path: "/get"})
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	payload := mustDecodeResultPayload(t, result)
+	matchResult, _ := payload["result"].(map[string]any)
+	assert.Nil(t, matchResult["matched"])
+	candidates, _ := matchResult["candidates"].([]any)
+	if assert.Len(t, candidates, 1) {
+		candidate, _ := candidates[0].(map[string]any)
+		assert.Equal(t, route.ID, candidate["id"])
+		assert.Equal(t, []any{"method POST 不在 methods [GET] 中"}, candidate["reasons"])
+	}
+
+	result, _, err = simulateRouteMatchHandler(ctx, nil, SimulateRouteMatchInput{Path: "/get?a=1"})
+	assert.NoError(t, err)
+	matchResult, _ = mustDecodeResultPayload(t, result)["result"].(map[string]any)
+	matched, _ := matchResult["matched"].(map[string]any)
+	assert.Equal(t, route.ID, matched["id"])
+
+	result, _, err = simulateRouteMatchHandler(ctx, nil, SimulateRouteMatchInput{State: "online", Path: "/get"})
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, mustDecodeResultText(t, result), "state online 不合法")
+}
@@ -25,12 +25,14 @@ import (
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/tidwall/gjson"
+	"gorm.io/datatypes"
 
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	unifyopbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/unifyop"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 // ============================================================================
@@ -227,7 +229,7 @@ func listSyncedResourceHandler(
 			ResourceType: sync.Type.String(),
 			Name:         resourceName,
 			Status:       resourceStatus,
-			Config:       sync.Config,
+			Config:       datatypes.JSON(sensitive.Mask(sync.Type, sync.Config)),
 			ModRevision:  sync.ModRevision,
 		})
 	}
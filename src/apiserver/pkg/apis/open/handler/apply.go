@@ -0,0 +1,104 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/common"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/open/serializer"
+	applybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/apply"
+	changerequestbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/changerequest"
+	maintenancebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/maintenance"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ResourceApply ...
+//
+//	@ID			openapi_resource_apply
+//	@Summary	资源声明式 apply，dry_run 时只返回计划；存在冲突时返回 409 及计划，不做任何修改
+//	@Accept		json
+//	@Produce	json
+//	@Tags		openapi.resource
+//	@Param		X-BK-API-TOKEN	header		string							true	"创建网关返回的 token"
+//	@Param		gateway_name	path		string							true	"网关名称"
+//	@Param		request			body		serializer.ResourceApplyRequest	true	"网关编辑区的期望状态"
+//	@Success	200				{object}	serializer.ResourceApplyResponse
+//	@Router		/api/v1/open/gateways/{gateway_name}/resources/-/apply/ [post]
+func ResourceApply(c *gin.Context) {
+	var req serializer.ResourceApplyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if req.DryRun {
+		plan, err := applybiz.Plan(c.Request.Context(), &req.ApplyRequest)
+		plan.MaskSensitive()
+		if err != nil {
+			applyErrorResponse(c, plan, err)
+			return
+		}
+		ginx.SuccessJSONResponse(c, serializer.ResourceApplyResponse{Plan: plan})
+		return
+	}
+	plan, err := applybiz.Apply(c.Request.Context(), &req.ApplyRequest)
+	plan.MaskSensitive()
+	if err != nil {
+		applyErrorResponse(c, plan, err)
+		return
+	}
+	output := serializer.ResourceApplyResponse{Plan: plan}
+	if req.Publish {
+		ctx := maintenancebiz.WithOverride(c.Request.Context(), req.OverrideMaintenanceWindow)
+		ctx = releasebiz.WithMeta(ctx, releasebiz.Meta{
+			Trigger:   constant.ReleaseTriggerOpen,
+			Changelog: req.Changelog,
+		})
+		// 只发布本次 apply 写入编辑区的资源，编辑区中其他待发布的资源不受影响
+		changeRequests, err := changerequestbiz.PublishResourcesWithPolicy(ctx, plan.ChangedResources())
+		if err != nil {
+			publishResponse(c, nil, err)
+			return
+		}
+		for _, changeRequest := range changeRequests {
+			output.ChangeRequests = append(output.ChangeRequests, common.ChangeRequestToOutputInfo(changeRequest))
+		}
+	}
+	ginx.SuccessJSONResponse(c, output)
+}
+
+// applyErrorResponse 将 apply 错误转换为响应，冲突时返回计划以便查看冲突资源
+func applyErrorResponse(c *gin.Context, plan *dto.ApplyPlan, err error) {
+	switch {
+	case errors.Is(err, applybiz.ErrApplyConflict):
+		ginx.BaseErrorJSONResponseWithData(c, ginx.ConflictError, err.Error(), http.StatusConflict, plan)
+	case errors.Is(err, applybiz.ErrInvalidDocument):
+		ginx.BadRequestErrorJSONResponse(c, err)
+	case errors.Is(err, applybiz.ErrGatewayReadOnly):
+		ginx.ForbiddenJSONResponse(c, err)
+	default:
+		ginx.SystemErrorJSONResponse(c, err)
+	}
+}
@@ -0,0 +1,225 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/common"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/open/serializer"
+	changerequestbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/changerequest"
+	maintenancebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/maintenance"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ChangeRequestList ...
+//
+//	@ID			openapi_change_request_list
+//	@Summary	发布变更请求列表
+//	@Produce	json
+//	@Tags		openapi.change_request
+//	@Param		X-BK-API-TOKEN	header		string							true	"创建网关返回的 token"
+//	@Param		gateway_name	path		string							true	"网关名称"
+//	@Param		request			query		common.ChangeRequestListRequest	false	"查询参数"
+//	@Param		offset			query		int								false	"offset"
+//	@Param		limit			query		int								false	"limit"
+//	@Success	200				{object}	ginx.PaginatedResponse{results=[]common.ChangeRequestOutputInfo}
+//	@Router		/api/v1/open/gateways/{gateway_name}/change_requests/ [get]
+func ChangeRequestList(c *gin.Context) {
+	var req common.ChangeRequestListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	changeRequests, total, err := changerequestbiz.ListChangeRequests(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c).ID,
+		req.Status,
+		utils.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make([]common.ChangeRequestOutputInfo, 0, len(changeRequests))
+	for _, changeRequest := range changeRequests {
+		results = append(results, common.ChangeRequestToOutputInfo(changeRequest))
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// ChangeRequestGet ...
+//
+//	@ID			openapi_change_request_get
+//	@Summary	发布变更请求详情
+//	@Produce	json
+//	@Tags		openapi.change_request
+//	@Param		X-BK-API-TOKEN		header		string	true	"创建网关返回的 token"
+//	@Param		gateway_name		path		string	true	"网关名称"
+//	@Param		change_request_id	path		int		true	"变更请求 ID"
+//	@Success	200					{object}	common.ChangeRequestDetailOutputInfo
+//	@Router		/api/v1/open/gateways/{gateway_name}/change_requests/{change_request_id}/ [get]
+func ChangeRequestGet(c *gin.Context) {
+	changeRequest, ok := getChangeRequest(c)
+	if !ok {
+		return
+	}
+	changeRequestDetailResponse(c, changeRequest)
+}
+
+// ChangeRequestComment ...
+//
+//	@ID			openapi_change_request_comment
+//	@Summary	评论发布变更请求
+//	@Accept		json
+//	@Produce	json
+//	@Tags		openapi.change_request
+//	@Param		X-BK-API-TOKEN		header		string									true	"创建网关返回的 token"
+//	@Param		gateway_name		path		string									true	"网关名称"
+//	@Param		change_request_id	path		int										true	"变更请求 ID"
+//	@Param		request				body		serializer.ChangeRequestOperateRequest	true	"评论参数"
+//	@Success	200					{object}	common.ChangeRequestDetailOutputInfo
+//	@Router		/api/v1/open/gateways/{gateway_name}/change_requests/{change_request_id}/comment/ [post]
+func ChangeRequestComment(c *gin.Context) {
+	changeRequestOperate(c, changerequestbiz.CommentChangeRequest)
+}
+
+// ChangeRequestApply ...
+//
+//	@ID			openapi_change_request_apply
+//	@Summary	重新执行已审批通过的发布变更请求
+//	@Accept		json
+//	@Produce	json
+//	@Tags		openapi.change_request
+//	@Param		X-BK-API-TOKEN		header		string									true	"创建网关返回的 token"
+//	@Param		gateway_name		path		string									true	"网关名称"
+//	@Param		change_request_id	path		int										true	"变更请求 ID"
+//	@Param		request				body		serializer.ChangeRequestOperateRequest	true	"执行参数"
+//	@Success	200					{object}	common.ChangeRequestDetailOutputInfo
+//	@Router		/api/v1/open/gateways/{gateway_name}/change_requests/{change_request_id}/apply/ [post]
+func ChangeRequestApply(c *gin.Context) {
+	changeRequestOperate(c, func(ctx context.Context, changeRequest *model.GatewayChangeRequest, _ string) error {
+		return changerequestbiz.ApplyChangeRequest(ctx, changeRequest)
+	})
+}
+
+// getChangeRequest 根据路径参数查询当前网关的发布变更请求，失败时直接返回错误响应
+func getChangeRequest(c *gin.Context) (*model.GatewayChangeRequest, bool) {
+	var pathParam common.ChangeRequestPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return nil, false
+	}
+	changeRequest, err := changerequestbiz.GetChangeRequest(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c).ID,
+		pathParam.ChangeRequestID,
+	)
+	if err != nil {
+		changeRequestErrorResponse(c, err)
+		return nil, false
+	}
+	return changeRequest, true
+}
+
+// changeRequestOperate 评论或执行发布变更请求
+//
+// 网关 token 无法证明操作人身份，不从请求中读取操作人；审批与驳回需要校验审批人身份，仅在 web 中由登录用户操作
+func changeRequestOperate(
+	c *gin.Context,
+	operate func(ctx context.Context, changeRequest *model.GatewayChangeRequest, comment string) error,
+) {
+	var req serializer.ChangeRequestOperateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	changeRequest, ok := getChangeRequest(c)
+	if !ok {
+		return
+	}
+	ctx := maintenancebiz.WithOverride(c.Request.Context(), req.OverrideMaintenanceWindow)
+	if err := operate(ctx, changeRequest, req.Comment); err != nil {
+		changeRequestErrorResponse(c, err)
+		return
+	}
+	changeRequestDetailResponse(c, changeRequest)
+}
+
+// changeRequestDetailResponse 返回发布变更请求详情
+func changeRequestDetailResponse(c *gin.Context, changeRequest *model.GatewayChangeRequest) {
+	diff, err := changerequestbiz.GetChangeRequestDiff(changeRequest)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	events, err := changerequestbiz.ListChangeRequestEvents(c.Request.Context(), changeRequest.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, common.ChangeRequestToDetailOutputInfo(changeRequest, diff, events))
+}
+
+// changeRequestErrorResponse 将发布变更请求的错误转换为响应
+func changeRequestErrorResponse(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, changerequestbiz.ErrChangeRequestNotFound):
+		ginx.NotFoundJSONResponse(c, err)
+	case errors.Is(err, changerequestbiz.ErrNotReviewer), errors.Is(err, changerequestbiz.ErrSelfApproval):
+		ginx.ForbiddenJSONResponse(c, err)
+	case errors.Is(err, changerequestbiz.ErrChangeRequestNotPending),
+		errors.Is(err, changerequestbiz.ErrChangeRequestNotApproved),
+		errors.Is(err, changerequestbiz.ErrAlreadyApproved),
+		errors.Is(err, changerequestbiz.ErrChangeRequestStale):
+		ginx.ConflictJSONResponse(c, err)
+	case errors.Is(err, maintenancebiz.ErrOutsideMaintenanceWindow):
+		ginx.ForbiddenJSONResponse(c, err)
+	default:
+		ginx.SystemErrorJSONResponse(c, err)
+	}
+}
+
+// publishResponse 返回发布结果，网关开启发布审批时返回创建的发布变更请求
+func publishResponse(c *gin.Context, changeRequest *model.GatewayChangeRequest, err error) {
+	if err != nil {
+		switch {
+		case errors.Is(err, changerequestbiz.ErrNoDraftResources):
+			ginx.BadRequestErrorJSONResponse(c, err)
+		case errors.Is(err, maintenancebiz.ErrOutsideMaintenanceWindow):
+			ginx.ForbiddenJSONResponse(c, err)
+		default:
+			ginx.SystemErrorJSONResponse(c, err)
+		}
+		return
+	}
+	if changeRequest != nil {
+		ginx.SuccessCreateJSONResponse(c, common.ChangeRequestToOutputInfo(changeRequest))
+		return
+	}
+	ginx.SuccessCreateResponse(c)
+}
@@ -20,12 +20,17 @@
 package handler
 
 import (
+	"errors"
+	"io"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/common"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/open/serializer"
+	changerequestbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/changerequest"
 	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
-	publishbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/publish"
+	maintenancebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/maintenance"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
@@ -215,15 +220,23 @@ func GatewayDelete(c *gin.Context) {
 //	@Accept		json
 //	@Produce	json
 //	@Tags		openapi.gateway
-//	@Param		X-BK-API-TOKEN	header	string	true	"创建网关返回的 token"
-//	@Param		gateway_name	path	string	true	"网关名称"
-//	@Success	201
+//	@Param		X-BK-API-TOKEN	header	string							true	"创建网关返回的 token"
+//	@Param		gateway_name	path	string							true	"网关名称"
+//	@Param		request			body	serializer.GatewayPublishRequest	false	"一键发布请求参数"
+//	@Success	201	{object}	common.ChangeRequestOutputInfo	"开启发布审批时返回发布变更请求"
 //	@Router		/api/v1/open/gateways/{gateway_name}/publish/ [post]
 func GatewayPublish(c *gin.Context) {
-	err := publishbiz.PublishAllResource(c.Request.Context(), ginx.GetGatewayInfo(c).ID)
-	if err != nil {
-		ginx.SystemErrorJSONResponse(c, err)
+	var req serializer.GatewayPublishRequest
+	// 兼容不传请求体的调用
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
-	ginx.SuccessCreateResponse(c)
+	ctx := maintenancebiz.WithOverride(c.Request.Context(), req.OverrideMaintenanceWindow)
+	ctx = releasebiz.WithMeta(ctx, releasebiz.Meta{
+		Trigger:   constant.ReleaseTriggerOpen,
+		Changelog: req.Changelog,
+	})
+	changeRequest, err := changerequestbiz.PublishWithPolicy(ctx, "", nil)
+	publishResponse(c, changeRequest, err)
 }
@@ -0,0 +1,177 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"errors"
+	"io"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/open/serializer"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ReleaseVersionList ...
+//
+//	@ID			openapi_release_version_list
+//	@Summary	发布版本列表
+//	@Produce	json
+//	@Tags		openapi.release_version
+//	@Param		X-BK-API-TOKEN	header		string	true	"创建网关返回的 token"
+//	@Param		gateway_name	path		string	true	"网关名称"
+//	@Param		offset			query		int		false	"offset"
+//	@Param		limit			query		int		false	"limit"
+//	@Success	200				{object}	ginx.PaginatedResponse{results=[]serializer.ReleaseVersionResponse}
+//	@Router		/api/v1/open/gateways/{gateway_name}/release_versions/ [get]
+func ReleaseVersionList(c *gin.Context) {
+	versions, total, err := releasebiz.ListReleaseVersions(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c).ID,
+		utils.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make([]serializer.ReleaseVersionResponse, 0, len(versions))
+	for _, version := range versions {
+		results = append(results, serializer.NewReleaseVersionResponse(version))
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// ReleaseVersionGet ...
+//
+//	@ID			openapi_release_version_get
+//	@Summary	发布版本详情，快照中的敏感字段脱敏返回
+//	@Produce	json
+//	@Tags		openapi.release_version
+//	@Param		X-BK-API-TOKEN	header		string	true	"创建网关返回的 token"
+//	@Param		gateway_name	path		string	true	"网关名称"
+//	@Param		version_id		path		int		true	"发布版本 ID"
+//	@Success	200				{object}	serializer.ReleaseVersionDetailResponse
+//	@Router		/api/v1/open/gateways/{gateway_name}/release_versions/{version_id}/ [get]
+func ReleaseVersionGet(c *gin.Context) {
+	var pathParam serializer.ReleaseVersionPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	version, err := releasebiz.GetReleaseVersion(c.Request.Context(), ginx.GetGatewayInfo(c).ID, pathParam.VersionID)
+	if err != nil {
+		if errors.Is(err, releasebiz.ErrReleaseVersionNotFound) {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	resources, err := version.GetReleaseResources()
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.ReleaseVersionDetailResponse{
+		ReleaseVersionResponse: serializer.NewReleaseVersionResponse(version),
+		Resources:              releasebiz.MaskReleaseResources(resources),
+	})
+}
+
+// ReleaseVersionDiff ...
+//
+//	@ID			openapi_release_version_diff
+//	@Summary	发布版本对比
+//	@Produce	json
+//	@Tags		openapi.release_version
+//	@Param		X-BK-API-TOKEN	header		string								true	"创建网关返回的 token"
+//	@Param		gateway_name	path		string								true	"网关名称"
+//	@Param		version_id		path		int									true	"发布版本 ID"
+//	@Param		request			query		serializer.ReleaseVersionDiffRequest	false	"查询参数"
+//	@Success	200				{object}	dto.ReleaseVersionDiff
+//	@Router		/api/v1/open/gateways/{gateway_name}/release_versions/{version_id}/diff/ [get]
+func ReleaseVersionDiff(c *gin.Context) {
+	var pathParam serializer.ReleaseVersionPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.ReleaseVersionDiffRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	diff, err := releasebiz.DiffReleaseVersion(c.Request.Context(), pathParam.VersionID, req.BaseVersionID)
+	if err != nil {
+		if errors.Is(err, releasebiz.ErrReleaseVersionNotFound) {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, diff)
+}
+
+// ReleaseVersionRollback ...
+//
+//	@ID			openapi_release_version_rollback
+//	@Summary	发布版本一键回滚
+//	@Accept		json
+//	@Produce	json
+//	@Tags		openapi.release_version
+//	@Param		X-BK-API-TOKEN	header		string									true	"创建网关返回的 token"
+//	@Param		gateway_name	path		string									true	"网关名称"
+//	@Param		version_id		path		int										true	"发布版本 ID"
+//	@Param		request			body		serializer.ReleaseVersionRollbackRequest	false	"回滚参数"
+//	@Success	201				{object}	serializer.ReleaseVersionResponse
+//	@Router		/api/v1/open/gateways/{gateway_name}/release_versions/{version_id}/rollback/ [post]
+func ReleaseVersionRollback(c *gin.Context) {
+	var pathParam serializer.ReleaseVersionPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.ReleaseVersionRollbackRequest
+	// 兼容不传请求体的调用
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	ctx := releasebiz.WithMeta(c.Request.Context(), releasebiz.Meta{
+		Trigger:   constant.ReleaseTriggerOpen,
+		Changelog: req.Changelog,
+	})
+	version, err := releasebiz.RollbackReleaseVersion(ctx, pathParam.VersionID)
+	if err != nil {
+		if errors.Is(err, releasebiz.ErrReleaseVersionNotFound) {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateJSONResponse(c, serializer.NewReleaseVersionResponse(version))
+}
@@ -28,8 +28,10 @@ import (
 	"gorm.io/datatypes"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/open/serializer"
+	changerequestbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/changerequest"
 	importflowbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/importflow"
-	publishbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/publish"
+	maintenancebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/maintenance"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	unifyopbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/unifyop"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
@@ -37,6 +39,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/status"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/filex"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 // ResourceBatchCreate ...
@@ -148,7 +151,7 @@ func ResourceBatchGet(c *gin.Context) {
 		configRaw, _ := resource.Config.MarshalJSON()
 		res = append(res, serializer.ResourceBatchGetResponse{
 			ID:         resource.ID,
-			RawMessage: configRaw,
+			RawMessage: sensitive.Mask(ginx.GetResourceType(c), configRaw),
 		})
 	}
 	ginx.SuccessJSONResponse(c, res)
@@ -226,7 +229,7 @@ func ResourceGet(c *gin.Context) {
 	configRaw, _ := resource.Config.MarshalJSON()
 	res := serializer.ResourceGetResponse{
 		ID:         resource.ID,
-		RawMessage: configRaw,
+		RawMessage: sensitive.Mask(ginx.GetResourceType(c), configRaw),
 	}
 	ginx.SuccessJSONResponse(c, res)
 }
@@ -363,7 +366,7 @@ func ResourceDelete(c *gin.Context) {
 //	@Param		gateway_name	path	string								true	"网关名称"
 //	@Param		resource_type	path	constant.ResourcePath				true	"资源类型"
 //	@Param		request			body	serializer.ResourcePublishRequest	true	"资源删除参数"
-//	@Success	201
+//	@Success	201	{object}	common.ChangeRequestOutputInfo	"开启发布审批时返回发布变更请求"
 //	@Router		/api/v1/open/gateways/{gateway_name}/resources/{resource_type}/publish/ [post]
 func ResourcePublish(c *gin.Context) {
 	var req serializer.ResourcePublishRequest
@@ -371,12 +374,13 @@ func ResourcePublish(c *gin.Context) {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
-	err := publishbiz.PublishResource(c.Request.Context(), ginx.GetResourceType(c), req.IDs)
-	if err != nil {
-		ginx.SystemErrorJSONResponse(c, err)
-		return
-	}
-	ginx.SuccessCreateResponse(c)
+	ctx := maintenancebiz.WithOverride(c.Request.Context(), req.OverrideMaintenanceWindow)
+	ctx = releasebiz.WithMeta(ctx, releasebiz.Meta{
+		Trigger:   constant.ReleaseTriggerOpen,
+		Changelog: req.Changelog,
+	})
+	changeRequest, err := changerequestbiz.PublishWithPolicy(ctx, ginx.GetResourceType(c), req.IDs)
+	publishResponse(c, changeRequest, err)
 }
 
 // ResourceImport ...
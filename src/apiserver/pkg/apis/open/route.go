@@ -37,8 +37,20 @@ func RegisterOpenApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.PUT("/:gateway_name/", handler.GatewayUpdate)
 	gatewayGroup.DELETE("/:gateway_name/", handler.GatewayDelete)
 	gatewayGroup.POST("/:gateway_name/publish/", handler.GatewayPublish)
+	// release version
+	gatewayGroup.GET("/:gateway_name/release_versions/", handler.ReleaseVersionList)
+	gatewayGroup.GET("/:gateway_name/release_versions/:version_id/", handler.ReleaseVersionGet)
+	gatewayGroup.GET("/:gateway_name/release_versions/:version_id/diff/", handler.ReleaseVersionDiff)
+	gatewayGroup.POST("/:gateway_name/release_versions/:version_id/rollback/", handler.ReleaseVersionRollback)
+	// change request
+	gatewayGroup.GET("/:gateway_name/change_requests/", handler.ChangeRequestList)
+	gatewayGroup.GET("/:gateway_name/change_requests/:change_request_id/", handler.ChangeRequestGet)
+	gatewayGroup.POST("/:gateway_name/change_requests/:change_request_id/comment/", handler.ChangeRequestComment)
+	gatewayGroup.POST("/:gateway_name/change_requests/:change_request_id/apply/", handler.ChangeRequestApply)
 	// resource import
 	gatewayGroup.POST("/:gateway_name/resources/-/import/", handler.ResourceImport)
+	// resource apply
+	gatewayGroup.POST("/:gateway_name/resources/-/apply/", handler.ResourceApply)
 
 	// resource
 	resourceGroup := gatewayGroup.Group("/:gateway_name/resources")
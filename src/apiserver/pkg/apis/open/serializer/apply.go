@@ -0,0 +1,41 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/common"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+)
+
+// ResourceApplyRequest 声明式 apply 请求
+type ResourceApplyRequest struct {
+	dto.ApplyRequest
+	DryRun    bool   `json:"dry_run"`                      // 仅返回计划，不写入编辑区
+	Publish   bool   `json:"publish"`                      // apply 后发布本次写入编辑区的资源
+	Changelog string `json:"changelog" binding:"max=1024"` // 发布时的变更说明
+	// 发布时是否在维护窗口外强制发布
+	OverrideMaintenanceWindow bool `json:"override_maintenance_window"`
+}
+
+// ResourceApplyResponse 声明式 apply 结果
+type ResourceApplyResponse struct {
+	Plan *dto.ApplyPlan `json:"plan"`
+	// 发布时网关开启发布审批，返回按资源类型创建的发布变更请求
+	ChangeRequests []common.ChangeRequestOutputInfo `json:"change_requests,omitempty"`
+}
@@ -0,0 +1,28 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+// ChangeRequestOperateRequest 发布变更请求评论/执行参数
+//
+// openapi 使用网关 token 认证，没有用户身份，不记录操作人；审批与驳回仅支持在 web 中操作
+type ChangeRequestOperateRequest struct {
+	Comment string `json:"comment" binding:"max=1024"` // 评论内容
+	// 执行发布时是否在维护窗口外强制发布
+	OverrideMaintenanceWindow bool `json:"override_maintenance_window"`
+}
@@ -24,3 +24,10 @@ type GatewayCreateResponse struct {
 	ID    int    `json:"id"`
 	Token string `json:"token"`
 }
+
+// GatewayPublishRequest ...
+type GatewayPublishRequest struct {
+	Changelog string `json:"changelog" binding:"max=1024"` // 变更说明
+	// 是否在维护窗口外强制发布
+	OverrideMaintenanceWindow bool `json:"override_maintenance_window"`
+}
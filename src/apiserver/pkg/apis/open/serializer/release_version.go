@@ -0,0 +1,72 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// ReleaseVersionPathParam 发布版本路径参数
+type ReleaseVersionPathParam struct {
+	VersionID int64 `uri:"version_id" binding:"required"`
+}
+
+// ReleaseVersionDiffRequest 发布版本对比请求
+type ReleaseVersionDiffRequest struct {
+	// 对比的基准版本 ID，不传时与当前 etcd 生效的配置对比
+	BaseVersionID int64 `form:"base_version_id" json:"base_version_id"`
+}
+
+// ReleaseVersionRollbackRequest 发布版本回滚请求
+type ReleaseVersionRollbackRequest struct {
+	Changelog string `json:"changelog" binding:"max=1024"` // 变更说明
+}
+
+// ReleaseVersionResponse 发布版本响应
+type ReleaseVersionResponse struct {
+	ID             int64                   `json:"id"`
+	Version        string                  `json:"version"`
+	TriggerSource  constant.ReleaseTrigger `json:"trigger_source"`
+	Changelog      string                  `json:"changelog"`
+	ResourceCount  int                     `json:"resource_count"`
+	RollbackFromID int64                   `json:"rollback_from_id"`
+	Creator        string                  `json:"creator"`
+	CreatedAt      int64                   `json:"created_at"`
+}
+
+// ReleaseVersionDetailResponse 发布版本详情响应（包含快照资源）
+type ReleaseVersionDetailResponse struct {
+	ReleaseVersionResponse
+	Resources []model.ReleaseResource `json:"resources"`
+}
+
+// NewReleaseVersionResponse 将模型转换为发布版本响应
+func NewReleaseVersionResponse(version *model.GatewayReleaseVersion) ReleaseVersionResponse {
+	return ReleaseVersionResponse{
+		ID:             version.ID,
+		Version:        version.Version,
+		TriggerSource:  version.TriggerSource,
+		Changelog:      version.Changelog,
+		ResourceCount:  version.ResourceCount,
+		RollbackFromID: version.RollbackFromID,
+		Creator:        version.Creator,
+		CreatedAt:      version.CreatedAt.Unix(),
+	}
+}
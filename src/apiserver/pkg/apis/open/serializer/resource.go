@@ -48,6 +48,7 @@ type ResourceAssociateID struct {
 	UpstreamID     string `json:"upstream_id" validate:"upstreamID"`          // 上游服务地址 ID
 	PluginConfigID string `json:"plugin_config_id" validate:"pluginConfigID"` // 插件配置 groupID
 	GroupID        string `json:"group_id" validate:"groupID"`
+	ConsumerID     string `json:"consumer_id" validate:"consumerID"` // 凭证所属消费者 ID
 }
 
 // ResourceBatchCreateRequest 资源批量创建
@@ -71,7 +72,10 @@ type ResourceBatchGetResponse struct {
 
 // ResourcePublishRequest ...
 type ResourcePublishRequest struct {
-	IDs []string `json:"ids" binding:"required"`
+	IDs       []string `json:"ids" binding:"required"`
+	Changelog string   `json:"changelog" binding:"max=1024"` // 变更说明
+	// 是否在维护窗口外强制发布
+	OverrideMaintenanceWindow bool `json:"override_maintenance_window"`
 }
 
 // ResourceGetResponse 单个资源响应
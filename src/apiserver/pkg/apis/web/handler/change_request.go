@@ -0,0 +1,305 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/common"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	changerequestbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/changerequest"
+	maintenancebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/maintenance"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// PublishPolicyGet 获取网关发布审批策略
+//
+//	@ID			publish_policy_get
+//	@Summary	获取网关发布审批策略
+//	@Produce	json
+//	@Tags		webapi.change_request
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Success	200			{object}	ginx.Response{data=serializer.PublishPolicyOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/publish_policy/ [get]
+func PublishPolicyGet(c *gin.Context) {
+	policy, err := changerequestbiz.GetPublishPolicy(c.Request.Context(), ginx.GetGatewayInfo(c).ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.PublishPolicyToOutputInfo(policy))
+}
+
+// PublishPolicyUpdate 更新网关发布审批策略
+//
+//	@ID			publish_policy_update
+//	@Summary	更新网关发布审批策略
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.change_request
+//	@Param		gateway_id	path		int								true	"网关 ID"
+//	@Param		request		body		serializer.PublishPolicyRequest	true	"发布审批策略"
+//	@Success	200			{object}	ginx.Response{data=serializer.PublishPolicyOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/publish_policy/ [put]
+func PublishPolicyUpdate(c *gin.Context) {
+	var req serializer.PublishPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	policy := &model.GatewayPublishPolicy{
+		GatewayID:         ginx.GetGatewayInfo(c).ID,
+		Enabled:           req.Enabled,
+		RequiredApprovals: req.RequiredApprovals,
+		Reviewers:         lo.Uniq(req.Reviewers),
+		ExpireHours:       req.ExpireHours,
+		BaseModel: model.BaseModel{
+			Creator: ginx.GetUserID(c),
+			Updater: ginx.GetUserID(c),
+		},
+	}
+	if err := changerequestbiz.SavePublishPolicy(c.Request.Context(), policy); err != nil {
+		if errors.Is(err, changerequestbiz.ErrInvalidPublishPolicy) {
+			ginx.BadRequestErrorJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.PublishPolicyToOutputInfo(policy))
+}
+
+// ChangeRequestList 发布变更请求列表
+//
+//	@ID			change_request_list
+//	@Summary	发布变更请求列表
+//	@Produce	json
+//	@Tags		webapi.change_request
+//	@Param		gateway_id	path		int									true	"网关 ID"
+//	@Param		request		query		common.ChangeRequestListRequest	false	"查询参数"
+//	@Param		offset		query		int									false	"offset"
+//	@Param		limit		query		int									false	"limit"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=[]common.ChangeRequestOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/change_requests/ [get]
+func ChangeRequestList(c *gin.Context) {
+	var req common.ChangeRequestListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	changeRequests, total, err := changerequestbiz.ListChangeRequests(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c).ID,
+		req.Status,
+		utils.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make([]common.ChangeRequestOutputInfo, 0, len(changeRequests))
+	for _, changeRequest := range changeRequests {
+		results = append(results, common.ChangeRequestToOutputInfo(changeRequest))
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// ChangeRequestGet 发布变更请求详情
+//
+//	@ID			change_request_get
+//	@Summary	发布变更请求详情
+//	@Produce	json
+//	@Tags		webapi.change_request
+//	@Param		gateway_id			path		int	true	"网关 ID"
+//	@Param		change_request_id	path		int	true	"变更请求 ID"
+//	@Success	200					{object}	ginx.Response{data=common.ChangeRequestDetailOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/change_requests/{change_request_id}/ [get]
+func ChangeRequestGet(c *gin.Context) {
+	changeRequest, ok := getChangeRequest(c)
+	if !ok {
+		return
+	}
+	changeRequestDetailResponse(c, changeRequest)
+}
+
+// ChangeRequestApprove 审批通过发布变更请求，通过人数达到要求后自动发布
+//
+//	@ID			change_request_approve
+//	@Summary	审批通过发布变更请求
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.change_request
+//	@Param		gateway_id			path		int									true	"网关 ID"
+//	@Param		change_request_id	path		int									true	"变更请求 ID"
+//	@Param		request				body		common.ChangeRequestReviewRequest	false	"审批意见"
+//	@Success	200					{object}	ginx.Response{data=common.ChangeRequestDetailOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/change_requests/{change_request_id}/approve/ [post]
+func ChangeRequestApprove(c *gin.Context) {
+	changeRequestReview(c, changerequestbiz.ApproveChangeRequest)
+}
+
+// ChangeRequestReject 驳回发布变更请求
+//
+//	@ID			change_request_reject
+//	@Summary	驳回发布变更请求
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.change_request
+//	@Param		gateway_id			path		int									true	"网关 ID"
+//	@Param		change_request_id	path		int									true	"变更请求 ID"
+//	@Param		request				body		common.ChangeRequestReviewRequest	false	"驳回原因"
+//	@Success	200					{object}	ginx.Response{data=common.ChangeRequestDetailOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/change_requests/{change_request_id}/reject/ [post]
+func ChangeRequestReject(c *gin.Context) {
+	changeRequestReview(c, changerequestbiz.RejectChangeRequest)
+}
+
+// ChangeRequestComment 评论发布变更请求
+//
+//	@ID			change_request_comment
+//	@Summary	评论发布变更请求
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.change_request
+//	@Param		gateway_id			path		int									true	"网关 ID"
+//	@Param		change_request_id	path		int									true	"变更请求 ID"
+//	@Param		request				body		common.ChangeRequestReviewRequest	true	"评论内容"
+//	@Success	200					{object}	ginx.Response{data=common.ChangeRequestDetailOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/change_requests/{change_request_id}/comment/ [post]
+func ChangeRequestComment(c *gin.Context) {
+	changeRequestReview(c, changerequestbiz.CommentChangeRequest)
+}
+
+// ChangeRequestApply 重新执行已审批通过的发布变更请求
+//
+//	@ID			change_request_apply
+//	@Summary	执行发布变更请求
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.change_request
+//	@Param		gateway_id			path		int									true	"网关 ID"
+//	@Param		change_request_id	path		int									true	"变更请求 ID"
+//	@Param		request				body		common.ChangeRequestApplyRequest	false	"执行参数"
+//	@Success	200					{object}	ginx.Response{data=common.ChangeRequestDetailOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/change_requests/{change_request_id}/apply/ [post]
+func ChangeRequestApply(c *gin.Context) {
+	var req common.ChangeRequestApplyRequest
+	// 兼容不传请求体的调用
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	changeRequest, ok := getChangeRequest(c)
+	if !ok {
+		return
+	}
+	ctx, ok := withMaintenanceOverride(c.Request.Context(), c, req.OverrideMaintenanceWindow)
+	if !ok {
+		return
+	}
+	if err := changerequestbiz.ApplyChangeRequest(ctx, changeRequest); err != nil {
+		changeRequestErrorResponse(c, err)
+		return
+	}
+	changeRequestDetailResponse(c, changeRequest)
+}
+
+// getChangeRequest 根据路径参数查询当前网关的发布变更请求，失败时直接返回错误响应
+func getChangeRequest(c *gin.Context) (*model.GatewayChangeRequest, bool) {
+	var pathParam common.ChangeRequestPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return nil, false
+	}
+	changeRequest, err := changerequestbiz.GetChangeRequest(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c).ID,
+		pathParam.ChangeRequestID,
+	)
+	if err != nil {
+		changeRequestErrorResponse(c, err)
+		return nil, false
+	}
+	return changeRequest, true
+}
+
+// changeRequestReview 审批/驳回/评论发布变更请求
+func changeRequestReview(
+	c *gin.Context,
+	review func(ctx context.Context, changeRequest *model.GatewayChangeRequest, comment string) error,
+) {
+	var req common.ChangeRequestReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	changeRequest, ok := getChangeRequest(c)
+	if !ok {
+		return
+	}
+	if err := review(c.Request.Context(), changeRequest, req.Comment); err != nil {
+		changeRequestErrorResponse(c, err)
+		return
+	}
+	changeRequestDetailResponse(c, changeRequest)
+}
+
+// changeRequestDetailResponse 返回发布变更请求详情
+func changeRequestDetailResponse(c *gin.Context, changeRequest *model.GatewayChangeRequest) {
+	diff, err := changerequestbiz.GetChangeRequestDiff(changeRequest)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	events, err := changerequestbiz.ListChangeRequestEvents(c.Request.Context(), changeRequest.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, common.ChangeRequestToDetailOutputInfo(changeRequest, diff, events))
+}
+
+// changeRequestErrorResponse 将发布变更请求的错误转换为响应
+func changeRequestErrorResponse(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, changerequestbiz.ErrChangeRequestNotFound):
+		ginx.NotFoundJSONResponse(c, err)
+	case errors.Is(err, changerequestbiz.ErrNotReviewer), errors.Is(err, changerequestbiz.ErrSelfApproval):
+		ginx.ForbiddenJSONResponse(c, err)
+	case errors.Is(err, changerequestbiz.ErrChangeRequestNotPending),
+		errors.Is(err, changerequestbiz.ErrChangeRequestNotApproved),
+		errors.Is(err, changerequestbiz.ErrAlreadyApproved),
+		errors.Is(err, changerequestbiz.ErrChangeRequestStale):
+		ginx.ConflictJSONResponse(c, err)
+	case errors.Is(err, maintenancebiz.ErrOutsideMaintenanceWindow):
+		ginx.ForbiddenJSONResponse(c, err)
+	default:
+		ginx.SystemErrorJSONResponse(c, err)
+	}
+}
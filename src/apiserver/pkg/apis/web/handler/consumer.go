@@ -34,6 +34,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -193,7 +194,7 @@ func ConsumerList(c *gin.Context) {
 				ID:      consumer.ID,
 				Name:    consumer.Username,
 				GroupID: consumer.GroupID,
-				Config:  json.RawMessage(consumer.Config),
+				Config:  json.RawMessage(sensitive.Mask(constant.Consumer, consumer.Config)),
 			},
 			Status:    consumer.Status,
 			CreatedAt: consumer.CreatedAt.Unix(),
@@ -234,7 +235,7 @@ func ConsumerGet(c *gin.Context) {
 			ID:      consumer.ID,
 			Name:    consumer.Username,
 			GroupID: consumer.GroupID,
-			Config:  json.RawMessage(consumer.Config),
+			Config:  json.RawMessage(sensitive.Mask(constant.Consumer, consumer.Config)),
 		},
 		CreatedAt: consumer.CreatedAt.Unix(),
 		UpdatedAt: consumer.UpdatedAt.Unix(),
@@ -33,6 +33,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -194,7 +195,7 @@ func ConsumerGroupList(c *gin.Context) {
 			ConsumerGroupInfo: serializer.ConsumerGroupInfo{
 				ID:     consumerGroup.ID,
 				Name:   consumerGroup.Name,
-				Config: json.RawMessage(consumerGroup.Config),
+				Config: json.RawMessage(sensitive.Mask(constant.ConsumerGroup, consumerGroup.Config)),
 			},
 			Status:    consumerGroup.Status,
 			CreatedAt: consumerGroup.CreatedAt.Unix(),
@@ -234,7 +235,7 @@ func ConsumerGroupGet(c *gin.Context) {
 		ConsumerGroupInfo: serializer.ConsumerGroupInfo{
 			ID:     consumerGroup.ID,
 			Name:   consumerGroup.Name,
-			Config: json.RawMessage(consumerGroup.Config),
+			Config: json.RawMessage(sensitive.Mask(constant.ConsumerGroup, consumerGroup.Config)),
 		},
 		CreatedAt: consumerGroup.CreatedAt.Unix(),
 		UpdatedAt: consumerGroup.UpdatedAt.Unix(),
@@ -35,6 +35,7 @@ import (
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
@@ -47,6 +48,7 @@ func initWebCreateHandlerTestEnv() {
 	webCreateHandlerTestOnce.Do(func() {
 		gin.SetMode(gin.TestMode)
 		util.InitEmbedDb()
+		_ = cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
 		validation.RegisterValidator()
 	})
 }
@@ -165,6 +167,108 @@ func TestConsumerGroupCreateCurrentBehavior(t *testing.T) {
 	assert.Equal(t, name, gjson.GetBytes(created.Config, "name").String())
 }
 
+func TestCredentialCreateCurrentBehavior(t *testing.T) {
+	initWebCreateHandlerTestEnv()
+
+	gateway := &model.Gateway{ID: 2106, APISIXVersion: "3.13.0"}
+	userID := "credential-tester"
+	ctx := ginx.SetGatewayInfoToContext(t.Context(), gateway)
+	consumer := data.Consumer1WithNoRelation(gateway, constant.ResourceStatusSuccess)
+	consumer.Username = uniqueWebCreateName("credential-consumer")
+	assert.NoError(t, resourcebiz.CreateConsumer(ctx, *consumer))
+
+	name := uniqueWebCreateName("credential")
+	body := mustJSONBody(t, map[string]any{
+		"name":        name,
+		"consumer_id": consumer.ID,
+		"config": map[string]any{
+			"plugins": map[string]any{
+				"key-auth": map[string]any{"key": "credential-key"},
+			},
+		},
+	})
+
+	c, w := newWebCreateTestContext(t, body, gateway, userID)
+	CredentialCreate(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	items, err := resourcebiz.QueryCredentials(c.Request.Context(), map[string]any{"name": name})
+	assert.NoError(t, err)
+	if !assert.Len(t, items, 1) {
+		return
+	}
+
+	created := items[0]
+	assert.Equal(t, constant.ResourceStatusCreateDraft, created.Status)
+	assert.Equal(t, consumer.ID, created.ConsumerID)
+	assert.Equal(t, created.ID, gjson.GetBytes(created.Config, "id").String())
+	assert.Equal(t, consumer.ID, gjson.GetBytes(created.Config, "consumer_id").String())
+
+	// 所属 consumer 不存在时拒绝创建
+	body = mustJSONBody(t, map[string]any{
+		"name":        uniqueWebCreateName("credential"),
+		"consumer_id": "not-exist-consumer",
+		"config": map[string]any{
+			"plugins": map[string]any{
+				"key-auth": map[string]any{"key": "credential-key"},
+			},
+		},
+	})
+	c, w = newWebCreateTestContext(t, body, gateway, userID)
+	CredentialCreate(c)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSecretCreateCurrentBehavior(t *testing.T) {
+	initWebCreateHandlerTestEnv()
+
+	gateway := &model.Gateway{ID: 2107, APISIXVersion: "3.13.0"}
+	userID := "secret-tester"
+
+	name := uniqueWebCreateName("secret")
+	body := mustJSONBody(t, map[string]any{
+		"name":    name,
+		"manager": "vault",
+		"config": map[string]any{
+			"uri":    "http://127.0.0.1:8200",
+			"prefix": "kv/apisix",
+			"token":  "vault-token",
+		},
+	})
+
+	c, w := newWebCreateTestContext(t, body, gateway, userID)
+	SecretCreate(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	items, err := resourcebiz.QuerySecrets(c.Request.Context(), map[string]any{"name": name})
+	assert.NoError(t, err)
+	if !assert.Len(t, items, 1) {
+		return
+	}
+
+	created := items[0]
+	assert.Equal(t, constant.ResourceStatusCreateDraft, created.Status)
+	assert.Equal(t, "vault", created.Manager)
+	assert.Equal(t, created.ID, gjson.GetBytes(created.Config, "id").String())
+	assert.Equal(t, "vault", gjson.GetBytes(created.Config, "manager").String())
+
+	// 配置不满足对应密钥管理器的 schema 时拒绝创建
+	body = mustJSONBody(t, map[string]any{
+		"name":    uniqueWebCreateName("secret"),
+		"manager": "aws",
+		"config": map[string]any{
+			"uri":    "http://127.0.0.1:8200",
+			"prefix": "kv/apisix",
+			"token":  "vault-token",
+		},
+	})
+	c, w = newWebCreateTestContext(t, body, gateway, userID)
+	SecretCreate(c)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
 func TestGlobalRuleCreateCurrentBehavior(t *testing.T) {
 	initWebCreateHandlerTestEnv()
 
@@ -0,0 +1,324 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
+)
+
+// CredentialCreate ...
+//
+//	@ID			credential_create
+//	@Summary	credential 创建
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.credential
+//	@Param		gateway_id	path	int								true	"网关 ID"
+//	@Param		request		body	serializer.CredentialInfo	true	"credential 创建参数"
+//	@Success	201
+//	@Router		/api/v1/web/gateways/{gateway_id}/credentials/ [post]
+func CredentialCreate(c *gin.Context) {
+	var req serializer.CredentialInfo
+	if err := bindAndValidateWebCreateWithGeneratedID(
+		c,
+		&req,
+		constant.Credential,
+		func(resourceID string) { req.ID = resourceID },
+	); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	credential := model.Credential{
+		Name:                req.Name,
+		ConsumerID:          req.ConsumerID,
+		ResourceCommonModel: buildWebCreateDraft(c, req.ID, req.Config),
+	}
+
+	if err := resourcebiz.CreateCredential(c.Request.Context(), credential); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateResponse(c)
+}
+
+// CredentialUpdate ...
+//
+//	@ID			credential_update
+//	@Summary	credential 更新
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.credential
+//	@Param		gateway_id	path	int								true	"网关 ID"	@Param	id	path	string	true	"credential ID"
+//	@Param		request		body	serializer.CredentialInfo	true	"credential 更新参数"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/credentials/{id}/ [put]
+func CredentialUpdate(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+
+	req := serializer.CredentialInfo{ID: pathParam.ID}
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+
+	// if resource not changed (config and extra fields), return success directly
+	if !resourcebiz.IsResourceChanged(
+		c.Request.Context(),
+		constant.Credential,
+		pathParam.ID,
+		req.Config,
+		map[string]any{
+			"name":        req.Name,
+			"consumer_id": req.ConsumerID,
+		},
+	) {
+		ginx.SuccessNoContentResponse(c)
+		return
+	}
+
+	updateStatus, err := resourcebiz.GetResourceUpdateStatus(
+		c.Request.Context(),
+		constant.Credential,
+		pathParam.ID,
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+
+	credential := model.Credential{
+		Name:       req.Name,
+		ConsumerID: req.ConsumerID,
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID:        pathParam.ID,
+			GatewayID: pathParam.GatewayID,
+			Config:    datatypes.JSON(req.Config),
+			Status:    updateStatus,
+			BaseModel: model.BaseModel{
+				Updater: ginx.GetUserID(c),
+			},
+		},
+	}
+
+	if err := resourcebiz.UpdateCredential(c.Request.Context(), credential); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// CredentialList ...
+//
+//	@ID			credential_list
+//	@Summary	credential 列表
+//	@Produce	json
+//	@Tags		webapi.credential
+//	@Param		gateway_id	path		int									true	"网关 ID"
+//	@Param		request		query		serializer.CredentialListRequest	false	"查询参数"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=serializer.CredentialListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/credentials/ [get]
+func CredentialList(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.CredentialListRequest
+	if err := c.ShouldBind(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	labelMap, err := serializer.CheckLabel(req.Label)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	queryParam := map[string]any{}
+	if req.ID != "" {
+		queryParam["id"] = req.ID
+	}
+	if req.ConsumerID != "" {
+		queryParam["consumer_id"] = req.ConsumerID
+	}
+	credentials, total, err := resourcebiz.ListPagedCredentials(
+		c.Request.Context(),
+		queryParam,
+		labelMap,
+		strings.Split(req.Status, ","),
+		req.Name,
+		req.Updater,
+		req.OrderBy,
+		utils.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	var results serializer.CredentialListResponse
+	for _, credential := range credentials {
+		results = append(results, serializer.CredentialOutputInfo{
+			AutoID:    credential.AutoID,
+			ID:        credential.ID,
+			GatewayID: credential.GatewayID,
+			CredentialInfo: serializer.CredentialInfo{
+				ID:         credential.ID,
+				Name:       credential.Name,
+				ConsumerID: credential.ConsumerID,
+				Config:     json.RawMessage(sensitive.Mask(constant.Credential, credential.Config)),
+			},
+			Status:    credential.Status,
+			CreatedAt: credential.CreatedAt.Unix(),
+			UpdatedAt: credential.UpdatedAt.Unix(),
+			Creator:   credential.Creator,
+			Updater:   credential.Updater,
+		})
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// CredentialGet ...
+//
+//	@ID			credential_get
+//	@Summary	credential 详情
+//	@Produce	json
+//	@Tags		webapi.credential
+//	@Param		gateway_id	path		int		true	"网关 id"
+//	@Param		id			path		string	true	"资源 ID"
+//	@Success	200			{object}	serializer.CredentialOutputInfo
+//	@Router		/api/v1/web/gateways/{gateway_id}/credentials/{id}/ [get]
+func CredentialGet(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	credential, err := resourcebiz.GetCredential(c.Request.Context(), pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	output := serializer.CredentialOutputInfo{
+		AutoID:    credential.AutoID,
+		ID:        credential.ID,
+		GatewayID: credential.GatewayID,
+		CredentialInfo: serializer.CredentialInfo{
+			ID:         credential.ID,
+			Name:       credential.Name,
+			ConsumerID: credential.ConsumerID,
+			Config:     json.RawMessage(sensitive.Mask(constant.Credential, credential.Config)),
+		},
+		CreatedAt: credential.CreatedAt.Unix(),
+		UpdatedAt: credential.UpdatedAt.Unix(),
+		Creator:   credential.Creator,
+		Updater:   credential.Updater,
+		Status:    credential.Status,
+	}
+	ginx.SuccessJSONResponse(c, output)
+}
+
+// CredentialDelete ...
+//
+//	@ID			credential_delete
+//	@Summary	credential 删除
+//	@Produce	json
+//	@Tags		webapi.credential
+//	@Param		gateway_id	path	int		true	"网关 id"
+//	@Param		id			path	string	true	"资源 ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/credentials/{id}/ [delete]
+func CredentialDelete(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	credential, err := resourcebiz.GetCredential(c.Request.Context(), pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	// create_draft 状态可以直接删除
+	if credential.Status == constant.ResourceStatusCreateDraft {
+		err = resourcebiz.BatchDeleteCredentials(c.Request.Context(), []string{credential.ID})
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		ginx.SuccessNoContentResponse(c)
+		return
+	}
+	err = resourcebiz.UpdateResourceStatusWithAuditLog(c.Request.Context(),
+		constant.Credential, credential.ID, constant.ResourceStatusDeleteDraft)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// CredentialDropDownList ...
+//
+//	@ID			credential_dropdown_list
+//	@Summary	credential 下拉列表
+//	@Produce	json
+//	@Tags		webapi.credential
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=serializer.CredentialDropDownListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/credentials-dropdown/ [get]
+func CredentialDropDownList(c *gin.Context) {
+	credentials, err := resourcebiz.ListCredentials(c.Request.Context())
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+
+	var output serializer.CredentialDropDownListResponse
+	for _, credential := range credentials {
+		desc := gjson.ParseBytes(credential.Config).Get("desc").String()
+		output = append(output, serializer.CredentialDropDownOutputInfo{
+			AutoID:     credential.AutoID,
+			ID:         credential.ID,
+			Name:       credential.Name,
+			ConsumerID: credential.ConsumerID,
+			Desc:       desc,
+		})
+	}
+	ginx.SuccessJSONResponse(c, output)
+}
@@ -0,0 +1,71 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	dataplanebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/dataplane"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// DataPlaneInstanceList 数据面实例清单
+//
+//	@ID			data_plane_instance_list
+//	@Summary	数据面实例清单：从 etcd 刷新上报到 /data_plane/server_info 的 APISIX 实例，并给出失联、版本不一致、revision 落后告警
+//	@Produce	json
+//	@Tags		webapi.data_plane
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Success	200			{object}	ginx.Response{data=serializer.DataPlaneInstanceListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/data_plane/instances/ [get]
+func DataPlaneInstanceList(c *gin.Context) {
+	gateway := ginx.GetGatewayInfo(c)
+	output := serializer.DataPlaneInstanceListResponse{}
+	inventory, err := dataplanebiz.Refresh(c.Request.Context(), gateway)
+	if err != nil {
+		// etcd 不可用时返回上一次刷新记录的清单
+		logging.WarnFWithCtx(c.Request.Context(), "refresh data plane instances of gateway %s err: %s",
+			gateway.Name, err.Error())
+		output.RefreshError = err.Error()
+		inventory, err = dataplanebiz.Get(c.Request.Context(), gateway, 0)
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+	}
+	output.APISIXVersion = inventory.APISIXVersion
+	output.LatestRevision = inventory.LatestRevision
+	output.Instances = make([]serializer.DataPlaneInstanceOutputInfo, 0, len(inventory.Instances))
+	for _, instance := range inventory.Instances {
+		output.Instances = append(output.Instances, serializer.DataPlaneInstanceOutputInfo{
+			InstanceID:      instance.InstanceID,
+			Hostname:        instance.Hostname,
+			Version:         instance.Version,
+			BootTime:        instance.BootTime,
+			LastHeartbeatAt: instance.LastHeartbeatAt.Unix(),
+			Revision:        instance.Revision,
+			Online:          instance.Online,
+			Stale:           instance.Stale(),
+			Warnings:        instance.Warnings,
+		})
+	}
+	ginx.SuccessJSONResponse(c, output)
+}
@@ -0,0 +1,82 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	driftbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/drift"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// DriftRecordList 配置漂移记录列表
+//
+//	@ID			drift_record_list
+//	@Summary	配置漂移记录列表
+//	@Produce	json
+//	@Tags		webapi.drift
+//	@Param		gateway_id	path		int								true	"网关 ID"
+//	@Param		request		query		serializer.DriftRecordListRequest	false	"查询参数"
+//	@Param		offset		query		int								false	"offset"
+//	@Param		limit		query		int								false	"limit"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=[]serializer.DriftRecordOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/drifts/ [get]
+func DriftRecordList(c *gin.Context) {
+	var pathParam serializer.DriftRecordPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.DriftRecordListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	records, total, err := driftbiz.ListDriftRecords(
+		c.Request.Context(),
+		pathParam.GatewayID,
+		map[string]any{
+			"status": string(req.Status),
+			"type":   string(req.ResourceType),
+			"name":   req.Name,
+		},
+		utils.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make([]serializer.DriftRecordOutputInfo, 0, len(records))
+	for _, record := range records {
+		output := serializer.DriftRecordOutputInfo{
+			DriftResource: driftbiz.ToDriftResource(record),
+			Status:        record.Status,
+		}
+		if record.ResolvedAt != nil {
+			output.ResolvedAt = record.ResolvedAt.Unix()
+		}
+		results = append(results, output)
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
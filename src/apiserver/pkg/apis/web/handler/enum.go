@@ -41,14 +41,30 @@ func Enum(c *gin.Context) {
 		resourceTypeOrderedMap.Set(resType.String(), constant.ResourceTypeMap[resType])
 	}
 	constants := map[string]any{
-		"gateway_mode":           constant.GatewayModeMap,
-		"resource_status":        constant.ResourceStatusMap,
-		"synced_resource_status": constant.SyncedResourceStatusMap,
-		"upload_status":          constant.UploadResourceStatusMap,
-		"apisix_type":            constant.APISIXTypeMap,
-		"resource_type":          resourceTypeOrderedMap,
-		"operation_type":         constant.OperationTypeMap,
-		"support_apisix_version": schema.GetSupportVersionMap(),
+		"gateway_mode":               constant.GatewayModeMap,
+		"resource_status":            constant.ResourceStatusMap,
+		"synced_resource_status":     constant.SyncedResourceStatusMap,
+		"upload_status":              constant.UploadResourceStatusMap,
+		"apisix_type":                constant.APISIXTypeMap,
+		"resource_type":              resourceTypeOrderedMap,
+		"operation_type":             constant.OperationTypeMap,
+		"gateway_role":               constant.GatewayRoleMap,
+		"change_request_status":      constant.ChangeRequestStatusMap,
+		"scheduled_publish_status":   constant.ScheduledPublishStatusMap,
+		"task_status":                constant.TaskStatusMap,
+		"group_rollout_status":       constant.GroupRolloutStatusMap,
+		"group_rollout_stage_status": constant.GroupRolloutStageStatusMap,
+		"promotion_action":           constant.PromotionActionMap,
+		"export_state":               constant.ExportStateMap,
+		"export_format":              constant.ExportFormatMap,
+		"import_format":              constant.ImportFormatMap,
+		"openapi_format":             constant.OpenAPIFormatMap,
+		"apply_action":               constant.ApplyActionMap,
+		"webhook_event":              constant.WebhookEventMap,
+		"webhook_delivery_status":    constant.WebhookDeliveryStatusMap,
+		"data_plane_warning":         constant.DataPlaneWarningMap,
+		"publish_journal_status":     constant.PublishJournalStatusMap,
+		"support_apisix_version":     schema.GetSupportVersionMap(),
 	}
 	ginx.SuccessJSONResponse(c, constants)
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
 	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	sslexpirybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/sslexpiry"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
@@ -189,7 +190,12 @@ func GatewayList(c *gin.Context) {
 	var output serializer.GatewayListResponse
 	for _, gateway := range gateways {
 		// 校验权限 todo: 需要优化直接在数据库层过滤
-		if !gateway.HasPermission(ginx.GetUserID(c)) {
+		role, err := gatewaybiz.GetGatewayRole(c.Request.Context(), gateway, ginx.GetUserID(c))
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		if role == "" {
 			continue
 		}
 		// 设置 gateway
@@ -209,11 +215,17 @@ func GatewayList(c *gin.Context) {
 			ginx.SystemErrorJSONResponse(c, err)
 			return
 		}
+		expiredSSLCount, err := sslexpirybiz.CountExpiredInUse(ctx, gateway.ID)
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
 		output = append(output, serializer.GatewayOutputListInfo{
 			ID:          gateway.ID,
 			Name:        gateway.Name,
 			Mode:        gateway.Mode,
 			Maintainers: gateway.Maintainers,
+			Role:        role,
 			Description: gateway.Desc,
 			APISIX: common.APISIX{
 				Version: gateway.APISIXVersion,
@@ -226,9 +238,10 @@ func GatewayList(c *gin.Context) {
 				Prefix:     gateway.EtcdConfig.Prefix,
 			},
 			Count: serializer.Count{
-				Route:    routeCount,
-				Service:  serviceCount,
-				Upstream: upstreamCount,
+				Route:      routeCount,
+				Service:    serviceCount,
+				Upstream:   upstreamCount,
+				ExpiredSSL: expiredSSLCount,
 			},
 			CreatedAt: gateway.CreatedAt.Unix(),
 			UpdatedAt: gateway.UpdatedAt.Unix(),
@@ -0,0 +1,372 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	gatewaygroupbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gatewaygroup"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// GatewayGroupList 网关组列表
+//
+//	@ID			gateway_group_list
+//	@Summary	网关组列表，仅返回有组内所有网关查看权限的网关组
+//	@Produce	json
+//	@Tags		webapi.gateway_group
+//	@Success	200	{object}	ginx.Response{data=[]serializer.GatewayGroupOutputInfo}
+//	@Router		/api/v1/web/gateway_groups/ [get]
+func GatewayGroupList(c *gin.Context) {
+	groups, err := gatewaygroupbiz.ListGatewayGroups(c.Request.Context(), ginx.GetUserID(c))
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make([]serializer.GatewayGroupOutputInfo, 0, len(groups))
+	for _, group := range groups {
+		members, err := gatewaygroupbiz.ListGatewayGroupMembers(c.Request.Context(), group.ID)
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		results = append(results, serializer.GatewayGroupToOutputInfo(group, members))
+	}
+	ginx.SuccessJSONResponse(c, results)
+}
+
+// GatewayGroupCreate 创建网关组
+//
+//	@ID			gateway_group_create
+//	@Summary	创建网关组，需要组内所有网关的管理权限
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.gateway_group
+//	@Param		request	body		serializer.GatewayGroupRequest	true	"网关组参数"
+//	@Success	201		{object}	ginx.Response{data=serializer.GatewayGroupOutputInfo}
+//	@Router		/api/v1/web/gateway_groups/ [post]
+func GatewayGroupCreate(c *gin.Context) {
+	var req serializer.GatewayGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	group := &model.GatewayGroup{
+		BaseModel: model.BaseModel{
+			Creator: ginx.GetUserID(c),
+		},
+	}
+	members := fillGatewayGroup(c, group, req)
+	err := gatewaygroupbiz.CheckGatewayGroupPermission(c.Request.Context(), members, ginx.GetUserID(c),
+		constant.GatewayPermissionManage)
+	if err != nil {
+		gatewayGroupErrorResponse(c, err)
+		return
+	}
+	if err := gatewaygroupbiz.CreateGatewayGroup(c.Request.Context(), group, members); err != nil {
+		gatewayGroupErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateJSONResponse(c, serializer.GatewayGroupToOutputInfo(group, members))
+}
+
+// GatewayGroupGet 网关组详情
+//
+//	@ID			gateway_group_get
+//	@Summary	网关组详情
+//	@Produce	json
+//	@Tags		webapi.gateway_group
+//	@Param		group_id	path		int	true	"网关组 ID"
+//	@Success	200			{object}	ginx.Response{data=serializer.GatewayGroupOutputInfo}
+//	@Router		/api/v1/web/gateway_groups/{group_id}/ [get]
+func GatewayGroupGet(c *gin.Context) {
+	group, members, ok := getGatewayGroup(c, constant.GatewayPermissionView)
+	if !ok {
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.GatewayGroupToOutputInfo(group, members))
+}
+
+// GatewayGroupUpdate 更新网关组
+//
+//	@ID			gateway_group_update
+//	@Summary	更新网关组，需要更新前后组内所有网关的管理权限
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.gateway_group
+//	@Param		group_id	path		int								true	"网关组 ID"
+//	@Param		request		body		serializer.GatewayGroupRequest	true	"网关组参数"
+//	@Success	200			{object}	ginx.Response{data=serializer.GatewayGroupOutputInfo}
+//	@Router		/api/v1/web/gateway_groups/{group_id}/ [put]
+func GatewayGroupUpdate(c *gin.Context) {
+	var req serializer.GatewayGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	group, _, ok := getGatewayGroup(c, constant.GatewayPermissionManage)
+	if !ok {
+		return
+	}
+	members := fillGatewayGroup(c, group, req)
+	err := gatewaygroupbiz.CheckGatewayGroupPermission(c.Request.Context(), members, ginx.GetUserID(c),
+		constant.GatewayPermissionManage)
+	if err != nil {
+		gatewayGroupErrorResponse(c, err)
+		return
+	}
+	if err := gatewaygroupbiz.UpdateGatewayGroup(c.Request.Context(), group, members); err != nil {
+		gatewayGroupErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.GatewayGroupToOutputInfo(group, members))
+}
+
+// GatewayGroupDelete 删除网关组
+//
+//	@ID			gateway_group_delete
+//	@Summary	删除网关组，不影响组内的网关
+//	@Produce	json
+//	@Tags		webapi.gateway_group
+//	@Param		group_id	path	int	true	"网关组 ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateway_groups/{group_id}/ [delete]
+func GatewayGroupDelete(c *gin.Context) {
+	group, _, ok := getGatewayGroup(c, constant.GatewayPermissionManage)
+	if !ok {
+		return
+	}
+	if err := gatewaygroupbiz.DeleteGatewayGroup(c.Request.Context(), group); err != nil {
+		gatewayGroupErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// GatewayGroupRolloutList 网关组分批发布列表
+//
+//	@ID			gateway_group_rollout_list
+//	@Summary	网关组分批发布列表
+//	@Produce	json
+//	@Tags		webapi.gateway_group
+//	@Param		group_id	path		int	true	"网关组 ID"
+//	@Param		offset		query		int	false	"offset"
+//	@Param		limit		query		int	false	"limit"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=[]serializer.GatewayGroupRolloutOutputInfo}
+//	@Router		/api/v1/web/gateway_groups/{group_id}/rollouts/ [get]
+func GatewayGroupRolloutList(c *gin.Context) {
+	group, _, ok := getGatewayGroup(c, constant.GatewayPermissionView)
+	if !ok {
+		return
+	}
+	rollouts, total, err := gatewaygroupbiz.ListRollouts(c.Request.Context(), group.ID, utils.PageParam{
+		Offset: ginx.GetOffset(c),
+		Limit:  ginx.GetLimit(c),
+	})
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make([]serializer.GatewayGroupRolloutOutputInfo, 0, len(rollouts))
+	for _, rollout := range rollouts {
+		results = append(results, serializer.GatewayGroupRolloutToOutputInfo(rollout, nil))
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// GatewayGroupRolloutCreate 创建网关组分批发布
+//
+//	@ID			gateway_group_rollout_create
+//	@Summary	创建网关组分批发布：先发布金丝雀网关的草稿资源，观察期结束且健康检查通过后依次发布其余网关，失败时自动回滚
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.gateway_group
+//	@Param		group_id	path		int											true	"网关组 ID"
+//	@Param		request		body		serializer.GatewayGroupRolloutCreateRequest	true	"分批发布参数"
+//	@Success	201			{object}	ginx.Response{data=serializer.GatewayGroupRolloutOutputInfo}
+//	@Router		/api/v1/web/gateway_groups/{group_id}/rollouts/ [post]
+func GatewayGroupRolloutCreate(c *gin.Context) {
+	var req serializer.GatewayGroupRolloutCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	group, _, ok := getGatewayGroup(c, constant.GatewayPermissionPublish)
+	if !ok {
+		return
+	}
+	rollout, err := gatewaygroupbiz.CreateRollout(c.Request.Context(), group, req.Changelog)
+	if err != nil {
+		gatewayGroupErrorResponse(c, err)
+		return
+	}
+	stages, err := gatewaygroupbiz.ListRolloutStages(c.Request.Context(), rollout.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateJSONResponse(c, serializer.GatewayGroupRolloutToOutputInfo(rollout, stages))
+}
+
+// GatewayGroupRolloutGet 网关组分批发布详情
+//
+//	@ID			gateway_group_rollout_get
+//	@Summary	网关组分批发布详情，包含各批次的发布状态
+//	@Produce	json
+//	@Tags		webapi.gateway_group
+//	@Param		group_id	path		int	true	"网关组 ID"
+//	@Param		rollout_id	path		int	true	"分批发布 ID"
+//	@Success	200			{object}	ginx.Response{data=serializer.GatewayGroupRolloutOutputInfo}
+//	@Router		/api/v1/web/gateway_groups/{group_id}/rollouts/{rollout_id}/ [get]
+func GatewayGroupRolloutGet(c *gin.Context) {
+	rollout, ok := getGatewayGroupRollout(c, constant.GatewayPermissionView)
+	if !ok {
+		return
+	}
+	gatewayGroupRolloutResponse(c, rollout)
+}
+
+// GatewayGroupRolloutCancel 取消网关组分批发布
+//
+//	@ID			gateway_group_rollout_cancel
+//	@Summary	取消网关组分批发布，已开始发布的网关将被回滚
+//	@Produce	json
+//	@Tags		webapi.gateway_group
+//	@Param		group_id	path		int	true	"网关组 ID"
+//	@Param		rollout_id	path		int	true	"分批发布 ID"
+//	@Success	200			{object}	ginx.Response{data=serializer.GatewayGroupRolloutOutputInfo}
+//	@Router		/api/v1/web/gateway_groups/{group_id}/rollouts/{rollout_id}/cancel/ [post]
+func GatewayGroupRolloutCancel(c *gin.Context) {
+	rollout, ok := getGatewayGroupRollout(c, constant.GatewayPermissionPublish)
+	if !ok {
+		return
+	}
+	if err := gatewaygroupbiz.CancelRollout(c.Request.Context(), rollout); err != nil {
+		gatewayGroupErrorResponse(c, err)
+		return
+	}
+	gatewayGroupRolloutResponse(c, rollout)
+}
+
+// fillGatewayGroup 使用请求参数填充网关组，返回网关组成员
+func fillGatewayGroup(
+	c *gin.Context,
+	group *model.GatewayGroup,
+	req serializer.GatewayGroupRequest,
+) []*model.GatewayGroupMember {
+	group.Name = req.Name
+	group.Description = req.Description
+	group.SoakSeconds = req.SoakSeconds
+	group.Updater = ginx.GetUserID(c)
+	members := make([]*model.GatewayGroupMember, 0, len(req.Members))
+	for _, member := range req.Members {
+		members = append(members, &model.GatewayGroupMember{
+			GatewayID:      member.GatewayID,
+			Priority:       member.Priority,
+			HealthCheckURL: member.HealthCheckURL,
+		})
+	}
+	return members
+}
+
+// getGatewayGroup 根据路径参数查询网关组并校验用户拥有组内所有网关的指定权限，失败时直接返回错误响应
+func getGatewayGroup(
+	c *gin.Context,
+	permission constant.GatewayPermission,
+) (*model.GatewayGroup, []*model.GatewayGroupMember, bool) {
+	var pathParam serializer.GatewayGroupPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return nil, nil, false
+	}
+	group, err := gatewaygroupbiz.GetGatewayGroup(c.Request.Context(), pathParam.GroupID)
+	if err != nil {
+		gatewayGroupErrorResponse(c, err)
+		return nil, nil, false
+	}
+	members, err := gatewaygroupbiz.ListGatewayGroupMembers(c.Request.Context(), group.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return nil, nil, false
+	}
+	err = gatewaygroupbiz.CheckGatewayGroupPermission(c.Request.Context(), members, ginx.GetUserID(c), permission)
+	if err != nil {
+		gatewayGroupErrorResponse(c, err)
+		return nil, nil, false
+	}
+	return group, members, true
+}
+
+// getGatewayGroupRollout 根据路径参数查询网关组的分批发布，失败时直接返回错误响应
+func getGatewayGroupRollout(
+	c *gin.Context,
+	permission constant.GatewayPermission,
+) (*model.GatewayGroupRollout, bool) {
+	var pathParam serializer.GatewayGroupRolloutPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return nil, false
+	}
+	group, _, ok := getGatewayGroup(c, permission)
+	if !ok {
+		return nil, false
+	}
+	rollout, err := gatewaygroupbiz.GetRollout(c.Request.Context(), group.ID, pathParam.RolloutID)
+	if err != nil {
+		gatewayGroupErrorResponse(c, err)
+		return nil, false
+	}
+	return rollout, true
+}
+
+// gatewayGroupRolloutResponse 返回包含各批次的分批发布详情
+func gatewayGroupRolloutResponse(c *gin.Context, rollout *model.GatewayGroupRollout) {
+	stages, err := gatewaygroupbiz.ListRolloutStages(c.Request.Context(), rollout.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.GatewayGroupRolloutToOutputInfo(rollout, stages))
+}
+
+// gatewayGroupErrorResponse 将网关组及分批发布的错误转换为响应
+func gatewayGroupErrorResponse(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gatewaygroupbiz.ErrGatewayGroupNotFound),
+		errors.Is(err, gatewaygroupbiz.ErrRolloutNotFound):
+		ginx.NotFoundJSONResponse(c, err)
+	case errors.Is(err, gatewaygroupbiz.ErrGatewayGroupNoPermission):
+		ginx.ForbiddenJSONResponse(c, err)
+	case errors.Is(err, gatewaygroupbiz.ErrGatewayGroupTooFewMembers),
+		errors.Is(err, gatewaygroupbiz.ErrGatewayGroupDuplicate),
+		errors.Is(err, gatewaygroupbiz.ErrGatewayGroupNoGateway),
+		errors.Is(err, gatewaygroupbiz.ErrRolloutApprovalEnabled):
+		ginx.BadRequestErrorJSONResponse(c, err)
+	case errors.Is(err, gatewaygroupbiz.ErrGatewayGroupRolloutActive),
+		errors.Is(err, gatewaygroupbiz.ErrRolloutFinished):
+		ginx.ConflictJSONResponse(c, err)
+	default:
+		ginx.SystemErrorJSONResponse(c, err)
+	}
+}
@@ -0,0 +1,189 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// GatewayMemberList 网关成员列表
+//
+//	@ID			gateway_member_list
+//	@Summary	网关成员列表
+//	@Produce	json
+//	@Tags		webapi.gateway_member
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Success	200			{object}	ginx.Response{data=[]serializer.GatewayMemberOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/members/ [get]
+func GatewayMemberList(c *gin.Context) {
+	var pathParam serializer.GatewayMemberPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	members, err := gatewaybiz.ListGatewayMembers(c.Request.Context(), pathParam.GatewayID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make([]serializer.GatewayMemberOutputInfo, 0, len(members))
+	for _, member := range members {
+		results = append(results, serializer.GatewayMemberToOutputInfo(member))
+	}
+	ginx.SuccessJSONResponse(c, results)
+}
+
+// GatewayMemberCreate 添加网关成员
+//
+//	@ID			gateway_member_create
+//	@Summary	添加网关成员
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.gateway_member
+//	@Param		gateway_id	path		int										true	"网关 ID"
+//	@Param		request		body		serializer.GatewayMemberCreateRequest	true	"成员参数"
+//	@Success	201			{object}	ginx.Response{data=serializer.GatewayMemberOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/members/ [post]
+func GatewayMemberCreate(c *gin.Context) {
+	var pathParam serializer.GatewayMemberPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.GatewayMemberCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	member := &model.GatewayMember{
+		GatewayID: pathParam.GatewayID,
+		Username:  req.Username,
+		Role:      req.Role,
+		BaseModel: model.BaseModel{
+			Creator: ginx.GetUserID(c),
+			Updater: ginx.GetUserID(c),
+		},
+	}
+	err := gatewaybiz.CreateGatewayMember(c.Request.Context(), ginx.GetGatewayInfo(c), member)
+	if err != nil {
+		switch {
+		case errors.Is(err, gatewaybiz.ErrGatewayMemberExists):
+			ginx.ConflictJSONResponse(c, err)
+		case errors.Is(err, gatewaybiz.ErrGatewayLastAdmin):
+			ginx.BadRequestErrorJSONResponse(c, err)
+		default:
+			ginx.SystemErrorJSONResponse(c, err)
+		}
+		return
+	}
+	ginx.SuccessCreateJSONResponse(c, serializer.GatewayMemberToOutputInfo(member))
+}
+
+// GatewayMemberUpdate 更新网关成员角色
+//
+//	@ID			gateway_member_update
+//	@Summary	更新网关成员角色
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.gateway_member
+//	@Param		gateway_id	path		int										true	"网关 ID"
+//	@Param		member_id	path		int										true	"成员 ID"
+//	@Param		request		body		serializer.GatewayMemberUpdateRequest	true	"成员参数"
+//	@Success	200			{object}	ginx.Response{data=serializer.GatewayMemberOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/members/{member_id}/ [put]
+func GatewayMemberUpdate(c *gin.Context) {
+	var pathParam serializer.GatewayMemberPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.GatewayMemberUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	member, err := gatewaybiz.GetGatewayMember(c.Request.Context(), pathParam.GatewayID, pathParam.MemberID)
+	if err != nil {
+		if errors.Is(err, gatewaybiz.ErrGatewayMemberNotFound) {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	err = gatewaybiz.UpdateGatewayMemberRole(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c),
+		member,
+		req.Role,
+		ginx.GetUserID(c),
+	)
+	if err != nil {
+		if errors.Is(err, gatewaybiz.ErrGatewayLastAdmin) {
+			ginx.BadRequestErrorJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.GatewayMemberToOutputInfo(member))
+}
+
+// GatewayMemberDelete 删除网关成员
+//
+//	@ID			gateway_member_delete
+//	@Summary	删除网关成员
+//	@Produce	json
+//	@Tags		webapi.gateway_member
+//	@Param		gateway_id	path	int	true	"网关 ID"
+//	@Param		member_id	path	int	true	"成员 ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/members/{member_id}/ [delete]
+func GatewayMemberDelete(c *gin.Context) {
+	var pathParam serializer.GatewayMemberPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	member, err := gatewaybiz.GetGatewayMember(c.Request.Context(), pathParam.GatewayID, pathParam.MemberID)
+	if err != nil {
+		if errors.Is(err, gatewaybiz.ErrGatewayMemberNotFound) {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	if err := gatewaybiz.DeleteGatewayMember(c.Request.Context(), ginx.GetGatewayInfo(c), member); err != nil {
+		if errors.Is(err, gatewaybiz.ErrGatewayLastAdmin) {
+			ginx.BadRequestErrorJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
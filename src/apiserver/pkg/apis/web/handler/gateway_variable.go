@@ -0,0 +1,194 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	variablebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/variable"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// GatewayVariableList 网关变量列表
+//
+//	@ID			gateway_variable_list
+//	@Summary	网关变量列表
+//	@Produce	json
+//	@Tags		webapi.gateway_variable
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Success	200			{object}	ginx.Response{data=serializer.GatewayVariableListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/variables/ [get]
+func GatewayVariableList(c *gin.Context) {
+	variables, err := variablebiz.ListVariables(c.Request.Context(), ginx.GetGatewayInfo(c).ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make(serializer.GatewayVariableListResponse, 0, len(variables))
+	for _, variable := range variables {
+		results = append(results, serializer.GatewayVariableToOutputInfo(variable))
+	}
+	ginx.SuccessJSONResponse(c, results)
+}
+
+// GatewayVariableCreate 创建网关变量
+//
+//	@ID			gateway_variable_create
+//	@Summary	创建网关变量，资源配置中可通过 ${NAME} 引用，发布时替换为变量值
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.gateway_variable
+//	@Param		gateway_id	path		int									true	"网关 ID"
+//	@Param		request		body		serializer.GatewayVariableRequest	true	"网关变量"
+//	@Success	201			{object}	ginx.Response{data=serializer.GatewayVariableOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/variables/ [post]
+func GatewayVariableCreate(c *gin.Context) {
+	var req serializer.GatewayVariableRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	variable := &model.GatewayVariable{
+		GatewayID:   ginx.GetGatewayInfo(c).ID,
+		Name:        req.Name,
+		Value:       req.Value,
+		Secret:      req.Secret,
+		Description: req.Description,
+		BaseModel: model.BaseModel{
+			Creator: ginx.GetUserID(c),
+			Updater: ginx.GetUserID(c),
+		},
+	}
+	if err := variablebiz.CreateVariable(c.Request.Context(), variable); err != nil {
+		gatewayVariableErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateJSONResponse(c, serializer.GatewayVariableToOutputInfo(variable))
+}
+
+// GatewayVariableGet 获取网关变量详情
+//
+//	@ID			gateway_variable_get
+//	@Summary	获取网关变量详情
+//	@Produce	json
+//	@Tags		webapi.gateway_variable
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Param		variable_id	path		int	true	"变量 ID"
+//	@Success	200			{object}	ginx.Response{data=serializer.GatewayVariableOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/variables/{variable_id}/ [get]
+func GatewayVariableGet(c *gin.Context) {
+	var pathParam serializer.GatewayVariablePathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	variable, err := variablebiz.GetVariable(c.Request.Context(), pathParam.GatewayID, pathParam.VariableID)
+	if err != nil {
+		gatewayVariableErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.GatewayVariableToOutputInfo(variable))
+}
+
+// GatewayVariableUpdate 更新网关变量
+//
+//	@ID			gateway_variable_update
+//	@Summary	更新网关变量，更新后需重新发布引用该变量的资源才会生效
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.gateway_variable
+//	@Param		gateway_id	path		int									true	"网关 ID"
+//	@Param		variable_id	path		int									true	"变量 ID"
+//	@Param		request		body		serializer.GatewayVariableRequest	true	"网关变量"
+//	@Success	200			{object}	ginx.Response{data=serializer.GatewayVariableOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/variables/{variable_id}/ [put]
+func GatewayVariableUpdate(c *gin.Context) {
+	var pathParam serializer.GatewayVariablePathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.GatewayVariableRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	variable := &model.GatewayVariable{
+		ID:          pathParam.VariableID,
+		GatewayID:   pathParam.GatewayID,
+		Name:        req.Name,
+		Value:       req.Value,
+		Secret:      req.Secret,
+		Description: req.Description,
+		BaseModel: model.BaseModel{
+			Updater: ginx.GetUserID(c),
+		},
+	}
+	if err := variablebiz.UpdateVariable(c.Request.Context(), variable); err != nil {
+		gatewayVariableErrorResponse(c, err)
+		return
+	}
+	variable, err := variablebiz.GetVariable(c.Request.Context(), pathParam.GatewayID, pathParam.VariableID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.GatewayVariableToOutputInfo(variable))
+}
+
+// GatewayVariableDelete 删除网关变量
+//
+//	@ID			gateway_variable_delete
+//	@Summary	删除网关变量
+//	@Produce	json
+//	@Tags		webapi.gateway_variable
+//	@Param		gateway_id	path	int	true	"网关 ID"
+//	@Param		variable_id	path	int	true	"变量 ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/variables/{variable_id}/ [delete]
+func GatewayVariableDelete(c *gin.Context) {
+	var pathParam serializer.GatewayVariablePathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	err := variablebiz.DeleteVariable(c.Request.Context(), pathParam.GatewayID, pathParam.VariableID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// gatewayVariableErrorResponse 将网关变量的错误转换为响应
+func gatewayVariableErrorResponse(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, variablebiz.ErrVariableNotFound):
+		ginx.NotFoundJSONResponse(c, err)
+	case errors.Is(err, variablebiz.ErrVariableDuplicate):
+		ginx.ConflictJSONResponse(c, err)
+	case errors.Is(err, variablebiz.ErrVariableInvalidName):
+		ginx.BadRequestErrorJSONResponse(c, err)
+	default:
+		ginx.SystemErrorJSONResponse(c, err)
+	}
+}
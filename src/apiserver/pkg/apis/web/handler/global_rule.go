@@ -32,6 +32,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -182,7 +183,7 @@ func GlobalRuleList(c *gin.Context) {
 			GlobalRuleInfo: serializer.GlobalRuleInfo{
 				ID:     globalRule.ID,
 				Name:   globalRule.Name,
-				Config: json.RawMessage(globalRule.Config),
+				Config: json.RawMessage(sensitive.Mask(constant.GlobalRule, globalRule.Config)),
 			},
 			Status:    globalRule.Status,
 			CreatedAt: globalRule.CreatedAt.Unix(),
@@ -222,7 +223,7 @@ func GlobalRuleGet(c *gin.Context) {
 		GlobalRuleInfo: serializer.GlobalRuleInfo{
 			ID:     globalRule.ID,
 			Name:   globalRule.Name,
-			Config: json.RawMessage(globalRule.Config),
+			Config: json.RawMessage(sensitive.Mask(constant.GlobalRule, globalRule.Config)),
 		},
 		CreatedAt: globalRule.CreatedAt.Unix(),
 		UpdatedAt: globalRule.UpdatedAt.Unix(),
@@ -32,6 +32,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -194,7 +195,7 @@ func PluginConfigList(c *gin.Context) {
 			PluginConfigInfo: serializer.PluginConfigInfo{
 				ID:     pc.ID,
 				Name:   pc.Name,
-				Config: json.RawMessage(pc.Config),
+				Config: json.RawMessage(sensitive.Mask(constant.PluginConfig, pc.Config)),
 			},
 			Status:    pc.Status,
 			CreatedAt: pc.CreatedAt.Unix(),
@@ -235,7 +236,7 @@ func PluginConfigGet(c *gin.Context) {
 		PluginConfigInfo: serializer.PluginConfigInfo{
 			ID:     pluginConfig.ID,
 			Name:   pluginConfig.Name,
-			Config: json.RawMessage(pluginConfig.Config),
+			Config: json.RawMessage(sensitive.Mask(constant.PluginConfig, pluginConfig.Config)),
 		},
 		CreatedAt: pluginConfig.CreatedAt.Unix(),
 		UpdatedAt: pluginConfig.UpdatedAt.Unix(),
@@ -34,6 +34,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -185,7 +186,7 @@ func PluginMetadataList(c *gin.Context) {
 			PluginMetadataInfo: serializer.PluginMetadataInfo{
 				ID:     pluginMetadata.ID,
 				Name:   pluginMetadata.Name,
-				Config: json.RawMessage(pluginMetadata.Config),
+				Config: json.RawMessage(sensitive.Mask(constant.PluginMetadata, pluginMetadata.Config)),
 			},
 			Status:    pluginMetadata.Status,
 			CreatedAt: pluginMetadata.CreatedAt.Unix(),
@@ -226,7 +227,7 @@ func PluginMetadataGet(c *gin.Context) {
 		PluginMetadataInfo: serializer.PluginMetadataInfo{
 			ID:     pluginMetadata.ID,
 			Name:   pluginMetadata.Name,
-			Config: json.RawMessage(pluginMetadata.Config),
+			Config: json.RawMessage(sensitive.Mask(constant.PluginMetadata, pluginMetadata.Config)),
 		},
 		CreatedAt: pluginMetadata.CreatedAt.Unix(),
 		UpdatedAt: pluginMetadata.UpdatedAt.Unix(),
@@ -0,0 +1,121 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	promotionbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/promotion"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// PromotionPlan 预览跨网关资源晋级计划
+//
+//	@ID			promotion_plan
+//	@Summary	预览从源网关晋级资源到当前网关的计划，不做任何修改
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.promotion
+//	@Param		gateway_id	path		int							true	"目标网关 ID"
+//	@Param		request		body		serializer.PromotionRequest	true	"晋级参数"
+//	@Success	200			{object}	ginx.Response{data=serializer.PromotionPlanOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/promotions/plan/ [post]
+func PromotionPlan(c *gin.Context) {
+	req, source, ok := bindPromotionRequest(c)
+	if !ok {
+		return
+	}
+	plan, err := promotionbiz.Plan(c.Request.Context(), source, req)
+	plan.MaskSensitive()
+	if err != nil {
+		promotionErrorResponse(c, plan, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, plan)
+}
+
+// PromotionCreate 执行跨网关资源晋级，存在冲突时返回 409，error.data 为晋级计划
+//
+//	@ID			promotion_create
+//	@Summary	将源网关的资源及其依赖以草稿写入当前网关，存在冲突时不做任何修改
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.promotion
+//	@Param		gateway_id	path		int							true	"目标网关 ID"
+//	@Param		request		body		serializer.PromotionRequest	true	"晋级参数"
+//	@Success	200			{object}	ginx.Response{data=serializer.PromotionPlanOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/promotions/ [post]
+func PromotionCreate(c *gin.Context) {
+	req, source, ok := bindPromotionRequest(c)
+	if !ok {
+		return
+	}
+	plan, err := promotionbiz.Promote(c.Request.Context(), source, req)
+	plan.MaskSensitive()
+	if err != nil {
+		promotionErrorResponse(c, plan, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, plan)
+}
+
+// bindPromotionRequest 解析晋级请求并校验当前用户对源网关的查看权限
+func bindPromotionRequest(c *gin.Context) (*serializer.PromotionRequest, *model.Gateway, bool) {
+	var req serializer.PromotionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return nil, nil, false
+	}
+	source, err := gatewaybiz.GetGateway(c.Request.Context(), req.SourceGatewayID)
+	if err != nil {
+		ginx.NotFoundJSONResponse(c, fmt.Errorf("源网关 %d 不存在", req.SourceGatewayID))
+		return nil, nil, false
+	}
+	role, err := gatewaybiz.GetGatewayRole(c.Request.Context(), source, ginx.GetUserID(c))
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return nil, nil, false
+	}
+	if !role.HasPermission(constant.GatewayPermissionView) {
+		ginx.ForbiddenJSONResponse(c, fmt.Errorf("没有源网关 %d 的查看权限", source.ID))
+		return nil, nil, false
+	}
+	return &req, source, true
+}
+
+// promotionErrorResponse 将晋级错误转换为响应，冲突时返回晋级计划以便查看冲突资源
+func promotionErrorResponse(c *gin.Context, plan *serializer.PromotionPlanOutputInfo, err error) {
+	switch {
+	case errors.Is(err, promotionbiz.ErrPromotionConflict):
+		ginx.BaseErrorJSONResponseWithData(c, ginx.ConflictError, err.Error(), http.StatusConflict, plan)
+	case errors.Is(err, promotionbiz.ErrSameGateway),
+		errors.Is(err, promotionbiz.ErrEmptySelection),
+		errors.Is(err, promotionbiz.ErrNoResourceSelected):
+		ginx.BadRequestErrorJSONResponse(c, err)
+	default:
+		ginx.SystemErrorJSONResponse(c, err)
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -147,7 +148,7 @@ func ProtoGet(c *gin.Context) {
 		ProtoInfo: serializer.ProtoInfo{
 			ID:     proto.ID,
 			Name:   proto.Name,
-			Config: json.RawMessage(proto.Config),
+			Config: json.RawMessage(sensitive.Mask(constant.Proto, proto.Config)),
 		},
 		CreatedAt: proto.CreatedAt.Unix(),
 		UpdatedAt: proto.UpdatedAt.Unix(),
@@ -248,7 +249,7 @@ func ProtoList(c *gin.Context) {
 			ProtoInfo: serializer.ProtoInfo{
 				ID:     pb.ID,
 				Name:   pb.Name,
-				Config: json.RawMessage(pb.Config),
+				Config: json.RawMessage(sensitive.Mask(constant.Proto, pb.Config)),
 			},
 			Status:    pb.Status,
 			CreatedAt: pb.CreatedAt.Unix(),
@@ -19,14 +19,27 @@
 package handler
 
 import (
+	"context"
+	"errors"
+	"io"
+
 	"github.com/gin-gonic/gin"
 
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/common"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
-	publishbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/publish"
+	changerequestbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/changerequest"
+	maintenancebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/maintenance"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
+	taskbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/task"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
+var errOverrideMaintenanceWindowForbidden = errors.New(
+	"only gateway admin can publish outside the maintenance windows")
+
 // PublishResource ...
 //
 //	@ID			resource_publish
@@ -36,7 +49,7 @@ import (
 //	@Tags		webapi.publish
 //	@Param		gateway_id	path	int							true	"网关 ID"
 //	@Param		request		body	serializer.PublishRequest	true	"发布资源请求参数"
-//	@Success	201
+//	@Success	201	{object}	ginx.Response{data=common.ChangeRequestOutputInfo}	"开启发布审批时返回发布变更请求"
 //	@Router		/api/v1/web/gateways/{gateway_id}/publish/ [post]
 func PublishResource(c *gin.Context) {
 	var req serializer.PublishRequest
@@ -44,12 +57,16 @@ func PublishResource(c *gin.Context) {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
-	err := publishbiz.PublishResource(c.Request.Context(), req.ResourceType, req.ResourceIDList)
-	if err != nil {
-		ginx.SystemErrorJSONResponse(c, err)
+	ctx, ok := withMaintenanceOverride(c.Request.Context(), c, req.OverrideMaintenanceWindow)
+	if !ok {
 		return
 	}
-	ginx.SuccessCreateResponse(c)
+	ctx = releasebiz.WithMeta(ctx, releasebiz.Meta{
+		Trigger:   constant.ReleaseTriggerWeb,
+		Changelog: req.Changelog,
+	})
+	changeRequest, err := changerequestbiz.PublishWithPolicy(ctx, req.ResourceType, req.ResourceIDList)
+	publishResponse(c, changeRequest, err)
 }
 
 // PublishResourceAll ...
@@ -59,14 +76,66 @@ func PublishResource(c *gin.Context) {
 //	@Accept		json
 //	@Produce	json
 //	@Tags		webapi.publish
-//	@Param		gateway_id	path	int	true	"网关 ID"
-//	@Success	201
+//	@Param		gateway_id	path	int							true	"网关 ID"
+//	@Param		request		body	serializer.PublishAllRequest	false	"一键发布请求参数"
+//	@Param		async		query	bool							false	"是否下发后台任务异步执行"
+//	@Success	201	{object}	ginx.Response{data=common.ChangeRequestOutputInfo}	"开启发布审批时返回发布变更请求，异步执行时返回后台任务"
 //	@Router		/api/v1/web/gateways/{gateway_id}/publish/all/ [post]
 func PublishResourceAll(c *gin.Context) {
-	err := publishbiz.PublishAllResource(c.Request.Context(), ginx.GetGatewayInfo(c).ID)
+	var req serializer.PublishAllRequest
+	// 兼容不传请求体的调用
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	ctx, ok := withMaintenanceOverride(c.Request.Context(), c, req.OverrideMaintenanceWindow)
+	if !ok {
+		return
+	}
+	// 大网关一键发布耗时较长，可下发后台任务异步执行
+	if isAsyncRequest(c) {
+		enqueueTask(c, taskbiz.TaskNamePublishAll, taskbiz.PublishAllArgs{
+			Changelog:                 req.Changelog,
+			OverrideMaintenanceWindow: req.OverrideMaintenanceWindow,
+		}, publishAllMaxAttempts)
+		return
+	}
+	ctx = releasebiz.WithMeta(ctx, releasebiz.Meta{
+		Trigger:   constant.ReleaseTriggerWeb,
+		Changelog: req.Changelog,
+	})
+	changeRequest, err := changerequestbiz.PublishWithPolicy(ctx, "", nil)
+	publishResponse(c, changeRequest, err)
+}
+
+// publishResponse 返回发布结果，网关开启发布审批时返回创建的发布变更请求
+func publishResponse(c *gin.Context, changeRequest *model.GatewayChangeRequest, err error) {
 	if err != nil {
-		ginx.SystemErrorJSONResponse(c, err)
+		switch {
+		case errors.Is(err, changerequestbiz.ErrNoDraftResources):
+			ginx.BadRequestErrorJSONResponse(c, err)
+		case errors.Is(err, maintenancebiz.ErrOutsideMaintenanceWindow):
+			ginx.ForbiddenJSONResponse(c, err)
+		default:
+			ginx.SystemErrorJSONResponse(c, err)
+		}
+		return
+	}
+	if changeRequest != nil {
+		ginx.SuccessCreateJSONResponse(c, common.ChangeRequestToOutputInfo(changeRequest))
 		return
 	}
 	ginx.SuccessCreateResponse(c)
 }
+
+// withMaintenanceOverride 在维护窗口外强制发布时校验网关管理员权限，失败时直接返回错误响应
+func withMaintenanceOverride(ctx context.Context, c *gin.Context, override bool) (context.Context, bool) {
+	if !override {
+		return ctx, true
+	}
+	if !ginx.GetGatewayRole(c).HasPermission(constant.GatewayPermissionManage) {
+		ginx.ForbiddenJSONResponse(c, errOverrideMaintenanceWindowForbidden)
+		return ctx, false
+	}
+	return maintenancebiz.WithOverride(ctx, true), true
+}
@@ -0,0 +1,128 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	publishbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/publish"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// PublishJournalList 分批发布日志列表
+//
+//	@ID			publish_journal_list
+//	@Summary	分批发布日志列表：超过单个 etcd 事务上限的发布分批写入，失败时回滚已写入的批次
+//	@Produce	json
+//	@Tags		webapi.publish
+//	@Param		gateway_id	path		int									true	"网关 ID"
+//	@Param		request		query		serializer.PublishJournalListRequest	false	"查询参数"
+//	@Param		offset		query		int									false	"offset"
+//	@Param		limit		query		int									false	"limit"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=[]serializer.PublishJournalOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/publish_journals/ [get]
+func PublishJournalList(c *gin.Context) {
+	var req serializer.PublishJournalListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	journals, total, err := publishbiz.ListPublishJournals(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c).ID,
+		map[string]any{"status": req.Status},
+		utils.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make([]serializer.PublishJournalOutputInfo, 0, len(journals))
+	for _, journal := range journals {
+		results = append(results, serializer.PublishJournalToOutputInfo(journal))
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// PublishJournalResume 继续写入中断的发布
+//
+//	@ID			publish_journal_resume
+//	@Summary	继续写入中断的发布：已写入的 key 跳过，其余 key 在未被修改时写入；资源仍为待发布状态，需要重新发布以更新状态
+//	@Produce	json
+//	@Tags		webapi.publish
+//	@Param		gateway_id	path	int	true	"网关 ID"
+//	@Param		journal_id	path	int	true	"发布日志 ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/publish_journals/{journal_id}/resume/ [post]
+func PublishJournalResume(c *gin.Context) {
+	var pathParam serializer.PublishJournalPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	err := publishbiz.ResumePublishJournal(c.Request.Context(), ginx.GetGatewayInfo(c), pathParam.JournalID)
+	if err != nil {
+		publishJournalErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// PublishJournalRevert 回滚中断的发布
+//
+//	@ID			publish_journal_revert
+//	@Summary	回滚中断或回滚失败的发布：仍为本次写入结果的 key 恢复为写入前的值
+//	@Produce	json
+//	@Tags		webapi.publish
+//	@Param		gateway_id	path	int	true	"网关 ID"
+//	@Param		journal_id	path	int	true	"发布日志 ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/publish_journals/{journal_id}/revert/ [post]
+func PublishJournalRevert(c *gin.Context) {
+	var pathParam serializer.PublishJournalPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	err := publishbiz.RevertPublishJournal(c.Request.Context(), ginx.GetGatewayInfo(c), pathParam.JournalID)
+	if err != nil {
+		publishJournalErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// publishJournalErrorResponse 将分批发布日志的错误转换为响应
+func publishJournalErrorResponse(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, publishbiz.ErrPublishJournalNotFound):
+		ginx.NotFoundJSONResponse(c, err)
+	case errors.Is(err, publishbiz.ErrPublishJournalNotRecoverable):
+		ginx.ConflictJSONResponse(c, err)
+	default:
+		ginx.SystemErrorJSONResponse(c, err)
+	}
+}
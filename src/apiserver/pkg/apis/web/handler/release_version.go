@@ -0,0 +1,178 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"errors"
+	"io"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ReleaseVersionList 发布版本列表
+//
+//	@ID			release_version_list
+//	@Summary	发布版本列表
+//	@Produce	json
+//	@Tags		webapi.release_version
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Param		offset		query		int	false	"offset"
+//	@Param		limit		query		int	false	"limit"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=[]serializer.ReleaseVersionOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/release_versions/ [get]
+func ReleaseVersionList(c *gin.Context) {
+	var pathParam serializer.ReleaseVersionPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	versions, total, err := releasebiz.ListReleaseVersions(
+		c.Request.Context(),
+		pathParam.GatewayID,
+		utils.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make([]serializer.ReleaseVersionOutputInfo, 0, len(versions))
+	for _, version := range versions {
+		results = append(results, serializer.ReleaseVersionToOutputInfo(version))
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// ReleaseVersionGet 发布版本详情
+//
+//	@ID			release_version_get
+//	@Summary	发布版本详情
+//	@Produce	json
+//	@Tags		webapi.release_version
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Param		version_id	path		int	true	"发布版本 ID"
+//	@Success	200			{object}	ginx.Response{data=serializer.ReleaseVersionDetailOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/release_versions/{version_id}/ [get]
+func ReleaseVersionGet(c *gin.Context) {
+	var pathParam serializer.ReleaseVersionPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	version, err := releasebiz.GetReleaseVersion(c.Request.Context(), pathParam.GatewayID, pathParam.VersionID)
+	if err != nil {
+		if errors.Is(err, releasebiz.ErrReleaseVersionNotFound) {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	resources, err := version.GetReleaseResources()
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.ReleaseVersionDetailOutputInfo{
+		ReleaseVersionOutputInfo: serializer.ReleaseVersionToOutputInfo(version),
+		Resources:                releasebiz.MaskReleaseResources(resources),
+	})
+}
+
+// ReleaseVersionDiff 发布版本对比
+//
+//	@ID			release_version_diff
+//	@Summary	发布版本对比
+//	@Produce	json
+//	@Tags		webapi.release_version
+//	@Param		gateway_id	path		int									true	"网关 ID"
+//	@Param		version_id	path		int									true	"发布版本 ID"
+//	@Param		request		query		serializer.ReleaseVersionDiffRequest	false	"查询参数"
+//	@Success	200			{object}	ginx.Response{data=dto.ReleaseVersionDiff}
+//	@Router		/api/v1/web/gateways/{gateway_id}/release_versions/{version_id}/diff/ [get]
+func ReleaseVersionDiff(c *gin.Context) {
+	var pathParam serializer.ReleaseVersionPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.ReleaseVersionDiffRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	diff, err := releasebiz.DiffReleaseVersion(c.Request.Context(), pathParam.VersionID, req.BaseVersionID)
+	if err != nil {
+		if errors.Is(err, releasebiz.ErrReleaseVersionNotFound) {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, diff)
+}
+
+// ReleaseVersionRollback 回滚到指定发布版本
+//
+//	@ID			release_version_rollback
+//	@Summary	发布版本一键回滚
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.release_version
+//	@Param		gateway_id	path		int										true	"网关 ID"
+//	@Param		version_id	path		int										true	"发布版本 ID"
+//	@Param		request		body		serializer.ReleaseVersionRollbackRequest	false	"回滚参数"
+//	@Success	201			{object}	ginx.Response{data=serializer.ReleaseVersionOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/release_versions/{version_id}/rollback/ [post]
+func ReleaseVersionRollback(c *gin.Context) {
+	var pathParam serializer.ReleaseVersionPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.ReleaseVersionRollbackRequest
+	// 兼容不传请求体的调用
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	ctx := releasebiz.WithMeta(c.Request.Context(), releasebiz.Meta{
+		Trigger:   constant.ReleaseTriggerWeb,
+		Changelog: req.Changelog,
+	})
+	version, err := releasebiz.RollbackReleaseVersion(ctx, pathParam.VersionID)
+	if err != nil {
+		if errors.Is(err, releasebiz.ErrReleaseVersionNotFound) {
+			ginx.NotFoundJSONResponse(c, err)
+			return
+		}
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateJSONResponse(c, serializer.ReleaseVersionToOutputInfo(version))
+}
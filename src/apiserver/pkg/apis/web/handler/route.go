@@ -20,6 +20,8 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -27,12 +29,15 @@ import (
 	"gorm.io/datatypes"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	exportflowbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/exportflow"
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -195,7 +200,7 @@ func RouteList(c *gin.Context) {
 				ServiceID:      route.ServiceID,
 				UpstreamID:     route.UpstreamID,
 				PluginConfigID: route.PluginConfigID,
-				Config:         json.RawMessage(route.Config),
+				Config:         json.RawMessage(sensitive.Mask(constant.Route, route.Config)),
 				ID:             route.ID,
 			},
 			Status:    route.Status,
@@ -239,7 +244,7 @@ func RouteGet(c *gin.Context) {
 			ServiceID:      route.ServiceID,
 			UpstreamID:     route.UpstreamID,
 			PluginConfigID: route.PluginConfigID,
-			Config:         json.RawMessage(route.Config),
+			Config:         json.RawMessage(sensitive.Mask(constant.Route, route.Config)),
 		},
 		CreatedAt: route.CreatedAt.Unix(),
 		UpdatedAt: route.UpdatedAt.Unix(),
@@ -327,3 +332,91 @@ func RouteDropDownList(c *gin.Context) {
 	}
 	ginx.SuccessJSONResponse(c, output)
 }
+
+// RouteOpenAPI ...
+//
+//	@ID			route_openapi
+//	@Summary	route 生成 OpenAPI 3 文档，可按服务或 label 筛选路由
+//	@Produce	json
+//	@Tags		webapi.route
+//	@Param		gateway_id	path	int								true	"网关 id"
+//	@Param		request		query	serializer.RouteOpenAPIRequest	false	"生成参数"
+//	@Success	200
+//	@Router		/api/v1/web/gateways/{gateway_id}/routes-openapi/ [get]
+func RouteOpenAPI(c *gin.Context) {
+	var req serializer.RouteOpenAPIRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	labelMap, err := serializer.CheckLabel(req.Label)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	opts := &dto.OpenAPIGenerateOptions{
+		State:     req.State,
+		ServiceID: req.ServiceID,
+		Labels:    labelMap,
+	}
+	if opts.State == "" {
+		opts.State = constant.ExportStateDraft
+	}
+	doc, err := exportflowbiz.GenerateOpenAPI(c.Request.Context(), opts)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	format := req.Format
+	if format == "" {
+		format = constant.OpenAPIFormatJSON
+	}
+	fileData, err := exportflowbiz.MarshalOpenAPI(doc, format)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	fileName := fmt.Sprintf("%s_openapi.%s", ginx.GetGatewayInfo(c).Name, format)
+	ginx.SuccessFileResponse(c, "application/"+string(format), fileData, fileName)
+}
+
+// RouteMatch ...
+//
+//	@ID			route_match
+//	@Summary	route 匹配模拟：离线判断请求会命中的路由及其他路由未命中的原因
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.route
+//	@Param		gateway_id	path		int							true	"网关 id"
+//	@Param		request		body		serializer.RouteMatchRequest	true	"模拟请求"
+//	@Success	200			{object}	ginx.Response{data=exportflow.RouteMatchResult}
+//	@Router		/api/v1/web/gateways/{gateway_id}/routes-match/ [post]
+func RouteMatch(c *gin.Context) {
+	var req serializer.RouteMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	opts := &dto.RouteMatchOptions{
+		State:      req.State,
+		Method:     req.Method,
+		Host:       req.Host,
+		Path:       req.Path,
+		Headers:    req.Headers,
+		Query:      req.Query,
+		RemoteAddr: req.RemoteAddr,
+	}
+	if opts.State == "" {
+		opts.State = constant.ExportStateDraft
+	}
+	result, err := exportflowbiz.SimulateRouteMatch(c.Request.Context(), opts)
+	if errors.Is(err, exportflowbiz.ErrInvalidRouteMatchRequest) {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, result)
+}
@@ -0,0 +1,336 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	maintenancebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/maintenance"
+	schedulebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/schedule"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// MaintenanceWindowList 维护窗口列表
+//
+//	@ID			maintenance_window_list
+//	@Summary	维护窗口列表
+//	@Produce	json
+//	@Tags		webapi.schedule
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Success	200			{object}	ginx.Response{data=[]serializer.MaintenanceWindowOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/maintenance_windows/ [get]
+func MaintenanceWindowList(c *gin.Context) {
+	windows, err := maintenancebiz.ListMaintenanceWindows(c.Request.Context(), ginx.GetGatewayInfo(c).ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make([]serializer.MaintenanceWindowOutputInfo, 0, len(windows))
+	for _, window := range windows {
+		results = append(results, serializer.MaintenanceWindowToOutputInfo(window))
+	}
+	ginx.SuccessJSONResponse(c, results)
+}
+
+// MaintenanceWindowCreate 创建维护窗口
+//
+//	@ID			maintenance_window_create
+//	@Summary	创建维护窗口
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.schedule
+//	@Param		gateway_id	path		int									true	"网关 ID"
+//	@Param		request		body		serializer.MaintenanceWindowRequest	true	"维护窗口参数"
+//	@Success	201			{object}	ginx.Response{data=serializer.MaintenanceWindowOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/maintenance_windows/ [post]
+func MaintenanceWindowCreate(c *gin.Context) {
+	var req serializer.MaintenanceWindowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	window := &model.GatewayMaintenanceWindow{
+		GatewayID: ginx.GetGatewayInfo(c).ID,
+		BaseModel: model.BaseModel{
+			Creator: ginx.GetUserID(c),
+		},
+	}
+	fillMaintenanceWindow(c, window, req)
+	if err := maintenancebiz.CreateMaintenanceWindow(c.Request.Context(), window); err != nil {
+		maintenanceWindowErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateJSONResponse(c, serializer.MaintenanceWindowToOutputInfo(window))
+}
+
+// MaintenanceWindowUpdate 更新维护窗口
+//
+//	@ID			maintenance_window_update
+//	@Summary	更新维护窗口
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.schedule
+//	@Param		gateway_id	path		int									true	"网关 ID"
+//	@Param		window_id	path		int									true	"维护窗口 ID"
+//	@Param		request		body		serializer.MaintenanceWindowRequest	true	"维护窗口参数"
+//	@Success	200			{object}	ginx.Response{data=serializer.MaintenanceWindowOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/maintenance_windows/{window_id}/ [put]
+func MaintenanceWindowUpdate(c *gin.Context) {
+	var pathParam serializer.MaintenanceWindowPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.MaintenanceWindowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	window, err := maintenancebiz.GetMaintenanceWindow(c.Request.Context(), ginx.GetGatewayInfo(c).ID,
+		pathParam.WindowID)
+	if err != nil {
+		maintenanceWindowErrorResponse(c, err)
+		return
+	}
+	fillMaintenanceWindow(c, window, req)
+	if err := maintenancebiz.UpdateMaintenanceWindow(c.Request.Context(), window); err != nil {
+		maintenanceWindowErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.MaintenanceWindowToOutputInfo(window))
+}
+
+// MaintenanceWindowDelete 删除维护窗口
+//
+//	@ID			maintenance_window_delete
+//	@Summary	删除维护窗口
+//	@Produce	json
+//	@Tags		webapi.schedule
+//	@Param		gateway_id	path	int	true	"网关 ID"
+//	@Param		window_id	path	int	true	"维护窗口 ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/maintenance_windows/{window_id}/ [delete]
+func MaintenanceWindowDelete(c *gin.Context) {
+	var pathParam serializer.MaintenanceWindowPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	window, err := maintenancebiz.GetMaintenanceWindow(c.Request.Context(), ginx.GetGatewayInfo(c).ID,
+		pathParam.WindowID)
+	if err != nil {
+		maintenanceWindowErrorResponse(c, err)
+		return
+	}
+	if err := maintenancebiz.DeleteMaintenanceWindow(c.Request.Context(), window); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// fillMaintenanceWindow 使用请求参数填充维护窗口
+func fillMaintenanceWindow(
+	c *gin.Context,
+	window *model.GatewayMaintenanceWindow,
+	req serializer.MaintenanceWindowRequest,
+) {
+	window.Name = req.Name
+	window.Weekdays = lo.Uniq(req.Weekdays)
+	window.StartTime = req.StartTime
+	window.EndTime = req.EndTime
+	window.Timezone = req.Timezone
+	window.Enabled = req.Enabled
+	window.Updater = ginx.GetUserID(c)
+}
+
+// maintenanceWindowErrorResponse 将维护窗口的错误转换为响应
+func maintenanceWindowErrorResponse(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, maintenancebiz.ErrMaintenanceWindowNotFound):
+		ginx.NotFoundJSONResponse(c, err)
+	case errors.Is(err, maintenancebiz.ErrInvalidMaintenanceWindow):
+		ginx.BadRequestErrorJSONResponse(c, err)
+	default:
+		ginx.SystemErrorJSONResponse(c, err)
+	}
+}
+
+// ScheduledPublishList 定时发布列表
+//
+//	@ID			scheduled_publish_list
+//	@Summary	定时发布列表
+//	@Produce	json
+//	@Tags		webapi.schedule
+//	@Param		gateway_id	path		int										true	"网关 ID"
+//	@Param		request		query		serializer.ScheduledPublishListRequest	false	"查询参数"
+//	@Param		offset		query		int										false	"offset"
+//	@Param		limit		query		int										false	"limit"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=[]serializer.ScheduledPublishOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/scheduled_publishes/ [get]
+func ScheduledPublishList(c *gin.Context) {
+	var req serializer.ScheduledPublishListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	scheduledPublishes, total, err := schedulebiz.ListScheduledPublishes(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c).ID,
+		req.Status,
+		utils.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make([]serializer.ScheduledPublishOutputInfo, 0, len(scheduledPublishes))
+	for _, scheduledPublish := range scheduledPublishes {
+		results = append(results, serializer.ScheduledPublishToOutputInfo(scheduledPublish))
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// ScheduledPublishCreate 创建定时发布
+//
+//	@ID			scheduled_publish_create
+//	@Summary	创建定时发布
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.schedule
+//	@Param		gateway_id	path		int										true	"网关 ID"
+//	@Param		request		body		serializer.ScheduledPublishCreateRequest	true	"定时发布参数"
+//	@Success	201			{object}	ginx.Response{data=serializer.ScheduledPublishOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/scheduled_publishes/ [post]
+func ScheduledPublishCreate(c *gin.Context) {
+	var req serializer.ScheduledPublishCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if req.OverrideMaintenanceWindow &&
+		!ginx.GetGatewayRole(c).HasPermission(constant.GatewayPermissionManage) {
+		ginx.ForbiddenJSONResponse(c, errOverrideMaintenanceWindowForbidden)
+		return
+	}
+	scheduledPublish := &model.GatewayScheduledPublish{
+		GatewayID:                 ginx.GetGatewayInfo(c).ID,
+		ResourceType:              req.ResourceType,
+		ResourceIDs:               req.ResourceIDList,
+		Changelog:                 req.Changelog,
+		ScheduledAt:               time.Unix(req.ScheduledAt, 0),
+		OverrideMaintenanceWindow: req.OverrideMaintenanceWindow,
+		BaseModel: model.BaseModel{
+			Creator: ginx.GetUserID(c),
+			Updater: ginx.GetUserID(c),
+		},
+	}
+	if req.ResourceType == "" {
+		scheduledPublish.ResourceIDs = nil
+	}
+	if err := schedulebiz.CreateScheduledPublish(c.Request.Context(), scheduledPublish); err != nil {
+		scheduledPublishErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateJSONResponse(c, serializer.ScheduledPublishToOutputInfo(scheduledPublish))
+}
+
+// ScheduledPublishGet 定时发布详情
+//
+//	@ID			scheduled_publish_get
+//	@Summary	定时发布详情
+//	@Produce	json
+//	@Tags		webapi.schedule
+//	@Param		gateway_id				path		int	true	"网关 ID"
+//	@Param		scheduled_publish_id	path		int	true	"定时发布 ID"
+//	@Success	200						{object}	ginx.Response{data=serializer.ScheduledPublishOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/scheduled_publishes/{scheduled_publish_id}/ [get]
+func ScheduledPublishGet(c *gin.Context) {
+	scheduledPublish, ok := getScheduledPublish(c)
+	if !ok {
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.ScheduledPublishToOutputInfo(scheduledPublish))
+}
+
+// ScheduledPublishCancel 取消定时发布
+//
+//	@ID			scheduled_publish_cancel
+//	@Summary	取消定时发布
+//	@Produce	json
+//	@Tags		webapi.schedule
+//	@Param		gateway_id				path		int	true	"网关 ID"
+//	@Param		scheduled_publish_id	path		int	true	"定时发布 ID"
+//	@Success	200						{object}	ginx.Response{data=serializer.ScheduledPublishOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/scheduled_publishes/{scheduled_publish_id}/cancel/ [post]
+func ScheduledPublishCancel(c *gin.Context) {
+	scheduledPublish, ok := getScheduledPublish(c)
+	if !ok {
+		return
+	}
+	if err := schedulebiz.CancelScheduledPublish(c.Request.Context(), scheduledPublish); err != nil {
+		scheduledPublishErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.ScheduledPublishToOutputInfo(scheduledPublish))
+}
+
+// getScheduledPublish 根据路径参数查询当前网关的定时发布，失败时直接返回错误响应
+func getScheduledPublish(c *gin.Context) (*model.GatewayScheduledPublish, bool) {
+	var pathParam serializer.ScheduledPublishPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return nil, false
+	}
+	scheduledPublish, err := schedulebiz.GetScheduledPublish(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c).ID,
+		pathParam.ScheduledPublishID,
+	)
+	if err != nil {
+		scheduledPublishErrorResponse(c, err)
+		return nil, false
+	}
+	return scheduledPublish, true
+}
+
+// scheduledPublishErrorResponse 将定时发布的错误转换为响应
+func scheduledPublishErrorResponse(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, schedulebiz.ErrScheduledPublishNotFound):
+		ginx.NotFoundJSONResponse(c, err)
+	case errors.Is(err, schedulebiz.ErrScheduledAtInPast):
+		ginx.BadRequestErrorJSONResponse(c, err)
+	case errors.Is(err, schedulebiz.ErrScheduledPublishNotPending):
+		ginx.ConflictJSONResponse(c, err)
+	default:
+		ginx.SystemErrorJSONResponse(c, err)
+	}
+}
@@ -0,0 +1,334 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
+)
+
+// SecretCreate ...
+//
+//	@ID			secret_create
+//	@Summary	secret 创建
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.secret
+//	@Param		gateway_id	path	int								true	"网关 ID"
+//	@Param		request		body	serializer.SecretInfo	true	"secret 创建参数"
+//	@Success	201
+//	@Router		/api/v1/web/gateways/{gateway_id}/secrets/ [post]
+func SecretCreate(c *gin.Context) {
+	var req serializer.SecretInfo
+	if err := bindAndValidateWebCreateWithGeneratedID(
+		c,
+		&req,
+		constant.Secret,
+		func(resourceID string) { req.ID = resourceID },
+	); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	secret := model.Secret{
+		Name:                req.Name,
+		Manager:             req.Manager,
+		ResourceCommonModel: buildWebCreateDraft(c, req.ID, req.Config),
+	}
+
+	if err := resourcebiz.CreateSecret(c.Request.Context(), secret); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateResponse(c)
+}
+
+// SecretUpdate ...
+//
+//	@ID			secret_update
+//	@Summary	secret 更新
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.secret
+//	@Param		gateway_id	path	int								true	"网关 ID"	@Param	id	path	string	true	"secret ID"
+//	@Param		request		body	serializer.SecretInfo	true	"secret 更新参数"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/secrets/{id}/ [put]
+func SecretUpdate(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+
+	req := serializer.SecretInfo{ID: pathParam.ID}
+	if err := validation.BindAndValidate(c, &req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+
+	// 密钥管理器为 etcd key 的一部分，创建后不可修改
+	secret, err := resourcebiz.GetSecret(c.Request.Context(), pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	if secret.Manager != req.Manager {
+		ginx.BadRequestErrorJSONResponse(c, model.ErrSecretManagerChanged)
+		return
+	}
+
+	// if resource not changed (config and extra fields), return success directly
+	if !resourcebiz.IsResourceChanged(
+		c.Request.Context(),
+		constant.Secret,
+		pathParam.ID,
+		req.Config,
+		map[string]any{
+			"name":    req.Name,
+			"manager": req.Manager,
+		},
+	) {
+		ginx.SuccessNoContentResponse(c)
+		return
+	}
+
+	updateStatus, err := resourcebiz.GetResourceUpdateStatus(
+		c.Request.Context(),
+		constant.Secret,
+		pathParam.ID,
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+
+	secret = &model.Secret{
+		Name:    req.Name,
+		Manager: req.Manager,
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID:        pathParam.ID,
+			GatewayID: pathParam.GatewayID,
+			Config:    datatypes.JSON(req.Config),
+			Status:    updateStatus,
+			BaseModel: model.BaseModel{
+				Updater: ginx.GetUserID(c),
+			},
+		},
+	}
+
+	if err := resourcebiz.UpdateSecret(c.Request.Context(), *secret); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// SecretList ...
+//
+//	@ID			secret_list
+//	@Summary	secret 列表
+//	@Produce	json
+//	@Tags		webapi.secret
+//	@Param		gateway_id	path		int									true	"网关 ID"
+//	@Param		request		query		serializer.SecretListRequest	false	"查询参数"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=serializer.SecretListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/secrets/ [get]
+func SecretList(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.SecretListRequest
+	if err := c.ShouldBind(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	labelMap, err := serializer.CheckLabel(req.Label)
+	if err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	queryParam := map[string]any{}
+	if req.ID != "" {
+		queryParam["id"] = req.ID
+	}
+	if req.Manager != "" {
+		queryParam["manager"] = req.Manager
+	}
+	secrets, total, err := resourcebiz.ListPagedSecrets(
+		c.Request.Context(),
+		queryParam,
+		labelMap,
+		strings.Split(req.Status, ","),
+		req.Name,
+		req.Updater,
+		req.OrderBy,
+		utils.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	var results serializer.SecretListResponse
+	for _, secret := range secrets {
+		results = append(results, serializer.SecretOutputInfo{
+			AutoID:    secret.AutoID,
+			ID:        secret.ID,
+			GatewayID: secret.GatewayID,
+			SecretInfo: serializer.SecretInfo{
+				ID:      secret.ID,
+				Name:    secret.Name,
+				Manager: secret.Manager,
+				Config:  json.RawMessage(sensitive.Mask(constant.Secret, secret.Config)),
+			},
+			Status:    secret.Status,
+			CreatedAt: secret.CreatedAt.Unix(),
+			UpdatedAt: secret.UpdatedAt.Unix(),
+			Creator:   secret.Creator,
+			Updater:   secret.Updater,
+		})
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// SecretGet ...
+//
+//	@ID			secret_get
+//	@Summary	secret 详情
+//	@Produce	json
+//	@Tags		webapi.secret
+//	@Param		gateway_id	path		int		true	"网关 id"
+//	@Param		id			path		string	true	"资源 ID"
+//	@Success	200			{object}	serializer.SecretOutputInfo
+//	@Router		/api/v1/web/gateways/{gateway_id}/secrets/{id}/ [get]
+func SecretGet(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	secret, err := resourcebiz.GetSecret(c.Request.Context(), pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	output := serializer.SecretOutputInfo{
+		AutoID:    secret.AutoID,
+		ID:        secret.ID,
+		GatewayID: secret.GatewayID,
+		SecretInfo: serializer.SecretInfo{
+			ID:      secret.ID,
+			Name:    secret.Name,
+			Manager: secret.Manager,
+			Config:  json.RawMessage(sensitive.Mask(constant.Secret, secret.Config)),
+		},
+		CreatedAt: secret.CreatedAt.Unix(),
+		UpdatedAt: secret.UpdatedAt.Unix(),
+		Creator:   secret.Creator,
+		Updater:   secret.Updater,
+		Status:    secret.Status,
+	}
+	ginx.SuccessJSONResponse(c, output)
+}
+
+// SecretDelete ...
+//
+//	@ID			secret_delete
+//	@Summary	secret 删除
+//	@Produce	json
+//	@Tags		webapi.secret
+//	@Param		gateway_id	path	int		true	"网关 id"
+//	@Param		id			path	string	true	"资源 ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/secrets/{id}/ [delete]
+func SecretDelete(c *gin.Context) {
+	var pathParam serializer.ResourceCommonPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	secret, err := resourcebiz.GetSecret(c.Request.Context(), pathParam.ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	// create_draft 状态可以直接删除
+	if secret.Status == constant.ResourceStatusCreateDraft {
+		err = resourcebiz.BatchDeleteSecrets(c.Request.Context(), []string{secret.ID})
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		ginx.SuccessNoContentResponse(c)
+		return
+	}
+	err = resourcebiz.UpdateResourceStatusWithAuditLog(c.Request.Context(),
+		constant.Secret, secret.ID, constant.ResourceStatusDeleteDraft)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// SecretDropDownList ...
+//
+//	@ID			secret_dropdown_list
+//	@Summary	secret 下拉列表
+//	@Produce	json
+//	@Tags		webapi.secret
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=serializer.SecretDropDownListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/secrets-dropdown/ [get]
+func SecretDropDownList(c *gin.Context) {
+	secrets, err := resourcebiz.ListSecrets(c.Request.Context())
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+
+	var output serializer.SecretDropDownListResponse
+	for _, secret := range secrets {
+		output = append(output, serializer.SecretDropDownOutputInfo{
+			AutoID:    secret.AutoID,
+			ID:        secret.ID,
+			Name:      secret.Name,
+			Manager:   secret.Manager,
+			Reference: fmt.Sprintf("$secret://%s/%s/", secret.Manager, secret.ID),
+		})
+	}
+	ginx.SuccessJSONResponse(c, output)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -191,7 +192,7 @@ func ServiceList(c *gin.Context) {
 				ID:         service.ID,
 				Name:       service.Name,
 				UpstreamID: service.UpstreamID,
-				Config:     json.RawMessage(service.Config),
+				Config:     json.RawMessage(sensitive.Mask(constant.Service, service.Config)),
 			},
 			Status:    service.Status,
 			CreatedAt: service.CreatedAt.Unix(),
@@ -232,7 +233,7 @@ func ServiceGet(c *gin.Context) {
 			ID:         service.ID,
 			Name:       service.Name,
 			UpstreamID: service.UpstreamID,
-			Config:     json.RawMessage(service.Config),
+			Config:     json.RawMessage(sensitive.Mask(constant.Service, service.Config)),
 		},
 		CreatedAt: service.CreatedAt.Unix(),
 		UpdatedAt: service.UpdatedAt.Unix(),
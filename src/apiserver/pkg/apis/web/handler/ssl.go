@@ -20,19 +20,23 @@ package handler
 
 import (
 	"encoding/json"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"gorm.io/datatypes"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	sslexpirybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/sslexpiry"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -242,6 +246,7 @@ func SSLList(c *gin.Context) {
 		return
 	}
 	var results serializer.SSLListResponse
+	now := time.Now().Unix()
 	for _, ssl := range ssls {
 		results = append(results, serializer.SSLOutputInfo{
 			AutoID:    ssl.AutoID,
@@ -250,13 +255,16 @@ func SSLList(c *gin.Context) {
 			SSLInfo: serializer.SSLInfo{
 				ID:     ssl.ID,
 				Name:   ssl.Name,
-				Config: json.RawMessage(ssl.Config),
+				Config: json.RawMessage(sensitive.Mask(constant.SSL, ssl.Config)),
 			},
-			Status:    ssl.Status,
-			CreatedAt: ssl.CreatedAt.Unix(),
-			UpdatedAt: ssl.UpdatedAt.Unix(),
-			Creator:   ssl.Creator,
-			Updater:   ssl.Updater,
+			ValidityStart: ssl.ValidityStart,
+			ValidityEnd:   ssl.ValidityEnd,
+			ExpiresIn:     serializer.SSLExpiresIn(ssl.ValidityEnd, now),
+			Status:        ssl.Status,
+			CreatedAt:     ssl.CreatedAt.Unix(),
+			UpdatedAt:     ssl.UpdatedAt.Unix(),
+			Creator:       ssl.Creator,
+			Updater:       ssl.Updater,
 		})
 	}
 	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
@@ -290,13 +298,16 @@ func SSLGet(c *gin.Context) {
 		SSLInfo: serializer.SSLInfo{
 			ID:     ssl.ID,
 			Name:   ssl.Name,
-			Config: json.RawMessage(ssl.Config),
+			Config: json.RawMessage(sensitive.Mask(constant.SSL, ssl.Config)),
 		},
-		CreatedAt: ssl.CreatedAt.Unix(),
-		UpdatedAt: ssl.UpdatedAt.Unix(),
-		Creator:   ssl.Creator,
-		Updater:   ssl.Updater,
-		Status:    ssl.Status,
+		ValidityStart: ssl.ValidityStart,
+		ValidityEnd:   ssl.ValidityEnd,
+		ExpiresIn:     serializer.SSLExpiresIn(ssl.ValidityEnd, time.Now().Unix()),
+		CreatedAt:     ssl.CreatedAt.Unix(),
+		UpdatedAt:     ssl.UpdatedAt.Unix(),
+		Creator:       ssl.Creator,
+		Updater:       ssl.Updater,
+		Status:        ssl.Status,
 	}
 	ginx.SuccessJSONResponse(c, output)
 }
@@ -366,3 +377,52 @@ func SSLDropDownList(c *gin.Context) {
 	}
 	ginx.SuccessJSONResponse(c, output)
 }
+
+// SSLExpiringList ...
+//
+//	@ID			ssl_expiring_list
+//	@Summary	即将过期的证书列表，包含编辑区及 etcd 中未纳管的证书，按过期时间升序
+//	@Produce	json
+//	@Tags		webapi.ssl
+//	@Param		gateway_id	path		int									true	"网关 ID"
+//	@Param		request		query		serializer.SSLExpiringListRequest	false	"查询参数"
+//	@Success	200			{object}	ginx.Response{data=serializer.SSLExpiringListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/ssls-expiring/ [get]
+func SSLExpiringList(c *gin.Context) {
+	var req serializer.SSLExpiringListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	days := req.Days
+	if days == 0 {
+		days = slices.Max(sslexpirybiz.AlertDays())
+	}
+	now := time.Now()
+	certificates, err := sslexpirybiz.ListCertificates(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c).ID,
+		now.AddDate(0, 0, days).Unix(),
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	output := make(serializer.SSLExpiringListResponse, 0, len(certificates))
+	for _, certificate := range certificates {
+		output = append(output, serializer.SSLExpiringOutputInfo{
+			ID:            certificate.ID,
+			Name:          certificate.Name,
+			Source:        certificate.Source,
+			Snis:          certificate.Snis,
+			UpstreamIDs:   certificate.UpstreamIDs,
+			ValidityStart: certificate.ValidityStart,
+			ValidityEnd:   certificate.ValidityEnd,
+			ExpiresIn:     serializer.SSLExpiresIn(certificate.ValidityEnd, now.Unix()),
+			ExpiresInDays: certificate.ExpiresInDays(now),
+			Expired:       certificate.Expired(now),
+			InUse:         certificate.InUse(),
+		})
+	}
+	ginx.SuccessJSONResponse(c, output)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -166,7 +167,7 @@ func StreamRouteGet(c *gin.Context) {
 			Name:       streamRoute.Name,
 			ServiceID:  streamRoute.ServiceID,
 			UpstreamID: streamRoute.UpstreamID,
-			Config:     json.RawMessage(streamRoute.Config),
+			Config:     json.RawMessage(sensitive.Mask(constant.StreamRoute, streamRoute.Config)),
 		},
 		CreatedAt: streamRoute.CreatedAt.Unix(),
 		UpdatedAt: streamRoute.UpdatedAt.Unix(),
@@ -277,7 +278,7 @@ func StreamRouteList(c *gin.Context) {
 				Name:       sr.Name,
 				ServiceID:  sr.ServiceID,
 				UpstreamID: sr.UpstreamID,
-				Config:     json.RawMessage(sr.Config),
+				Config:     json.RawMessage(sensitive.Mask(constant.StreamRoute, sr.Config)),
 			},
 			Status:    sr.Status,
 			CreatedAt: sr.CreatedAt.Unix(),
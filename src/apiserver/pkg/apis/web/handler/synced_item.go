@@ -28,12 +28,14 @@ import (
 	"github.com/tidwall/gjson"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	driftbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/drift"
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	syncdatabiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/syncdata"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 // SyncedItemList ...
@@ -131,18 +133,22 @@ func SyncedItemSummary(c *gin.Context) {
 	}
 	var successCount int64
 	var missCount int64
-	// 统计一致/缺失数量
+	var driftedCount int64
+	// 统计一致/缺失/漂移数量
 	for _, item := range results {
 		switch item.Status {
 		case constant.SyncedResourceStatusSuccess:
 			successCount++
 		case constant.SyncedResourceStatusMiss:
 			missCount++
+		case constant.SyncedResourceStatusDrifted:
+			driftedCount++
 		}
 	}
 	ginx.SuccessJSONResponse(c, serializer.SyncedSummaryOutputInfo{
 		Success: successCount,
 		Miss:    missCount,
+		Drifted: driftedCount,
 	})
 }
 
@@ -157,6 +163,10 @@ func enrichOutputInfo(
 	outputDataMap := make(map[string]*serializer.SyncDataOutputInfo) // id:sync
 	var output []*serializer.SyncDataOutputInfo
 	var filterOutput []*serializer.SyncDataOutputInfo
+	driftedKeys, err := driftbiz.GetDriftedResourceKeys(ctx, ginx.GetGatewayInfoFromContext(ctx).ID)
+	if err != nil {
+		return nil, err
+	}
 	for _, sync := range syncDataList {
 		if idList, ok := resourceIDMap[sync.Type]; ok {
 			resourceIDMap[sync.Type] = append(idList, sync.ID)
@@ -168,11 +178,15 @@ func enrichOutputInfo(
 			GatewayID:    sync.GatewayID,
 			ResourceType: sync.Type,
 			ModeRevision: sync.ModRevision,
-			Config:       json.RawMessage(sync.Config),
+			Config:       json.RawMessage(sensitive.Mask(sync.Type, sync.Config)),
 			Status:       constant.SyncedResourceStatusSuccess,
 			CreatedAt:    sync.CreatedAt.Unix(),
 			UpdatedAt:    sync.UpdatedAt.Unix(),
 		}
+		// etcd 中的配置与最近一次发布的配置不一致
+		if driftedKeys[sync.GetEtcdKey()] {
+			syncData.Status = constant.SyncedResourceStatusDrifted
+		}
 		outputDataMap[sync.ID] = syncData
 		output = append(output, syncData)
 	}
@@ -188,7 +202,7 @@ func enrichOutputInfo(
 		}
 	}
 	for _, sync := range output {
-		if _, ok := dbResourceIDMap[sync.ID]; !ok {
+		if _, ok := dbResourceIDMap[sync.ID]; !ok && sync.Status != constant.SyncedResourceStatusDrifted {
 			sync.Status = constant.SyncedResourceStatusMiss
 		}
 		// 判断发布来源 todo: 根据标签来判断
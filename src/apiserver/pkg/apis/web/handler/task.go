@@ -0,0 +1,164 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	taskbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/task"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// 后台任务的最大执行次数：一键发布、纳管、导出可以安全重试，资源导入失败后需要用户确认后重新导入
+const (
+	publishAllMaxAttempts            = 3
+	syncedResourceManagedMaxAttempts = 3
+	etcdExportMaxAttempts            = 3
+	resourceImportMaxAttempts        = 1
+)
+
+// TaskList 后台任务列表
+//
+//	@ID			task_list
+//	@Summary	后台任务列表
+//	@Produce	json
+//	@Tags		webapi.task
+//	@Param		gateway_id	path		int							true	"网关 ID"
+//	@Param		request		query		serializer.TaskListRequest	false	"查询参数"
+//	@Param		offset		query		int							false	"offset"
+//	@Param		limit		query		int							false	"limit"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=[]serializer.TaskOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/tasks/ [get]
+func TaskList(c *gin.Context) {
+	var req serializer.TaskListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	tasks, total, err := taskbiz.ListTasks(
+		c.Request.Context(),
+		ginx.GetGatewayInfo(c).ID,
+		req.Name,
+		req.Status,
+		utils.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make([]serializer.TaskOutputInfo, 0, len(tasks))
+	for _, task := range tasks {
+		results = append(results, serializer.TaskToOutputInfo(task, false))
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// TaskGet 后台任务详情，包含任务结果
+//
+//	@ID			task_get
+//	@Summary	后台任务详情
+//	@Produce	json
+//	@Tags		webapi.task
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Param		task_id		path		int	true	"任务 ID"
+//	@Success	200			{object}	ginx.Response{data=serializer.TaskOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/tasks/{task_id}/ [get]
+func TaskGet(c *gin.Context) {
+	task, ok := getTask(c)
+	if !ok {
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.TaskToOutputInfo(task, true))
+}
+
+// TaskCancel 取消后台任务，执行中的任务会在下次上报进度时中止
+//
+//	@ID			task_cancel
+//	@Summary	取消后台任务
+//	@Produce	json
+//	@Tags		webapi.task
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Param		task_id		path		int	true	"任务 ID"
+//	@Success	200			{object}	ginx.Response{data=serializer.TaskOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/tasks/{task_id}/cancel/ [post]
+func TaskCancel(c *gin.Context) {
+	task, ok := getTask(c)
+	if !ok {
+		return
+	}
+	if err := taskbiz.CancelTask(c.Request.Context(), task); err != nil {
+		taskErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.TaskToOutputInfo(task, false))
+}
+
+// getTask 根据路径参数查询当前网关的后台任务，失败时直接返回错误响应
+func getTask(c *gin.Context) (*model.Task, bool) {
+	var pathParam serializer.TaskPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return nil, false
+	}
+	task, err := taskbiz.GetTask(c.Request.Context(), ginx.GetGatewayInfo(c).ID, pathParam.TaskID)
+	if err != nil {
+		taskErrorResponse(c, err)
+		return nil, false
+	}
+	return task, true
+}
+
+// taskErrorResponse 将后台任务的错误转换为响应
+func taskErrorResponse(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, taskbiz.ErrTaskNotFound):
+		ginx.NotFoundJSONResponse(c, err)
+	case errors.Is(err, taskbiz.ErrTaskFinished):
+		ginx.ConflictJSONResponse(c, err)
+	default:
+		ginx.SystemErrorJSONResponse(c, err)
+	}
+}
+
+// isAsyncRequest 是否要求以后台任务异步执行
+func isAsyncRequest(c *gin.Context) bool {
+	var req serializer.AsyncRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		return false
+	}
+	return req.Async
+}
+
+// enqueueTask 下发后台任务并返回任务信息
+func enqueueTask(c *gin.Context, name string, args any, maxAttempts int) {
+	task, err := taskbiz.Enqueue(c.Request.Context(), name, args, maxAttempts)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateJSONResponse(c, serializer.TaskToOutputInfo(task, false))
+}
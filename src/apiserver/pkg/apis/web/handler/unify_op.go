@@ -19,8 +19,8 @@
 package handler
 
 import (
-	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"slices"
 
@@ -28,17 +28,15 @@ import (
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
 	diffbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/diff"
+	exportflowbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/exportflow"
 	importflowbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/importflow"
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
-	schemabiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/schema"
+	taskbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/task"
 	unifyopbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/unifyop"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
-	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
-	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/filex"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
-	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -111,7 +109,9 @@ func ResourceRevert(c *gin.Context) {
 //	@Tags		webapi.unify_op
 //	@Param		gateway_id	path		int									true	"网关 ID"
 //	@Param		request		body		serializer.ResourceManagedRequest	true	"添加资源到编辑区请求参数"
+//	@Param		async		query		bool								false	"是否下发后台任务异步执行"
 //	@Success	200			{object}	serializer.ResourceManagedResponse
+//	@Success	201			{object}	ginx.Response{data=serializer.TaskOutputInfo}	"异步执行时返回后台任务"
 //	@Router		/api/v1/web/gateways/{gateway_id}/unify_op/resources/-/managed/ [post]
 func SyncedResourceManaged(c *gin.Context) {
 	var req serializer.ResourceManagedRequest
@@ -119,6 +119,11 @@ func SyncedResourceManaged(c *gin.Context) {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
+	if isAsyncRequest(c) {
+		enqueueTask(c, taskbiz.TaskNameSyncedResourceManaged,
+			taskbiz.SyncedResourceManagedArgs{ResourceIDList: req.ResourceIDList}, syncedResourceManagedMaxAttempts)
+		return
+	}
 	syncedResourceTypeStats, err := unifyopbiz.AddSyncedResources(c.Request.Context(), req.ResourceIDList)
 	if err != nil {
 		ginx.SystemErrorJSONResponse(c, err)
@@ -296,7 +301,9 @@ func ResourceLabelsList(c *gin.Context) {
 //	@Accept		json
 //	@Produce	json
 //	@Tags		webapi.unify_op
-//	@Param		gateway_id	path	int	true	"网关 ID"
+//	@Param		gateway_id	path	int		true	"网关 ID"
+//	@Param		async		query	bool	false	"是否下发后台任务异步执行，导出结果记录在任务结果中"
+//	@Success	201	{object}	ginx.Response{data=serializer.TaskOutputInfo}	"异步执行时返回后台任务"
 //	@Router		/api/v1/web/gateways/{gateway_id}/unify_op/etcd/export/ [get]
 //
 // EtcdExport handles the export of etcd resources for a specified gateway.
@@ -310,21 +317,12 @@ func EtcdExport(c *gin.Context) {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
-	exporter, err := unifyopbiz.NewUnifyOp(ginx.GetGatewayInfo(c), false)
-	if err != nil {
-		logging.ErrorFWithContext(c.Request.Context(), "new exporter error: %s", err.Error())
-		ginx.SystemErrorJSONResponse(c, err)
-		return
-	}
-	resources, err := exporter.ExportEtcdResources(c.Request.Context())
-	if err != nil {
-		logging.ErrorFWithContext(c.Request.Context(), "export etcd resources error: %s", err.Error())
-		ginx.SystemErrorJSONResponse(c, err)
+	if isAsyncRequest(c) {
+		enqueueTask(c, taskbiz.TaskNameEtcdExport, nil, etcdExportMaxAttempts)
 		return
 	}
-	outputs, err := handExportEtcdResources(c.Request.Context(), resources)
+	outputs, err := unifyopbiz.ExportGatewayEtcdResources(c.Request.Context())
 	if err != nil {
-		logging.ErrorFWithContext(c.Request.Context(), "handle export etcd resources error: %s", err.Error())
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
@@ -338,55 +336,74 @@ func EtcdExport(c *gin.Context) {
 	ginx.SuccessFileResponse(c, "text/plain", fileData, fileName)
 }
 
-// handExportEtcdResources 处理导出 etcd 资源
-func handExportEtcdResources(
-	ctx context.Context,
-	resources []*model.GatewaySyncData,
-) (serializer.EtcdExportOutput, error) {
-	outputs := make(serializer.EtcdExportOutput)
-	for _, resource := range resources {
-		if resource.ID == "" {
-			resource.ID = idx.GenResourceID(resource.Type)
-		}
-		resourceOutput := serializer.ResourceInfo{
-			ResourceType: resource.Type,
-			ResourceID:   resource.ID,
-			Name:         resource.GetName(),
-			Config:       json.RawMessage(resource.Config),
-		}
-		if _, ok := outputs[resource.Type]; !ok {
-			outputs[resource.Type] = []serializer.ResourceInfo{resourceOutput}
-			continue
-		}
-		outputs[resource.Type] = append(outputs[resource.Type], resourceOutput)
+// EditorExport 编辑区资源导出 ...
+//
+//	@ID			resources_editor_export
+//	@Summary	编辑区资源导出为 json（敏感字段脱敏）或 APISIX standalone 格式（明文，需要编辑权限），资源均按发布时的 schema 校验
+//	@Produce	json
+//	@Tags		webapi.unify_op
+//	@Param		gateway_id	path	int								true	"网关 ID"
+//	@Param		request		query	serializer.EditorExportRequest	false	"导出参数"
+//	@Success	200
+//	@Router		/api/v1/web/gateways/{gateway_id}/unify_op/resources/-/export/ [get]
+func EditorExport(c *gin.Context) {
+	var req serializer.EditorExportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
 	}
-	// add schema
-	schemaMap, err := schemabiz.GetCustomizePluginSchemaInfoMap(ctx)
+	labelMap, err := serializer.CheckLabel(req.Label)
 	if err != nil {
-		logging.ErrorFWithContext(ctx, "get customize plugin schema info map error: %s", err.Error())
-		return nil, err
-	}
-	var schemaInfoList []serializer.ResourceInfo
-	for name, schema := range schemaMap {
-		schemaInfo := map[string]any{
-			"name":    name,
-			"schema":  schema.Schema,
-			"example": schema.Example,
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	opts := &dto.EditorExportOptions{
+		State:         req.State,
+		ResourceTypes: req.ResourceTypes,
+		Labels:        labelMap,
+	}
+	if opts.State == "" {
+		opts.State = constant.ExportStateDraft
+	}
+	// standalone 配置直接用于数据面，需包含明文的敏感字段，仅对有编辑权限的成员开放
+	if req.Format == constant.ExportFormatStandalone {
+		if !ginx.GetGatewayRole(c).HasPermission(constant.GatewayPermissionEdit) {
+			ginx.ForbiddenJSONResponse(c, errors.New("导出 standalone 格式包含明文敏感配置，需要编辑权限"))
+			return
 		}
-		schemaInfoBytes, err := json.Marshal(schemaInfo)
+		opts.Plaintext = true
+	}
+	resources, err := exportflowbiz.ExportEditorResources(c.Request.Context(), opts)
+	if errors.Is(err, exportflowbiz.ErrResourceInvalid) {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	gatewayName := ginx.GetGatewayInfo(c).Name
+	if req.Format == constant.ExportFormatStandalone {
+		fileData, err := exportflowbiz.ToStandaloneYAML(resources)
 		if err != nil {
-			return nil, fmt.Errorf("marshal schema info failed: %w", err)
+			ginx.SystemErrorJSONResponse(c, err)
+			return
 		}
-		schemaInfoList = append(schemaInfoList, serializer.ResourceInfo{
-			ResourceType: constant.Schema,
-			Name:         name,
-			Config:       schemaInfoBytes,
-		})
+		ginx.SuccessFileResponse(c, "application/yaml", fileData, gatewayName+"_apisix.yaml")
+		return
 	}
-	if len(schemaInfoList) > 0 {
-		outputs[constant.Schema] = schemaInfoList
+	outputs, err := exportflowbiz.ToExportOutput(c.Request.Context(), resources)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
 	}
-	return outputs, nil
+	fileData, err := json.MarshalIndent(outputs, "", "    ")
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	fileName := fmt.Sprintf("%s_export_%s_resources.json", gatewayName, opts.State)
+	ginx.SuccessFileResponse(c, "text/plain", fileData, fileName)
 }
 
 // ResourceUpload 资源上传 ...
@@ -397,13 +414,17 @@ func handExportEtcdResources(
 //	@Produce	json
 //	@Tags		webapi.unify_op
 //	@Accept		multipart/form-data
-//	@Param		resource_file	formData	file					true	"资源配置文件 (json)"
+//	@Param		resource_file	formData	file					true	"资源配置文件 (json) 或 OpenAPI 文档 (json/yaml)"
+//	@Param		format			formData	string					false	"文件格式：json/openapi，默认为 json"
+//	@Param		service_id		formData	string					false	"openapi 格式时，生成的路由绑定的服务 ID"
+//	@Param		upstream_id		formData	string					false	"openapi 格式时，生成的路由绑定的上游 ID"
 //	@Param		gateway_id		path		int						true	"网关 ID"
 //	@Success	200				{object}	dto.ImportUploadInfo	"导入资源列表"
 //	@Router		/api/v1/web/gateways/{gateway_id}/unify_op/resources/upload/ [post]
 //
 // ResourceUpload handles the upload of resource configuration files for import.
 // It processes the uploaded file, validates the resources, and returns the imported resource list.
+// OpenAPI documents are converted into one route per path and method before the same preview flow.
 func ResourceUpload(c *gin.Context) {
 	// pathParam holds the common path parameters for resource operations
 	var pathParam serializer.ResourceCommonPathParam
@@ -413,13 +434,32 @@ func ResourceUpload(c *gin.Context) {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
+	var req serializer.ResourceUploadRequest
+	if err := c.ShouldBind(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
 	fileHeader, err := c.FormFile("resource_file")
 	if err != nil {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
 	var resourceInfoTypeMap map[constant.APISIXResource][]*dto.ImportResourceInfo
-	if err := filex.ReadFileToObject(fileHeader, &resourceInfoTypeMap); err != nil {
+	if req.Format == constant.ImportFormatOpenAPI {
+		data, err := filex.ReadFile(fileHeader)
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		resourceInfoTypeMap, err = importflowbiz.ConvertOpenAPISpec(data, dto.OpenAPIImportOptions{
+			ServiceID:  req.ServiceID,
+			UpstreamID: req.UpstreamID,
+		})
+		if err != nil {
+			ginx.BadRequestErrorJSONResponse(c, err)
+			return
+		}
+	} else if err := filex.ReadFileToObject(fileHeader, &resourceInfoTypeMap); err != nil {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
@@ -428,6 +468,11 @@ func ResourceUpload(c *gin.Context) {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
+	// 允许关联网关中已有的资源，与导入时的校验保持一致
+	if err := importflowbiz.IncludeExistingResourceIDs(c.Request.Context(), indexResult.AllResourceIDs); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
 	// check 配置
 	err = importflowbiz.ValidateImportedResources(
 		c.Request.Context(),
@@ -458,6 +503,8 @@ func ResourceUpload(c *gin.Context) {
 //	@Tags		webapi.unify_op
 //	@Param		gateway_id	path	int						true	"网关 ID"
 //	@Param		request		body	dto.ImportUploadInfo	true	"待导入的资源列表"
+//	@Param		async		query	bool					false	"是否下发后台任务异步执行"
+//	@Success	201	{object}	ginx.Response{data=serializer.TaskOutputInfo}	"异步执行时返回后台任务"
 //	@Router		/api/v1/web/gateways/{gateway_id}/unify_op/resources/import/ [post]
 //
 // ResourceImport handles importing resources from the request body,
@@ -476,45 +523,16 @@ func ResourceImport(c *gin.Context) {
 		ginx.BadRequestErrorJSONResponse(c, err)
 		return
 	}
-	// 处理新增的 schema 资源
-	allSchemaMap, err := schemabiz.GetCustomizePluginSchemaMap(c.Request.Context())
-	if err != nil {
-		ginx.SystemErrorJSONResponse(c, err)
-		return
-	}
-	addedSchemaMap, err := importflowbiz.BuildImportUploadSchemaModels(
-		c.Request.Context(),
-		resourcesImport.Add,
-		allSchemaMap,
-	)
-	if err != nil {
-		ginx.SystemErrorJSONResponse(c, err)
-		return
-	}
-	updatedSchemaMap, err := importflowbiz.BuildImportUploadSchemaModels(
-		c.Request.Context(),
-		resourcesImport.Update,
-		allSchemaMap,
-	)
-	if err != nil {
-		ginx.SystemErrorJSONResponse(c, err)
-		return
-	}
-	handleResult, err := importflowbiz.PrepareImportUpload(c.Request.Context(), &resourcesImport, allSchemaMap,
-		map[constant.APISIXResource][]string{})
-	if err != nil {
-		ginx.SystemErrorJSONResponse(c, err)
+	if isAsyncRequest(c) {
+		// 任务参数持久化到数据库，敏感字段加密存储
+		if err := resourcesImport.EncryptSensitive(); err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			return
+		}
+		enqueueTask(c, taskbiz.TaskNameResourceImport, resourcesImport, resourceImportMaxAttempts)
 		return
 	}
-	// 插入数据
-	err = unifyopbiz.UploadResources(
-		c.Request.Context(),
-		handleResult.AddResourceTypeMap,
-		handleResult.UpdateResourceTypeMap,
-		addedSchemaMap,
-		updatedSchemaMap,
-	)
-	if err != nil {
+	if err := importflowbiz.ImportUploadResourcesWithExisting(c.Request.Context(), &resourcesImport); err != nil {
 		ginx.SystemErrorJSONResponse(c, err)
 		return
 	}
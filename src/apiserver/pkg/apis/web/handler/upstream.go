@@ -33,6 +33,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
 )
 
@@ -187,7 +188,7 @@ func UpstreamList(c *gin.Context) {
 			UpstreamInfo: serializer.UpstreamInfo{
 				ID:     upstream.ID,
 				Name:   upstream.Name,
-				Config: json.RawMessage(upstream.Config),
+				Config: json.RawMessage(sensitive.Mask(constant.Upstream, upstream.Config)),
 				SSLID:  upstream.SSLID,
 			},
 			Status:    upstream.Status,
@@ -228,7 +229,7 @@ func UpstreamGet(c *gin.Context) {
 		UpstreamInfo: serializer.UpstreamInfo{
 			ID:     upstream.ID,
 			Name:   upstream.Name,
-			Config: json.RawMessage(upstream.Config),
+			Config: json.RawMessage(sensitive.Mask(constant.Upstream, upstream.Config)),
 			SSLID:  upstream.SSLID,
 		},
 		CreatedAt: upstream.CreatedAt.Unix(),
@@ -0,0 +1,282 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/serializer"
+	webhookbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/webhook"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// WebhookList 网关 webhook 列表
+//
+//	@ID			webhook_list
+//	@Summary	网关 webhook 列表
+//	@Produce	json
+//	@Tags		webapi.webhook
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Success	200			{object}	ginx.Response{data=serializer.WebhookListResponse}
+//	@Router		/api/v1/web/gateways/{gateway_id}/webhooks/ [get]
+func WebhookList(c *gin.Context) {
+	webhooks, err := webhookbiz.ListWebhooks(c.Request.Context(), ginx.GetGatewayInfo(c).ID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make(serializer.WebhookListResponse, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		results = append(results, serializer.WebhookToOutputInfo(webhook))
+	}
+	ginx.SuccessJSONResponse(c, results)
+}
+
+// WebhookCreate 创建网关 webhook
+//
+//	@ID			webhook_create
+//	@Summary	创建网关 webhook，订阅的事件发生时推送 HMAC 签名的 JSON，签名密钥明文仅在创建时返回
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.webhook
+//	@Param		gateway_id	path		int							true	"网关 ID"
+//	@Param		request		body		serializer.WebhookRequest	true	"网关 webhook"
+//	@Success	201			{object}	ginx.Response{data=serializer.WebhookCreateOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/webhooks/ [post]
+func WebhookCreate(c *gin.Context) {
+	var req serializer.WebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	webhook := &model.GatewayWebhook{
+		GatewayID:   ginx.GetGatewayInfo(c).ID,
+		Name:        req.Name,
+		URL:         req.URL,
+		Secret:      req.Secret,
+		Events:      pq.StringArray(req.Events),
+		Enabled:     req.Enabled,
+		Description: req.Description,
+		BaseModel: model.BaseModel{
+			Creator: ginx.GetUserID(c),
+			Updater: ginx.GetUserID(c),
+		},
+	}
+	secret, err := webhookbiz.CreateWebhook(c.Request.Context(), webhook)
+	if err != nil {
+		webhookErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessCreateJSONResponse(c, serializer.WebhookToCreateOutputInfo(webhook, secret))
+}
+
+// WebhookGet 获取网关 webhook 详情
+//
+//	@ID			webhook_get
+//	@Summary	获取网关 webhook 详情
+//	@Produce	json
+//	@Tags		webapi.webhook
+//	@Param		gateway_id	path		int	true	"网关 ID"
+//	@Param		webhook_id	path		int	true	"webhook ID"
+//	@Success	200			{object}	ginx.Response{data=serializer.WebhookOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/webhooks/{webhook_id}/ [get]
+func WebhookGet(c *gin.Context) {
+	var pathParam serializer.WebhookPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	webhook, err := webhookbiz.GetWebhook(c.Request.Context(), pathParam.GatewayID, pathParam.WebhookID)
+	if err != nil {
+		webhookErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.WebhookToOutputInfo(webhook))
+}
+
+// WebhookUpdate 更新网关 webhook
+//
+//	@ID			webhook_update
+//	@Summary	更新网关 webhook，签名密钥为空时保留原值
+//	@Accept		json
+//	@Produce	json
+//	@Tags		webapi.webhook
+//	@Param		gateway_id	path		int							true	"网关 ID"
+//	@Param		webhook_id	path		int							true	"webhook ID"
+//	@Param		request		body		serializer.WebhookRequest	true	"网关 webhook"
+//	@Success	200			{object}	ginx.Response{data=serializer.WebhookOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/webhooks/{webhook_id}/ [put]
+func WebhookUpdate(c *gin.Context) {
+	var pathParam serializer.WebhookPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.WebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	webhook := &model.GatewayWebhook{
+		ID:          pathParam.WebhookID,
+		GatewayID:   pathParam.GatewayID,
+		Name:        req.Name,
+		URL:         req.URL,
+		Secret:      req.Secret,
+		Events:      pq.StringArray(req.Events),
+		Enabled:     req.Enabled,
+		Description: req.Description,
+		BaseModel: model.BaseModel{
+			Updater: ginx.GetUserID(c),
+		},
+	}
+	if err := webhookbiz.UpdateWebhook(c.Request.Context(), webhook); err != nil {
+		webhookErrorResponse(c, err)
+		return
+	}
+	webhook, err := webhookbiz.GetWebhook(c.Request.Context(), pathParam.GatewayID, pathParam.WebhookID)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessJSONResponse(c, serializer.WebhookToOutputInfo(webhook))
+}
+
+// WebhookDelete 删除网关 webhook
+//
+//	@ID			webhook_delete
+//	@Summary	删除网关 webhook 及其投递记录
+//	@Produce	json
+//	@Tags		webapi.webhook
+//	@Param		gateway_id	path	int	true	"网关 ID"
+//	@Param		webhook_id	path	int	true	"webhook ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/webhooks/{webhook_id}/ [delete]
+func WebhookDelete(c *gin.Context) {
+	var pathParam serializer.WebhookPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	if err := webhookbiz.DeleteWebhook(c.Request.Context(), pathParam.GatewayID, pathParam.WebhookID); err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// WebhookDeliveryList webhook 投递记录列表
+//
+//	@ID			webhook_delivery_list
+//	@Summary	webhook 投递记录列表
+//	@Produce	json
+//	@Tags		webapi.webhook
+//	@Param		gateway_id	path		int									true	"网关 ID"
+//	@Param		webhook_id	path		int									true	"webhook ID"
+//	@Param		request		query		serializer.WebhookDeliveryListRequest	false	"查询参数"
+//	@Param		offset		query		int									false	"offset"
+//	@Param		limit		query		int									false	"limit"
+//	@Success	200			{object}	ginx.PaginatedResponse{results=[]serializer.WebhookDeliveryOutputInfo}
+//	@Router		/api/v1/web/gateways/{gateway_id}/webhooks/{webhook_id}/deliveries/ [get]
+func WebhookDeliveryList(c *gin.Context) {
+	var pathParam serializer.WebhookPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	var req serializer.WebhookDeliveryListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	ctx := c.Request.Context()
+	if _, err := webhookbiz.GetWebhook(ctx, pathParam.GatewayID, pathParam.WebhookID); err != nil {
+		webhookErrorResponse(c, err)
+		return
+	}
+	deliveries, total, err := webhookbiz.ListDeliveries(
+		ctx,
+		pathParam.GatewayID,
+		pathParam.WebhookID,
+		map[string]any{"status": req.Status, "event": req.Event},
+		utils.PageParam{
+			Offset: ginx.GetOffset(c),
+			Limit:  ginx.GetLimit(c),
+		},
+	)
+	if err != nil {
+		ginx.SystemErrorJSONResponse(c, err)
+		return
+	}
+	results := make([]serializer.WebhookDeliveryOutputInfo, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		results = append(results, serializer.WebhookDeliveryToOutputInfo(delivery))
+	}
+	ginx.SuccessJSONResponse(c, ginx.NewPaginatedRespData(total, results))
+}
+
+// WebhookDeliveryRedeliver 重新投递 webhook
+//
+//	@ID			webhook_delivery_redeliver
+//	@Summary	重新投递 webhook，重置投递次数后由后台任务在下一轮投递
+//	@Produce	json
+//	@Tags		webapi.webhook
+//	@Param		gateway_id	path	int	true	"网关 ID"
+//	@Param		webhook_id	path	int	true	"webhook ID"
+//	@Param		delivery_id	path	int	true	"投递记录 ID"
+//	@Success	204
+//	@Router		/api/v1/web/gateways/{gateway_id}/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver/ [post]
+func WebhookDeliveryRedeliver(c *gin.Context) {
+	var pathParam serializer.WebhookDeliveryPathParam
+	if err := c.ShouldBindUri(&pathParam); err != nil {
+		ginx.BadRequestErrorJSONResponse(c, err)
+		return
+	}
+	err := webhookbiz.Redeliver(
+		c.Request.Context(),
+		pathParam.GatewayID,
+		pathParam.WebhookID,
+		pathParam.DeliveryID,
+	)
+	if err != nil {
+		webhookErrorResponse(c, err)
+		return
+	}
+	ginx.SuccessNoContentResponse(c)
+}
+
+// webhookErrorResponse 将网关 webhook 的错误转换为响应
+func webhookErrorResponse(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, webhookbiz.ErrWebhookNotFound), errors.Is(err, webhookbiz.ErrDeliveryNotFound):
+		ginx.NotFoundJSONResponse(c, err)
+	case errors.Is(err, webhookbiz.ErrWebhookDuplicate):
+		ginx.ConflictJSONResponse(c, err)
+	case errors.Is(err, webhookbiz.ErrWebhookInvalidURL), errors.Is(err, webhookbiz.ErrWebhookInvalidEvent),
+		errors.Is(err, webhookbiz.ErrWebhookDeniedAddress):
+		ginx.BadRequestErrorJSONResponse(c, err)
+	default:
+		ginx.SystemErrorJSONResponse(c, err)
+	}
+}
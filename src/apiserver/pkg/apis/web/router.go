@@ -27,6 +27,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/account"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/web/handler"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/middleware"
 )
 
@@ -62,9 +63,20 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	group.POST("/gateways/check_name/", handler.GatewayCheckName)
 	group.POST("/gateways/etcd/test_connection/", handler.EtcdTestConnection)
 
+	// gateway group：权限由组内各网关的角色决定，在 handler 中校验
+	group.GET("/gateway_groups/", handler.GatewayGroupList)
+	group.POST("/gateway_groups/", handler.GatewayGroupCreate)
+	group.GET("/gateway_groups/:group_id/", handler.GatewayGroupGet)
+	group.PUT("/gateway_groups/:group_id/", handler.GatewayGroupUpdate)
+	group.DELETE("/gateway_groups/:group_id/", handler.GatewayGroupDelete)
+	group.GET("/gateway_groups/:group_id/rollouts/", handler.GatewayGroupRolloutList)
+	group.POST("/gateway_groups/:group_id/rollouts/", handler.GatewayGroupRolloutCreate)
+	group.GET("/gateway_groups/:group_id/rollouts/:rollout_id/", handler.GatewayGroupRolloutGet)
+	group.POST("/gateway_groups/:group_id/rollouts/:rollout_id/cancel/", handler.GatewayGroupRolloutCancel)
+
 	// gateway:gateway_id
 	gatewayGroup := group.Group("/gateways/:gateway_id")
-	gatewayGroup.Use(middleware.GatewayAccess())
+	gatewayGroup.Use(middleware.GatewayAccess(gatewayRoutePermissions))
 	gatewayGroup.Use(middleware.ResourceOperationCheck())
 
 	gatewayGroup.POST("/etcd/test_connection/", handler.GatewayEtcdTestConnection)
@@ -83,6 +95,8 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.DELETE("/routes/:id/", handler.RouteDelete)
 	gatewayGroup.GET("/routes/", handler.RouteList)
 	gatewayGroup.GET("/routes-dropdown/", handler.RouteDropDownList)
+	gatewayGroup.GET("/routes-openapi/", handler.RouteOpenAPI)
+	gatewayGroup.POST("/routes-match/", handler.RouteMatch)
 
 	// service
 	gatewayGroup.POST("/services/", handler.ServiceCreate)
@@ -108,6 +122,7 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.DELETE("/ssls/:id/", handler.SSLDelete)
 	gatewayGroup.GET("/ssls/", handler.SSLList)
 	gatewayGroup.GET("/ssls-dropdown/", handler.SSLDropDownList)
+	gatewayGroup.GET("/ssls-expiring/", handler.SSLExpiringList)
 
 	// global_rule
 	gatewayGroup.POST("/global_rules/", handler.GlobalRuleCreate)
@@ -134,6 +149,22 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.GET("/consumer_groups/", handler.ConsumerGroupList)
 	gatewayGroup.GET("/consumer_groups-dropdown/", handler.ConsumerGroupDropDownList)
 
+	// credential
+	gatewayGroup.POST("/credentials/", handler.CredentialCreate)
+	gatewayGroup.PUT("/credentials/:id/", handler.CredentialUpdate)
+	gatewayGroup.GET("/credentials/:id/", handler.CredentialGet)
+	gatewayGroup.DELETE("/credentials/:id/", handler.CredentialDelete)
+	gatewayGroup.GET("/credentials/", handler.CredentialList)
+	gatewayGroup.GET("/credentials-dropdown/", handler.CredentialDropDownList)
+
+	// secret
+	gatewayGroup.POST("/secrets/", handler.SecretCreate)
+	gatewayGroup.PUT("/secrets/:id/", handler.SecretUpdate)
+	gatewayGroup.GET("/secrets/:id/", handler.SecretGet)
+	gatewayGroup.DELETE("/secrets/:id/", handler.SecretDelete)
+	gatewayGroup.GET("/secrets/", handler.SecretList)
+	gatewayGroup.GET("/secrets-dropdown/", handler.SecretDropDownList)
+
 	// plugin_config
 	gatewayGroup.POST("/plugin_configs/", handler.PluginConfigCreate)
 	gatewayGroup.PUT("/plugin_configs/:id/", handler.PluginConfigUpdate)
@@ -183,6 +214,7 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.DELETE("/unify_op/resources/:type/", handler.ResourceDelete)
 	gatewayGroup.GET("/unify_op/resources/labels/:type/", handler.ResourceLabelsList)
 	gatewayGroup.GET("/unify_op/etcd/export/", handler.EtcdExport)
+	gatewayGroup.GET("/unify_op/resources/-/export/", handler.EditorExport)
 	gatewayGroup.POST("/unify_op/resources/upload/", handler.ResourceUpload)
 	gatewayGroup.POST("/unify_op/resources/import/", handler.ResourceImport)
 
@@ -200,6 +232,70 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.POST("/publish/", handler.PublishResource)
 	gatewayGroup.POST("/publish/all/", handler.PublishResourceAll)
 	gatewayGroup.POST("/sync/", handler.ResourceSync)
+	gatewayGroup.GET("/publish_journals/", handler.PublishJournalList)
+	gatewayGroup.POST("/publish_journals/:journal_id/resume/", handler.PublishJournalResume)
+	gatewayGroup.POST("/publish_journals/:journal_id/revert/", handler.PublishJournalRevert)
+
+	// change request
+	gatewayGroup.GET("/publish_policy/", handler.PublishPolicyGet)
+	gatewayGroup.PUT("/publish_policy/", handler.PublishPolicyUpdate)
+	gatewayGroup.GET("/change_requests/", handler.ChangeRequestList)
+	gatewayGroup.GET("/change_requests/:change_request_id/", handler.ChangeRequestGet)
+	// 审批人校验在 biz 中完成
+	gatewayGroup.POST("/change_requests/:change_request_id/approve/", handler.ChangeRequestApprove)
+	gatewayGroup.POST("/change_requests/:change_request_id/reject/", handler.ChangeRequestReject)
+	gatewayGroup.POST("/change_requests/:change_request_id/comment/", handler.ChangeRequestComment)
+	gatewayGroup.POST("/change_requests/:change_request_id/apply/", handler.ChangeRequestApply)
+
+	// schedule
+	gatewayGroup.GET("/maintenance_windows/", handler.MaintenanceWindowList)
+	gatewayGroup.POST("/maintenance_windows/", handler.MaintenanceWindowCreate)
+	gatewayGroup.PUT("/maintenance_windows/:window_id/", handler.MaintenanceWindowUpdate)
+	gatewayGroup.DELETE("/maintenance_windows/:window_id/", handler.MaintenanceWindowDelete)
+	gatewayGroup.GET("/scheduled_publishes/", handler.ScheduledPublishList)
+	gatewayGroup.POST("/scheduled_publishes/", handler.ScheduledPublishCreate)
+	gatewayGroup.GET("/scheduled_publishes/:scheduled_publish_id/", handler.ScheduledPublishGet)
+	gatewayGroup.POST("/scheduled_publishes/:scheduled_publish_id/cancel/", handler.ScheduledPublishCancel)
+
+	// task
+	gatewayGroup.GET("/tasks/", handler.TaskList)
+	gatewayGroup.GET("/tasks/:task_id/", handler.TaskGet)
+	gatewayGroup.POST("/tasks/:task_id/cancel/", handler.TaskCancel)
+
+	// release_version
+	gatewayGroup.GET("/release_versions/", handler.ReleaseVersionList)
+	gatewayGroup.GET("/release_versions/:version_id/", handler.ReleaseVersionGet)
+	gatewayGroup.GET("/release_versions/:version_id/diff/", handler.ReleaseVersionDiff)
+	gatewayGroup.POST("/release_versions/:version_id/rollback/", handler.ReleaseVersionRollback)
+
+	// drift
+	gatewayGroup.GET("/drifts/", handler.DriftRecordList)
+
+	// data plane
+	gatewayGroup.GET("/data_plane/instances/", handler.DataPlaneInstanceList)
+
+	// promotion
+	gatewayGroup.POST("/promotions/plan/", handler.PromotionPlan)
+	gatewayGroup.POST("/promotions/", handler.PromotionCreate)
+
+	// variable
+	gatewayGroup.GET("/variables/", handler.GatewayVariableList)
+	gatewayGroup.POST("/variables/", handler.GatewayVariableCreate)
+	gatewayGroup.GET("/variables/:variable_id/", handler.GatewayVariableGet)
+	gatewayGroup.PUT("/variables/:variable_id/", handler.GatewayVariableUpdate)
+	gatewayGroup.DELETE("/variables/:variable_id/", handler.GatewayVariableDelete)
+
+	// webhook
+	gatewayGroup.GET("/webhooks/", handler.WebhookList)
+	gatewayGroup.POST("/webhooks/", handler.WebhookCreate)
+	gatewayGroup.GET("/webhooks/:webhook_id/", handler.WebhookGet)
+	gatewayGroup.PUT("/webhooks/:webhook_id/", handler.WebhookUpdate)
+	gatewayGroup.DELETE("/webhooks/:webhook_id/", handler.WebhookDelete)
+	gatewayGroup.GET("/webhooks/:webhook_id/deliveries/", handler.WebhookDeliveryList)
+	gatewayGroup.POST(
+		"/webhooks/:webhook_id/deliveries/:delivery_id/redeliver/",
+		handler.WebhookDeliveryRedeliver,
+	)
 
 	// mcp access tokens
 	gatewayGroup.GET("/mcp/tokens/", handler.MCPAccessTokenList)
@@ -207,4 +303,243 @@ func RegisterWebApi(path string, router *gin.RouterGroup) {
 	gatewayGroup.GET("/mcp/tokens/:token_id/", handler.MCPAccessTokenGet)
 	// Note: Update is not supported - tokens should be deleted and recreated
 	gatewayGroup.DELETE("/mcp/tokens/:token_id/", handler.MCPAccessTokenDelete)
+
+	// member
+	gatewayGroup.GET("/members/", handler.GatewayMemberList)
+	gatewayGroup.POST("/members/", handler.GatewayMemberCreate)
+	gatewayGroup.PUT("/members/:member_id/", handler.GatewayMemberUpdate)
+	gatewayGroup.DELETE("/members/:member_id/", handler.GatewayMemberDelete)
+}
+
+// gatewayRoutePermissions 网关下各路由所需的权限，key 为 "METHOD 路由"（去掉网关前缀）
+//
+// 查看 view / 编辑草稿 edit / 发布 publish / 管理网关及成员 manage，新增路由时需要同步配置
+var gatewayRoutePermissions = middleware.GatewayRoutePermissions{
+	// gateway
+	"POST /etcd/test_connection/": constant.GatewayPermissionManage,
+	"PUT /":                       constant.GatewayPermissionManage,
+	"GET /":                       constant.GatewayPermissionView,
+	"DELETE /":                    constant.GatewayPermissionManage,
+
+	// labels
+	"GET /labels/:type/": constant.GatewayPermissionView,
+
+	// route
+	"POST /routes/":         constant.GatewayPermissionEdit,
+	"PUT /routes/:id/":      constant.GatewayPermissionEdit,
+	"GET /routes/:id/":      constant.GatewayPermissionView,
+	"DELETE /routes/:id/":   constant.GatewayPermissionEdit,
+	"GET /routes/":          constant.GatewayPermissionView,
+	"GET /routes-dropdown/": constant.GatewayPermissionView,
+	"GET /routes-openapi/":  constant.GatewayPermissionView,
+	"POST /routes-match/":   constant.GatewayPermissionView,
+
+	// service
+	"POST /services/":         constant.GatewayPermissionEdit,
+	"PUT /services/:id/":      constant.GatewayPermissionEdit,
+	"GET /services/:id/":      constant.GatewayPermissionView,
+	"DELETE /services/:id/":   constant.GatewayPermissionEdit,
+	"GET /services/":          constant.GatewayPermissionView,
+	"GET /services-dropdown/": constant.GatewayPermissionView,
+
+	// upstream
+	"POST /upstreams/":         constant.GatewayPermissionEdit,
+	"PUT /upstreams/:id/":      constant.GatewayPermissionEdit,
+	"GET /upstreams/:id/":      constant.GatewayPermissionView,
+	"DELETE /upstreams/:id/":   constant.GatewayPermissionEdit,
+	"GET /upstreams/":          constant.GatewayPermissionView,
+	"GET /upstreams-dropdown/": constant.GatewayPermissionView,
+
+	// ssl
+	"POST /ssls/":         constant.GatewayPermissionEdit,
+	"POST /ssls/check/":   constant.GatewayPermissionEdit,
+	"PUT /ssls/:id/":      constant.GatewayPermissionEdit,
+	"GET /ssls/:id/":      constant.GatewayPermissionView,
+	"DELETE /ssls/:id/":   constant.GatewayPermissionEdit,
+	"GET /ssls/":          constant.GatewayPermissionView,
+	"GET /ssls-dropdown/": constant.GatewayPermissionView,
+	"GET /ssls-expiring/": constant.GatewayPermissionView,
+
+	// global_rule
+	"POST /global_rules/":          constant.GatewayPermissionEdit,
+	"PUT /global_rules/:id/":       constant.GatewayPermissionEdit,
+	"GET /global_rules/:id/":       constant.GatewayPermissionView,
+	"DELETE /global_rules/:id/":    constant.GatewayPermissionEdit,
+	"GET /global_rules/":           constant.GatewayPermissionView,
+	"GET /global_rules/-/plugins/": constant.GatewayPermissionView,
+	"GET /global_rules-dropdown/":  constant.GatewayPermissionView,
+
+	// consumer
+	"POST /consumers/":         constant.GatewayPermissionEdit,
+	"PUT /consumers/:id/":      constant.GatewayPermissionEdit,
+	"GET /consumers/:id/":      constant.GatewayPermissionView,
+	"DELETE /consumers/:id/":   constant.GatewayPermissionEdit,
+	"GET /consumers/":          constant.GatewayPermissionView,
+	"GET /consumers-dropdown/": constant.GatewayPermissionView,
+
+	// consumer_group
+	"POST /consumer_groups/":         constant.GatewayPermissionEdit,
+	"PUT /consumer_groups/:id/":      constant.GatewayPermissionEdit,
+	"GET /consumer_groups/:id/":      constant.GatewayPermissionView,
+	"DELETE /consumer_groups/:id/":   constant.GatewayPermissionEdit,
+	"GET /consumer_groups/":          constant.GatewayPermissionView,
+	"GET /consumer_groups-dropdown/": constant.GatewayPermissionView,
+
+	// credential
+	"POST /credentials/":         constant.GatewayPermissionEdit,
+	"PUT /credentials/:id/":      constant.GatewayPermissionEdit,
+	"GET /credentials/:id/":      constant.GatewayPermissionView,
+	"DELETE /credentials/:id/":   constant.GatewayPermissionEdit,
+	"GET /credentials/":          constant.GatewayPermissionView,
+	"GET /credentials-dropdown/": constant.GatewayPermissionView,
+
+	// secret
+	"POST /secrets/":         constant.GatewayPermissionEdit,
+	"PUT /secrets/:id/":      constant.GatewayPermissionEdit,
+	"GET /secrets/:id/":      constant.GatewayPermissionView,
+	"DELETE /secrets/:id/":   constant.GatewayPermissionEdit,
+	"GET /secrets/":          constant.GatewayPermissionView,
+	"GET /secrets-dropdown/": constant.GatewayPermissionView,
+
+	// plugin_config
+	"POST /plugin_configs/":         constant.GatewayPermissionEdit,
+	"PUT /plugin_configs/:id/":      constant.GatewayPermissionEdit,
+	"GET /plugin_configs/:id/":      constant.GatewayPermissionView,
+	"DELETE /plugin_configs/:id/":   constant.GatewayPermissionEdit,
+	"GET /plugin_configs/":          constant.GatewayPermissionView,
+	"GET /plugin_configs-dropdown/": constant.GatewayPermissionView,
+
+	// plugin_metadata
+	"POST /plugin_metadatas/":         constant.GatewayPermissionEdit,
+	"PUT /plugin_metadatas/:id/":      constant.GatewayPermissionEdit,
+	"GET /plugin_metadatas/:id/":      constant.GatewayPermissionView,
+	"DELETE /plugin_metadatas/:id/":   constant.GatewayPermissionEdit,
+	"GET /plugin_metadatas/":          constant.GatewayPermissionView,
+	"GET /plugin_metadatas-dropdown/": constant.GatewayPermissionView,
+
+	// proto
+	"POST /protos/":         constant.GatewayPermissionEdit,
+	"PUT /protos/:id/":      constant.GatewayPermissionEdit,
+	"GET /protos/:id/":      constant.GatewayPermissionView,
+	"DELETE /protos/:id/":   constant.GatewayPermissionEdit,
+	"GET /protos/":          constant.GatewayPermissionView,
+	"GET /protos-dropdown/": constant.GatewayPermissionView,
+
+	// stream_route
+	"POST /stream_routes/":         constant.GatewayPermissionEdit,
+	"PUT /stream_routes/:id/":      constant.GatewayPermissionEdit,
+	"GET /stream_routes/:id/":      constant.GatewayPermissionView,
+	"DELETE /stream_routes/:id/":   constant.GatewayPermissionEdit,
+	"GET /stream_routes/":          constant.GatewayPermissionView,
+	"GET /stream_routes-dropdown/": constant.GatewayPermissionView,
+
+	// operation_audit_log
+	"GET /audits/logs/": constant.GatewayPermissionView,
+
+	// sync_data
+	"GET /synced/items/":     constant.GatewayPermissionView,
+	"GET /synced/summary/":   constant.GatewayPermissionView,
+	"GET /synced/last_time/": constant.GatewayPermissionView,
+
+	// unify_op
+	"POST /unify_op/resources/:type/revert/":  constant.GatewayPermissionEdit,
+	"POST /unify_op/resources/-/managed/":     constant.GatewayPermissionEdit,
+	"POST /unify_op/resources/-/diff/":        constant.GatewayPermissionView,
+	"POST /unify_op/resources/:type/diff/":    constant.GatewayPermissionView,
+	"GET /unify_op/resources/:type/diff/:id/": constant.GatewayPermissionView,
+	"DELETE /unify_op/resources/:type/":       constant.GatewayPermissionEdit,
+	"GET /unify_op/resources/labels/:type/":   constant.GatewayPermissionView,
+	"POST /unify_op/resources/upload/":        constant.GatewayPermissionEdit,
+	"POST /unify_op/resources/import/":        constant.GatewayPermissionEdit,
+
+	// export：导出的配置均已脱敏，只读成员可导出；含明文的 standalone 格式在 handler 中校验编辑权限
+	"GET /unify_op/etcd/export/":        constant.GatewayPermissionView,
+	"GET /unify_op/resources/-/export/": constant.GatewayPermissionView,
+
+	// schema
+	"GET /schemas/plugins/:name/":   constant.GatewayPermissionView,
+	"GET /schemas/resources/:type/": constant.GatewayPermissionView,
+	"POST /schemas/":                constant.GatewayPermissionEdit,
+	"PUT /schemas/:auto_id/":        constant.GatewayPermissionEdit,
+	"GET /schemas/:auto_id/":        constant.GatewayPermissionView,
+	"DELETE /schemas/:auto_id/":     constant.GatewayPermissionEdit,
+	"GET /schemas/":                 constant.GatewayPermissionView,
+	"GET /plugins/":                 constant.GatewayPermissionView,
+
+	// publish
+	"POST /publish/":         constant.GatewayPermissionPublish,
+	"POST /publish/all/":     constant.GatewayPermissionPublish,
+	"POST /sync/":            constant.GatewayPermissionPublish,
+	"GET /publish_journals/": constant.GatewayPermissionView,
+	"POST /publish_journals/:journal_id/resume/": constant.GatewayPermissionPublish,
+	"POST /publish_journals/:journal_id/revert/": constant.GatewayPermissionPublish,
+
+	// change request
+	"GET /publish_policy/":                              constant.GatewayPermissionView,
+	"PUT /publish_policy/":                              constant.GatewayPermissionManage,
+	"GET /change_requests/":                             constant.GatewayPermissionView,
+	"GET /change_requests/:change_request_id/":          constant.GatewayPermissionView,
+	"POST /change_requests/:change_request_id/approve/": constant.GatewayPermissionView,
+	"POST /change_requests/:change_request_id/reject/":  constant.GatewayPermissionView,
+	"POST /change_requests/:change_request_id/comment/": constant.GatewayPermissionView,
+	"POST /change_requests/:change_request_id/apply/":   constant.GatewayPermissionPublish,
+
+	// schedule
+	"GET /maintenance_windows/":                               constant.GatewayPermissionView,
+	"POST /maintenance_windows/":                              constant.GatewayPermissionManage,
+	"PUT /maintenance_windows/:window_id/":                    constant.GatewayPermissionManage,
+	"DELETE /maintenance_windows/:window_id/":                 constant.GatewayPermissionManage,
+	"GET /scheduled_publishes/":                               constant.GatewayPermissionView,
+	"POST /scheduled_publishes/":                              constant.GatewayPermissionPublish,
+	"GET /scheduled_publishes/:scheduled_publish_id/":         constant.GatewayPermissionView,
+	"POST /scheduled_publishes/:scheduled_publish_id/cancel/": constant.GatewayPermissionPublish,
+
+	// task
+	"GET /tasks/":                  constant.GatewayPermissionView,
+	"GET /tasks/:task_id/":         constant.GatewayPermissionView,
+	"POST /tasks/:task_id/cancel/": constant.GatewayPermissionEdit,
+
+	// release_version
+	"GET /release_versions/":                       constant.GatewayPermissionView,
+	"GET /release_versions/:version_id/":           constant.GatewayPermissionView,
+	"GET /release_versions/:version_id/diff/":      constant.GatewayPermissionView,
+	"POST /release_versions/:version_id/rollback/": constant.GatewayPermissionPublish,
+
+	// drift
+	"GET /drifts/": constant.GatewayPermissionView,
+
+	// data plane
+	"GET /data_plane/instances/": constant.GatewayPermissionView,
+
+	// promotion
+	"POST /promotions/plan/": constant.GatewayPermissionEdit,
+	"POST /promotions/":      constant.GatewayPermissionEdit,
+
+	// variable
+	"GET /variables/":                 constant.GatewayPermissionView,
+	"POST /variables/":                constant.GatewayPermissionEdit,
+	"GET /variables/:variable_id/":    constant.GatewayPermissionView,
+	"PUT /variables/:variable_id/":    constant.GatewayPermissionEdit,
+	"DELETE /variables/:variable_id/": constant.GatewayPermissionEdit,
+
+	// webhook
+	"GET /webhooks/":                                                constant.GatewayPermissionView,
+	"POST /webhooks/":                                               constant.GatewayPermissionManage,
+	"GET /webhooks/:webhook_id/":                                    constant.GatewayPermissionView,
+	"PUT /webhooks/:webhook_id/":                                    constant.GatewayPermissionManage,
+	"DELETE /webhooks/:webhook_id/":                                 constant.GatewayPermissionManage,
+	"GET /webhooks/:webhook_id/deliveries/":                         constant.GatewayPermissionView,
+	"POST /webhooks/:webhook_id/deliveries/:delivery_id/redeliver/": constant.GatewayPermissionManage,
+
+	// mcp access tokens
+	"GET /mcp/tokens/":              constant.GatewayPermissionView,
+	"POST /mcp/tokens/":             constant.GatewayPermissionManage,
+	"GET /mcp/tokens/:token_id/":    constant.GatewayPermissionView,
+	"DELETE /mcp/tokens/:token_id/": constant.GatewayPermissionManage,
+
+	// member
+	"GET /members/":               constant.GatewayPermissionView,
+	"POST /members/":              constant.GatewayPermissionManage,
+	"PUT /members/:member_id/":    constant.GatewayPermissionManage,
+	"DELETE /members/:member_id/": constant.GatewayPermissionManage,
 }
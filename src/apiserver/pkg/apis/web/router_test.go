@@ -0,0 +1,59 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package web
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/middleware"
+)
+
+func TestGatewayRoutePermissions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	config.G = &config.Config{
+		Service: config.ServiceConfig{
+			AppCode:   "bk-micro-apigateway",
+			AppSecret: "secret",
+		},
+	}
+	router := gin.New()
+	RegisterWebApi("/v1/web", router.Group("/api"))
+
+	prefix := "/api/v1/web/gateways/:gateway_id"
+	registered := make(map[string]bool)
+	for _, route := range router.Routes() {
+		path, ok := strings.CutPrefix(route.Path, prefix)
+		if !ok {
+			continue
+		}
+		key := middleware.GatewayRoutePermissionKey(route.Method, path)
+		registered[key] = true
+		assert.Contains(t, gatewayRoutePermissions, key, "route permission not configured")
+	}
+	assert.NotEmpty(t, registered)
+	// 权限配置中不应存在已不存在的路由
+	for key := range gatewayRoutePermissions {
+		assert.True(t, registered[key], "route %s not registered", key)
+	}
+}
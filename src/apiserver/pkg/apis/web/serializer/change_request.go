@@ -0,0 +1,57 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// PublishPolicyRequest 网关发布审批策略更新请求
+type PublishPolicyRequest struct {
+	Enabled           bool     `json:"enabled"`                                  // 是否开启发布审批
+	RequiredApprovals int      `json:"required_approvals" binding:"min=0"`       // 需要的审批通过人数
+	Reviewers         []string `json:"reviewers" binding:"dive,required,max=32"` // 审批人
+	ExpireHours       int      `json:"expire_hours" binding:"min=0"`             // 有效期（小时），默认 72
+}
+
+// PublishPolicyOutputInfo 网关发布审批策略
+type PublishPolicyOutputInfo struct {
+	Enabled           bool     `json:"enabled"`
+	RequiredApprovals int      `json:"required_approvals"`
+	Reviewers         []string `json:"reviewers"`
+	ExpireHours       int      `json:"expire_hours"`
+	Updater           string   `json:"updater"`
+	UpdatedAt         int64    `json:"updated_at"`
+}
+
+// PublishPolicyToOutputInfo 将模型转换为发布审批策略输出信息
+func PublishPolicyToOutputInfo(policy *model.GatewayPublishPolicy) PublishPolicyOutputInfo {
+	reviewers := []string(policy.Reviewers)
+	if reviewers == nil {
+		reviewers = []string{}
+	}
+	return PublishPolicyOutputInfo{
+		Enabled:           policy.Enabled,
+		RequiredApprovals: policy.RequiredApprovals,
+		Reviewers:         reviewers,
+		ExpireHours:       policy.ExpireHours,
+		Updater:           policy.Updater,
+		UpdatedAt:         policy.UpdatedAt.Unix(),
+	}
+}
@@ -24,9 +24,12 @@ import (
 	"fmt"
 
 	validator "github.com/go-playground/validator/v10"
+	"github.com/tidwall/sjson"
 
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	resourcevalidationbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resourcevalidation"
 	schemabiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/schema"
+	variablebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/variable"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
@@ -57,6 +60,12 @@ func CheckAPISIXConfig(ctx context.Context, fl validator.FieldLevel) bool {
 	// the plain string name.
 	resourceTypeName := resourceType.String()
 	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if resourceType == constant.Secret {
+		// secret 的 manager 为独立字段，需要补充到配置中参与校验
+		if manager := fl.Parent().FieldByName("Manager"); manager.IsValid() && manager.String() != "" {
+			rawConfig, _ = sjson.SetBytes(rawConfig, "manager", manager.String())
+		}
+	}
 	rawConfig, resourceIdentification := resourcevalidationbiz.PrepareWebValidationPayload(
 		gatewayInfo.GetAPISIXVersionX(),
 		resourceType,
@@ -84,11 +93,26 @@ func CheckAPISIXConfig(ctx context.Context, fl validator.FieldLevel) bool {
 		logging.Errorf("new database payload validator failed, err: %v", err)
 		return false
 	}
+	// 使用网关变量替换配置中的变量引用后再校验
+	rawConfig, err = variablebiz.ResolveForGateway(ctx, rawConfig)
+	if err != nil {
+		ginx.GetValidateErrorInfoFromContext(ctx).Err = fmt.Errorf("resource:%s validate failed, err: %w",
+			resourceIdentification, err)
+		logging.Errorf("resolve gateway variables failed, err: %v", err)
+		return false
+	}
 	if err = databaseValidator.Validate(rawConfig); err != nil {
 		ginx.GetValidateErrorInfoFromContext(ctx).Err = err
 		logging.Errorf("database payload validate failed, err: %v", err)
 		return false
 	}
+	// 校验插件中引用的 secret 是否存在
+	if err = resourcebiz.CheckSecretReferences(ctx, rawConfig); err != nil {
+		ginx.GetValidateErrorInfoFromContext(ctx).Err = fmt.Errorf("resource:%s validate failed, err: %w",
+			resourceIdentification, err)
+		logging.Errorf("check secret references failed, err: %v", err)
+		return false
+	}
 	return true
 }
 
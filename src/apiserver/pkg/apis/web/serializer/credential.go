@@ -0,0 +1,117 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package serializer
+
+import (
+	"context"
+	"encoding/json"
+
+	validator "github.com/go-playground/validator/v10"
+
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
+)
+
+// CredentialInfo Credential 基本信息
+type CredentialInfo struct {
+	ID   string `json:"-"`                                                 // 资源 apisix 资源 id
+	Name string `json:"name" binding:"required" validate:"credentialName"` // Credential 名称
+	// 所属 Consumer ID
+	ConsumerID string `json:"consumer_id" binding:"required" validate:"consumerID"`
+	// 配置数据 (json 格式)
+	Config json.RawMessage `json:"config" validate:"apisixConfig=credential" swaggertype:"object"`
+}
+
+// CredentialListRequest Credential 列表请求参数
+type CredentialListRequest struct {
+	ID         string `json:"id,omitempty" form:"id"`
+	Name       string `json:"name,omitempty" form:"name"`
+	ConsumerID string `json:"consumer_id,omitempty" form:"consumer_id"`
+	Updater    string `json:"updater,omitempty" form:"updater"`
+	Label      string `json:"label" form:"label"`
+	Status     string `json:"status" form:"status" binding:"resourceStatus"`
+	OrderBy    string `json:"order_by" form:"order_by"`
+	Offset     int    `json:"offset" form:"offset"`
+	Limit      int    `json:"limit" form:"limit"`
+}
+
+// CredentialListResponse Credential 列表
+type CredentialListResponse []CredentialOutputInfo
+
+// CredentialOutputInfo Credential 详情
+type CredentialOutputInfo struct {
+	AutoID    int    `json:"auto_id"`
+	ID        string `json:"id"`
+	GatewayID int    `json:"gateway_id"` // 网关 ID
+	CredentialInfo
+	CreatedAt int64                   `json:"created_at"`
+	UpdatedAt int64                   `json:"updated_at"`
+	Creator   string                  `json:"creator"`
+	Updater   string                  `json:"updater"`
+	Status    constant.ResourceStatus `json:"status"` // 发布状态
+}
+
+// CredentialDropDownListResponse Credential 下拉列表
+type CredentialDropDownListResponse []CredentialDropDownOutputInfo
+
+// CredentialDropDownOutputInfo Credential 下拉列表
+type CredentialDropDownOutputInfo struct {
+	AutoID     int    `json:"auto_id"`     // 自增 ID
+	ID         string `json:"id"`          // 资源 apisix 资源 id
+	Name       string `json:"name"`        // 凭证名称
+	ConsumerID string `json:"consumer_id"` // 所属 Consumer ID
+	Desc       string `json:"desc"`        // 凭证描述
+}
+
+// ValidateConsumerID 校验 ConsumerID
+func ValidateConsumerID(ctx context.Context, fl validator.FieldLevel) bool {
+	consumerID := fl.Field().String()
+	if consumerID == "" {
+		return true
+	}
+	return resourcebiz.ExistsConsumer(ctx, consumerID)
+}
+
+// ValidateCredentialName 校验 CredentialName
+func ValidateCredentialName(ctx context.Context, fl validator.FieldLevel) bool {
+	credentialName := fl.Field().String()
+	if credentialName == "" {
+		return false
+	}
+	return !resourcebiz.DuplicatedResourceName(
+		ctx,
+		constant.Credential,
+		fl.Parent().FieldByName("ID").String(),
+		credentialName,
+	)
+}
+
+// 注册校验器
+func init() {
+	validation.AddBizFieldTagValidatorWithCtx(
+		"consumerID",
+		ValidateConsumerID,
+		"{0}:{1} 无效",
+	)
+	validation.AddBizFieldTagValidatorWithCtx(
+		"credentialName",
+		ValidateCredentialName,
+		"{0}: {1} 该资源名称已经被存在的 credential 资源占用",
+	)
+}
@@ -0,0 +1,45 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// DataPlaneInstanceListResponse 数据面实例清单
+type DataPlaneInstanceListResponse struct {
+	APISIXVersion  string `json:"apisix_version"`  // 网关配置的 APISIX 版本
+	LatestRevision int64  `json:"latest_revision"` // 网关配置在 etcd 中的最新 revision
+	// 从 etcd 刷新失败时的错误信息，此时返回的是上一次刷新记录的清单
+	RefreshError string                        `json:"refresh_error"`
+	Instances    []DataPlaneInstanceOutputInfo `json:"instances"`
+}
+
+// DataPlaneInstanceOutputInfo 数据面实例输出信息
+type DataPlaneInstanceOutputInfo struct {
+	InstanceID      string                      `json:"instance_id"`
+	Hostname        string                      `json:"hostname"`
+	Version         string                      `json:"version"`           // 实例 APISIX 版本
+	BootTime        int64                       `json:"boot_time"`         // 实例启动时间
+	LastHeartbeatAt int64                       `json:"last_heartbeat_at"` // 最近一次心跳时间
+	Revision        int64                       `json:"revision"`          // 实例最近一次上报时的 etcd revision
+	Online          bool                        `json:"online"`            // server_info 是否仍在 etcd 中
+	Stale           bool                        `json:"stale"`             // 是否失联
+	Warnings        []constant.DataPlaneWarning `json:"warnings"`          // 告警：stale/version_mismatch/revision_lag
+}
@@ -0,0 +1,43 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+)
+
+// DriftRecordPathParam 漂移记录路径参数
+type DriftRecordPathParam struct {
+	GatewayID int `json:"gateway_id" uri:"gateway_id" binding:"required"`
+}
+
+// DriftRecordListRequest 漂移记录列表请求
+type DriftRecordListRequest struct {
+	Status       constant.DriftStatus    `json:"status" form:"status"`               // 漂移状态：drifted/resolved
+	ResourceType constant.APISIXResource `json:"resource_type" form:"resource_type"` // 资源类型
+	Name         string                  `json:"name" form:"name"`                   // 资源名称
+}
+
+// DriftRecordOutputInfo 漂移记录输出信息
+type DriftRecordOutputInfo struct {
+	dto.DriftResource
+	Status     constant.DriftStatus `json:"status"`      // 漂移状态：drifted/resolved
+	ResolvedAt int64                `json:"resolved_at"` // 恢复时间，未恢复时为 0
+}
@@ -44,6 +44,8 @@ type Count struct {
 	Route    int64 `json:"route"`
 	Service  int64 `json:"service"`
 	Upstream int64 `json:"upstream"`
+	// 已过期但仍配置了 SNI 或被上游引用的证书数
+	ExpiredSSL int `json:"expired_ssl"`
 }
 
 // GatewayOutputListInfo 网关列表信息
@@ -51,17 +53,18 @@ type GatewayOutputListInfo struct {
 	ID   int    `json:"id"`
 	Name string `json:"name" binding:"required"` // 网关名称
 	// 网关control模式：1-direct 2-indirect
-	Mode        uint8         `json:"mode" binding:"required,gatewayMode" enums:"1,2,3"`
-	Maintainers []string      `json:"maintainers"` // 网关维护者
-	Description string        `json:"description"` // 网关描述
-	APISIX      common.APISIX `json:"apisix"`
-	ReadOnly    bool          `json:"read_only"` // 是否只读
-	Etcd        common.Etcd   `json:"etcd"`
-	Count       Count         `json:"count"`
-	CreatedAt   int64         `json:"created_at"`
-	UpdatedAt   int64         `json:"updated_at"`
-	Creator     string        `json:"creator"`
-	Updater     string        `json:"updater"`
+	Mode        uint8                `json:"mode" binding:"required,gatewayMode" enums:"1,2,3"`
+	Maintainers []string             `json:"maintainers"` // 网关维护者
+	Role        constant.GatewayRole `json:"role"`        // 当前用户在网关中的角色
+	Description string               `json:"description"` // 网关描述
+	APISIX      common.APISIX        `json:"apisix"`
+	ReadOnly    bool                 `json:"read_only"` // 是否只读
+	Etcd        common.Etcd          `json:"etcd"`
+	Count       Count                `json:"count"`
+	CreatedAt   int64                `json:"created_at"`
+	UpdatedAt   int64                `json:"updated_at"`
+	Creator     string               `json:"creator"`
+	Updater     string               `json:"updater"`
 }
 
 // GatewayGetRequest 网关详情请求
@@ -0,0 +1,182 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package serializer
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// GatewayGroupPathParam 网关组路径参数
+type GatewayGroupPathParam struct {
+	GroupID int `json:"group_id" uri:"group_id" binding:"required"`
+}
+
+// GatewayGroupMemberRequest 网关组成员
+type GatewayGroupMemberRequest struct {
+	GatewayID int `json:"gateway_id" binding:"required"`
+	// 发布顺序，从小到大依次发布，最小的为金丝雀网关
+	Priority int `json:"priority"`
+	// 数据面健康检查地址（可选），发布后 GET 请求返回 2xx 视为健康
+	HealthCheckURL string `json:"health_check_url" binding:"omitempty,url,max=512"`
+}
+
+// GatewayGroupRequest 网关组创建/更新请求
+type GatewayGroupRequest struct {
+	Name        string `json:"name" binding:"required,max=64"`
+	Description string `json:"description" binding:"max=512"`
+	// 金丝雀网关发布后的观察时长（秒）
+	SoakSeconds int                         `json:"soak_seconds" binding:"min=0,max=86400"`
+	Members     []GatewayGroupMemberRequest `json:"members" binding:"required,min=2,dive"`
+}
+
+// GatewayGroupMemberOutputInfo 网关组成员输出信息
+type GatewayGroupMemberOutputInfo struct {
+	GatewayID      int    `json:"gateway_id"`
+	Priority       int    `json:"priority"`
+	HealthCheckURL string `json:"health_check_url"`
+}
+
+// GatewayGroupOutputInfo 网关组输出信息
+type GatewayGroupOutputInfo struct {
+	ID          int                            `json:"id"`
+	Name        string                         `json:"name"`
+	Description string                         `json:"description"`
+	SoakSeconds int                            `json:"soak_seconds"`
+	Members     []GatewayGroupMemberOutputInfo `json:"members"` // 按发布顺序排列
+	Creator     string                         `json:"creator"`
+	Updater     string                         `json:"updater"`
+	CreatedAt   int64                          `json:"created_at"`
+	UpdatedAt   int64                          `json:"updated_at"`
+}
+
+// GatewayGroupToOutputInfo 将模型转换为网关组输出信息
+func GatewayGroupToOutputInfo(
+	group *model.GatewayGroup,
+	members []*model.GatewayGroupMember,
+) GatewayGroupOutputInfo {
+	output := GatewayGroupOutputInfo{
+		ID:          group.ID,
+		Name:        group.Name,
+		Description: group.Description,
+		SoakSeconds: group.SoakSeconds,
+		Members:     make([]GatewayGroupMemberOutputInfo, 0, len(members)),
+		Creator:     group.Creator,
+		Updater:     group.Updater,
+		CreatedAt:   group.CreatedAt.Unix(),
+		UpdatedAt:   group.UpdatedAt.Unix(),
+	}
+	for _, member := range members {
+		output.Members = append(output.Members, GatewayGroupMemberOutputInfo{
+			GatewayID:      member.GatewayID,
+			Priority:       member.Priority,
+			HealthCheckURL: member.HealthCheckURL,
+		})
+	}
+	return output
+}
+
+// GatewayGroupRolloutPathParam 网关组分批发布路径参数
+type GatewayGroupRolloutPathParam struct {
+	GroupID   int   `json:"group_id" uri:"group_id" binding:"required"`
+	RolloutID int64 `json:"rollout_id" uri:"rollout_id" binding:"required"`
+}
+
+// GatewayGroupRolloutCreateRequest 网关组分批发布创建请求
+type GatewayGroupRolloutCreateRequest struct {
+	Changelog string `json:"changelog" binding:"max=1024"` // 变更说明
+}
+
+// GatewayGroupRolloutStageOutputInfo 分批发布批次输出信息
+type GatewayGroupRolloutStageOutputInfo struct {
+	Stage                    int                              `json:"stage"` // 0 为金丝雀网关
+	GatewayID                int                              `json:"gateway_id"`
+	HealthCheckURL           string                           `json:"health_check_url"`
+	PreviousReleaseVersionID int64                            `json:"previous_release_version_id"`
+	ReleaseVersionID         int64                            `json:"release_version_id"`
+	Status                   constant.GroupRolloutStageStatus `json:"status"`
+	Error                    string                           `json:"error"`
+	StartedAt                int64                            `json:"started_at"`  // 未开始时为 0
+	FinishedAt               int64                            `json:"finished_at"` // 未结束时为 0
+}
+
+// GatewayGroupRolloutOutputInfo 网关组分批发布输出信息
+type GatewayGroupRolloutOutputInfo struct {
+	ID                     int64                                `json:"id"`
+	GroupID                int                                  `json:"group_id"`
+	SourceReleaseVersionID int64                                `json:"source_release_version_id"`
+	Changelog              string                               `json:"changelog"`
+	SoakSeconds            int                                  `json:"soak_seconds"`
+	Status                 constant.GroupRolloutStatus          `json:"status"`
+	CurrentStage           int                                  `json:"current_stage"`
+	SoakUntil              int64                                `json:"soak_until"` // 未进入观察期时为 0
+	Error                  string                               `json:"error"`
+	StartedAt              int64                                `json:"started_at"`  // 未开始时为 0
+	FinishedAt             int64                                `json:"finished_at"` // 未结束时为 0
+	Stages                 []GatewayGroupRolloutStageOutputInfo `json:"stages,omitempty"`
+	Creator                string                               `json:"creator"`
+	CreatedAt              int64                                `json:"created_at"`
+	UpdatedAt              int64                                `json:"updated_at"`
+}
+
+// GatewayGroupRolloutToOutputInfo 将模型转换为网关组分批发布输出信息，stages 为空时不返回批次
+func GatewayGroupRolloutToOutputInfo(
+	rollout *model.GatewayGroupRollout,
+	stages []*model.GatewayGroupRolloutStage,
+) GatewayGroupRolloutOutputInfo {
+	output := GatewayGroupRolloutOutputInfo{
+		ID:                     rollout.ID,
+		GroupID:                rollout.GroupID,
+		SourceReleaseVersionID: rollout.SourceReleaseVersionID,
+		Changelog:              rollout.Changelog,
+		SoakSeconds:            rollout.SoakSeconds,
+		Status:                 rollout.Status,
+		CurrentStage:           rollout.CurrentStage,
+		SoakUntil:              unixOrZero(rollout.SoakUntil),
+		Error:                  rollout.Error,
+		StartedAt:              unixOrZero(rollout.StartedAt),
+		FinishedAt:             unixOrZero(rollout.FinishedAt),
+		Creator:                rollout.Creator,
+		CreatedAt:              rollout.CreatedAt.Unix(),
+		UpdatedAt:              rollout.UpdatedAt.Unix(),
+	}
+	for _, stage := range stages {
+		output.Stages = append(output.Stages, GatewayGroupRolloutStageOutputInfo{
+			Stage:                    stage.Stage,
+			GatewayID:                stage.GatewayID,
+			HealthCheckURL:           stage.HealthCheckURL,
+			PreviousReleaseVersionID: stage.PreviousReleaseVersionID,
+			ReleaseVersionID:         stage.ReleaseVersionID,
+			Status:                   stage.Status,
+			Error:                    stage.Error,
+			StartedAt:                unixOrZero(stage.StartedAt),
+			FinishedAt:               unixOrZero(stage.FinishedAt),
+		})
+	}
+	return output
+}
+
+// unixOrZero 返回时间的 Unix timestamp，为空时返回 0
+func unixOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,67 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// GatewayMemberPathParam 网关成员路径参数
+type GatewayMemberPathParam struct {
+	GatewayID int `json:"gateway_id" uri:"gateway_id" binding:"required"`
+	MemberID  int `json:"member_id" uri:"member_id"`
+}
+
+// GatewayMemberCreateRequest 网关成员添加请求
+type GatewayMemberCreateRequest struct {
+	Username string               `json:"username" binding:"required,max=64"`
+	Role     constant.GatewayRole `json:"role" binding:"required,oneof=viewer editor publisher admin"`
+}
+
+// GatewayMemberUpdateRequest 网关成员更新请求
+type GatewayMemberUpdateRequest struct {
+	Role constant.GatewayRole `json:"role" binding:"required,oneof=viewer editor publisher admin"`
+}
+
+// GatewayMemberOutputInfo 网关成员输出信息
+type GatewayMemberOutputInfo struct {
+	ID        int                  `json:"id"`
+	GatewayID int                  `json:"gateway_id"`
+	Username  string               `json:"username"`
+	Role      constant.GatewayRole `json:"role"` // 角色：viewer/editor/publisher/admin
+	Creator   string               `json:"creator"`
+	Updater   string               `json:"updater"`
+	CreatedAt int64                `json:"created_at"` // Unix timestamp
+	UpdatedAt int64                `json:"updated_at"` // Unix timestamp
+}
+
+// GatewayMemberToOutputInfo 将模型转换为输出信息
+func GatewayMemberToOutputInfo(member *model.GatewayMember) GatewayMemberOutputInfo {
+	return GatewayMemberOutputInfo{
+		ID:        member.ID,
+		GatewayID: member.GatewayID,
+		Username:  member.Username,
+		Role:      member.Role,
+		Creator:   member.Creator,
+		Updater:   member.Updater,
+		CreatedAt: member.CreatedAt.Unix(),
+		UpdatedAt: member.UpdatedAt.Unix(),
+	}
+}
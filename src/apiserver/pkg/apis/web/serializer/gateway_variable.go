@@ -0,0 +1,77 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package serializer
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// secretValueMask 敏感变量的值不返回给前端
+const secretValueMask = "******"
+
+// GatewayVariablePathParam 网关变量路径参数
+type GatewayVariablePathParam struct {
+	GatewayID  int `json:"gateway_id" uri:"gateway_id" binding:"required"`
+	VariableID int `json:"variable_id" uri:"variable_id"`
+}
+
+// GatewayVariableRequest 网关变量创建/更新请求
+type GatewayVariableRequest struct {
+	Name string `json:"name" binding:"required,max=64"` // 变量名，大写字母开头，只包含大写字母、数字和下划线
+	// 变量值；更新敏感变量时为空表示保留原值
+	Value       string `json:"value" binding:"max=4096"`
+	Secret      bool   `json:"secret"` // 是否为敏感变量，敏感变量加密存储且不返回变量值
+	Description string `json:"description" binding:"max=512"`
+}
+
+// GatewayVariableOutputInfo 网关变量输出信息
+type GatewayVariableOutputInfo struct {
+	ID          int    `json:"id"`
+	GatewayID   int    `json:"gateway_id"`
+	Name        string `json:"name"`
+	Value       string `json:"value"` // 敏感变量返回 ******
+	Secret      bool   `json:"secret"`
+	Description string `json:"description"`
+	Creator     string `json:"creator"`
+	Updater     string `json:"updater"`
+	CreatedAt   int64  `json:"created_at"`
+	UpdatedAt   int64  `json:"updated_at"`
+}
+
+// GatewayVariableListResponse 网关变量列表响应
+type GatewayVariableListResponse []GatewayVariableOutputInfo
+
+// GatewayVariableToOutputInfo 将模型转换为输出信息
+func GatewayVariableToOutputInfo(variable *model.GatewayVariable) GatewayVariableOutputInfo {
+	value := variable.Value
+	if variable.Secret {
+		value = secretValueMask
+	}
+	return GatewayVariableOutputInfo{
+		ID:          variable.ID,
+		GatewayID:   variable.GatewayID,
+		Name:        variable.Name,
+		Value:       value,
+		Secret:      variable.Secret,
+		Description: variable.Description,
+		Creator:     variable.Creator,
+		Updater:     variable.Updater,
+		CreatedAt:   variable.CreatedAt.Unix(),
+		UpdatedAt:   variable.UpdatedAt.Unix(),
+	}
+}
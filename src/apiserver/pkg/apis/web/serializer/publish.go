@@ -24,4 +24,14 @@ import "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/const
 type PublishRequest struct {
 	ResourceType   constant.APISIXResource `json:"resource_type" binding:"required"`    // 资源类型：route/upstream/...
 	ResourceIDList []string                `json:"resource_id_list" binding:"required"` // 资源ID列表
+	Changelog      string                  `json:"changelog" binding:"max=1024"`        // 变更说明
+	// 是否在维护窗口外强制发布，需要网关管理员权限
+	OverrideMaintenanceWindow bool `json:"override_maintenance_window"`
+}
+
+// PublishAllRequest ...
+type PublishAllRequest struct {
+	Changelog string `json:"changelog" binding:"max=1024"` // 变更说明
+	// 是否在维护窗口外强制发布，需要网关管理员权限
+	OverrideMaintenanceWindow bool `json:"override_maintenance_window"`
 }
@@ -0,0 +1,63 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// PublishJournalPathParam 分批发布日志路径参数
+type PublishJournalPathParam struct {
+	GatewayID int   `json:"gateway_id" uri:"gateway_id" binding:"required"`
+	JournalID int64 `json:"journal_id" uri:"journal_id" binding:"required"`
+}
+
+// PublishJournalListRequest 分批发布日志查询参数
+type PublishJournalListRequest struct {
+	Status string `json:"status" form:"status"` // 状态：running/succeeded/rolled_back/failed
+}
+
+// PublishJournalOutputInfo 分批发布日志输出信息
+type PublishJournalOutputInfo struct {
+	ID             int64                         `json:"id"`
+	Operator       string                        `json:"operator"`
+	Status         constant.PublishJournalStatus `json:"status"`
+	OperationCount int                           `json:"operation_count"` // 写入的 key 数量
+	ChunkCount     int                           `json:"chunk_count"`     // 总批次数
+	AppliedChunks  int                           `json:"applied_chunks"`  // 已写入的批次数
+	Error          string                        `json:"error"`
+	CreatedAt      int64                         `json:"created_at"`
+	UpdatedAt      int64                         `json:"updated_at"`
+}
+
+// PublishJournalToOutputInfo ...
+func PublishJournalToOutputInfo(journal *model.GatewayPublishJournal) PublishJournalOutputInfo {
+	return PublishJournalOutputInfo{
+		ID:             journal.ID,
+		Operator:       journal.Operator,
+		Status:         journal.Status,
+		OperationCount: journal.OperationCount,
+		ChunkCount:     journal.ChunkCount,
+		AppliedChunks:  journal.AppliedChunks,
+		Error:          journal.Error,
+		CreatedAt:      journal.CreatedAt.Unix(),
+		UpdatedAt:      journal.UpdatedAt.Unix(),
+	}
+}
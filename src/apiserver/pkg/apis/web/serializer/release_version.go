@@ -0,0 +1,75 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// ReleaseVersionPathParam 发布版本路径参数
+type ReleaseVersionPathParam struct {
+	GatewayID int   `json:"gateway_id" uri:"gateway_id" binding:"required"`
+	VersionID int64 `json:"version_id" uri:"version_id"`
+}
+
+// ReleaseVersionDiffRequest 发布版本对比请求
+type ReleaseVersionDiffRequest struct {
+	// 对比的基准版本 ID，不传时与当前 etcd 生效的配置对比
+	BaseVersionID int64 `form:"base_version_id" json:"base_version_id"`
+}
+
+// ReleaseVersionRollbackRequest 发布版本回滚请求
+type ReleaseVersionRollbackRequest struct {
+	Changelog string `json:"changelog" binding:"max=1024"` // 变更说明
+}
+
+// ReleaseVersionOutputInfo 发布版本输出信息
+type ReleaseVersionOutputInfo struct {
+	ID             int64                   `json:"id"`
+	GatewayID      int                     `json:"gateway_id"`
+	Version        string                  `json:"version"`
+	TriggerSource  constant.ReleaseTrigger `json:"trigger_source"`   // 触发来源：web/open/mcp
+	Changelog      string                  `json:"changelog"`        // 变更说明
+	ResourceCount  int                     `json:"resource_count"`   // 快照资源数量
+	RollbackFromID int64                   `json:"rollback_from_id"` // 回滚来源版本 ID，非回滚产生的版本为 0
+	Creator        string                  `json:"creator"`          // 发布人
+	CreatedAt      int64                   `json:"created_at"`       // Unix timestamp
+}
+
+// ReleaseVersionDetailOutputInfo 发布版本详情输出信息（包含快照资源）
+type ReleaseVersionDetailOutputInfo struct {
+	ReleaseVersionOutputInfo
+	Resources []model.ReleaseResource `json:"resources"`
+}
+
+// ReleaseVersionToOutputInfo 将模型转换为输出信息
+func ReleaseVersionToOutputInfo(version *model.GatewayReleaseVersion) ReleaseVersionOutputInfo {
+	return ReleaseVersionOutputInfo{
+		ID:             version.ID,
+		GatewayID:      version.GatewayID,
+		Version:        version.Version,
+		TriggerSource:  version.TriggerSource,
+		Changelog:      version.Changelog,
+		ResourceCount:  version.ResourceCount,
+		RollbackFromID: version.RollbackFromID,
+		Creator:        version.Creator,
+		CreatedAt:      version.CreatedAt.Unix(),
+	}
+}
@@ -83,6 +83,28 @@ type RouteDropDownOutputInfo struct {
 	Desc   string   `json:"desc"`    // 路由描述
 }
 
+// RouteOpenAPIRequest 路由生成 OpenAPI 文档的请求
+type RouteOpenAPIRequest struct {
+	// 使用草稿或已发布的配置，默认为草稿
+	State constant.ExportState `json:"state" form:"state" binding:"omitempty,oneof=draft published"`
+	// 文档格式，默认为 json
+	Format    constant.OpenAPIFormat `json:"format" form:"format" binding:"omitempty,oneof=json yaml"`
+	ServiceID string                 `json:"service_id" form:"service_id"` // 仅包含绑定该服务的路由
+	Label     string                 `json:"label" form:"label"`           // 标签，格式为 k1:v1,k2:v2
+}
+
+// RouteMatchRequest 路由匹配模拟的请求
+type RouteMatchRequest struct {
+	// 使用草稿或已发布的配置，默认为草稿
+	State      constant.ExportState `json:"state" binding:"omitempty,oneof=draft published"`
+	Method     string               `json:"method"`                  // 请求方法，默认为 GET
+	Host       string               `json:"host"`                    // 请求 host，可包含端口
+	Path       string               `json:"path" binding:"required"` // 请求路径，可包含 query
+	Headers    map[string]string    `json:"headers"`                 // 请求头
+	Query      map[string]string    `json:"query"`                   // 请求参数
+	RemoteAddr string               `json:"remote_addr"`             // 客户端地址
+}
+
 // ValidationRouteName ...
 func ValidationRouteName(ctx context.Context, fl validator.FieldLevel) bool {
 	routeName := fl.Field().String()
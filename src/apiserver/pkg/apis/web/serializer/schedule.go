@@ -0,0 +1,151 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// MaintenanceWindowPathParam 维护窗口路径参数
+type MaintenanceWindowPathParam struct {
+	WindowID int `json:"window_id" uri:"window_id" binding:"required"`
+}
+
+// MaintenanceWindowRequest 维护窗口创建/更新请求
+type MaintenanceWindowRequest struct {
+	Name string `json:"name" binding:"required,max=64"`
+	// 生效的星期（0 为周日），为空表示每天
+	Weekdays  []int64 `json:"weekdays" binding:"dive,min=0,max=6"`
+	StartTime string  `json:"start_time" binding:"required,len=5"` // 开始时间 HH:MM
+	EndTime   string  `json:"end_time" binding:"required,len=5"`   // 结束时间 HH:MM，早于开始时间表示跨天
+	Timezone  string  `json:"timezone" binding:"max=64"`           // 时区，如 Asia/Shanghai
+	Enabled   bool    `json:"enabled"`
+}
+
+// MaintenanceWindowOutputInfo 维护窗口输出信息
+type MaintenanceWindowOutputInfo struct {
+	ID        int     `json:"id"`
+	Name      string  `json:"name"`
+	Weekdays  []int64 `json:"weekdays"`
+	StartTime string  `json:"start_time"`
+	EndTime   string  `json:"end_time"`
+	Timezone  string  `json:"timezone"`
+	Enabled   bool    `json:"enabled"`
+	Active    bool    `json:"active"` // 当前是否处于该窗口内
+	Creator   string  `json:"creator"`
+	Updater   string  `json:"updater"`
+	CreatedAt int64   `json:"created_at"`
+	UpdatedAt int64   `json:"updated_at"`
+}
+
+// MaintenanceWindowToOutputInfo 将模型转换为维护窗口输出信息
+func MaintenanceWindowToOutputInfo(window *model.GatewayMaintenanceWindow) MaintenanceWindowOutputInfo {
+	active, _ := window.Contains(time.Now())
+	weekdays := []int64(window.Weekdays)
+	if weekdays == nil {
+		weekdays = []int64{}
+	}
+	return MaintenanceWindowOutputInfo{
+		ID:        window.ID,
+		Name:      window.Name,
+		Weekdays:  weekdays,
+		StartTime: window.StartTime,
+		EndTime:   window.EndTime,
+		Timezone:  window.Timezone,
+		Enabled:   window.Enabled,
+		Active:    window.Enabled && active,
+		Creator:   window.Creator,
+		Updater:   window.Updater,
+		CreatedAt: window.CreatedAt.Unix(),
+		UpdatedAt: window.UpdatedAt.Unix(),
+	}
+}
+
+// ScheduledPublishPathParam 定时发布路径参数
+type ScheduledPublishPathParam struct {
+	ScheduledPublishID int64 `json:"scheduled_publish_id" uri:"scheduled_publish_id" binding:"required"`
+}
+
+// ScheduledPublishListRequest 定时发布列表查询参数
+type ScheduledPublishListRequest struct {
+	// 状态：pending/running/succeeded/failed/cancelled
+	Status constant.ScheduledPublishStatus `json:"status" form:"status"`
+}
+
+// ScheduledPublishCreateRequest 定时发布创建请求
+type ScheduledPublishCreateRequest struct {
+	// 资源类型：route/upstream/...，为空表示一键发布
+	ResourceType constant.APISIXResource `json:"resource_type"`
+	// 资源ID列表，指定资源类型时必填
+	ResourceIDList []string `json:"resource_id_list" binding:"required_with=ResourceType"`
+	ScheduledAt    int64    `json:"scheduled_at" binding:"required"` // 计划执行时间，Unix timestamp
+	Changelog      string   `json:"changelog" binding:"max=1024"`    // 变更说明
+	// 是否允许在维护窗口外执行，需要网关管理员权限
+	OverrideMaintenanceWindow bool `json:"override_maintenance_window"`
+}
+
+// ScheduledPublishOutputInfo 定时发布输出信息
+type ScheduledPublishOutputInfo struct {
+	ID                        int64                           `json:"id"`
+	ResourceType              constant.APISIXResource         `json:"resource_type"`
+	ResourceIDs               []string                        `json:"resource_ids"`
+	Changelog                 string                          `json:"changelog"`
+	ScheduledAt               int64                           `json:"scheduled_at"`
+	OverrideMaintenanceWindow bool                            `json:"override_maintenance_window"`
+	Status                    constant.ScheduledPublishStatus `json:"status"`
+	TaskID                    int64                           `json:"task_id"`
+	ChangeRequestID           int64                           `json:"change_request_id"`
+	Error                     string                          `json:"error"`
+	StartedAt                 int64                           `json:"started_at"`  // 未执行时为 0
+	FinishedAt                int64                           `json:"finished_at"` // 未执行完成时为 0
+	Creator                   string                          `json:"creator"`
+	Updater                   string                          `json:"updater"`
+	CreatedAt                 int64                           `json:"created_at"`
+	UpdatedAt                 int64                           `json:"updated_at"`
+}
+
+// ScheduledPublishToOutputInfo 将模型转换为定时发布输出信息
+func ScheduledPublishToOutputInfo(scheduledPublish *model.GatewayScheduledPublish) ScheduledPublishOutputInfo {
+	output := ScheduledPublishOutputInfo{
+		ID:                        scheduledPublish.ID,
+		ResourceType:              scheduledPublish.ResourceType,
+		ResourceIDs:               scheduledPublish.ResourceIDs,
+		Changelog:                 scheduledPublish.Changelog,
+		ScheduledAt:               scheduledPublish.ScheduledAt.Unix(),
+		OverrideMaintenanceWindow: scheduledPublish.OverrideMaintenanceWindow,
+		Status:                    scheduledPublish.Status,
+		TaskID:                    scheduledPublish.TaskID,
+		ChangeRequestID:           scheduledPublish.ChangeRequestID,
+		Error:                     scheduledPublish.Error,
+		Creator:                   scheduledPublish.Creator,
+		Updater:                   scheduledPublish.Updater,
+		CreatedAt:                 scheduledPublish.CreatedAt.Unix(),
+		UpdatedAt:                 scheduledPublish.UpdatedAt.Unix(),
+	}
+	if scheduledPublish.StartedAt != nil {
+		output.StartedAt = scheduledPublish.StartedAt.Unix()
+	}
+	if scheduledPublish.FinishedAt != nil {
+		output.FinishedAt = scheduledPublish.FinishedAt.Unix()
+	}
+	return output
+}
@@ -0,0 +1,104 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package serializer
+
+import (
+	"context"
+	"encoding/json"
+
+	validator "github.com/go-playground/validator/v10"
+
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/validation"
+)
+
+// SecretInfo Secret 基本信息
+type SecretInfo struct {
+	ID   string `json:"-"`                                             // 资源 apisix 资源 id
+	Name string `json:"name" binding:"required" validate:"secretName"` // Secret 名称
+	// 密钥管理器: vault、aws、gcp，创建后不可修改
+	Manager string `json:"manager" binding:"required,oneof=vault aws gcp"`
+	// 配置数据 (json 格式)
+	Config json.RawMessage `json:"config" validate:"apisixConfig=secret" swaggertype:"object"`
+}
+
+// SecretListRequest Secret 列表请求参数
+type SecretListRequest struct {
+	ID      string `json:"id,omitempty" form:"id"`
+	Name    string `json:"name,omitempty" form:"name"`
+	Manager string `json:"manager,omitempty" form:"manager"`
+	Updater string `json:"updater,omitempty" form:"updater"`
+	Label   string `json:"label" form:"label"`
+	Status  string `json:"status" form:"status" binding:"resourceStatus"`
+	OrderBy string `json:"order_by" form:"order_by"`
+	Offset  int    `json:"offset" form:"offset"`
+	Limit   int    `json:"limit" form:"limit"`
+}
+
+// SecretListResponse Secret 列表
+type SecretListResponse []SecretOutputInfo
+
+// SecretOutputInfo Secret 详情
+type SecretOutputInfo struct {
+	AutoID    int    `json:"auto_id"`
+	ID        string `json:"id"`
+	GatewayID int    `json:"gateway_id"` // 网关 ID
+	SecretInfo
+	CreatedAt int64                   `json:"created_at"`
+	UpdatedAt int64                   `json:"updated_at"`
+	Creator   string                  `json:"creator"`
+	Updater   string                  `json:"updater"`
+	Status    constant.ResourceStatus `json:"status"` // 发布状态
+}
+
+// SecretDropDownListResponse Secret 下拉列表
+type SecretDropDownListResponse []SecretDropDownOutputInfo
+
+// SecretDropDownOutputInfo Secret 下拉列表
+type SecretDropDownOutputInfo struct {
+	AutoID  int    `json:"auto_id"` // 自增 ID
+	ID      string `json:"id"`      // 资源 apisix 资源 id
+	Name    string `json:"name"`    // 密钥名称
+	Manager string `json:"manager"` // 密钥管理器
+	// 插件中引用该密钥时的前缀，如 $secret://vault/{id}/
+	Reference string `json:"reference"`
+}
+
+// ValidateSecretName 校验 SecretName
+func ValidateSecretName(ctx context.Context, fl validator.FieldLevel) bool {
+	secretName := fl.Field().String()
+	if secretName == "" {
+		return false
+	}
+	return !resourcebiz.DuplicatedResourceName(
+		ctx,
+		constant.Secret,
+		fl.Parent().FieldByName("ID").String(),
+		secretName,
+	)
+}
+
+// 注册校验器
+func init() {
+	validation.AddBizFieldTagValidatorWithCtx(
+		"secretName",
+		ValidateSecretName,
+		"{0}: {1} 该资源名称已经被存在的 secret 资源占用",
+	)
+}
@@ -71,6 +71,7 @@ type SSLListRequest struct {
 	Updater string `json:"updater,omitempty" form:"updater"`
 	Label   string `json:"label" form:"label"`
 	Status  string `json:"status" form:"status" binding:"resourceStatus"`
+	// 排序，如 expires_in:asc，支持 name/updated_at/validity_end/expires_in
 	OrderBy string `json:"order_by" form:"order_by"`
 	Offset  int    `json:"offset" form:"offset"`
 	Limit   int    `json:"limit" form:"limit"`
@@ -95,11 +96,45 @@ type SSLOutputInfo struct {
 	GatewayID int    `json:"gateway_id"` // 网关 ID
 	ID        string `json:"id"`
 	SSLInfo
-	CreatedAt int64                   `json:"created_at"`
-	UpdatedAt int64                   `json:"updated_at"`
-	Creator   string                  `json:"creator"`
-	Updater   string                  `json:"updater"`
-	Status    constant.ResourceStatus `json:"status"` // 发布状态
+	ValidityStart int64                   `json:"validity_start"` // 证书生效时间
+	ValidityEnd   int64                   `json:"validity_end"`   // 证书过期时间
+	ExpiresIn     int64                   `json:"expires_in"`     // 剩余有效期（秒），已过期为负数，证书无法解析时为 0
+	CreatedAt     int64                   `json:"created_at"`
+	UpdatedAt     int64                   `json:"updated_at"`
+	Creator       string                  `json:"creator"`
+	Updater       string                  `json:"updater"`
+	Status        constant.ResourceStatus `json:"status"` // 发布状态
+}
+
+// SSLExpiringListRequest 即将过期证书查询参数
+type SSLExpiringListRequest struct {
+	Days int `json:"days" form:"days" binding:"omitempty,min=1,max=3650"` // 剩余有效天数，默认为最大的告警阈值
+}
+
+// SSLExpiringListResponse 即将过期证书列表
+type SSLExpiringListResponse []SSLExpiringOutputInfo
+
+// SSLExpiringOutputInfo 即将过期证书信息
+type SSLExpiringOutputInfo struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Source        string   `json:"source"` // edit: 编辑区；etcd: 仅存在于 etcd 中的未纳管证书
+	Snis          []string `json:"snis"`
+	UpstreamIDs   []string `json:"upstream_ids"` // 引用该证书的上游
+	ValidityStart int64    `json:"validity_start"`
+	ValidityEnd   int64    `json:"validity_end"`
+	ExpiresIn     int64    `json:"expires_in"` // 剩余有效期（秒），已过期为负数
+	ExpiresInDays int      `json:"expires_in_days"`
+	Expired       bool     `json:"expired"`
+	InUse         bool     `json:"in_use"` // 是否配置了 SNI 或被上游引用
+}
+
+// SSLExpiresIn 计算证书剩余有效期（秒），证书无法解析时为 0
+func SSLExpiresIn(validityEnd int64, now int64) int64 {
+	if validityEnd == 0 {
+		return 0
+	}
+	return validityEnd - now
 }
 
 // ValidateSSLID 校验 证书ID
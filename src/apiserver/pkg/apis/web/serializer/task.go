@@ -0,0 +1,91 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"encoding/json"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// AsyncRequest 耗时操作的异步执行参数，async=true 时下发后台任务并直接返回任务信息
+type AsyncRequest struct {
+	Async bool `json:"async" form:"async"`
+}
+
+// TaskPathParam 后台任务路径参数
+type TaskPathParam struct {
+	TaskID int64 `json:"task_id" uri:"task_id" binding:"required"`
+}
+
+// TaskListRequest 后台任务列表查询参数
+type TaskListRequest struct {
+	Name string `json:"name" form:"name"` // 任务名称
+	// 状态：pending/running/succeeded/failed/cancelled
+	Status constant.TaskStatus `json:"status" form:"status"`
+}
+
+// TaskOutputInfo 后台任务输出信息
+type TaskOutputInfo struct {
+	ID              int64               `json:"id"`
+	Name            string              `json:"name"`
+	Status          constant.TaskStatus `json:"status"`
+	Progress        int                 `json:"progress"` // 进度百分比 0-100
+	Message         string              `json:"message"`
+	Error           string              `json:"error"`
+	Result          json.RawMessage     `json:"result,omitempty" swaggertype:"object"`
+	Attempts        int                 `json:"attempts"`
+	MaxAttempts     int                 `json:"max_attempts"`
+	CancelRequested bool                `json:"cancel_requested"`
+	StartedAt       int64               `json:"started_at"`  // 未执行时为 0
+	FinishedAt      int64               `json:"finished_at"` // 未执行完成时为 0
+	Creator         string              `json:"creator"`
+	CreatedAt       int64               `json:"created_at"`
+	UpdatedAt       int64               `json:"updated_at"`
+}
+
+// TaskToOutputInfo 将模型转换为后台任务输出信息
+func TaskToOutputInfo(task *model.Task, withResult bool) TaskOutputInfo {
+	output := TaskOutputInfo{
+		ID:              task.ID,
+		Name:            task.Name,
+		Status:          task.Status,
+		Progress:        task.Progress,
+		Message:         task.Message,
+		Error:           task.Error,
+		Attempts:        task.Attempts,
+		MaxAttempts:     task.MaxAttempts,
+		CancelRequested: task.CancelRequested,
+		Creator:         task.Creator,
+		CreatedAt:       task.CreatedAt.Unix(),
+		UpdatedAt:       task.UpdatedAt.Unix(),
+	}
+	// 任务结果（如 etcd 导出）可能较大，仅在查询详情时返回
+	if withResult && len(task.Result) > 0 {
+		output.Result = json.RawMessage(task.Result)
+	}
+	if !task.StartedAt.IsZero() {
+		output.StartedAt = task.StartedAt.Unix()
+	}
+	if task.FinishedAt != nil {
+		output.FinishedAt = task.FinishedAt.Unix()
+	}
+	return output
+}
@@ -19,8 +19,6 @@
 package serializer
 
 import (
-	"encoding/json"
-
 	validator "github.com/go-playground/validator/v10"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
@@ -56,6 +54,27 @@ type ResourceManagedRequest struct {
 // ResourceManagedResponse ...
 type ResourceManagedResponse map[constant.APISIXResource]int
 
+// EditorExportRequest 编辑区资源导出请求
+type EditorExportRequest struct {
+	// 导出草稿或已发布的配置，默认为草稿
+	State constant.ExportState `json:"state" form:"state" binding:"omitempty,oneof=draft published"`
+	// 导出格式：json 与 etcd 资源导出格式相同，standalone 为 APISIX standalone 模式的 apisix.yaml
+	Format constant.ExportFormat `json:"format" form:"format" binding:"omitempty,oneof=json standalone"`
+	// 资源类型，为空表示所有类型
+	ResourceTypes []constant.APISIXResource `json:"resource_types" form:"resource_types"`
+	Label         string                    `json:"label" form:"label"` // 标签，格式为 k1:v1,k2:v2
+}
+
+// ResourceUploadRequest 资源上传请求的表单参数
+type ResourceUploadRequest struct {
+	// 文件格式：json 为资源导出格式，openapi 为 OpenAPI 3.x / Swagger 2.0 文档，默认为 json
+	Format constant.ImportFormat `form:"format" binding:"omitempty,oneof=json openapi"`
+	// openapi 格式时，生成的路由绑定的服务 ID
+	ServiceID string `form:"service_id"`
+	// openapi 格式时，生成的路由绑定的上游 ID
+	UpstreamID string `form:"upstream_id"`
+}
+
 // ResourceDiffAllRequest ...
 type ResourceDiffAllRequest struct {
 	ID            string                   `json:"id"`                                                 // 资源ID
@@ -75,16 +94,10 @@ type ResourceDiffRequest struct {
 type ResourceDiffResponse []dto.ResourceChangeInfo
 
 // EtcdExportOutput ...
-type EtcdExportOutput map[constant.APISIXResource][]ResourceInfo
+type EtcdExportOutput = dto.EtcdExportOutput
 
 // ResourceInfo ...
-type ResourceInfo struct {
-	ResourceType constant.APISIXResource `json:"resource_type,omitempty"`               // 资源类型
-	ResourceID   string                  `json:"resource_id,omitempty"`                 // 资源ID
-	Name         string                  `json:"name,omitempty"`                        // 资源名称
-	Config       json.RawMessage         `json:"config,omitempty" swaggertype:"object"` // 资源配置
-	Status       constant.UploadStatus   `json:"status,omitempty"`                      // 资源导入状态(add/update)
-}
+type ResourceInfo = dto.ResourceInfo
 
 // OperationTypeToResourceStatus 操作类型转换资源状态
 func OperationTypeToResourceStatus(operationType []constant.OperationType) []constant.ResourceStatus {
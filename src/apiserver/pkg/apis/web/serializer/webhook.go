@@ -0,0 +1,153 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package serializer
+
+import (
+	"encoding/json"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+// WebhookPathParam 网关 webhook 路径参数
+type WebhookPathParam struct {
+	GatewayID int `json:"gateway_id" uri:"gateway_id" binding:"required"`
+	WebhookID int `json:"webhook_id" uri:"webhook_id"`
+}
+
+// WebhookDeliveryPathParam webhook 投递记录路径参数
+type WebhookDeliveryPathParam struct {
+	WebhookPathParam
+	DeliveryID int64 `json:"delivery_id" uri:"delivery_id" binding:"required"`
+}
+
+// WebhookRequest 网关 webhook 创建/更新请求
+type WebhookRequest struct {
+	Name string `json:"name" binding:"required,max=64"`
+	URL  string `json:"url" binding:"required,max=1024"` // 推送地址，仅支持 http/https
+	// 签名密钥；创建时为空则自动生成，更新时为空表示保留原值
+	Secret      string   `json:"secret" binding:"max=256"`
+	Events      []string `json:"events" binding:"required,min=1"` // 订阅的事件
+	Enabled     bool     `json:"enabled"`
+	Description string   `json:"description" binding:"max=512"`
+}
+
+// WebhookOutputInfo 网关 webhook 输出信息
+type WebhookOutputInfo struct {
+	ID          int      `json:"id"`
+	GatewayID   int      `json:"gateway_id"`
+	Name        string   `json:"name"`
+	URL         string   `json:"url"`
+	Secret      string   `json:"secret"` // 固定返回 ******
+	Events      []string `json:"events"`
+	Enabled     bool     `json:"enabled"`
+	Description string   `json:"description"`
+	Creator     string   `json:"creator"`
+	Updater     string   `json:"updater"`
+	CreatedAt   int64    `json:"created_at"`
+	UpdatedAt   int64    `json:"updated_at"`
+}
+
+// WebhookCreateOutputInfo 网关 webhook 创建输出信息（包含签名密钥明文）
+type WebhookCreateOutputInfo struct {
+	WebhookOutputInfo
+	Secret string `json:"secret"` // 签名密钥明文，仅在创建时返回
+}
+
+// WebhookListResponse 网关 webhook 列表响应
+type WebhookListResponse []WebhookOutputInfo
+
+// WebhookToOutputInfo 将模型转换为输出信息
+func WebhookToOutputInfo(webhook *model.GatewayWebhook) WebhookOutputInfo {
+	events := []string(webhook.Events)
+	if events == nil {
+		events = []string{}
+	}
+	return WebhookOutputInfo{
+		ID:          webhook.ID,
+		GatewayID:   webhook.GatewayID,
+		Name:        webhook.Name,
+		URL:         webhook.URL,
+		Secret:      secretValueMask,
+		Events:      events,
+		Enabled:     webhook.Enabled,
+		Description: webhook.Description,
+		Creator:     webhook.Creator,
+		Updater:     webhook.Updater,
+		CreatedAt:   webhook.CreatedAt.Unix(),
+		UpdatedAt:   webhook.UpdatedAt.Unix(),
+	}
+}
+
+// WebhookToCreateOutputInfo 将模型及签名密钥明文转换为创建输出信息
+func WebhookToCreateOutputInfo(webhook *model.GatewayWebhook, secret string) WebhookCreateOutputInfo {
+	return WebhookCreateOutputInfo{
+		WebhookOutputInfo: WebhookToOutputInfo(webhook),
+		Secret:            secret,
+	}
+}
+
+// WebhookDeliveryListRequest webhook 投递记录查询参数
+type WebhookDeliveryListRequest struct {
+	Status string `json:"status" form:"status"` // 投递状态：pending/succeeded/failed
+	Event  string `json:"event" form:"event"`   // 事件类型
+}
+
+// WebhookDeliveryOutputInfo webhook 投递记录输出信息
+type WebhookDeliveryOutputInfo struct {
+	ID             int64                          `json:"id"`
+	WebhookID      int                            `json:"webhook_id"`
+	EventID        string                         `json:"event_id"`
+	Event          constant.WebhookEvent          `json:"event"`
+	Payload        json.RawMessage                `json:"payload" swaggertype:"object"`
+	Status         constant.WebhookDeliveryStatus `json:"status"`
+	Attempts       int                            `json:"attempts"`
+	NextAttemptAt  int64                          `json:"next_attempt_at"`
+	LastAttemptAt  int64                          `json:"last_attempt_at"` // 未投递时为 0
+	ResponseStatus int                            `json:"response_status"`
+	ResponseBody   string                         `json:"response_body"`
+	Error          string                         `json:"error"`
+	DeliveredAt    int64                          `json:"delivered_at"` // 未投递成功时为 0
+	CreatedAt      int64                          `json:"created_at"`
+}
+
+// WebhookDeliveryToOutputInfo 将投递记录转换为输出信息
+func WebhookDeliveryToOutputInfo(delivery *model.GatewayWebhookDelivery) WebhookDeliveryOutputInfo {
+	info := WebhookDeliveryOutputInfo{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		EventID:        delivery.EventID,
+		Event:          delivery.Event,
+		Payload:        json.RawMessage(delivery.Payload),
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt.Unix(),
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		Error:          delivery.Error,
+		CreatedAt:      delivery.CreatedAt.Unix(),
+	}
+	if delivery.LastAttemptAt != nil {
+		info.LastAttemptAt = delivery.LastAttemptAt.Unix()
+	}
+	if delivery.DeliveredAt != nil {
+		info.DeliveredAt = delivery.DeliveredAt.Unix()
+	}
+	return info
+}
@@ -46,6 +46,8 @@ var initOnce sync.Once
 *
 * Q：目前这套基于 goroutine 实现的机制会有什么问题
 * A：由于没有使用消息队列，也没有保护机制，因此如果进程重启/崩溃，会导致运行中的任务中断
+*    因此需要保证执行完成的耗时任务（如一键发布、资源导入）通过 taskbiz.Enqueue 下发到 DB 任务队列，
+*    worker 通过租约抢占任务，进程退出后租约过期的任务会被其他 worker 重新执行
 *
 * Q：scheduler 是如何管理周期任务的？
 * A：- scheduler 首次启动时，会从 DB 中加载所有周期任务，并根据指定的 Cron 表达式执行
@@ -59,6 +61,24 @@ var initOnce sync.Once
 // TODO: SaaS 开发者可以根据需要自行调整，但不建议过大/过小
 const reloadTasksCron = "*/5 * * * *"
 
+// 每分钟检查并执行到期的定时发布
+const scheduledPublishCron = "* * * * *"
+
+// 每分钟推进未结束的网关组分批发布
+const gatewayGroupRolloutCron = "* * * * *"
+
+// 每分钟投递到期的 webhook 事件
+const webhookDeliveryCron = "* * * * *"
+
+// 每小时检查证书有效期，同一阈值只告警一次
+const sslExpiryCheckCron = "0 * * * *"
+
+// 每分钟从 etcd 刷新数据面实例清单
+const dataPlaneRefreshCron = "* * * * *"
+
+// 每 5 分钟回滚长时间未处理的中断发布
+const publishJournalRecoverCron = "*/5 * * * *"
+
 // TaskScheduler 简单的定时任务调度器，依赖 robfig/cron & model.PeriodicTask
 type TaskScheduler struct {
 	cron         *cron.Cron
@@ -177,6 +197,48 @@ func InitTaskScheduler() {
 		if err != nil {
 			log.Fatalf("failed to add reload tasks periodic task: %s", err)
 		}
+		// 添加周期任务：执行到期的定时发布
+		_, err = srv.cron.AddFunc(scheduledPublishCron, func() {
+			ApplyTask("RunScheduledPublishes", nil)
+		})
+		if err != nil {
+			log.Fatalf("failed to add scheduled publish periodic task: %s", err)
+		}
+		// 添加周期任务：推进网关组分批发布
+		_, err = srv.cron.AddFunc(gatewayGroupRolloutCron, func() {
+			ApplyTask("RunGatewayGroupRollouts", nil)
+		})
+		if err != nil {
+			log.Fatalf("failed to add gateway group rollout periodic task: %s", err)
+		}
+		// 添加周期任务：投递 webhook 事件
+		_, err = srv.cron.AddFunc(webhookDeliveryCron, func() {
+			ApplyTask("DeliverWebhooks", nil)
+		})
+		if err != nil {
+			log.Fatalf("failed to add webhook delivery periodic task: %s", err)
+		}
+		// 添加周期任务：检查证书有效期
+		_, err = srv.cron.AddFunc(sslExpiryCheckCron, func() {
+			ApplyTask("CheckSSLExpiry", nil)
+		})
+		if err != nil {
+			log.Fatalf("failed to add ssl expiry check periodic task: %s", err)
+		}
+		// 添加周期任务：刷新数据面实例清单
+		_, err = srv.cron.AddFunc(dataPlaneRefreshCron, func() {
+			ApplyTask("RefreshDataPlaneInstances", nil)
+		})
+		if err != nil {
+			log.Fatalf("failed to add data plane refresh periodic task: %s", err)
+		}
+		// 添加周期任务：回滚中断的发布
+		_, err = srv.cron.AddFunc(publishJournalRecoverCron, func() {
+			ApplyTask("RecoverPublishJournals", nil)
+		})
+		if err != nil {
+			log.Fatalf("failed to add publish journal recover periodic task: %s", err)
+		}
 		log.Infof("task server initialized")
 	})
 }
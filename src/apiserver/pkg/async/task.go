@@ -19,21 +19,43 @@
 // Package async 提供一个简单的异步 / 定时任务封装：
 // 1. 使用 cron 支持定时任务（cmd: scheduler）
 // 2. 简单封装 goroutine 以支持异步任务
+// 3. 基于 DB 的任务队列，支持租约、重试、进度及取消（cmd: webserver & scheduler 均会启动 worker）
 package async
 
 import (
+	"context"
 	"reflect"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/async/task"
+	taskbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/task"
 	log "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 )
 
 // RegisteredTasks 已注册的任务
 var RegisteredTasks = map[string]any{
-	"CalcFib": task.CalcFib,
+	"CalcFib":                   task.CalcFib,
+	"RunScheduledPublishes":     task.RunScheduledPublishes,
+	"RunGatewayGroupRollouts":   task.RunGatewayGroupRollouts,
+	"DeliverWebhooks":           task.DeliverWebhooks,
+	"CheckSSLExpiry":            task.CheckSSLExpiry,
+	"RefreshDataPlaneInstances": task.RefreshDataPlaneInstances,
+	"RecoverPublishJournals":    task.RecoverPublishJournals,
 	// TODO: SaaS 开发者可根据需求添加自定义任务
 }
 
+// RegisteredQueueTasks 已注册的队列任务，通过 taskbiz.Enqueue 下发，由 worker 抢占执行
+var RegisteredQueueTasks = map[string]taskbiz.Handler{
+	taskbiz.TaskNamePublishAll:            task.PublishAll,
+	taskbiz.TaskNameResourceImport:        task.ResourceImport,
+	taskbiz.TaskNameSyncedResourceManaged: task.SyncedResourceManaged,
+	taskbiz.TaskNameEtcdExport:            task.EtcdExport,
+}
+
+// RunTaskWorker 启动任务队列 worker，直到 ctx 结束
+func RunTaskWorker(ctx context.Context) {
+	taskbiz.NewWorker(RegisteredQueueTasks).Run(ctx)
+}
+
 // ApplyTask 下发异步任务
 func ApplyTask(name string, args []any) {
 	go func() {
@@ -0,0 +1,109 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+
+	changerequestbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/changerequest"
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	importflowbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/importflow"
+	maintenancebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/maintenance"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
+	taskbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/task"
+	unifyopbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/unifyop"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// withTaskContext 还原任务下发时的网关及用户上下文
+func withTaskContext(ctx context.Context, task *model.Task) (context.Context, error) {
+	gatewayInfo, err := gatewaybiz.GetGateway(ctx, task.GatewayID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = ginx.SetGatewayInfoToContext(ctx, gatewayInfo)
+	return context.WithValue(ctx, constant.UserIDKey, task.Creator), nil
+}
+
+// PublishAll 一键发布任务，网关开启发布审批时创建发布变更请求
+func PublishAll(ctx context.Context, task *model.Task) (any, error) {
+	var args taskbiz.PublishAllArgs
+	if err := json.Unmarshal(task.Args, &args); err != nil {
+		return nil, err
+	}
+	ctx, err := withTaskContext(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	ctx = maintenancebiz.WithOverride(ctx, args.OverrideMaintenanceWindow)
+	ctx = releasebiz.WithMeta(ctx, releasebiz.Meta{
+		Trigger:   constant.ReleaseTriggerWeb,
+		Changelog: args.Changelog,
+	})
+	changeRequest, err := changerequestbiz.PublishWithPolicy(ctx, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if changeRequest != nil {
+		return map[string]int64{"change_request_id": changeRequest.ID}, nil
+	}
+	return nil, nil
+}
+
+// ResourceImport 资源导入任务
+func ResourceImport(ctx context.Context, task *model.Task) (any, error) {
+	var args dto.ImportUploadInfo
+	if err := json.Unmarshal(task.Args, &args); err != nil {
+		return nil, err
+	}
+	if err := args.DecryptSensitive(); err != nil {
+		return nil, err
+	}
+	ctx, err := withTaskContext(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	return nil, importflowbiz.ImportUploadResourcesWithExisting(ctx, &args)
+}
+
+// SyncedResourceManaged 同步资源纳管任务，返回各类资源的纳管数量
+func SyncedResourceManaged(ctx context.Context, task *model.Task) (any, error) {
+	var args taskbiz.SyncedResourceManagedArgs
+	if err := json.Unmarshal(task.Args, &args); err != nil {
+		return nil, err
+	}
+	ctx, err := withTaskContext(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	return unifyopbiz.AddSyncedResources(ctx, args.ResourceIDList)
+}
+
+// EtcdExport etcd 资源导出任务，导出结果记录在任务结果中
+func EtcdExport(ctx context.Context, task *model.Task) (any, error) {
+	ctx, err := withTaskContext(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	return unifyopbiz.ExportGatewayEtcdResources(ctx)
+}
@@ -20,12 +20,21 @@
 package task
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
 
+	dataplanebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/dataplane"
+	gatewaygroupbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gatewaygroup"
+	publishbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/publish"
+	schedulebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/schedule"
+	sslexpirybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/sslexpiry"
+	webhookbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/webhook"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
+	log "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 )
 
 // Fibonacci 斐波那契数的递归实现，因为性能很差所以适合模拟需要长时间运行的后台任务
@@ -45,6 +54,7 @@ func CalcFib(n float64) (int, error) {
 		Name:      "CalcFib",
 		Args:      fmt.Appendf(nil, "{\"n\": %d}", nInt),
 		StartedAt: time.Now(),
+		Status:    constant.TaskStatusRunning,
 	}
 	if err := database.Client().Create(&task).Error; err != nil {
 		return 0, err
@@ -56,9 +66,78 @@ func CalcFib(n float64) (int, error) {
 	// 回填执行结果
 	task.Result = []byte(strconv.Itoa(fibN))
 	task.Duration = time.Since(task.StartedAt)
+	task.Status = constant.TaskStatusSucceeded
+	task.Progress = 100
 	if err := database.Client().Save(&task).Error; err != nil {
 		return 0, err
 	}
 
 	return fibN, nil
 }
+
+// RunScheduledPublishes 执行到期的定时发布任务，每个定时发布的执行结果记录在单独的 Task 中
+func RunScheduledPublishes() {
+	count, err := schedulebiz.RunDueScheduledPublishes(context.Background())
+	if err != nil {
+		log.Errorf("failed to run scheduled publishes: %s", err)
+		return
+	}
+	if count > 0 {
+		log.Infof("run %d scheduled publishes", count)
+	}
+}
+
+// RunGatewayGroupRollouts 推进未结束的网关组分批发布
+func RunGatewayGroupRollouts() {
+	count, err := gatewaygroupbiz.AdvanceRollouts(context.Background())
+	if err != nil {
+		log.Errorf("failed to run gateway group rollouts: %s", err)
+		return
+	}
+	if count > 0 {
+		log.Infof("advance %d gateway group rollouts", count)
+	}
+}
+
+// DeliverWebhooks 投递到期的 webhook 事件
+func DeliverWebhooks() {
+	count, err := webhookbiz.DeliverDueDeliveries(context.Background())
+	if err != nil {
+		log.Errorf("failed to deliver webhooks: %s", err)
+		return
+	}
+	if count > 0 {
+		log.Infof("deliver %d webhook events", count)
+	}
+}
+
+// CheckSSLExpiry 检查证书有效期，达到阈值时告警
+func CheckSSLExpiry() {
+	count, err := sslexpirybiz.CheckExpiry(context.Background())
+	if err != nil {
+		log.Errorf("failed to check ssl expiry: %s", err)
+		return
+	}
+	if count > 0 {
+		log.Infof("raise %d ssl expiry alerts", count)
+	}
+}
+
+// RefreshDataPlaneInstances 从 etcd 刷新所有网关的数据面实例清单
+func RefreshDataPlaneInstances() {
+	if err := dataplanebiz.RefreshAll(context.Background()); err != nil {
+		log.Errorf("failed to refresh data plane instances: %s", err)
+	}
+}
+
+// RecoverPublishJournals 回滚长时间未处理的中断发布
+func RecoverPublishJournals() {
+	count, err := publishbiz.RecoverInterruptedPublishJournals(context.Background())
+	if err != nil {
+		log.Errorf("failed to recover publish journals: %s", err)
+		return
+	}
+	if count > 0 {
+		log.Infof("revert %d interrupted publishes", count)
+	}
+}
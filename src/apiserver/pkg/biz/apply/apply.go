@@ -0,0 +1,482 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package apply 实现网关编辑区的声明式 apply：按完整的期望状态计算新增、更新及删除，并写入编辑区
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	importflowbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/importflow"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	schemabiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/schema"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
+)
+
+// ApplyErrors 定义声明式 apply 相关的错误
+var (
+	ErrGatewayNotInContext = errors.New("gateway not found in context")
+	ErrInvalidDocument     = errors.New("apply 文档无效")
+	ErrApplyConflict       = errors.New("资源与编辑区冲突")
+	ErrGatewayReadOnly     = errors.New("网关为只读")
+)
+
+// ownerPattern 归属 label 值需满足 APISIX label 的格式
+var ownerPattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,63}$`)
+
+// ownerLabelPath 归属 label 在资源配置中的 json 路径
+var ownerLabelPath = "labels." + gjson.Escape(constant.ApplyOwnerLabelKey)
+
+// Plan 计算将 req 中的期望状态 apply 到 ctx 中网关编辑区的计划
+//
+// 声明的资源按 ID 与编辑区对比；支持 labels 的资源写入归属 label，已存在但归属不同的资源在未指定 Adopt 时为冲突。
+// Prune 时删除属于同一归属但未声明的资源，仍被其他资源引用的待删除资源为冲突。
+// 不支持 labels 的资源类型及自定义插件 schema 无法确定归属，只会新增或更新，不会被删除。
+func Plan(ctx context.Context, req *dto.ApplyRequest) (*dto.ApplyPlan, error) {
+	gateway := ginx.GetGatewayInfoFromContext(ctx)
+	if gateway == nil {
+		return nil, ErrGatewayNotInContext
+	}
+	owner := req.Owner
+	if owner == "" {
+		owner = constant.ApplyDefaultOwner
+	}
+	if !ownerPattern.MatchString(owner) {
+		return nil, fmt.Errorf("%w: owner %s 需匹配 %s", ErrInvalidDocument, owner, ownerPattern.String())
+	}
+	for resourceType := range req.Resources {
+		if resourceType != constant.Schema && !slices.Contains(constant.ResourceTypeList, resourceType) {
+			return nil, fmt.Errorf("%w: 未知的资源类型 %s", ErrInvalidDocument, resourceType)
+		}
+	}
+	existing, err := loadExistingResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &dto.ApplyPlan{Owner: owner, Items: make([]dto.ApplyItem, 0)}
+	declared := make(map[string]json.RawMessage)
+	for _, resourceType := range constant.ResourceTypeList {
+		supportLabels := schema.SupportLabels(gateway.GetAPISIXVersionX(), resourceType.String())
+		resources := req.Resources[resourceType]
+		sort.Slice(resources, func(i, j int) bool { return resources[i].ResourceID < resources[j].ResourceID })
+		for _, resource := range resources {
+			config, err := desiredConfig(resourceType, resource, owner, supportLabels)
+			if err != nil {
+				return nil, err
+			}
+			key := resource.GetResourceKey()
+			if _, ok := declared[key]; ok {
+				return nil, fmt.Errorf("%w: %s [id:%s] 重复声明",
+					ErrInvalidDocument, resourceType, resource.ResourceID)
+			}
+			declared[key] = config
+			plan.Items = append(plan.Items, diffResource(resourceType, resource, config, existing[resourceType],
+				owner, req.Adopt, supportLabels))
+		}
+	}
+	schemaItems, err := planSchemas(ctx, req.Resources[constant.Schema])
+	if err != nil {
+		return nil, err
+	}
+	plan.Items = append(plan.Items, schemaItems...)
+	if req.Prune {
+		plan.Items = append(plan.Items, pruneItems(gateway, existing, declared, owner)...)
+	}
+	checkDeleteReferences(plan, existing, declared)
+
+	for _, item := range plan.Items {
+		switch item.Action {
+		case constant.ApplyActionCreate:
+			plan.CreatedCount++
+		case constant.ApplyActionUpdate:
+			plan.UpdatedCount++
+		case constant.ApplyActionUnchanged:
+			plan.UnchangedCount++
+		case constant.ApplyActionDelete:
+			plan.DeletedCount++
+		case constant.ApplyActionConflict:
+			plan.ConflictCount++
+		}
+	}
+	return plan, nil
+}
+
+// Apply 将 req 中的期望状态写入 ctx 中网关的编辑区：新增及更新的资源按 schema 校验后以草稿写入，
+// 删除的资源按状态直接删除 (新增待发布) 或标记为待删除；计划中存在冲突时不做任何变更
+func Apply(ctx context.Context, req *dto.ApplyRequest) (*dto.ApplyPlan, error) {
+	if gateway := ginx.GetGatewayInfoFromContext(ctx); gateway != nil && gateway.ReadOnly {
+		return nil, ErrGatewayReadOnly
+	}
+	plan, err := Plan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if plan.ConflictCount > 0 {
+		return plan, ErrApplyConflict
+	}
+	uploadInfo := &dto.ImportUploadInfo{
+		Add:    make(map[constant.APISIXResource][]*dto.ImportResourceInfo),
+		Update: make(map[constant.APISIXResource][]*dto.ImportResourceInfo),
+	}
+	deleted := make(map[constant.APISIXResource][]string)
+	for _, item := range plan.Items {
+		importResource := &dto.ImportResourceInfo{
+			ResourceType: item.ResourceType,
+			ResourceID:   item.ResourceID,
+			Name:         item.Name,
+			Config:       item.After,
+		}
+		switch item.Action {
+		case constant.ApplyActionCreate:
+			importResource.Status = constant.UploadStatusAdd
+			uploadInfo.Add[item.ResourceType] = append(uploadInfo.Add[item.ResourceType], importResource)
+		case constant.ApplyActionUpdate:
+			importResource.Status = constant.UploadStatusUpdate
+			uploadInfo.Update[item.ResourceType] = append(uploadInfo.Update[item.ResourceType], importResource)
+		case constant.ApplyActionDelete:
+			deleted[item.ResourceType] = append(deleted[item.ResourceType], item.ResourceID)
+		}
+	}
+	if len(uploadInfo.Add) > 0 || len(uploadInfo.Update) > 0 {
+		if err := importflowbiz.ImportUploadResourcesWithExisting(ctx, uploadInfo); err != nil {
+			return nil, err
+		}
+	}
+	if err := deleteResources(ctx, deleted); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// loadExistingResources 查询编辑区中所有资源，按资源类型及资源 key 索引
+func loadExistingResources(
+	ctx context.Context,
+) (map[constant.APISIXResource]map[string]*model.ResourceCommonModel, error) {
+	existing := make(map[constant.APISIXResource]map[string]*model.ResourceCommonModel)
+	for _, resourceType := range constant.ResourceTypeList {
+		resources, err := resourcebiz.BatchGetResources(ctx, resourceType, []string{})
+		if err != nil {
+			return nil, err
+		}
+		resourceMap := make(map[string]*model.ResourceCommonModel, len(resources))
+		for _, resource := range resources {
+			resourceMap[resource.GetResourceKey(resourceType)] = resource
+		}
+		existing[resourceType] = resourceMap
+	}
+	return existing, nil
+}
+
+// desiredConfig 校验声明的资源并返回写入编辑区的配置，支持 labels 的资源写入归属 label
+func desiredConfig(
+	resourceType constant.APISIXResource,
+	resource *dto.ImportResourceInfo,
+	owner string,
+	supportLabels bool,
+) (json.RawMessage, error) {
+	if resource.ResourceType == "" {
+		resource.ResourceType = resourceType
+	}
+	if resource.ResourceType != resourceType {
+		return nil, fmt.Errorf("%w: %s 资源声明在 %s 下",
+			ErrInvalidDocument, resource.ResourceType, resourceType)
+	}
+	if resource.ResourceID == "" {
+		return nil, fmt.Errorf("%w: %s: 资源 id 为空: %s", ErrInvalidDocument, resourceType, resource.Name)
+	}
+	if !gjson.ValidBytes(resource.Config) || !gjson.ParseBytes(resource.Config).IsObject() {
+		return nil, fmt.Errorf("%w: %s [id:%s]: config 需为 json 对象",
+			ErrInvalidDocument, resourceType, resource.ResourceID)
+	}
+	if name := gjson.GetBytes(resource.Config, model.GetResourceNameKey(resourceType)).String(); name != "" {
+		resource.Name = name
+	}
+	if !supportLabels {
+		return resource.Config, nil
+	}
+	config, err := sjson.SetBytes(resource.Config, ownerLabelPath, owner)
+	if err != nil {
+		return nil, fmt.Errorf("设置 %s [id:%s] 的归属 label 失败: %w", resourceType, resource.ResourceID, err)
+	}
+	return config, nil
+}
+
+// diffResource 对比声明的资源与编辑区中的资源
+func diffResource(
+	resourceType constant.APISIXResource,
+	resource *dto.ImportResourceInfo,
+	config json.RawMessage,
+	existing map[string]*model.ResourceCommonModel,
+	owner string,
+	adopt bool,
+	supportLabels bool,
+) dto.ApplyItem {
+	item := dto.ApplyItem{
+		ResourceType: resourceType,
+		ResourceID:   resource.ResourceID,
+		Name:         resource.Name,
+		Action:       constant.ApplyActionCreate,
+		After:        config,
+	}
+	if current, ok := existing[resource.GetResourceKey()]; ok {
+		item.Before = json.RawMessage(current.Config)
+		currentOwner := gjson.GetBytes(current.Config, ownerLabelPath).String()
+		switch {
+		case supportLabels && !adopt && currentOwner != owner:
+			item.Action = constant.ApplyActionConflict
+			item.Reason = fmt.Sprintf("资源不属于 %s，指定 adopt 以接管", owner)
+			if currentOwner != "" {
+				item.Reason = fmt.Sprintf("资源属于 %s，指定 adopt 以接管", currentOwner)
+			}
+		case current.Status == constant.ResourceStatusDeleteDraft:
+			item.Action = constant.ApplyActionUpdate
+		case isConfigEqual(item.Before, item.After):
+			item.Action = constant.ApplyActionUnchanged
+		default:
+			item.Action = constant.ApplyActionUpdate
+		}
+		return item
+	}
+	// 编辑区中同名的其他资源无法与声明的资源共存
+	if resourceType == constant.PluginMetadata || item.Name == "" {
+		return item
+	}
+	for _, current := range existing {
+		if current.GetName(resourceType) == item.Name {
+			item.Action = constant.ApplyActionConflict
+			item.Reason = fmt.Sprintf("名称 %s 已被 %s 使用", item.Name, current.ID)
+			item.Before = json.RawMessage(current.Config)
+			return item
+		}
+	}
+	return item
+}
+
+// isConfigEqual 对比配置，忽略 id 字段，加密存储的敏感字段解密后对比
+func isConfigEqual(before, after json.RawMessage) bool {
+	return sensitive.IsEqual(
+		[]byte(jsonx.RemoveJsonKey(string(before), []string{"id"})),
+		[]byte(jsonx.RemoveJsonKey(string(after), []string{"id"})),
+	)
+}
+
+// planSchemas 对比声明的自定义插件 schema 与编辑区中的 schema
+func planSchemas(ctx context.Context, resources []*dto.ImportResourceInfo) ([]dto.ApplyItem, error) {
+	if len(resources) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		if resource.Name == "" {
+			return nil, fmt.Errorf("%w: %s: 名称为空", ErrInvalidDocument, constant.Schema)
+		}
+		if slices.Contains(names, resource.Name) {
+			return nil, fmt.Errorf("%w: %s %s 重复声明", ErrInvalidDocument, constant.Schema,
+				resource.Name)
+		}
+		names = append(names, resource.Name)
+	}
+	existingSchemas, err := schemabiz.BatchGetSchemaByName(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]*model.GatewayCustomPluginSchema, len(existingSchemas))
+	for _, existingSchema := range existingSchemas {
+		existing[existingSchema.Name] = existingSchema
+	}
+
+	sort.Slice(resources, func(i, j int) bool { return resources[i].Name < resources[j].Name })
+	items := make([]dto.ApplyItem, 0, len(resources))
+	for _, resource := range resources {
+		item := dto.ApplyItem{
+			ResourceType: constant.Schema,
+			Name:         resource.Name,
+			Action:       constant.ApplyActionCreate,
+			After:        resource.Config,
+		}
+		if current, ok := existing[resource.Name]; ok {
+			item.Before, err = json.Marshal(map[string]json.RawMessage{
+				"schema":  rawOrNull(current.Schema),
+				"example": rawOrNull(current.Example),
+			})
+			if err != nil {
+				return nil, err
+			}
+			item.Action = constant.ApplyActionUpdate
+			if isConfigEqual(item.Before, schemaConfig(resource.Config)) {
+				item.Action = constant.ApplyActionUnchanged
+			}
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// schemaConfig 返回自定义插件 schema 中参与对比的字段
+func schemaConfig(config json.RawMessage) json.RawMessage {
+	result, _ := json.Marshal(map[string]json.RawMessage{
+		"schema":  rawOrNull([]byte(gjson.GetBytes(config, "schema").Raw)),
+		"example": rawOrNull([]byte(gjson.GetBytes(config, "example").Raw)),
+	})
+	return result
+}
+
+func rawOrNull(raw []byte) json.RawMessage {
+	if len(raw) == 0 {
+		return json.RawMessage("null")
+	}
+	return raw
+}
+
+// pruneItems 返回属于 owner 但未声明的资源，已标记为待删除的资源不重复删除
+func pruneItems(
+	gateway *model.Gateway,
+	existing map[constant.APISIXResource]map[string]*model.ResourceCommonModel,
+	declared map[string]json.RawMessage,
+	owner string,
+) []dto.ApplyItem {
+	var items []dto.ApplyItem
+	for _, resourceType := range constant.ResourceTypeList {
+		if !schema.SupportLabels(gateway.GetAPISIXVersionX(), resourceType.String()) {
+			continue
+		}
+		var resourceItems []dto.ApplyItem
+		for key, current := range existing[resourceType] {
+			if _, ok := declared[key]; ok || current.Status == constant.ResourceStatusDeleteDraft {
+				continue
+			}
+			if gjson.GetBytes(current.Config, ownerLabelPath).String() != owner {
+				continue
+			}
+			resourceItems = append(resourceItems, dto.ApplyItem{
+				ResourceType: resourceType,
+				ResourceID:   current.ID,
+				Name:         current.GetName(resourceType),
+				Action:       constant.ApplyActionDelete,
+				Before:       json.RawMessage(current.Config),
+			})
+		}
+		sort.Slice(resourceItems, func(i, j int) bool {
+			return resourceItems[i].ResourceID < resourceItems[j].ResourceID
+		})
+		items = append(items, resourceItems...)
+	}
+	return items
+}
+
+// checkDeleteReferences 将仍被保留的资源引用的待删除资源标记为冲突
+func checkDeleteReferences(
+	plan *dto.ApplyPlan,
+	existing map[constant.APISIXResource]map[string]*model.ResourceCommonModel,
+	declared map[string]json.RawMessage,
+) {
+	deleting := make(map[string]int)
+	for i, item := range plan.Items {
+		if item.Action == constant.ApplyActionDelete {
+			deleting[fmt.Sprintf(constant.ResourceKeyFormat, item.ResourceType, item.ResourceID)] = i
+		}
+	}
+	if len(deleting) == 0 {
+		return
+	}
+	remaining := make(map[string]json.RawMessage, len(declared))
+	for key, config := range declared {
+		remaining[key] = config
+	}
+	for _, resources := range existing {
+		for key, current := range resources {
+			if _, ok := remaining[key]; ok || current.Status == constant.ResourceStatusDeleteDraft {
+				continue
+			}
+			if _, ok := deleting[key]; ok {
+				continue
+			}
+			remaining[key] = json.RawMessage(current.Config)
+		}
+	}
+	keys := make([]string, 0, len(remaining))
+	for key := range remaining {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, field := range constant.ResourceDependencyFields {
+			id := gjson.GetBytes(remaining[key], field.Path).String()
+			if id == "" {
+				continue
+			}
+			i, ok := deleting[fmt.Sprintf(constant.ResourceKeyFormat, field.Type, id)]
+			if !ok || plan.Items[i].Action == constant.ApplyActionConflict {
+				continue
+			}
+			plan.Items[i].Action = constant.ApplyActionConflict
+			plan.Items[i].Reason = fmt.Sprintf("资源仍被 %s 引用", key)
+		}
+	}
+}
+
+// deleteResources 删除编辑区中的资源：新增待发布的资源直接删除，其余标记为待删除
+func deleteResources(ctx context.Context, deleted map[constant.APISIXResource][]string) error {
+	for _, resourceType := range constant.ResourceTypeList {
+		ids := deleted[resourceType]
+		if len(ids) == 0 {
+			continue
+		}
+		resources, err := resourcebiz.BatchGetResources(ctx, resourceType, ids)
+		if err != nil {
+			return err
+		}
+		var deleteIDs, updateIDs []string
+		for _, resource := range resources {
+			if resource.Status == constant.ResourceStatusCreateDraft {
+				deleteIDs = append(deleteIDs, resource.ID)
+			} else {
+				updateIDs = append(updateIDs, resource.ID)
+			}
+		}
+		if len(deleteIDs) > 0 {
+			if err := resourcebiz.BatchDeleteResourceWithAuditLog(ctx, resourceType, deleteIDs); err != nil {
+				return err
+			}
+		}
+		if len(updateIDs) > 0 {
+			err := resourcebiz.BatchUpdateResourceStatusWithAuditLog(ctx, resourceType, updateIDs,
+				constant.ResourceStatusDeleteDraft)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"gorm.io/datatypes"
+
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+func TestMain(m *testing.M) {
+	// init crypto
+	err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化embed数据库
+	util.InitEmbedDb()
+
+	os.Exit(m.Run())
+}
+
+// newTestGateway 创建网关及一个页面创建的路由
+func newTestGateway(t *testing.T) (context.Context, string) {
+	t.Helper()
+
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = strings.ToLower(t.Name())
+	if err := gatewaybiz.CreateGateway(context.Background(), gateway); err != nil {
+		t.Fatal(err)
+	}
+	ctx := ginx.SetGatewayInfoToContext(context.Background(), gateway)
+	prefix := gateway.Name + "-"
+	assert.NoError(t, resourcebiz.CreateRoute(ctx, model.Route{
+		Name: prefix + "ui",
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID:        prefix + "ui",
+			GatewayID: gateway.ID,
+			Config: datatypes.JSON(`{"id":"` + prefix + `ui","name":"` + prefix + `ui","uris":["/ui"],` +
+				`"upstream":{"type":"roundrobin","nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}}`),
+			Status: constant.ResourceStatusCreateDraft,
+		},
+	}))
+	return ctx, prefix
+}
+
+// testDocument 返回包含上游、引用该上游的路由及全局规则的期望状态
+func testDocument(
+	prefix string, withRoute bool, withUpstream bool,
+) map[constant.APISIXResource][]*dto.ImportResourceInfo {
+	resources := map[constant.APISIXResource][]*dto.ImportResourceInfo{
+		constant.GlobalRule: {{
+			ResourceID: prefix + "g1",
+			Config:     json.RawMessage(`{"id":"` + prefix + `g1","plugins":{"cors":{}}}`),
+		}},
+	}
+	if withUpstream {
+		resources[constant.Upstream] = []*dto.ImportResourceInfo{{
+			ResourceID: prefix + "u1",
+			Config: json.RawMessage(`{"id":"` + prefix + `u1","name":"` + prefix + `u1","type":"roundrobin",` +
+				`"nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}`),
+		}}
+	}
+	if withRoute {
+		resources[constant.Route] = []*dto.ImportResourceInfo{{
+			ResourceID: prefix + "r1",
+			Config: json.RawMessage(`{"id":"` + prefix + `r1","name":"` + prefix + `r1","uris":["/r1"],` +
+				`"labels":{"team":"a"},"upstream_id":"` + prefix + `u1"}`),
+		}}
+	}
+	return resources
+}
+
+func TestApply(t *testing.T) {
+	ctx, prefix := newTestGateway(t)
+
+	req := &dto.ApplyRequest{Resources: testDocument(prefix, true, true), Prune: true}
+	plan, err := Plan(ctx, req)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, constant.ApplyDefaultOwner, plan.Owner)
+	assert.Equal(t, 3, plan.CreatedCount)
+	assert.Zero(t, plan.DeletedCount)
+
+	_, err = Apply(ctx, req)
+	if !assert.NoError(t, err) {
+		return
+	}
+	route, err := resourcebiz.GetResourceByID(ctx, constant.Route, prefix+"r1")
+	assert.NoError(t, err)
+	assert.Equal(t, "gitops", gjson.GetBytes(route.Config, "labels.managed-by").String())
+	assert.Equal(t, "a", gjson.GetBytes(route.Config, "labels.team").String())
+	globalRule, err := resourcebiz.GetResourceByID(ctx, constant.GlobalRule, prefix+"g1")
+	assert.NoError(t, err)
+	assert.False(t, gjson.GetBytes(globalRule.Config, "labels").Exists())
+
+	// 再次 apply 相同的期望状态没有变化，页面创建的路由不受 prune 影响
+	plan, err = Plan(ctx, &dto.ApplyRequest{Resources: testDocument(prefix, true, true), Prune: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, plan.UnchangedCount)
+	assert.False(t, plan.HasChanges())
+
+	// 删除上游但保留引用它的路由时冲突
+	plan, err = Apply(ctx, &dto.ApplyRequest{Resources: testDocument(prefix, true, false), Prune: true})
+	assert.ErrorIs(t, err, ErrApplyConflict)
+	if assert.Equal(t, 1, plan.ConflictCount) {
+		assert.Contains(t, plan.Items[len(plan.Items)-1].Reason, prefix+"r1")
+	}
+
+	// prune 删除未声明的归属资源
+	plan, err = Apply(ctx, &dto.ApplyRequest{Resources: testDocument(prefix, false, false), Prune: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 2, plan.DeletedCount)
+	assert.Equal(t, 1, plan.UnchangedCount)
+	_, err = resourcebiz.GetResourceByID(ctx, constant.Route, prefix+"r1")
+	assert.Error(t, err)
+	_, err = resourcebiz.GetResourceByID(ctx, constant.Route, prefix+"ui")
+	assert.NoError(t, err)
+}
+
+func TestApplyOwnership(t *testing.T) {
+	ctx, prefix := newTestGateway(t)
+
+	resources := map[constant.APISIXResource][]*dto.ImportResourceInfo{
+		constant.Route: {{
+			ResourceID: prefix + "ui",
+			Config: json.RawMessage(`{"id":"` + prefix + `ui","name":"` + prefix + `ui","uris":["/ui-v2"],` +
+				`"upstream":{"type":"roundrobin","nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}}`),
+		}},
+	}
+	plan, err := Apply(ctx, &dto.ApplyRequest{Resources: resources, Owner: "ci"})
+	assert.ErrorIs(t, err, ErrApplyConflict)
+	if assert.Len(t, plan.Items, 1) {
+		assert.Equal(t, constant.ApplyActionConflict, plan.Items[0].Action)
+		assert.Contains(t, plan.Items[0].Reason, "adopt")
+	}
+
+	plan, err = Apply(ctx, &dto.ApplyRequest{Resources: resources, Owner: "ci", Adopt: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 1, plan.UpdatedCount)
+	route, err := resourcebiz.GetResourceByID(ctx, constant.Route, prefix+"ui")
+	assert.NoError(t, err)
+	assert.Equal(t, "ci", gjson.GetBytes(route.Config, "labels.managed-by").String())
+	assert.Equal(t, "/ui-v2", gjson.GetBytes(route.Config, "uris.0").String())
+
+	// 其他归属的 apply 不会删除该路由
+	plan, err = Plan(ctx, &dto.ApplyRequest{
+		Resources: map[constant.APISIXResource][]*dto.ImportResourceInfo{},
+		Prune:     true,
+	})
+	assert.NoError(t, err)
+	assert.Zero(t, plan.DeletedCount)
+}
+
+func TestPlanInvalidDocument(t *testing.T) {
+	ctx, prefix := newTestGateway(t)
+
+	for _, req := range []*dto.ApplyRequest{
+		{Owner: "has space"},
+		{Resources: map[constant.APISIXResource][]*dto.ImportResourceInfo{"unknown": {}}},
+		{Resources: map[constant.APISIXResource][]*dto.ImportResourceInfo{
+			constant.Route: {{Config: json.RawMessage(`{"name":"no-id"}`)}},
+		}},
+		{Resources: map[constant.APISIXResource][]*dto.ImportResourceInfo{
+			constant.Route: {
+				{ResourceID: prefix + "dup", Config: json.RawMessage(`{}`)},
+				{ResourceID: prefix + "dup", Config: json.RawMessage(`{}`)},
+			},
+		}},
+	} {
+		_, err := Plan(ctx, req)
+		assert.ErrorIs(t, err, ErrInvalidDocument)
+	}
+}
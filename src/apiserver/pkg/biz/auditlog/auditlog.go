@@ -30,9 +30,11 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 // AddBatchAuditLog writes operation audit rows for batch status-only changes.
+// Sensitive plugin fields are masked in the recorded configs.
 func AddBatchAuditLog(
 	ctx context.Context,
 	operationType constant.OperationType,
@@ -52,13 +54,13 @@ func AddBatchAuditLog(
 		dataBefore = append(dataBefore, model.BatchOperationData{
 			ID:     resource.ID,
 			Status: resource.Status,
-			Config: json.RawMessage(resource.Config),
+			Config: json.RawMessage(sensitive.Mask(resourceType, resource.Config)),
 		})
 		if operationType != constant.OperationTypeDelete {
 			dataAfter = append(dataAfter, model.BatchOperationData{
 				ID:     resource.ID,
 				Status: resourceIDStatusAfterMap[resource.ID],
-				Config: json.RawMessage(resource.Config),
+				Config: json.RawMessage(sensitive.Mask(resourceType, resource.Config)),
 			})
 		}
 	}
@@ -101,14 +103,14 @@ func AddRevertAuditLog(
 		dataBefore = append(dataBefore, model.BatchOperationData{
 			ID:     resource.ID,
 			Status: resource.Status,
-			Config: json.RawMessage(resource.Config),
+			Config: json.RawMessage(sensitive.Mask(resourceType, resource.Config)),
 		})
 	}
 	for _, resource := range afterResources {
 		dataAfter = append(dataAfter, model.BatchOperationData{
 			ID:     resource.ID,
 			Status: resource.Status,
-			Config: json.RawMessage(resource.Config),
+			Config: json.RawMessage(sensitive.Mask(resourceType, resource.Config)),
 		})
 	}
 
@@ -0,0 +1,571 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package changerequest contains publish approval policy and change request helpers.
+package changerequest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gookit/goutil/arrutil"
+	"gorm.io/gen"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	diffbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/diff"
+	maintenancebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/maintenance"
+	publishbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/publish"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ChangeRequestErrors 定义发布变更请求相关的错误
+var (
+	ErrGatewayNotInContext      = errors.New("gateway not found in context")
+	ErrInvalidPublishPolicy     = errors.New("审批人数需大于 0 且不超过审批人的数量")
+	ErrNoDraftResources         = errors.New("没有待发布的资源")
+	ErrChangeRequestNotFound    = errors.New("发布变更请求不存在")
+	ErrChangeRequestNotPending  = errors.New("发布变更请求不是待审批状态")
+	ErrChangeRequestNotApproved = errors.New("发布变更请求未审批通过或正在执行")
+	ErrNotReviewer              = errors.New("操作人不是该发布变更请求的审批人")
+	ErrSelfApproval             = errors.New("发起人不能审批自己的发布变更请求")
+	ErrAlreadyApproved          = errors.New("操作人已审批通过该发布变更请求")
+	ErrChangeRequestStale       = errors.New("发布变更请求创建后待发布的资源已发生变化，请重新创建")
+)
+
+// DefaultExpireHours 变更请求默认有效期（小时）
+const DefaultExpireHours = 72
+
+// applyingTimeout 执行中的变更请求超过该时间未完成，视为执行进程已退出，可重新执行
+const applyingTimeout = 10 * time.Minute
+
+// GetPublishPolicy 获取网关发布审批策略，未配置时返回未开启的默认策略
+func GetPublishPolicy(ctx context.Context, gatewayID int) (*model.GatewayPublishPolicy, error) {
+	u := repo.GatewayPublishPolicy
+	policy, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &model.GatewayPublishPolicy{GatewayID: gatewayID, ExpireHours: DefaultExpireHours}, nil
+	}
+	return policy, err
+}
+
+// SavePublishPolicy 保存网关发布审批策略
+func SavePublishPolicy(ctx context.Context, policy *model.GatewayPublishPolicy) error {
+	if policy.Enabled && (policy.RequiredApprovals <= 0 || policy.RequiredApprovals > len(policy.Reviewers)) {
+		return ErrInvalidPublishPolicy
+	}
+	if policy.ExpireHours <= 0 {
+		policy.ExpireHours = DefaultExpireHours
+	}
+	existing, err := GetPublishPolicy(ctx, policy.GatewayID)
+	if err != nil {
+		return err
+	}
+	policy.ID = existing.ID
+	if existing.ID != 0 {
+		policy.Creator = existing.Creator
+		policy.CreatedAt = existing.CreatedAt
+	}
+	return repo.GatewayPublishPolicy.WithContext(ctx).Save(policy)
+}
+
+// PublishWithPolicy 按网关的发布审批策略发布资源：未开启审批时直接发布并返回 nil，
+// 开启审批时创建发布变更请求，审批通过后再执行发布；直接发布及执行变更请求时均需满足网关的维护窗口
+//
+// resourceType 为空表示一键发布
+func PublishWithPolicy(
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+	resourceIDs []string,
+) (*model.GatewayChangeRequest, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if gatewayInfo == nil {
+		return nil, ErrGatewayNotInContext
+	}
+	policy, err := GetPublishPolicy(ctx, gatewayInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+	if policy.Enabled {
+		return CreateChangeRequest(ctx, policy, resourceType, resourceIDs)
+	}
+	if err := maintenancebiz.CheckPublishAllowed(ctx); err != nil {
+		return nil, err
+	}
+	if resourceType == "" {
+		return nil, publishbiz.PublishAllResource(ctx, gatewayInfo.ID)
+	}
+	return nil, publishbiz.PublishResource(ctx, resourceType, resourceIDs)
+}
+
+// PublishResourcesWithPolicy 按资源类型顺序，通过 PublishWithPolicy 逐类发布指定的资源，返回创建的发布变更请求
+//
+// 发布资源时会连带发布其依赖的资源，因此每类资源发布前重新查询，跳过已不是草稿状态的资源
+func PublishResourcesWithPolicy(
+	ctx context.Context,
+	resources map[constant.APISIXResource][]string,
+) ([]*model.GatewayChangeRequest, error) {
+	var changeRequests []*model.GatewayChangeRequest
+	for _, resourceType := range constant.ResourceTypeList {
+		if len(resources[resourceType]) == 0 {
+			continue
+		}
+		drafts, err := resourcebiz.QueryResource(ctx, resourceType,
+			map[string]any{
+				"id": resources[resourceType],
+				"status": []constant.ResourceStatus{
+					constant.ResourceStatusCreateDraft,
+					constant.ResourceStatusUpdateDraft,
+					constant.ResourceStatusDeleteDraft,
+				},
+			}, "")
+		if err != nil {
+			return changeRequests, err
+		}
+		if len(drafts) == 0 {
+			continue
+		}
+		resourceIDs := make([]string, 0, len(drafts))
+		for _, resource := range drafts {
+			resourceIDs = append(resourceIDs, resource.ID)
+		}
+		changeRequest, err := PublishWithPolicy(ctx, resourceType, resourceIDs)
+		if errors.Is(err, ErrNoDraftResources) {
+			continue
+		}
+		if err != nil {
+			return changeRequests, err
+		}
+		if changeRequest != nil {
+			changeRequests = append(changeRequests, changeRequest)
+		}
+	}
+	return changeRequests, nil
+}
+
+// CreateChangeRequest 创建发布变更请求，记录当前编辑区待发布资源的变更
+func CreateChangeRequest(
+	ctx context.Context,
+	policy *model.GatewayPublishPolicy,
+	resourceType constant.APISIXResource,
+	resourceIDs []string,
+) (*model.GatewayChangeRequest, error) {
+	diff, err := diffChangeRequestResources(ctx, resourceType, resourceIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(diff) == 0 {
+		return nil, ErrNoDraftResources
+	}
+	diffData, err := json.Marshal(diff)
+	if err != nil {
+		return nil, err
+	}
+	meta := releasebiz.GetMeta(ctx)
+	operator := ginx.GetUserIDFromContext(ctx)
+	changeRequest := &model.GatewayChangeRequest{
+		GatewayID:         policy.GatewayID,
+		TriggerSource:     meta.Trigger,
+		Changelog:         meta.Changelog,
+		ResourceType:      resourceType,
+		ResourceIDs:       resourceIDs,
+		Diff:              diffData,
+		RequiredApprovals: policy.RequiredApprovals,
+		Reviewers:         policy.Reviewers,
+		Status:            constant.ChangeRequestStatusPending,
+		ExpiredAt:         time.Now().Add(time.Duration(policy.ExpireHours) * time.Hour),
+		BaseModel: model.BaseModel{
+			Creator: operator,
+			Updater: operator,
+		},
+	}
+	err = repo.Q.Transaction(func(tx *repo.Query) error {
+		if err := tx.GatewayChangeRequest.WithContext(ctx).Create(changeRequest); err != nil {
+			return err
+		}
+		return addEvent(ctx, tx, changeRequest, constant.ChangeRequestActionCreate, operator, meta.Changelog)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changeRequest, nil
+}
+
+// diffChangeRequestResources 对比待发布的资源，resourceType 为空时对比所有草稿资源
+func diffChangeRequestResources(
+	ctx context.Context,
+	resourceType constant.APISIXResource,
+	resourceIDs []string,
+) ([]dto.ResourceChangeInfo, error) {
+	return diffbiz.DiffResources(ctx, resourceType, resourceIDs, "", nil, resourceType == "")
+}
+
+// ListChangeRequests 分页查询网关的发布变更请求
+func ListChangeRequests(
+	ctx context.Context,
+	gatewayID int,
+	status constant.ChangeRequestStatus,
+	page utils.PageParam,
+) ([]*model.GatewayChangeRequest, int64, error) {
+	if err := expireChangeRequests(ctx, gatewayID); err != nil {
+		return nil, 0, err
+	}
+	u := repo.GatewayChangeRequest
+	conds := []gen.Condition{u.GatewayID.Eq(gatewayID)}
+	if status != "" {
+		conds = append(conds, u.Status.Eq(string(status)))
+	}
+	return u.WithContext(ctx).
+		Omit(u.Diff).
+		Where(conds...).
+		Order(u.ID.Desc()).
+		FindByPage(page.Offset, page.Limit)
+}
+
+// GetChangeRequest 获取网关的发布变更请求
+func GetChangeRequest(ctx context.Context, gatewayID int, id int64) (*model.GatewayChangeRequest, error) {
+	if err := expireChangeRequests(ctx, gatewayID); err != nil {
+		return nil, err
+	}
+	u := repo.GatewayChangeRequest
+	changeRequest, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrChangeRequestNotFound
+	}
+	return changeRequest, err
+}
+
+// ListChangeRequestEvents 查询发布变更请求的操作记录
+func ListChangeRequestEvents(ctx context.Context, id int64) ([]*model.GatewayChangeRequestEvent, error) {
+	u := repo.GatewayChangeRequestEvent
+	return u.WithContext(ctx).Where(u.ChangeRequestID.Eq(id)).Order(u.ID).Find()
+}
+
+// GetChangeRequestDiff 获取发布变更请求创建时的资源变更
+func GetChangeRequestDiff(changeRequest *model.GatewayChangeRequest) ([]dto.ResourceChangeInfo, error) {
+	var diff []dto.ResourceChangeInfo
+	if len(changeRequest.Diff) == 0 {
+		return diff, nil
+	}
+	if err := json.Unmarshal(changeRequest.Diff, &diff); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// ApproveChangeRequest 审批通过发布变更请求，通过人数达到要求后自动执行发布
+//
+// 在同一事务中锁定变更请求、统计通过人数并更新状态，避免并发审批时漏记通过人数。
+// 执行发布失败不影响审批结果，错误记录在变更请求中，可以重新执行发布
+func ApproveChangeRequest(ctx context.Context, changeRequest *model.GatewayChangeRequest, comment string) error {
+	operator := ginx.GetUserIDFromContext(ctx)
+	approved := false
+	err := repo.Q.Transaction(func(tx *repo.Query) error {
+		u := tx.GatewayChangeRequest
+		latest, err := u.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(u.ID.Eq(changeRequest.ID)).First()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrChangeRequestNotFound
+		}
+		if err != nil {
+			return err
+		}
+		*changeRequest = *latest
+		if err := checkReviewer(changeRequest, operator); err != nil {
+			return err
+		}
+		e := tx.GatewayChangeRequestEvent
+		events, err := e.WithContext(ctx).Where(
+			e.ChangeRequestID.Eq(changeRequest.ID),
+			e.Action.Eq(string(constant.ChangeRequestActionApprove)),
+		).Find()
+		if err != nil {
+			return err
+		}
+		approvers := map[string]bool{operator: true}
+		for _, event := range events {
+			if event.Operator == operator {
+				return ErrAlreadyApproved
+			}
+			approvers[event.Operator] = true
+		}
+		approved = len(approvers) >= changeRequest.RequiredApprovals
+		if approved {
+			if err := updateStatus(ctx, tx, changeRequest, constant.ChangeRequestStatusPending,
+				constant.ChangeRequestStatusApproved, operator); err != nil {
+				return err
+			}
+		}
+		return addEvent(ctx, tx, changeRequest, constant.ChangeRequestActionApprove, operator, comment)
+	})
+	if err != nil || !approved {
+		return err
+	}
+	if err := ApplyChangeRequest(ctx, changeRequest); err != nil {
+		logging.ErrorFWithContext(ctx, "apply change request %d err: %s", changeRequest.ID, err.Error())
+	}
+	return nil
+}
+
+// RejectChangeRequest 驳回发布变更请求
+func RejectChangeRequest(ctx context.Context, changeRequest *model.GatewayChangeRequest, comment string) error {
+	operator := ginx.GetUserIDFromContext(ctx)
+	if err := checkReviewer(changeRequest, operator); err != nil {
+		return err
+	}
+	return repo.Q.Transaction(func(tx *repo.Query) error {
+		if err := updateStatus(ctx, tx, changeRequest, constant.ChangeRequestStatusPending,
+			constant.ChangeRequestStatusRejected, operator); err != nil {
+			return err
+		}
+		return addEvent(ctx, tx, changeRequest, constant.ChangeRequestActionReject, operator, comment)
+	})
+}
+
+// CommentChangeRequest 评论发布变更请求
+func CommentChangeRequest(ctx context.Context, changeRequest *model.GatewayChangeRequest, comment string) error {
+	return addEvent(
+		ctx,
+		repo.Q,
+		changeRequest,
+		constant.ChangeRequestActionComment,
+		ginx.GetUserIDFromContext(ctx),
+		comment,
+	)
+}
+
+// ApplyChangeRequest 执行已审批通过的发布变更请求
+//
+// 编辑区中待发布的资源在创建请求后发生变化时拒绝执行，避免发布未经审批的配置
+func ApplyChangeRequest(ctx context.Context, changeRequest *model.GatewayChangeRequest) error {
+	operator := ginx.GetUserIDFromContext(ctx)
+	if err := claimChangeRequest(ctx, changeRequest, operator); err != nil {
+		return err
+	}
+
+	applyErr := applyChangeRequest(ctx, changeRequest)
+	if applyErr != nil {
+		changeRequest.ApplyError = applyErr.Error()
+		err := repo.Q.Transaction(func(tx *repo.Query) error {
+			if err := updateStatus(ctx, tx, changeRequest, constant.ChangeRequestStatusApplying,
+				constant.ChangeRequestStatusApproved, operator); err != nil {
+				return err
+			}
+			u := tx.GatewayChangeRequest
+			_, err := u.WithContext(ctx).Where(u.ID.Eq(changeRequest.ID)).
+				UpdateSimple(u.ApplyError.Value(changeRequest.ApplyError))
+			if err != nil {
+				return err
+			}
+			return addEvent(ctx, tx, changeRequest, constant.ChangeRequestActionApplyFailed, operator, applyErr.Error())
+		})
+		if err != nil {
+			logging.ErrorFWithContext(ctx, "record change request %d apply err: %s", changeRequest.ID, err.Error())
+		}
+		return applyErr
+	}
+
+	now := time.Now()
+	return repo.Q.Transaction(func(tx *repo.Query) error {
+		if err := updateStatus(ctx, tx, changeRequest, constant.ChangeRequestStatusApplying,
+			constant.ChangeRequestStatusApplied, operator); err != nil {
+			return err
+		}
+		u := tx.GatewayChangeRequest
+		_, err := u.WithContext(ctx).Where(u.ID.Eq(changeRequest.ID)).
+			UpdateSimple(u.AppliedAt.Value(now), u.ApplyError.Value(""))
+		if err != nil {
+			return err
+		}
+		changeRequest.AppliedAt = &now
+		changeRequest.ApplyError = ""
+		return addEvent(ctx, tx, changeRequest, constant.ChangeRequestActionApply, operator, "")
+	})
+}
+
+// claimChangeRequest 在执行发布前通过条件更新将已通过的请求标记为执行中，保证多个进程中只有一个执行发布；
+// 执行中超过 applyingTimeout 的请求视为执行进程已退出，可被重新抢占
+func claimChangeRequest(ctx context.Context, changeRequest *model.GatewayChangeRequest, operator string) error {
+	now := time.Now()
+	u := repo.GatewayChangeRequest
+	q := u.WithContext(ctx)
+	info, err := q.Where(
+		u.ID.Eq(changeRequest.ID),
+		u.GatewayID.Eq(changeRequest.GatewayID),
+		q.Where(u.Status.Eq(string(constant.ChangeRequestStatusApproved))).Or(
+			u.Status.Eq(string(constant.ChangeRequestStatusApplying)), u.UpdatedAt.Lt(now.Add(-applyingTimeout)),
+		),
+	).UpdateSimple(
+		u.Status.Value(string(constant.ChangeRequestStatusApplying)),
+		u.Updater.Value(operator),
+	)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return ErrChangeRequestNotApproved
+	}
+	// 重新查询抢占后的请求，避免使用过期的副本执行发布
+	latest, err := GetChangeRequest(ctx, changeRequest.GatewayID, changeRequest.ID)
+	if err != nil {
+		return err
+	}
+	*changeRequest = *latest
+	return nil
+}
+
+// applyChangeRequest 校验待发布的资源未发生变化后执行发布
+func applyChangeRequest(ctx context.Context, changeRequest *model.GatewayChangeRequest) error {
+	captured, err := GetChangeRequestDiff(changeRequest)
+	if err != nil {
+		return err
+	}
+	current, err := diffChangeRequestResources(ctx, changeRequest.ResourceType, changeRequest.ResourceIDs)
+	if err != nil {
+		return err
+	}
+	currentDetails := make(map[string]dto.ResourceChangeDetail)
+	for _, changeInfo := range current {
+		for _, detail := range changeInfo.ChangeDetail {
+			currentDetails[changeInfo.ResourceType.String()+"/"+detail.ResourceID] = detail
+		}
+	}
+	capturedCount := 0
+	resources := make(map[constant.APISIXResource][]string)
+	for _, changeInfo := range captured {
+		for _, detail := range changeInfo.ChangeDetail {
+			capturedCount++
+			currentDetail, ok := currentDetails[changeInfo.ResourceType.String()+"/"+detail.ResourceID]
+			if !ok || currentDetail.UpdatedAt != detail.UpdatedAt || currentDetail.BeforeStatus != detail.BeforeStatus {
+				return fmt.Errorf("%w: %s %s", ErrChangeRequestStale, changeInfo.ResourceType, detail.ResourceID)
+			}
+			resources[changeInfo.ResourceType] = append(resources[changeInfo.ResourceType], detail.ResourceID)
+		}
+	}
+	// 发布指定资源时会连带发布关联的草稿资源，关联资源发生变化同样需要重新审批
+	if changeRequest.ResourceType != "" && len(currentDetails) != capturedCount {
+		return ErrChangeRequestStale
+	}
+
+	if err := maintenancebiz.CheckPublishAllowed(ctx); err != nil {
+		return err
+	}
+	ctx = releasebiz.WithMeta(ctx, releasebiz.Meta{
+		Trigger:   changeRequest.TriggerSource,
+		Changelog: changeRequest.Changelog,
+	})
+	if changeRequest.ResourceType != "" {
+		return publishbiz.PublishResource(ctx, changeRequest.ResourceType, changeRequest.ResourceIDs)
+	}
+	return publishbiz.PublishResources(ctx, resources)
+}
+
+// checkReviewer 校验操作人可以审批变更请求
+func checkReviewer(changeRequest *model.GatewayChangeRequest, operator string) error {
+	if changeRequest.IsExpired() {
+		return ErrChangeRequestNotPending
+	}
+	if changeRequest.Status != constant.ChangeRequestStatusPending {
+		return ErrChangeRequestNotPending
+	}
+	if operator == changeRequest.Creator {
+		return ErrSelfApproval
+	}
+	if operator == "" || !arrutil.HasValue(changeRequest.Reviewers, operator) {
+		return ErrNotReviewer
+	}
+	return nil
+}
+
+// updateStatus 按当前状态更新变更请求状态，状态已被并发修改时返回错误
+func updateStatus(
+	ctx context.Context,
+	tx *repo.Query,
+	changeRequest *model.GatewayChangeRequest,
+	from, to constant.ChangeRequestStatus,
+	operator string,
+) error {
+	u := tx.GatewayChangeRequest
+	info, err := u.WithContext(ctx).
+		Where(u.ID.Eq(changeRequest.ID), u.Status.Eq(string(from))).
+		UpdateSimple(u.Status.Value(string(to)), u.Updater.Value(operator))
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return fmt.Errorf("发布变更请求 %d 的状态不是 %s", changeRequest.ID, from)
+	}
+	changeRequest.Status = to
+	changeRequest.Updater = operator
+	return nil
+}
+
+// addEvent 记录变更请求的操作
+func addEvent(
+	ctx context.Context,
+	tx *repo.Query,
+	changeRequest *model.GatewayChangeRequest,
+	action constant.ChangeRequestAction,
+	operator string,
+	comment string,
+) error {
+	return tx.GatewayChangeRequestEvent.WithContext(ctx).Create(&model.GatewayChangeRequestEvent{
+		GatewayID:       changeRequest.GatewayID,
+		ChangeRequestID: changeRequest.ID,
+		Action:          action,
+		Operator:        operator,
+		Comment:         comment,
+	})
+}
+
+// expireChangeRequests 将网关超过有效期的待审批请求标记为过期
+func expireChangeRequests(ctx context.Context, gatewayID int) error {
+	u := repo.GatewayChangeRequest
+	expired, err := u.WithContext(ctx).Select(u.ID, u.GatewayID).Where(
+		u.GatewayID.Eq(gatewayID),
+		u.Status.Eq(string(constant.ChangeRequestStatusPending)),
+		u.ExpiredAt.Lt(time.Now()),
+	).Find()
+	if err != nil {
+		return err
+	}
+	for _, changeRequest := range expired {
+		err := repo.Q.Transaction(func(tx *repo.Query) error {
+			if err := updateStatus(ctx, tx, changeRequest, constant.ChangeRequestStatusPending,
+				constant.ChangeRequestStatusExpired, ""); err != nil {
+				return err
+			}
+			return addEvent(ctx, tx, changeRequest, constant.ChangeRequestActionExpire, "", "")
+		})
+		if err != nil {
+			logging.ErrorFWithContext(ctx, "expire change request %d err: %s", changeRequest.ID, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,345 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package changerequest
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+var etcdEndpoint string
+
+func TestMain(m *testing.M) {
+	// init crypto
+	err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化embed数据库
+	util.InitEmbedDb()
+
+	_, server, endpoint, err := util.StartEmbedEtcdClientRandom(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	etcdEndpoint = endpoint
+
+	code := m.Run()
+
+	server.Close()
+	os.Exit(code)
+}
+
+// newGatewayWithPolicy 创建开启发布审批的网关及一个待发布的路由
+func newGatewayWithPolicy(t *testing.T) (*model.Gateway, *model.Route, context.Context) {
+	t.Helper()
+
+	name := strings.ToLower(strings.ReplaceAll(t.Name(), "_", "-"))
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = name
+	gateway.EtcdConfig.Endpoint = base.Endpoint(etcdEndpoint)
+	gateway.EtcdConfig.Prefix = "/" + name
+	if err := gatewaybiz.CreateGateway(context.Background(), gateway); err != nil {
+		t.Fatal(err)
+	}
+	ctx := ginx.SetGatewayInfoToContext(context.Background(), gateway)
+
+	err := SavePublishPolicy(ctx, &model.GatewayPublishPolicy{
+		GatewayID:         gateway.ID,
+		Enabled:           true,
+		RequiredApprovals: 2,
+		Reviewers:         []string{"reviewer1", "reviewer2", "reviewer3"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	route := data.Route1WithNoRelationResource(gateway, constant.ResourceStatusCreateDraft)
+	if err := resourcebiz.CreateRoute(ctx, *route); err != nil {
+		t.Fatal(err)
+	}
+	return gateway, route, ctx
+}
+
+func withUser(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, constant.UserIDKey, userID)
+}
+
+func TestSavePublishPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	policy, err := GetPublishPolicy(ctx, 10001)
+	assert.NoError(t, err)
+	assert.False(t, policy.Enabled)
+	assert.Equal(t, DefaultExpireHours, policy.ExpireHours)
+
+	err = SavePublishPolicy(ctx, &model.GatewayPublishPolicy{
+		GatewayID:         10001,
+		Enabled:           true,
+		RequiredApprovals: 2,
+		Reviewers:         []string{"reviewer1"},
+	})
+	assert.ErrorIs(t, err, ErrInvalidPublishPolicy)
+
+	err = SavePublishPolicy(ctx, &model.GatewayPublishPolicy{
+		GatewayID:         10001,
+		Enabled:           true,
+		RequiredApprovals: 1,
+		Reviewers:         []string{"reviewer1"},
+		ExpireHours:       24,
+	})
+	assert.NoError(t, err)
+
+	// 再次保存时更新已有的策略
+	err = SavePublishPolicy(ctx, &model.GatewayPublishPolicy{GatewayID: 10001})
+	assert.NoError(t, err)
+	policy, err = GetPublishPolicy(ctx, 10001)
+	assert.NoError(t, err)
+	assert.False(t, policy.Enabled)
+	assert.Equal(t, DefaultExpireHours, policy.ExpireHours)
+	count, err := repo.GatewayPublishPolicy.WithContext(ctx).
+		Where(repo.GatewayPublishPolicy.GatewayID.Eq(10001)).Count()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestPublishWithPolicyCreatesChangeRequest(t *testing.T) {
+	_, route, ctx := newGatewayWithPolicy(t)
+
+	changeRequest, err := PublishWithPolicy(withUser(ctx, "requester"), constant.Route, []string{route.ID})
+	if !assert.NoError(t, err) || !assert.NotNil(t, changeRequest) {
+		return
+	}
+	assert.Equal(t, constant.ChangeRequestStatusPending, changeRequest.Status)
+	assert.Equal(t, "requester", changeRequest.Creator)
+	assert.Equal(t, 2, changeRequest.RequiredApprovals)
+
+	diff, err := GetChangeRequestDiff(changeRequest)
+	assert.NoError(t, err)
+	if assert.Len(t, diff, 1) {
+		assert.Equal(t, constant.Route, diff[0].ResourceType)
+		assert.Equal(t, route.ID, diff[0].ChangeDetail[0].ResourceID)
+	}
+
+	// 路由仍为草稿状态
+	current, err := resourcebiz.GetRoute(ctx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusCreateDraft, current.Status)
+
+	// 没有草稿资源时不创建变更请求
+	_, err = PublishWithPolicy(withUser(ctx, "requester"), constant.Route, []string{"not-exist"})
+	assert.ErrorIs(t, err, ErrNoDraftResources)
+}
+
+func TestPublishResourcesWithPolicyOnlyPublishesSpecifiedResources(t *testing.T) {
+	gateway, route, ctx := newGatewayWithPolicy(t)
+	other := data.Route1WithNoRelationResource(gateway, constant.ResourceStatusCreateDraft)
+	other.Name = "route-other"
+	if err := resourcebiz.CreateRoute(ctx, *other); err != nil {
+		t.Fatal(err)
+	}
+
+	changeRequests, err := PublishResourcesWithPolicy(withUser(ctx, "requester"),
+		map[constant.APISIXResource][]string{constant.Route: {route.ID}, constant.Upstream: {"not-exist"}})
+	if !assert.NoError(t, err) || !assert.Len(t, changeRequests, 1) {
+		return
+	}
+	diff, err := GetChangeRequestDiff(changeRequests[0])
+	assert.NoError(t, err)
+	if assert.Len(t, diff, 1) && assert.Len(t, diff[0].ChangeDetail, 1) {
+		assert.Equal(t, route.ID, diff[0].ChangeDetail[0].ResourceID)
+	}
+}
+
+func TestApproveChangeRequest(t *testing.T) {
+	gateway, route, ctx := newGatewayWithPolicy(t)
+
+	changeRequest, err := PublishWithPolicy(withUser(ctx, "requester"), constant.Route, []string{route.ID})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.ErrorIs(t, ApproveChangeRequest(withUser(ctx, "requester"), changeRequest, ""), ErrSelfApproval)
+	assert.ErrorIs(t, ApproveChangeRequest(withUser(ctx, "someone"), changeRequest, ""), ErrNotReviewer)
+
+	assert.NoError(t, ApproveChangeRequest(withUser(ctx, "reviewer1"), changeRequest, "lgtm"))
+	assert.Equal(t, constant.ChangeRequestStatusPending, changeRequest.Status)
+	assert.ErrorIs(t, ApproveChangeRequest(withUser(ctx, "reviewer1"), changeRequest, ""), ErrAlreadyApproved)
+	assert.NoError(t, CommentChangeRequest(withUser(ctx, "requester"), changeRequest, "please review"))
+
+	// 达到审批人数后自动发布
+	assert.NoError(t, ApproveChangeRequest(withUser(ctx, "reviewer2"), changeRequest, ""))
+	changeRequest, err = GetChangeRequest(ctx, gateway.ID, changeRequest.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ChangeRequestStatusApplied, changeRequest.Status)
+	assert.NotNil(t, changeRequest.AppliedAt)
+	assert.Empty(t, changeRequest.ApplyError)
+
+	current, err := resourcebiz.GetRoute(ctx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusSuccess, current.Status)
+
+	events, err := ListChangeRequestEvents(ctx, changeRequest.ID)
+	assert.NoError(t, err)
+	actions := make([]constant.ChangeRequestAction, 0, len(events))
+	for _, event := range events {
+		actions = append(actions, event.Action)
+	}
+	assert.Equal(t, []constant.ChangeRequestAction{
+		constant.ChangeRequestActionCreate,
+		constant.ChangeRequestActionApprove,
+		constant.ChangeRequestActionComment,
+		constant.ChangeRequestActionApprove,
+		constant.ChangeRequestActionApply,
+	}, actions)
+
+	// 已执行的请求不能再次审批或执行
+	assert.ErrorIs(t, ApproveChangeRequest(withUser(ctx, "reviewer3"), changeRequest, ""), ErrChangeRequestNotPending)
+	assert.ErrorIs(t, ApplyChangeRequest(ctx, changeRequest), ErrChangeRequestNotApproved)
+}
+
+func TestApproveChangeRequestWithStaleCopy(t *testing.T) {
+	gateway, route, ctx := newGatewayWithPolicy(t)
+
+	changeRequest, err := PublishWithPolicy(withUser(ctx, "requester"), constant.Route, []string{route.ID})
+	if !assert.NoError(t, err) {
+		return
+	}
+	// 模拟两个审批请求各自查询到的变更请求
+	stale := *changeRequest
+	assert.NoError(t, ApproveChangeRequest(withUser(ctx, "reviewer1"), changeRequest, ""))
+	assert.NoError(t, ApproveChangeRequest(withUser(ctx, "reviewer2"), &stale, ""))
+
+	changeRequest, err = GetChangeRequest(ctx, gateway.ID, changeRequest.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ChangeRequestStatusApplied, changeRequest.Status)
+	assert.ErrorIs(t, ApproveChangeRequest(withUser(ctx, "reviewer3"), &stale, ""), ErrChangeRequestNotPending)
+}
+
+func TestApplyChangeRequestClaimsBeforePublish(t *testing.T) {
+	gateway, route, ctx := newGatewayWithPolicy(t)
+
+	changeRequest, err := PublishWithPolicy(withUser(ctx, "requester"), constant.Route, []string{route.ID})
+	if !assert.NoError(t, err) {
+		return
+	}
+	// 模拟其他进程已抢占执行该请求
+	u := repo.GatewayChangeRequest
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(changeRequest.ID)).
+		UpdateSimple(u.Status.Value(string(constant.ChangeRequestStatusApplying)))
+	assert.NoError(t, err)
+	assert.ErrorIs(t, ApplyChangeRequest(ctx, changeRequest), ErrChangeRequestNotApproved)
+	current, err := resourcebiz.GetRoute(ctx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusCreateDraft, current.Status)
+
+	// 执行中超时的请求可被重新执行
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(changeRequest.ID)).
+		UpdateColumn(u.UpdatedAt, time.Now().Add(-applyingTimeout-time.Minute))
+	assert.NoError(t, err)
+	assert.NoError(t, ApplyChangeRequest(ctx, changeRequest))
+	changeRequest, err = GetChangeRequest(ctx, gateway.ID, changeRequest.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ChangeRequestStatusApplied, changeRequest.Status)
+}
+
+func TestRejectChangeRequest(t *testing.T) {
+	gateway, route, ctx := newGatewayWithPolicy(t)
+
+	changeRequest, err := PublishWithPolicy(withUser(ctx, "requester"), constant.Route, []string{route.ID})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.ErrorIs(t, RejectChangeRequest(withUser(ctx, "someone"), changeRequest, ""), ErrNotReviewer)
+	assert.NoError(t, RejectChangeRequest(withUser(ctx, "reviewer3"), changeRequest, "not now"))
+
+	changeRequests, total, err := ListChangeRequests(
+		ctx, gateway.ID, constant.ChangeRequestStatusRejected, utils.PageParam{Offset: 0, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, changeRequest.ID, changeRequests[0].ID)
+
+	current, err := resourcebiz.GetRoute(ctx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusCreateDraft, current.Status)
+}
+
+func TestExpireChangeRequest(t *testing.T) {
+	gateway, route, ctx := newGatewayWithPolicy(t)
+
+	changeRequest, err := PublishWithPolicy(withUser(ctx, "requester"), constant.Route, []string{route.ID})
+	if !assert.NoError(t, err) {
+		return
+	}
+	u := repo.GatewayChangeRequest
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(changeRequest.ID)).
+		UpdateSimple(u.ExpiredAt.Value(time.Now().Add(-time.Minute)))
+	assert.NoError(t, err)
+
+	changeRequest, err = GetChangeRequest(ctx, gateway.ID, changeRequest.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ChangeRequestStatusExpired, changeRequest.Status)
+	assert.ErrorIs(t, ApproveChangeRequest(withUser(ctx, "reviewer1"), changeRequest, ""), ErrChangeRequestNotPending)
+}
+
+func TestApplyStaleChangeRequest(t *testing.T) {
+	gateway, route, ctx := newGatewayWithPolicy(t)
+
+	changeRequest, err := PublishWithPolicy(withUser(ctx, "requester"), "", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// 创建请求后修改草稿，审批通过后拒绝发布
+	r := repo.Route
+	_, err = r.WithContext(ctx).Where(r.ID.Eq(route.ID)).
+		UpdateColumn(r.UpdatedAt, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	assert.NoError(t, ApproveChangeRequest(withUser(ctx, "reviewer1"), changeRequest, ""))
+	assert.NoError(t, ApproveChangeRequest(withUser(ctx, "reviewer2"), changeRequest, ""))
+
+	changeRequest, err = GetChangeRequest(ctx, gateway.ID, changeRequest.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ChangeRequestStatusApproved, changeRequest.Status)
+	assert.Contains(t, changeRequest.ApplyError, ErrChangeRequestStale.Error())
+	assert.ErrorIs(t, ApplyChangeRequest(ctx, changeRequest), ErrChangeRequestStale)
+
+	current, err := resourcebiz.GetRoute(ctx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusCreateDraft, current.Status)
+}
@@ -0,0 +1,278 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package dataplane 维护网关数据面 APISIX 实例清单
+//
+// APISIX 实例会将自身信息（id、hostname、version、boot_time 等）上报到 etcd 的 /data_plane/server_info/<id> 下，
+// 并通过租约保持，实例下线后租约过期、server_info 被删除。
+//
+// 只有 APISIX 2.x 会定期重新上报 server_info（last_report_time），APISIX 3.x 仅在启动时写入一次、之后只续约，
+// 因此 3.x 实例无法通过 server_info 判断是否已加载最新配置，不计算 revision 落后的告警。
+package dataplane
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"gorm.io/gorm/clause"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/version"
+)
+
+const (
+	// serverInfoPrefix 实例信息在网关 etcd prefix 下的路径
+	serverInfoPrefix = "data_plane/server_info/"
+	// defaultStaleTimeout 默认的心跳超时时间
+	defaultStaleTimeout = 3 * time.Minute
+	// offlineRetention 已下线实例的保留时间，超过后从清单中删除
+	offlineRetention = 24 * time.Hour
+	// etcdTimeout 读取 etcd 的超时时间
+	etcdTimeout = 5 * time.Second
+)
+
+// Instance 数据面实例及其告警
+type Instance struct {
+	*model.GatewayDataPlaneInstance
+	Warnings []constant.DataPlaneWarning
+}
+
+// Stale 是否失联
+func (i *Instance) Stale() bool {
+	return slices.Contains(i.Warnings, constant.DataPlaneWarningStale)
+}
+
+// Inventory 网关数据面实例清单
+type Inventory struct {
+	APISIXVersion string // 网关配置的 APISIX 版本
+	// 网关配置在 etcd 中的最新 revision，即最近一次发布（或直接修改 etcd）写入的 revision
+	LatestRevision int64
+	Instances      []*Instance
+}
+
+// StaleTimeout 获取心跳超时时间
+func StaleTimeout() time.Duration {
+	if config.G != nil && config.G.Biz.DataPlaneStaleTimeout > 0 {
+		return config.G.Biz.DataPlaneStaleTimeout
+	}
+	return defaultStaleTimeout
+}
+
+// Refresh 从 etcd 刷新网关的数据面实例清单
+func Refresh(ctx context.Context, gateway *model.Gateway) (*Inventory, error) {
+	etcdStore, err := storage.NewGatewayEtcdStorage(gateway.ID, gateway.EtcdConfig.EtcdConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer etcdStore.Close()
+
+	etcdCtx, cancel := context.WithTimeout(ctx, etcdTimeout)
+	defer cancel()
+	prefix := gateway.GetEtcdPrefixForList()
+	kvs, err := etcdStore.List(etcdCtx, prefix+serverInfoPrefix)
+	if err != nil {
+		return nil, err
+	}
+	latestRevision, err := latestConfigRevision(etcdCtx, etcdStore.GetClient(), prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	instances := make([]*model.GatewayDataPlaneInstance, 0, len(kvs))
+	for _, kv := range kvs {
+		instance := parseServerInfo(kv, now)
+		if instance == nil {
+			logging.WarnFWithCtx(ctx, "invalid server_info %s of gateway %s: %s", kv.Key, gateway.Name, kv.Value)
+			continue
+		}
+		instance.GatewayID = gateway.ID
+		instances = append(instances, instance)
+	}
+	if err := save(ctx, gateway.ID, instances, now); err != nil {
+		return nil, err
+	}
+	return Get(ctx, gateway, latestRevision)
+}
+
+// RefreshAll 刷新所有网关的数据面实例清单，单个网关失败不影响其他网关
+func RefreshAll(ctx context.Context) error {
+	gateways, err := repo.Gateway.WithContext(ctx).Find()
+	if err != nil {
+		return err
+	}
+	for _, gateway := range gateways {
+		if _, err := Refresh(ctx, gateway); err != nil {
+			logging.ErrorFWithContext(ctx, "refresh data plane instances of gateway %s err: %s",
+				gateway.Name, err.Error())
+		}
+	}
+	return nil
+}
+
+// Get 查询已记录的网关数据面实例清单，并根据最新 revision 计算告警
+func Get(ctx context.Context, gateway *model.Gateway, latestRevision int64) (*Inventory, error) {
+	u := repo.GatewayDataPlaneInstance
+	instances, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gateway.ID)).Order(u.Hostname, u.InstanceID).Find()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	staleTimeout := StaleTimeout()
+	inventory := &Inventory{
+		APISIXVersion:  gateway.APISIXVersion,
+		LatestRevision: latestRevision,
+		Instances:      make([]*Instance, 0, len(instances)),
+	}
+	for _, instance := range instances {
+		inventory.Instances = append(inventory.Instances, &Instance{
+			GatewayDataPlaneInstance: instance,
+			Warnings:                 warnings(gateway, instance, latestRevision, now, staleTimeout),
+		})
+	}
+	return inventory, nil
+}
+
+// save 保存 etcd 中的实例，不在 etcd 中的实例标记为下线，并清理下线超过保留时间的实例
+func save(ctx context.Context, gatewayID int, instances []*model.GatewayDataPlaneInstance, now time.Time) error {
+	return repo.Q.Transaction(func(tx *repo.Query) error {
+		u := tx.GatewayDataPlaneInstance
+		if len(instances) > 0 {
+			err := u.WithContext(ctx).Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "gateway_id"}, {Name: "instance_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"hostname", "version", "etcd_key", "boot_time", "revision",
+					"last_heartbeat_at", "online", "server_info", "updated_at",
+				}),
+			}).Create(instances...)
+			if err != nil {
+				return err
+			}
+		}
+		instanceIDs := lo.Map(instances, func(instance *model.GatewayDataPlaneInstance, _ int) string {
+			return instance.InstanceID
+		})
+		offline := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.Online.Is(true))
+		if len(instanceIDs) > 0 {
+			offline = offline.Where(u.InstanceID.NotIn(instanceIDs...))
+		}
+		if _, err := offline.UpdateSimple(u.Online.Value(false)); err != nil {
+			return err
+		}
+		_, err := u.WithContext(ctx).Where(
+			u.GatewayID.Eq(gatewayID),
+			u.Online.Is(false),
+			u.LastHeartbeatAt.Lt(now.Add(-offlineRetention)),
+		).Delete()
+		return err
+	})
+}
+
+// parseServerInfo 解析实例上报的 server_info，缺少实例 id 时返回 nil
+func parseServerInfo(kv storage.KeyValuePair, now time.Time) *model.GatewayDataPlaneInstance {
+	if !gjson.Valid(kv.Value) {
+		return nil
+	}
+	info := gjson.Parse(kv.Value)
+	instanceID := info.Get("id").String()
+	if instanceID == "" {
+		return nil
+	}
+	heartbeat := now
+	// APISIX 2.x 会定期上报 last_report_time
+	if lastReportTime := info.Get("last_report_time").Int(); lastReportTime > 0 {
+		heartbeat = time.Unix(lastReportTime, 0)
+	}
+	return &model.GatewayDataPlaneInstance{
+		InstanceID:      instanceID,
+		Hostname:        info.Get("hostname").String(),
+		Version:         info.Get("version").String(),
+		EtcdKey:         kv.Key,
+		BootTime:        info.Get("boot_time").Int(),
+		Revision:        kv.ModRevision,
+		LastHeartbeatAt: heartbeat,
+		Online:          true,
+		ServerInfo:      []byte(kv.Value),
+	}
+}
+
+// warnings 计算实例的告警
+func warnings(
+	gateway *model.Gateway,
+	instance *model.GatewayDataPlaneInstance,
+	latestRevision int64,
+	now time.Time,
+	staleTimeout time.Duration,
+) []constant.DataPlaneWarning {
+	result := []constant.DataPlaneWarning{}
+	if !instance.Online || now.Sub(instance.LastHeartbeatAt) > staleTimeout {
+		result = append(result, constant.DataPlaneWarningStale)
+	}
+	if instance.Version != "" && !versionMatched(gateway, instance.Version) {
+		result = append(result, constant.DataPlaneWarningVersionMismatch)
+	}
+	// 定期上报的实例在最新发布之后没有再写入过 server_info 时，无法确认其已加载最新配置
+	if instance.Online && reportsPeriodically(instance) && latestRevision > 0 && instance.Revision < latestRevision {
+		result = append(result, constant.DataPlaneWarningRevisionLag)
+	}
+	return result
+}
+
+// reportsPeriodically 实例是否定期重新上报 server_info（APISIX 2.x 上报 last_report_time）
+func reportsPeriodically(instance *model.GatewayDataPlaneInstance) bool {
+	return gjson.GetBytes(instance.ServerInfo, "last_report_time").Int() > 0
+}
+
+// versionMatched 实例版本与网关配置的 APISIX 版本是否一致，只比较到次版本号
+func versionMatched(gateway *model.Gateway, instanceVersion string) bool {
+	instanceVersionX, err := version.ToXVersion(instanceVersion)
+	if err != nil {
+		return strings.HasPrefix(instanceVersion, gateway.APISIXVersion)
+	}
+	return instanceVersionX == gateway.GetAPISIXVersionX()
+}
+
+// latestConfigRevision 获取网关各类资源在 etcd 中最新的修改 revision，不包含数据面上报的信息
+func latestConfigRevision(ctx context.Context, client *clientv3.Client, prefix string) (int64, error) {
+	var latest int64
+	for _, resourcePrefix := range constant.ResourceTypePrefixMap {
+		resp, err := client.Get(ctx, prefix+resourcePrefix+"/",
+			clientv3.WithPrefix(),
+			clientv3.WithKeysOnly(),
+			clientv3.WithSort(clientv3.SortByModRevision, clientv3.SortDescend),
+			clientv3.WithLimit(1),
+		)
+		if err != nil {
+			return 0, err
+		}
+		if len(resp.Kvs) > 0 {
+			latest = max(latest, resp.Kvs[0].ModRevision)
+		}
+	}
+	return latest, nil
+}
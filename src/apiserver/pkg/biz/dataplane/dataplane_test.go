@@ -0,0 +1,187 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dataplane
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+var (
+	etcdClient   *clientv3.Client
+	etcdEndpoint string
+)
+
+func TestMain(m *testing.M) {
+	// init crypto
+	err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化embed数据库
+	util.InitEmbedDb()
+
+	client, server, endpoint, err := util.StartEmbedEtcdClientRandom(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	etcdClient = client
+	etcdEndpoint = endpoint
+
+	code := m.Run()
+
+	server.Close()
+	os.Exit(code)
+}
+
+func newTestGateway(t *testing.T) *model.Gateway {
+	t.Helper()
+
+	name := strings.ToLower(strings.ReplaceAll(t.Name(), "_", "-"))
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = name
+	gateway.EtcdConfig.Endpoint = base.Endpoint(etcdEndpoint)
+	gateway.EtcdConfig.Prefix = "/" + name
+	if err := gatewaybiz.CreateGateway(context.Background(), gateway); err != nil {
+		t.Fatal(err)
+	}
+	return gateway
+}
+
+func put(t *testing.T, key, value string) {
+	t.Helper()
+
+	if _, err := etcdClient.Put(context.Background(), key, value); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseServerInfo(t *testing.T) {
+	now := time.Now()
+	kv := storage.KeyValuePair{
+		Key:         "/apisix/data_plane/server_info/node-1",
+		Value:       `{"id":"node-1","hostname":"host-1","version":"3.11.0","boot_time":1700000000}`,
+		ModRevision: 10,
+	}
+	instance := parseServerInfo(kv, now)
+	assert.Equal(t, "node-1", instance.InstanceID)
+	assert.Equal(t, "host-1", instance.Hostname)
+	assert.Equal(t, "3.11.0", instance.Version)
+	assert.Equal(t, int64(1700000000), instance.BootTime)
+	assert.Equal(t, int64(10), instance.Revision)
+	assert.Equal(t, now, instance.LastHeartbeatAt)
+	assert.True(t, instance.Online)
+
+	kv.Value = `{"id":"node-1","last_report_time":1700000100}`
+	assert.Equal(t, int64(1700000100), parseServerInfo(kv, now).LastHeartbeatAt.Unix())
+
+	kv.Value = `{"hostname":"host-1"}`
+	assert.Nil(t, parseServerInfo(kv, now))
+	kv.Value = `not json`
+	assert.Nil(t, parseServerInfo(kv, now))
+}
+
+func TestWarnings(t *testing.T) {
+	gateway := &model.Gateway{APISIXVersion: "3.11.0"}
+	now := time.Now()
+	instance := &model.GatewayDataPlaneInstance{
+		Version:         "3.11.2",
+		Revision:        10,
+		LastHeartbeatAt: now,
+		Online:          true,
+	}
+	assert.Empty(t, warnings(gateway, instance, 10, now, time.Minute))
+	// APISIX 3.x 启动后不再重新上报，不计算 revision 落后
+	assert.Empty(t, warnings(gateway, instance, 11, now, time.Minute))
+	instance.ServerInfo = []byte(fmt.Sprintf(`{"last_report_time":%d}`, now.Unix()))
+	assert.Equal(t, []constant.DataPlaneWarning{constant.DataPlaneWarningRevisionLag},
+		warnings(gateway, instance, 11, now, time.Minute))
+
+	instance.Version = "3.2.1"
+	instance.LastHeartbeatAt = now.Add(-2 * time.Minute)
+	assert.Equal(t, []constant.DataPlaneWarning{
+		constant.DataPlaneWarningStale,
+		constant.DataPlaneWarningVersionMismatch,
+	}, warnings(gateway, instance, 10, now, time.Minute))
+
+	// 已下线的实例不再计算 revision 落后
+	instance.Version = "3.11.0"
+	instance.LastHeartbeatAt = now
+	instance.Online = false
+	assert.Equal(t, []constant.DataPlaneWarning{constant.DataPlaneWarningStale},
+		warnings(gateway, instance, 11, now, time.Minute))
+}
+
+func TestRefresh(t *testing.T) {
+	gateway := newTestGateway(t)
+	prefix := gateway.GetEtcdPrefixForList()
+	put(t, prefix+"routes/route-1", `{"id":"route-1"}`)
+	node1 := fmt.Sprintf(`{"id":"node-1","hostname":"host-1","version":"3.11.0","last_report_time":%d}`,
+		time.Now().Unix())
+	put(t, prefix+serverInfoPrefix+"node-1", node1)
+	put(t, prefix+serverInfoPrefix+"node-2", `{"id":"node-2","hostname":"host-2","version":"3.2.0"}`)
+	put(t, prefix+serverInfoPrefix+"invalid", `{"hostname":"host-3"}`)
+
+	inventory, err := Refresh(context.Background(), gateway)
+	assert.NoError(t, err)
+	assert.Positive(t, inventory.LatestRevision)
+	assert.Equal(t, "3.11.0", inventory.APISIXVersion)
+	assert.Len(t, inventory.Instances, 2)
+	assert.Equal(t, "node-1", inventory.Instances[0].InstanceID)
+	assert.Empty(t, inventory.Instances[0].Warnings)
+	assert.Equal(t, []constant.DataPlaneWarning{constant.DataPlaneWarningVersionMismatch},
+		inventory.Instances[1].Warnings)
+
+	// 发布新配置后，定期上报但未再上报的实例 revision 落后；下线的实例标记为失联
+	put(t, prefix+"services/service-1", `{"id":"service-1"}`)
+	if _, err := etcdClient.Delete(context.Background(), prefix+serverInfoPrefix+"node-2"); err != nil {
+		t.Fatal(err)
+	}
+	inventory, err = Refresh(context.Background(), gateway)
+	assert.NoError(t, err)
+	assert.Len(t, inventory.Instances, 2)
+	assert.True(t, inventory.Instances[0].Online)
+	assert.Equal(t, []constant.DataPlaneWarning{constant.DataPlaneWarningRevisionLag},
+		inventory.Instances[0].Warnings)
+	assert.False(t, inventory.Instances[1].Online)
+	assert.True(t, inventory.Instances[1].Stale())
+
+	// 实例重新上报后告警恢复
+	put(t, prefix+serverInfoPrefix+"node-1", node1)
+	inventory, err = Refresh(context.Background(), gateway)
+	assert.NoError(t, err)
+	assert.Empty(t, inventory.Instances[0].Warnings)
+}
@@ -21,6 +21,7 @@ package diff
 
 import (
 	"context"
+	"encoding/json"
 
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
@@ -233,4 +234,15 @@ func collectRelatedDiffResourceIDs(
 			consumerGroupID,
 		)
 	}
+	if consumerID := resourceInfo.GetConsumerID(); consumerID != "" {
+		diffResourceTypeMap[constant.Consumer] = append(
+			diffResourceTypeMap[constant.Consumer],
+			consumerID,
+		)
+	}
+	// 插件中通过 $secret:// 引用的 secret 会随资源一同发布
+	secretRefs, _ := resourcebiz.ParseSecretReferences(json.RawMessage(resourceInfo.Config))
+	for _, ref := range secretRefs {
+		diffResourceTypeMap[constant.Secret] = append(diffResourceTypeMap[constant.Secret], ref.ID)
+	}
 }
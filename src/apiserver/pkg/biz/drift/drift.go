@@ -0,0 +1,351 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package drift contains out-of-band etcd change (configuration drift) detection helpers.
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"gorm.io/datatypes"
+	"gorm.io/gen"
+
+	webhookbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/webhook"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+)
+
+// ErrGatewayNotInContext 上下文中缺少网关信息
+var ErrGatewayNotInContext = errors.New("gateway not found in context")
+
+// Detector 配置漂移检测器
+//
+// 以网关最近一次发布版本的快照作为控制面最近写入 etcd 的配置（基线），与 etcd 中的实际配置对比：
+// 配置不一致、未经发布新增或被删除的资源记录为漂移。没有发布版本的网关不做检测
+type Detector struct {
+	mu                sync.Mutex
+	baselineVersionID int64
+	baseline          map[string]model.ReleaseResource // etcd key -> 快照中的资源
+}
+
+// NewDetector 创建配置漂移检测器
+func NewDetector() *Detector {
+	return &Detector{}
+}
+
+// DetectAll 对比 prefix 下的全量资源，基线中存在但 resources 中不存在的资源视为被删除
+func (d *Detector) DetectAll(ctx context.Context, prefix string, resources []*model.GatewaySyncData) error {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if gatewayInfo == nil {
+		return ErrGatewayNotInContext
+	}
+	// prefix 可能是网关前缀，也可能是某类资源的前缀
+	keyPrefix := strings.TrimPrefix(prefix, gatewayInfo.GetEtcdPrefixForList())
+	observed := make(map[string]*model.GatewaySyncData, len(resources))
+	for _, resource := range resources {
+		observed[resource.GetEtcdKey()] = resource
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	baseline, err := d.loadBaseline(ctx, gatewayInfo.ID)
+	if err != nil || baseline == nil {
+		return err
+	}
+	for key := range baseline {
+		if _, ok := observed[key]; !ok && strings.HasPrefix(key, keyPrefix) {
+			observed[key] = nil
+		}
+	}
+	// 未恢复的漂移记录对应的资源也可能已不在 etcd 中，例如被删除的未发布资源
+	records, err := queryDriftedRecords(ctx, gatewayInfo.ID, nil)
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if _, ok := observed[record.EtcdKey]; !ok && strings.HasPrefix(record.EtcdKey, keyPrefix) {
+			observed[record.EtcdKey] = nil
+		}
+	}
+	return d.detect(ctx, gatewayInfo, observed)
+}
+
+// DetectChanges 对比增量变更的资源，deleted 为 etcd 中被删除的资源
+func (d *Detector) DetectChanges(ctx context.Context, updated, deleted []*model.GatewaySyncData) error {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if gatewayInfo == nil {
+		return ErrGatewayNotInContext
+	}
+	observed := make(map[string]*model.GatewaySyncData, len(updated)+len(deleted))
+	for _, resource := range deleted {
+		observed[resource.GetEtcdKey()] = nil
+	}
+	for _, resource := range updated {
+		observed[resource.GetEtcdKey()] = resource
+	}
+	if len(observed) == 0 {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	baseline, err := d.loadBaseline(ctx, gatewayInfo.ID)
+	if err != nil || baseline == nil {
+		return err
+	}
+	return d.detect(ctx, gatewayInfo, observed)
+}
+
+// loadBaseline 加载最近一次发布版本的快照，版本未变化时复用缓存；没有发布版本时返回 nil
+func (d *Detector) loadBaseline(ctx context.Context, gatewayID int) (map[string]model.ReleaseResource, error) {
+	u := repo.GatewayReleaseVersion
+	versions, err := u.WithContext(ctx).
+		Select(u.ID).
+		Where(u.GatewayID.Eq(gatewayID)).
+		Order(u.ID.Desc()).
+		Limit(1).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, nil
+	}
+	if d.baseline != nil && d.baselineVersionID == versions[0].ID {
+		return d.baseline, nil
+	}
+	version, err := u.WithContext(ctx).Where(u.ID.Eq(versions[0].ID)).First()
+	if err != nil {
+		return nil, err
+	}
+	resources, err := version.GetReleaseResources()
+	if err != nil {
+		return nil, err
+	}
+	baseline := make(map[string]model.ReleaseResource, len(resources))
+	for _, resource := range resources {
+		baseline[resource.Key] = resource
+	}
+	d.baselineVersionID = version.ID
+	d.baseline = baseline
+	return baseline, nil
+}
+
+// detect 对比 observed（etcd key -> etcd 中的资源，nil 表示已删除）与基线，更新漂移记录
+func (d *Detector) detect(
+	ctx context.Context,
+	gatewayInfo *model.Gateway,
+	observed map[string]*model.GatewaySyncData,
+) error {
+	records, err := queryDriftedRecords(ctx, gatewayInfo.ID, lo.Keys(observed))
+	if err != nil {
+		return err
+	}
+	driftedRecordMap := make(map[string]*model.GatewayDriftRecord, len(records))
+	for _, record := range records {
+		driftedRecordMap[record.EtcdKey] = record
+	}
+
+	now := time.Now()
+	var resolvedIDs []int64
+	var toSave []*model.GatewayDriftRecord
+	var detected []*model.GatewayDriftRecord
+	for key, resource := range observed {
+		expected, inBaseline := d.baseline[key]
+		record, drifted := driftedRecordMap[key]
+		driftType := getDriftType(expected, inBaseline, resource)
+		if driftType == "" {
+			if drifted {
+				resolvedIDs = append(resolvedIDs, record.ID)
+			}
+			continue
+		}
+		if !drifted {
+			record = &model.GatewayDriftRecord{
+				GatewayID:  gatewayInfo.ID,
+				EtcdKey:    key,
+				Status:     constant.DriftStatusDrifted,
+				DetectedAt: now,
+			}
+			detected = append(detected, record)
+		}
+		record.DriftType = driftType
+		record.ReleaseVersionID = d.baselineVersionID
+		record.ExpectedConfig = nil
+		record.ActualConfig = nil
+		record.ModRevision = 0
+		if inBaseline {
+			record.Type, record.ResourceID, record.Name = expected.Type, expected.ID, expected.Name
+			record.ExpectedConfig = datatypes.JSON(expected.Config)
+		}
+		if resource != nil {
+			record.Type, record.ResourceID, record.Name = resource.Type, resource.ID, resource.GetName()
+			record.ActualConfig = resource.Config
+			record.ModRevision = resource.ModRevision
+		}
+		toSave = append(toSave, record)
+	}
+
+	u := repo.GatewayDriftRecord
+	err = repo.Q.Transaction(func(tx *repo.Query) error {
+		if len(toSave) > 0 {
+			if err := tx.GatewayDriftRecord.WithContext(ctx).Save(toSave...); err != nil {
+				return err
+			}
+		}
+		if len(detected) > 0 {
+			event := dto.WebhookConflictEventData{Drifts: lo.Map(detected,
+				func(record *model.GatewayDriftRecord, _ int) dto.DriftResource { return ToDriftResource(record) })}
+			err := webhookbiz.EmitWithTx(ctx, tx, gatewayInfo.ID, constant.WebhookEventConflictDetected, event)
+			if err != nil {
+				return err
+			}
+		}
+		if len(resolvedIDs) > 0 {
+			_, err := tx.GatewayDriftRecord.WithContext(ctx).
+				Where(u.ID.In(resolvedIDs...)).
+				UpdateSimple(u.Status.Value(string(constant.DriftStatusResolved)), u.ResolvedAt.Value(now))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(detected) > 0 {
+		notifyDriftDetected(ctx, gatewayInfo, detected)
+	}
+	return nil
+}
+
+// getDriftType 判断资源的漂移类型，没有漂移时返回空
+func getDriftType(
+	expected model.ReleaseResource,
+	inBaseline bool,
+	resource *model.GatewaySyncData,
+) constant.DriftType {
+	switch {
+	case resource == nil && inBaseline:
+		return constant.DriftTypeDeleted
+	case resource != nil && !inBaseline:
+		return constant.DriftTypeAdded
+	case resource != nil && !jsonx.IsJSONEqual(expected.Config, json.RawMessage(resource.Config)):
+		return constant.DriftTypeModified
+	}
+	return ""
+}
+
+// queryDriftedRecords 查询网关未恢复的漂移记录，keys 为空时查询全部
+func queryDriftedRecords(ctx context.Context, gatewayID int, keys []string) ([]*model.GatewayDriftRecord, error) {
+	u := repo.GatewayDriftRecord
+	query := u.WithContext(ctx).Where(
+		u.GatewayID.Eq(gatewayID),
+		u.Status.Eq(string(constant.DriftStatusDrifted)),
+	)
+	if len(keys) == 0 {
+		return query.Find()
+	}
+	var records []*model.GatewayDriftRecord
+	for _, batchKeys := range lo.Chunk(keys, constant.DBConditionIDMaxLength) {
+		batchRecords, err := query.Where(u.EtcdKey.In(batchKeys...)).Find()
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, batchRecords...)
+	}
+	return records, nil
+}
+
+// ResolveDriftRecords 数据面整体切换到某个版本（如回滚）后，关闭网关所有未恢复的漂移记录
+func ResolveDriftRecords(ctx context.Context, gatewayID int) error {
+	u := repo.GatewayDriftRecord
+	_, err := u.WithContext(ctx).Where(
+		u.GatewayID.Eq(gatewayID),
+		u.Status.Eq(string(constant.DriftStatusDrifted)),
+	).UpdateSimple(
+		u.Status.Value(string(constant.DriftStatusResolved)),
+		u.ResolvedAt.Value(time.Now()),
+	)
+	return err
+}
+
+// ResolveDriftRecordsByKeys 发布覆盖写入 keys 后，关闭这些 key 未恢复的漂移记录，其他 key 的漂移记录保持不变
+func ResolveDriftRecordsByKeys(ctx context.Context, gatewayID int, keys []string) error {
+	u := repo.GatewayDriftRecord
+	now := time.Now()
+	for _, batchKeys := range lo.Chunk(keys, constant.DBConditionIDMaxLength) {
+		_, err := u.WithContext(ctx).Where(
+			u.GatewayID.Eq(gatewayID),
+			u.Status.Eq(string(constant.DriftStatusDrifted)),
+			u.EtcdKey.In(batchKeys...),
+		).UpdateSimple(
+			u.Status.Value(string(constant.DriftStatusResolved)),
+			u.ResolvedAt.Value(now),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListDriftRecords 分页查询网关的漂移记录
+func ListDriftRecords(
+	ctx context.Context,
+	gatewayID int,
+	queryParam map[string]any,
+	page utils.PageParam,
+) ([]*model.GatewayDriftRecord, int64, error) {
+	u := repo.GatewayDriftRecord
+	conds := []gen.Condition{u.GatewayID.Eq(gatewayID)}
+	if status, ok := queryParam["status"].(string); ok && status != "" {
+		conds = append(conds, u.Status.Eq(status))
+	}
+	if resourceType, ok := queryParam["type"].(string); ok && resourceType != "" {
+		conds = append(conds, u.Type.Eq(resourceType))
+	}
+	if name, ok := queryParam["name"].(string); ok && name != "" {
+		conds = append(conds, u.Name.Like("%"+name+"%"))
+	}
+	return u.WithContext(ctx).
+		Where(conds...).
+		Order(u.ID.Desc()).
+		FindByPage(page.Offset, page.Limit)
+}
+
+// GetDriftedResourceKeys 获取网关未恢复漂移的资源 etcd key
+func GetDriftedResourceKeys(ctx context.Context, gatewayID int) (map[string]bool, error) {
+	records, err := queryDriftedRecords(ctx, gatewayID, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]bool, len(records))
+	for _, record := range records {
+		keys[record.EtcdKey] = true
+	}
+	return keys, nil
+}
@@ -0,0 +1,295 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+func TestMain(m *testing.M) {
+	// init crypto
+	err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化embed数据库
+	util.InitEmbedDb()
+
+	os.Exit(m.Run())
+}
+
+func newDriftGatewayContext(t *testing.T, name string, resources ...model.ReleaseResource) context.Context {
+	t.Helper()
+
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = name
+	gateway.EtcdConfig.Prefix = "/" + name
+	if err := repo.Gateway.WithContext(context.Background()).Create(gateway); err != nil {
+		t.Fatal(err)
+	}
+	releaseData, _ := json.Marshal(resources)
+	err := repo.GatewayReleaseVersion.WithContext(context.Background()).Create(&model.GatewayReleaseVersion{
+		GatewayID:     gateway.ID,
+		ReleaseData:   releaseData,
+		Version:       "v1",
+		ResourceCount: len(resources),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ginx.SetGatewayInfoToContext(context.Background(), gateway)
+}
+
+func releaseRoute(id, name, uri string) model.ReleaseResource {
+	return model.ReleaseResource{
+		Type:   constant.Route,
+		ID:     id,
+		Name:   name,
+		Key:    "routes/" + id,
+		Config: json.RawMessage(`{"name":"` + name + `","uris":["` + uri + `"]}`),
+	}
+}
+
+func syncedRoute(ctx context.Context, id, name, uri string) *model.GatewaySyncData {
+	return &model.GatewaySyncData{
+		ID:          id,
+		GatewayID:   ginx.GetGatewayInfoFromContext(ctx).ID,
+		Type:        constant.Route,
+		Config:      []byte(`{"uris":["` + uri + `"],"name":"` + name + `"}`),
+		ModRevision: 1,
+	}
+}
+
+func listDrifted(t *testing.T, ctx context.Context) map[string]*model.GatewayDriftRecord {
+	t.Helper()
+
+	records, err := queryDriftedRecords(ctx, ginx.GetGatewayInfoFromContext(ctx).ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := make(map[string]*model.GatewayDriftRecord, len(records))
+	for _, record := range records {
+		result[record.EtcdKey] = record
+	}
+	return result
+}
+
+func TestDetectAll(t *testing.T) {
+	ctx := newDriftGatewayContext(
+		t,
+		"drift-detect-all",
+		releaseRoute("r1", "route-1", "/1"),
+		releaseRoute("r2", "route-2", "/2"),
+		releaseRoute("r3", "route-3", "/3"),
+	)
+	gateway := ginx.GetGatewayInfoFromContext(ctx)
+	detector := NewDetector()
+
+	// r1 一致（key 顺序不同），r2 被修改，r3 被删除，r4 为未经发布新增
+	err := detector.DetectAll(ctx, gateway.GetEtcdPrefixForList(), []*model.GatewaySyncData{
+		syncedRoute(ctx, "r1", "route-1", "/1"),
+		syncedRoute(ctx, "r2", "route-2", "/changed"),
+		syncedRoute(ctx, "r4", "route-4", "/4"),
+	})
+	assert.NoError(t, err)
+
+	drifted := listDrifted(t, ctx)
+	assert.Len(t, drifted, 3)
+	assert.Equal(t, constant.DriftTypeModified, drifted["routes/r2"].DriftType)
+	assert.Equal(t, constant.DriftTypeDeleted, drifted["routes/r3"].DriftType)
+	assert.Equal(t, "route-3", drifted["routes/r3"].Name)
+	assert.Equal(t, constant.DriftTypeAdded, drifted["routes/r4"].DriftType)
+	assert.Equal(t, "route-4", drifted["routes/r4"].Name)
+
+	// 再次全量检测不会重复创建记录
+	err = detector.DetectAll(ctx, gateway.GetEtcdPrefixForList(), []*model.GatewaySyncData{
+		syncedRoute(ctx, "r1", "route-1", "/1"),
+		syncedRoute(ctx, "r2", "route-2", "/changed"),
+		syncedRoute(ctx, "r4", "route-4", "/4"),
+	})
+	assert.NoError(t, err)
+	total, err := repo.GatewayDriftRecord.WithContext(ctx).
+		Where(repo.GatewayDriftRecord.GatewayID.Eq(gateway.ID)).
+		Count()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+
+	// 其它资源类型前缀的全量检测不影响路由的漂移记录
+	err = detector.DetectAll(ctx, gateway.GetEtcdPrefixForList()+"services/", nil)
+	assert.NoError(t, err)
+	assert.Len(t, listDrifted(t, ctx), 3)
+}
+
+func TestDetectChanges(t *testing.T) {
+	ctx := newDriftGatewayContext(
+		t,
+		"drift-detect-changes",
+		releaseRoute("r1", "route-1", "/1"),
+		releaseRoute("r2", "route-2", "/2"),
+	)
+	detector := NewDetector()
+
+	err := detector.DetectChanges(ctx, []*model.GatewaySyncData{
+		syncedRoute(ctx, "r1", "route-1", "/changed"),
+		syncedRoute(ctx, "r3", "route-3", "/3"),
+	}, nil)
+	assert.NoError(t, err)
+	drifted := listDrifted(t, ctx)
+	assert.Len(t, drifted, 2)
+	assert.Equal(t, constant.DriftTypeModified, drifted["routes/r1"].DriftType)
+
+	// r1 恢复为发布的配置，r3 被删除后也与基线一致
+	err = detector.DetectChanges(
+		ctx,
+		[]*model.GatewaySyncData{syncedRoute(ctx, "r1", "route-1", "/1")},
+		[]*model.GatewaySyncData{{ID: "r3", Type: constant.Route}},
+	)
+	assert.NoError(t, err)
+	assert.Empty(t, listDrifted(t, ctx))
+
+	// r2 被删除
+	err = detector.DetectChanges(ctx, nil, []*model.GatewaySyncData{{ID: "r2", Type: constant.Route}})
+	assert.NoError(t, err)
+	drifted = listDrifted(t, ctx)
+	assert.Len(t, drifted, 1)
+	assert.Equal(t, constant.DriftTypeDeleted, drifted["routes/r2"].DriftType)
+	assert.JSONEq(t, `{"name":"route-2","uris":["/2"]}`, string(drifted["routes/r2"].ExpectedConfig))
+}
+
+func TestDetectWithoutReleaseVersion(t *testing.T) {
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = "drift-without-version"
+	gateway.EtcdConfig.Prefix = "/drift-without-version"
+	assert.NoError(t, repo.Gateway.WithContext(context.Background()).Create(gateway))
+	ctx := ginx.SetGatewayInfoToContext(context.Background(), gateway)
+
+	err := NewDetector().DetectAll(ctx, gateway.GetEtcdPrefixForList(), []*model.GatewaySyncData{
+		syncedRoute(ctx, "r1", "route-1", "/1"),
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, listDrifted(t, ctx))
+
+	err = NewDetector().DetectAll(context.Background(), gateway.GetEtcdPrefixForList(), nil)
+	assert.ErrorIs(t, err, ErrGatewayNotInContext)
+}
+
+func TestResolveAndListDriftRecords(t *testing.T) {
+	ctx := newDriftGatewayContext(t, "drift-resolve", releaseRoute("r1", "route-1", "/1"))
+	gateway := ginx.GetGatewayInfoFromContext(ctx)
+
+	err := NewDetector().DetectChanges(ctx, []*model.GatewaySyncData{
+		syncedRoute(ctx, "r1", "route-1", "/changed"),
+		syncedRoute(ctx, "r2", "route-2", "/2"),
+	}, nil)
+	assert.NoError(t, err)
+
+	keys, err := GetDriftedResourceKeys(ctx, gateway.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"routes/r1": true, "routes/r2": true}, keys)
+
+	records, total, err := ListDriftRecords(
+		ctx,
+		gateway.ID,
+		map[string]any{"name": "route-2"},
+		utils.PageParam{Offset: 0, Limit: 10},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, "r2", records[0].ResourceID)
+
+	// 只关闭发布写入的 key 的漂移记录
+	assert.NoError(t, ResolveDriftRecordsByKeys(ctx, gateway.ID, []string{"routes/r1"}))
+	keys, err = GetDriftedResourceKeys(ctx, gateway.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"routes/r2": true}, keys)
+
+	assert.NoError(t, ResolveDriftRecords(ctx, gateway.ID))
+	keys, err = GetDriftedResourceKeys(ctx, gateway.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	records, total, err = ListDriftRecords(
+		ctx,
+		gateway.ID,
+		map[string]any{"status": string(constant.DriftStatusResolved)},
+		utils.PageParam{Offset: 0, Limit: 10},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	for _, record := range records {
+		assert.NotNil(t, record.ResolvedAt)
+	}
+}
+
+func TestNotifyDriftDetected(t *testing.T) {
+	received := make(chan dto.DriftNotification, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		var notification dto.DriftNotification
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&notification))
+		received <- notification
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	originConfig := config.G
+	config.G = &config.Config{Biz: config.BizConfig{DriftWebhook: config.WebhookConfig{
+		URL:     server.URL,
+		Token:   "test-token",
+		Timeout: 5 * time.Second,
+	}}}
+	defer func() { config.G = originConfig }()
+
+	ctx := newDriftGatewayContext(t, "drift-notify", releaseRoute("r1", "route-1", "/1"))
+	err := NewDetector().DetectChanges(ctx, []*model.GatewaySyncData{
+		syncedRoute(ctx, "r1", "route-1", "/changed"),
+	}, nil)
+	assert.NoError(t, err)
+
+	select {
+	case notification := <-received:
+		assert.Equal(t, dto.DriftNotificationEventDetected, notification.Event)
+		assert.Equal(t, "drift-notify", notification.GatewayName)
+		assert.Len(t, notification.Drifts, 1)
+		assert.Equal(t, "routes/r1", notification.Drifts[0].Key)
+		assert.Equal(t, constant.DriftTypeModified, notification.Drifts[0].DriftType)
+	case <-time.After(5 * time.Second):
+		t.Fatal("drift notification not received")
+	}
+}
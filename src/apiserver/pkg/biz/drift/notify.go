@@ -0,0 +1,114 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	resty "github.com/go-resty/resty/v2"
+	"github.com/samber/lo"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/goroutinex"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
+)
+
+// notifyDriftDetected 异步发送配置漂移 webhook 通知
+//
+// 发布过程中 etcd 的变更可能先于新的发布版本被检测到，因此延迟一段时间后只通知仍未恢复的记录
+func notifyDriftDetected(ctx context.Context, gatewayInfo *model.Gateway, records []*model.GatewayDriftRecord) {
+	if config.G == nil || config.G.Biz.DriftWebhook.URL == "" {
+		return
+	}
+	webhook := config.G.Biz.DriftWebhook
+	ids := lo.Map(records, func(record *model.GatewayDriftRecord, _ int) int64 { return record.ID })
+	goroutinex.GoroutineWithRecovery(ctx, func() {
+		if webhook.NotifyDelay > 0 {
+			time.Sleep(webhook.NotifyDelay)
+		}
+		u := repo.GatewayDriftRecord
+		drifted, err := u.WithContext(context.Background()).Where(
+			u.ID.In(ids...),
+			u.Status.Eq(string(constant.DriftStatusDrifted)),
+		).Find()
+		if err != nil {
+			logging.Errorf("query drift records of gateway %s error: %s", gatewayInfo.Name, err.Error())
+			return
+		}
+		if len(drifted) == 0 {
+			return
+		}
+		if err := sendDriftNotification(webhook, gatewayInfo, drifted); err != nil {
+			logging.Errorf("send drift notification of gateway %s error: %s", gatewayInfo.Name, err.Error())
+		}
+	})
+}
+
+// sendDriftNotification 发送配置漂移 webhook 通知
+func sendDriftNotification(
+	webhook config.WebhookConfig,
+	gatewayInfo *model.Gateway,
+	records []*model.GatewayDriftRecord,
+) error {
+	notification := dto.DriftNotification{
+		Event:       dto.DriftNotificationEventDetected,
+		GatewayID:   gatewayInfo.ID,
+		GatewayName: gatewayInfo.Name,
+		Drifts:      make([]dto.DriftResource, 0, len(records)),
+	}
+	for _, record := range records {
+		notification.Drifts = append(notification.Drifts, ToDriftResource(record))
+	}
+	client := resty.New().SetLogger(logging.New()).SetTimeout(webhook.Timeout)
+	request := client.R().SetHeader("Content-Type", "application/json").SetBody(notification)
+	if webhook.Token != "" {
+		request.SetAuthToken(webhook.Token)
+	}
+	resp, err := request.Post(webhook.URL)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("webhook %s return status %d", webhook.URL, resp.StatusCode())
+	}
+	return nil
+}
+
+// ToDriftResource 将漂移记录转换为通知及接口返回的资源漂移信息，配置中的敏感字段脱敏
+func ToDriftResource(record *model.GatewayDriftRecord) dto.DriftResource {
+	return dto.DriftResource{
+		ID:             record.ID,
+		ResourceType:   record.Type,
+		ResourceID:     record.ResourceID,
+		Name:           record.Name,
+		Key:            record.EtcdKey,
+		DriftType:      record.DriftType,
+		ExpectedConfig: json.RawMessage(sensitive.Mask(record.Type, record.ExpectedConfig)),
+		ActualConfig:   json.RawMessage(sensitive.Mask(record.Type, record.ActualConfig)),
+		DetectedAt:     record.DetectedAt.Unix(),
+	}
+}
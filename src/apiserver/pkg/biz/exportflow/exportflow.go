@@ -0,0 +1,317 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+// Package exportflow contains editor area export helpers.
+package exportflow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"sigs.k8s.io/yaml"
+
+	publishbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/publish"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	syncdatabiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/syncdata"
+	unifyopbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/unifyop"
+	variablebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/variable"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
+)
+
+// ExportErrors 定义编辑区资源导出相关的错误
+var (
+	ErrGatewayNotInContext      = errors.New("gateway not found in context")
+	ErrResourceInvalid          = errors.New("导出的资源配置不合法")
+	ErrInvalidRouteMatchRequest = errors.New("路由匹配请求不合法")
+)
+
+// standaloneEndMarker APISIX standalone 模式要求 apisix.yaml 以 #END 结尾
+const standaloneEndMarker = "#END\n"
+
+// ExportedResource 导出的编辑区资源
+type ExportedResource struct {
+	Type     constant.APISIXResource
+	Resource *model.ResourceCommonModel
+	// 按发布规则生成并替换网关变量后的配置，已通过发布时的 schema 校验；
+	// 非明文导出时敏感变量保留引用、敏感字段已脱敏
+	Payload json.RawMessage
+}
+
+// ExportEditorResources 导出 ctx 中网关编辑区的资源：按状态、类型及 label 选出资源，
+// 并补充被引用的资源，每个资源均使用发布时的规则生成配置并校验；
+// 明文导出包含敏感信息，调用方需校验权限
+func ExportEditorResources(ctx context.Context, opts *dto.EditorExportOptions) ([]*ExportedResource, error) {
+	gateway := ginx.GetGatewayInfoFromContext(ctx)
+	if gateway == nil {
+		return nil, ErrGatewayNotInContext
+	}
+	allResources, err := loadResources(ctx, opts.State)
+	if err != nil {
+		return nil, err
+	}
+	// 非明文导出时敏感变量不解密，保留引用
+	getValues := variablebiz.GetDisplayVariableValues
+	if opts.Plaintext {
+		getValues = variablebiz.GetVariableValues
+	}
+	values, err := getValues(ctx, gateway.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make(map[constant.APISIXResource]schema.Validator)
+	exported := make([]*ExportedResource, 0)
+	for _, resource := range selectResources(allResources, opts) {
+		payload, err := publishbiz.BuildResourcePayload(gateway.GetAPISIXVersionX(), resource.Type, resource.Resource)
+		if err != nil {
+			return nil, err
+		}
+		// 与发布一致，先解密敏感字段再替换变量、校验
+		payload, err = sensitive.Decrypt(payload)
+		if err != nil {
+			return nil, err
+		}
+		payload, err = variablebiz.Resolve(payload, values)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s [id:%s]: %w", ErrResourceInvalid, resource.Type, resource.Resource.ID, err)
+		}
+		validator, ok := validators[resource.Type]
+		if !ok {
+			validator, err = publisher.NewETCDValidator(ctx, gateway, resource.Type)
+			if err != nil {
+				return nil, err
+			}
+			validators[resource.Type] = validator
+		}
+		if err := validator.Validate(payload); err != nil {
+			return nil, fmt.Errorf("%w: %s [id:%s]: %w", ErrResourceInvalid, resource.Type, resource.Resource.ID, err)
+		}
+		resource.Payload = payload
+		if !opts.Plaintext {
+			resource.Payload = sensitive.Mask(resource.Type, payload)
+		}
+		exported = append(exported, resource)
+	}
+	return exported, nil
+}
+
+// ToExportOutput 转换为与 etcd 资源导出相同的 json 格式，配置保留编辑区中的变量引用，可再次导入
+func ToExportOutput(ctx context.Context, resources []*ExportedResource) (dto.EtcdExportOutput, error) {
+	outputs := make(dto.EtcdExportOutput)
+	for _, resource := range resources {
+		outputs[resource.Type] = append(outputs[resource.Type], dto.ResourceInfo{
+			ResourceType: resource.Type,
+			ResourceID:   resource.Resource.ID,
+			Name:         resource.Resource.GetName(resource.Type),
+			Config:       json.RawMessage(sensitive.Mask(resource.Type, resource.Resource.Config)),
+		})
+	}
+	if err := unifyopbiz.AppendSchemaExportOutput(ctx, outputs); err != nil {
+		return nil, err
+	}
+	return outputs, nil
+}
+
+// ToStandaloneYAML 转换为 APISIX standalone 模式的 apisix.yaml，配置为发布到数据面的配置，
+// 需使用明文导出的资源，否则数据面拿到的是脱敏占位值
+func ToStandaloneYAML(resources []*ExportedResource) ([]byte, error) {
+	document := make(map[string][]json.RawMessage)
+	usernames := make(map[string]string)
+	var credentials []*ExportedResource
+	for _, resource := range resources {
+		switch resource.Type {
+		case constant.Credential:
+			// credential 需跟在所属 consumer 之后
+			credentials = append(credentials, resource)
+			continue
+		case constant.Consumer:
+			usernames[resource.Resource.ID] = resource.Resource.GetName(resource.Type)
+		case constant.Secret:
+			// standalone 模式下 secret 的 id 为 {manager}/{id}
+			payload, err := sjson.SetBytes(resource.Payload, "id", fmt.Sprintf(constant.SecretKeyFormat,
+				resource.Resource.GetSecretManager(), resource.Resource.ID))
+			if err != nil {
+				return nil, err
+			}
+			key := constant.StandaloneResourceKeyMap[resource.Type]
+			document[key] = append(document[key], payload)
+			continue
+		}
+		key := constant.StandaloneResourceKeyMap[resource.Type]
+		document[key] = append(document[key], resource.Payload)
+	}
+	for _, credential := range credentials {
+		username, ok := usernames[credential.Resource.GetConsumerID()]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s [id:%s]: 所属的 consumer 未导出",
+				ErrResourceInvalid, credential.Type, credential.Resource.ID)
+		}
+		// standalone 模式下 credential 的 id 为 {username}/credentials/{id}
+		payload, err := sjson.SetBytes(credential.Payload, "id",
+			fmt.Sprintf(constant.CredentialKeyFormat, username, credential.Resource.ID))
+		if err != nil {
+			return nil, err
+		}
+		key := constant.StandaloneResourceKeyMap[credential.Type]
+		document[key] = append(document[key], payload)
+	}
+	jsonData, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	yamlData, err := yaml.JSONToYAML(jsonData)
+	if err != nil {
+		return nil, fmt.Errorf("转换为 yaml 失败: %w", err)
+	}
+	return append(yamlData, []byte(standaloneEndMarker)...), nil
+}
+
+// loadResources 查询编辑区中指定状态的资源
+//
+// 草稿状态为编辑区当前的配置，不包含待删除的资源；已发布状态不包含未发布的新资源，
+// 待更新、待删除的资源使用同步自 etcd 的已发布配置
+func loadResources(
+	ctx context.Context,
+	state constant.ExportState,
+) (map[constant.APISIXResource]map[string]*model.ResourceCommonModel, error) {
+	var syncedConfigs map[string]json.RawMessage
+	if state == constant.ExportStatePublished {
+		syncedItems, err := syncdatabiz.QuerySyncedItems(ctx, map[string]any{})
+		if err != nil {
+			return nil, err
+		}
+		syncedConfigs = make(map[string]json.RawMessage, len(syncedItems))
+		for _, item := range syncedItems {
+			syncedConfigs[item.GetResourceKey()] = json.RawMessage(item.Config)
+		}
+	}
+
+	allResources := make(map[constant.APISIXResource]map[string]*model.ResourceCommonModel)
+	for _, resourceType := range constant.ResourceTypeList {
+		resources, err := resourcebiz.BatchGetResources(ctx, resourceType, []string{})
+		if err != nil {
+			return nil, err
+		}
+		resourceMap := make(map[string]*model.ResourceCommonModel, len(resources))
+		for _, resource := range resources {
+			switch {
+			case state != constant.ExportStatePublished:
+				if resource.Status == constant.ResourceStatusDeleteDraft {
+					continue
+				}
+			case resource.Status == constant.ResourceStatusCreateDraft:
+				continue
+			case resource.Status != constant.ResourceStatusSuccess:
+				config, ok := syncedConfigs[resource.GetResourceKey(resourceType)]
+				if !ok {
+					logging.WarnFWithCtx(ctx, "published config of %s [id:%s] not found, skip export",
+						resourceType, resource.ID)
+					continue
+				}
+				resource.Config = []byte(config)
+			}
+			resourceMap[resource.ID] = resource
+		}
+		allResources[resourceType] = resourceMap
+	}
+	return allResources, nil
+}
+
+// selectResources 按类型及 label 选出资源，并补充被引用的资源，结果按资源类型及 ID 排序
+func selectResources(
+	allResources map[constant.APISIXResource]map[string]*model.ResourceCommonModel,
+	opts *dto.EditorExportOptions,
+) []*ExportedResource {
+	selected := make(map[string]*ExportedResource)
+	var queue []*ExportedResource
+	for resourceType, resources := range allResources {
+		if len(opts.ResourceTypes) > 0 && !slices.Contains(opts.ResourceTypes, resourceType) {
+			continue
+		}
+		for _, resource := range resources {
+			if !matchLabels(resource, opts.Labels) {
+				continue
+			}
+			exported := &ExportedResource{Type: resourceType, Resource: resource}
+			selected[resource.GetResourceKey(resourceType)] = exported
+			queue = append(queue, exported)
+		}
+	}
+	enqueue := func(resourceType constant.APISIXResource, id string) {
+		resource, ok := allResources[resourceType][id]
+		if id == "" || !ok {
+			return
+		}
+		key := resource.GetResourceKey(resourceType)
+		if _, ok := selected[key]; ok {
+			return
+		}
+		exported := &ExportedResource{Type: resourceType, Resource: resource}
+		selected[key] = exported
+		queue = append(queue, exported)
+	}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, field := range constant.ResourceDependencyFields {
+			enqueue(field.Type, gjson.GetBytes(current.Resource.Config, field.Path).String())
+		}
+		// 插件中通过 $secret:// 引用的 secret
+		refs, _ := resourcebiz.ParseSecretReferences(json.RawMessage(current.Resource.Config))
+		for _, ref := range refs {
+			enqueue(constant.Secret, ref.ID)
+		}
+	}
+
+	result := make([]*ExportedResource, 0, len(selected))
+	for _, resource := range selected {
+		result = append(result, resource)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		ti := slices.Index(constant.ResourceTypeList, result[i].Type)
+		tj := slices.Index(constant.ResourceTypeList, result[j].Type)
+		if ti != tj {
+			return ti < tj
+		}
+		return result[i].Resource.ID < result[j].Resource.ID
+	})
+	return result
+}
+
+// matchLabels 判断资源是否包含所有指定的 label，同一 label 指定多个值时满足其一即可
+func matchLabels(resource *model.ResourceCommonModel, labels map[string][]string) bool {
+	for key, values := range labels {
+		value := gjson.GetBytes(resource.Config, "labels."+gjson.Escape(key))
+		if !value.Exists() || !slices.Contains(values, value.String()) {
+			return false
+		}
+	}
+	return true
+}
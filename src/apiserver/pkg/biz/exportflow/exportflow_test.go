@@ -0,0 +1,231 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package exportflow
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"sigs.k8s.io/yaml"
+
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	variablebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/variable"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+func TestMain(m *testing.M) {
+	// init crypto
+	err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化embed数据库
+	util.InitEmbedDb()
+
+	os.Exit(m.Run())
+}
+
+// newTestGateway 创建网关：已发布的上游，引用该上游的路由草稿，以及另一个不同 label 的路由草稿
+func newTestGateway(t *testing.T) context.Context {
+	t.Helper()
+
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = strings.ToLower(t.Name())
+	if err := gatewaybiz.CreateGateway(context.Background(), gateway); err != nil {
+		t.Fatal(err)
+	}
+	ctx := ginx.SetGatewayInfoToContext(context.Background(), gateway)
+
+	upstream := data.Upstream1WithNoRelation(gateway, constant.ResourceStatusSuccess)
+	assert.NoError(t, resourcebiz.CreateUpstream(ctx, *upstream))
+	route := data.Route1WithNoRelationResource(gateway, constant.ResourceStatusCreateDraft)
+	route.UpstreamID = upstream.ID
+	route.Config, _ = sjson.DeleteBytes(route.Config, "upstream")
+	assert.NoError(t, resourcebiz.CreateRoute(ctx, *route))
+	other := data.Route2WithNoRelationResource(gateway, constant.ResourceStatusSuccess)
+	other.Config, _ = sjson.SetBytes(other.Config, "labels", map[string]string{"env": "prod"})
+	assert.NoError(t, resourcebiz.CreateRoute(ctx, *other))
+	return ctx
+}
+
+func TestExportEditorResources(t *testing.T) {
+	ctx := newTestGateway(t)
+
+	resources, err := ExportEditorResources(ctx, &dto.EditorExportOptions{
+		State:  constant.ExportStateDraft,
+		Labels: map[string][]string{"env": {"4"}},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resources, 2)
+	assert.Equal(t, constant.Route, resources[0].Type)
+	assert.Equal(t, "route1", gjson.GetBytes(resources[0].Payload, "name").String())
+	assert.Equal(t, constant.Upstream, resources[1].Type)
+	assert.Equal(t, resources[1].Resource.ID, gjson.GetBytes(resources[1].Payload, "id").String())
+
+	outputs, err := ToExportOutput(ctx, resources)
+	assert.NoError(t, err)
+	assert.Len(t, outputs[constant.Route], 1)
+	assert.Len(t, outputs[constant.Upstream], 1)
+
+	standalone, err := ToStandaloneYAML(resources)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasSuffix(string(standalone), "\n#END\n"))
+	var document map[string][]map[string]any
+	assert.NoError(t, yaml.Unmarshal(standalone, &document))
+	assert.Len(t, document["routes"], 1)
+	assert.Equal(t, resources[1].Resource.ID, document["routes"][0]["upstream_id"])
+	assert.Len(t, document["upstreams"], 1)
+
+	// 已发布状态不包含未发布的路由
+	resources, err = ExportEditorResources(ctx, &dto.EditorExportOptions{
+		State:         constant.ExportStatePublished,
+		ResourceTypes: []constant.APISIXResource{constant.Route},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resources, 1)
+	assert.Equal(t, "route2", resources[0].Resource.GetName(constant.Route))
+}
+
+func TestExportEditorResourcesInvalid(t *testing.T) {
+	ctx := newTestGateway(t)
+	gateway := ginx.GetGatewayInfoFromContext(ctx)
+
+	route := data.Route1WithNoRelationResource(gateway, constant.ResourceStatusCreateDraft)
+	route.ID = "invalid-route"
+	route.Name = "invalid-route"
+	route.Config, _ = sjson.SetBytes(route.Config, "name", route.Name)
+	route.Config, _ = sjson.SetBytes(route.Config, "priority", "${PRIORITY}")
+	assert.NoError(t, resourcebiz.CreateRoute(ctx, *route))
+
+	_, err := ExportEditorResources(ctx, &dto.EditorExportOptions{State: constant.ExportStateDraft})
+	assert.ErrorIs(t, err, ErrResourceInvalid)
+	assert.Contains(t, err.Error(), "PRIORITY")
+}
+
+func TestExportEditorResourcesSecretVariable(t *testing.T) {
+	ctx := newTestGateway(t)
+	gateway := ginx.GetGatewayInfoFromContext(ctx)
+	assert.NoError(t, variablebiz.CreateVariable(ctx, &model.GatewayVariable{
+		GatewayID: gateway.ID, Name: "ROUTE_ENV", Value: "prod",
+	}))
+	assert.NoError(t, variablebiz.CreateVariable(ctx, &model.GatewayVariable{
+		GatewayID: gateway.ID, Name: "ROUTE_TOKEN", Value: "s3cret", Secret: true,
+	}))
+
+	route := data.Route1WithNoRelationResource(gateway, constant.ResourceStatusCreateDraft)
+	route.ID = "variable-route"
+	route.Name = "variable-route"
+	route.Config, _ = sjson.SetBytes(route.Config, "name", route.Name)
+	route.Config, _ = sjson.SetBytes(route.Config, "desc", "${ROUTE_ENV}-${ROUTE_TOKEN}")
+	assert.NoError(t, resourcebiz.CreateRoute(ctx, *route))
+
+	resources, err := ExportEditorResources(ctx, &dto.EditorExportOptions{
+		State:         constant.ExportStateDraft,
+		ResourceTypes: []constant.APISIXResource{constant.Route},
+	})
+	assert.NoError(t, err)
+	for _, resource := range resources {
+		if resource.Resource.ID == route.ID {
+			// 敏感变量不解密，保留引用
+			assert.Equal(t, "prod-${ROUTE_TOKEN}", gjson.GetBytes(resource.Payload, "desc").String())
+		}
+		assert.NotContains(t, string(resource.Payload), "s3cret")
+	}
+}
+
+func TestExportEditorResourcesCredential(t *testing.T) {
+	ctx := newTestGateway(t)
+	gateway := ginx.GetGatewayInfoFromContext(ctx)
+
+	consumer := data.Consumer1WithNoRelation(gateway, constant.ResourceStatusSuccess)
+	consumer.Config, _ = sjson.DeleteBytes(consumer.Config, "plugins.limit-count")
+	assert.NoError(t, resourcebiz.CreateConsumer(ctx, *consumer))
+	credential := data.Credential1(gateway, consumer.ID, constant.ResourceStatusCreateDraft)
+	assert.NoError(t, resourcebiz.CreateCredential(ctx, *credential))
+
+	resources, err := ExportEditorResources(ctx, &dto.EditorExportOptions{
+		State:         constant.ExportStateDraft,
+		ResourceTypes: []constant.APISIXResource{constant.Credential},
+	})
+	assert.NoError(t, err)
+	// 被引用的 consumer 会一并导出
+	assert.Len(t, resources, 2)
+	assert.False(t, gjson.GetBytes(resources[0].Payload, "consumer_id").Exists())
+	assert.False(t, gjson.GetBytes(resources[0].Payload, "name").Exists())
+
+	standalone, err := ToStandaloneYAML(resources)
+	assert.NoError(t, err)
+	var document map[string][]map[string]any
+	assert.NoError(t, yaml.Unmarshal(standalone, &document))
+	assert.Len(t, document["consumers"], 2)
+	assert.Equal(t, "consumer1", document["consumers"][0]["username"])
+	assert.Equal(t, "consumer1/credentials/"+credential.ID, document["consumers"][1]["id"])
+	// 非明文导出的 standalone 配置为脱敏占位值
+	assert.NotContains(t, string(standalone), "credential-key-1")
+
+	// 未导出所属 consumer 时无法生成 standalone 配置
+	_, err = ToStandaloneYAML(resources[:1])
+	assert.ErrorIs(t, err, ErrResourceInvalid)
+}
+
+func TestExportEditorResourcesPlaintext(t *testing.T) {
+	ctx := newTestGateway(t)
+	gateway := ginx.GetGatewayInfoFromContext(ctx)
+	assert.NoError(t, variablebiz.CreateVariable(ctx, &model.GatewayVariable{
+		GatewayID: gateway.ID, Name: "CONSUMER_DESC", Value: "s3cret", Secret: true,
+	}))
+
+	consumer := data.Consumer1WithNoRelation(gateway, constant.ResourceStatusSuccess)
+	consumer.Config, _ = sjson.DeleteBytes(consumer.Config, "plugins.limit-count")
+	consumer.Config, _ = sjson.SetBytes(consumer.Config, "desc", "${CONSUMER_DESC}")
+	assert.NoError(t, resourcebiz.CreateConsumer(ctx, *consumer))
+	credential := data.Credential1(gateway, consumer.ID, constant.ResourceStatusCreateDraft)
+	assert.NoError(t, resourcebiz.CreateCredential(ctx, *credential))
+	// 敏感字段加密存储
+	stored, err := resourcebiz.GetResourceByID(ctx, constant.Credential, credential.ID)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(stored.Config), "credential-key-1")
+
+	resources, err := ExportEditorResources(ctx, &dto.EditorExportOptions{
+		State:         constant.ExportStateDraft,
+		ResourceTypes: []constant.APISIXResource{constant.Credential},
+		Plaintext:     true,
+	})
+	assert.NoError(t, err)
+	standalone, err := ToStandaloneYAML(resources)
+	assert.NoError(t, err)
+	var document map[string][]map[string]any
+	assert.NoError(t, yaml.Unmarshal(standalone, &document))
+	assert.Len(t, document["consumers"], 2)
+	assert.Equal(t, "s3cret", document["consumers"][0]["desc"])
+	plugins, _ := document["consumers"][1]["plugins"].(map[string]any)
+	assert.Equal(t, map[string]any{"key": "credential-key-1"}, plugins["key-auth"])
+}
@@ -0,0 +1,378 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package exportflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+	"sigs.k8s.io/yaml"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+const (
+	openAPIVersion         = "3.0.3"
+	openAPIDocumentVersion = "1.0.0"
+	// openAPIWildcardParam APISIX uri 中未命名的 * 对应的路径参数名
+	openAPIWildcardParam = "path"
+	// keyAuthDefaultHeader key-auth 插件默认读取的请求头
+	keyAuthDefaultHeader = "apikey"
+)
+
+// openAPIMethods OpenAPI 支持的 http 方法，路由未指定 methods 时生成全部方法
+var openAPIMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS", "TRACE"}
+
+// OpenAPIDocument 生成的 OpenAPI 3 文档
+type OpenAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       OpenAPIInfo                             `json:"info"`
+	Servers    []*OpenAPIServer                        `json:"servers,omitempty"`
+	Paths      map[string]map[string]*OpenAPIOperation `json:"paths"`
+	Components *OpenAPIComponents                      `json:"components,omitempty"`
+}
+
+// OpenAPIInfo ...
+type OpenAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// OpenAPIServer ...
+type OpenAPIServer struct {
+	URL       string                            `json:"url"`
+	Variables map[string]*OpenAPIServerVariable `json:"variables,omitempty"`
+}
+
+// OpenAPIServerVariable ...
+type OpenAPIServerVariable struct {
+	Default string   `json:"default"`
+	Enum    []string `json:"enum,omitempty"`
+}
+
+// OpenAPIOperation 路由的一个 uri + method 对应的接口
+type OpenAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Summary     string                      `json:"summary,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Tags        []string                    `json:"tags,omitempty"`
+	Parameters  []*OpenAPIParameter         `json:"parameters,omitempty"`
+	Responses   map[string]*OpenAPIResponse `json:"responses"`
+	Security    []map[string][]string       `json:"security,omitempty"`
+	Servers     []*OpenAPIServer            `json:"servers,omitempty"`
+	RouteID     string                      `json:"x-apisix-route-id"`
+}
+
+// OpenAPIParameter ...
+type OpenAPIParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required"`
+	Schema   map[string]any `json:"schema"`
+}
+
+// OpenAPIResponse ...
+type OpenAPIResponse struct {
+	Description string `json:"description"`
+}
+
+// OpenAPIComponents ...
+type OpenAPIComponents struct {
+	SecuritySchemes map[string]*OpenAPISecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// OpenAPISecurityScheme ...
+type OpenAPISecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	In           string `json:"in,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// GenerateOpenAPI 将 ctx 中网关的路由生成 OpenAPI 3 文档
+//
+// 每个路由的 uri/uris 与 methods 组合生成接口，hosts 作为 servers，desc 与 labels 作为接口说明及 tags；
+// 路由、绑定的服务及插件组中的 key-auth/jwt-auth/basic-auth 插件生成对应的 security scheme。
+// OpenAPI 无法表达 vars 等其他匹配条件，uri 与 method 相同的接口仅保留路由 ID 最小的一个。
+func GenerateOpenAPI(ctx context.Context, opts *dto.OpenAPIGenerateOptions) (*OpenAPIDocument, error) {
+	gateway := ginx.GetGatewayInfoFromContext(ctx)
+	if gateway == nil {
+		return nil, ErrGatewayNotInContext
+	}
+	allResources, err := loadResources(ctx, opts.State)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]*model.ResourceCommonModel, 0, len(allResources[constant.Route]))
+	for _, route := range allResources[constant.Route] {
+		if opts.ServiceID != "" && gjson.GetBytes(route.Config, "service_id").String() != opts.ServiceID {
+			continue
+		}
+		if !matchLabels(route, opts.Labels) {
+			continue
+		}
+		routes = append(routes, route)
+	}
+	sort.Slice(routes, func(i, j int) bool { return routes[i].ID < routes[j].ID })
+
+	doc := &OpenAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info: OpenAPIInfo{
+			Title:       gateway.Name,
+			Description: gateway.Desc,
+			Version:     openAPIDocumentVersion,
+		},
+		Paths: make(map[string]map[string]*OpenAPIOperation),
+	}
+	securitySchemes := make(map[string]*OpenAPISecurityScheme)
+	var allHosts []string
+	for _, route := range routes {
+		config := gjson.ParseBytes(route.Config)
+		hosts := routeStrings(config, "host", "hosts")
+		allHosts = append(allHosts, hosts...)
+		security := routeSecurity(allResources, config, securitySchemes)
+		operations := buildRouteOperations(route, config, hosts, security)
+		for path, methodOperations := range operations {
+			if doc.Paths[path] == nil {
+				doc.Paths[path] = make(map[string]*OpenAPIOperation)
+			}
+			for method, operation := range methodOperations {
+				if existing, ok := doc.Paths[path][method]; ok {
+					logging.WarnFWithCtx(ctx,
+						"openapi operation %s %s of route [id:%s] conflicts with route [id:%s], skip",
+						method, path, route.ID, existing.RouteID)
+					continue
+				}
+				doc.Paths[path][method] = operation
+			}
+		}
+	}
+	doc.Servers = buildServers(allHosts)
+	if len(securitySchemes) > 0 {
+		doc.Components = &OpenAPIComponents{SecuritySchemes: securitySchemes}
+	}
+	return doc, nil
+}
+
+// buildRouteOperations 按路由的 uri/uris 与 methods 生成接口，返回 path -> method -> 接口
+func buildRouteOperations(
+	route *model.ResourceCommonModel,
+	config gjson.Result,
+	hosts []string,
+	security []map[string][]string,
+) map[string]map[string]*OpenAPIOperation {
+	uris := routeStrings(config, "uri", "uris")
+	methods := routeStrings(config, "methods")
+	if len(methods) == 0 {
+		methods = openAPIMethods
+	}
+	name := config.Get("name").String()
+	if name == "" {
+		name = route.ID
+	}
+	var tags []string
+	config.Get("labels").ForEach(func(key, value gjson.Result) bool {
+		tags = append(tags, key.String()+":"+value.String())
+		return true
+	})
+	sort.Strings(tags)
+
+	servers := buildServers(hosts)
+	single := len(uris)*len(methods) == 1
+	operations := make(map[string]map[string]*OpenAPIOperation)
+	for i, uri := range uris {
+		path, params := convertAPISIXURI(uri)
+		for _, method := range methods {
+			if !slices.Contains(openAPIMethods, method) {
+				continue
+			}
+			operationID := name
+			if !single {
+				operationID = fmt.Sprintf("%s_%s", name, strings.ToLower(method))
+				if i > 0 {
+					operationID = fmt.Sprintf("%s_%d", operationID, i)
+				}
+			}
+			if operations[path] == nil {
+				operations[path] = make(map[string]*OpenAPIOperation)
+			}
+			operations[path][strings.ToLower(method)] = &OpenAPIOperation{
+				OperationID: operationID,
+				Summary:     name,
+				Description: config.Get("desc").String(),
+				Tags:        tags,
+				Parameters:  params,
+				Responses:   map[string]*OpenAPIResponse{"default": {Description: "response from upstream"}},
+				Security:    security,
+				Servers:     servers,
+				RouteID:     route.ID,
+			}
+		}
+	}
+	return operations
+}
+
+// convertAPISIXURI 将 APISIX 的 uri 转换为 OpenAPI 的 path 模板及路径参数
+//
+// :name 与 *name 转换为 {name}，未命名的 * 转换为 {path}
+func convertAPISIXURI(uri string) (string, []*OpenAPIParameter) {
+	segments := strings.Split(uri, "/")
+	var params []*OpenAPIParameter
+	for i, segment := range segments {
+		var name string
+		switch {
+		case strings.HasPrefix(segment, ":") && len(segment) > 1:
+			name = segment[1:]
+			segments[i] = "{" + name + "}"
+		case strings.Contains(segment, "*"):
+			prefix, suffix, _ := strings.Cut(segment, "*")
+			name = suffix
+			if name == "" {
+				name = openAPIWildcardParam
+			}
+			segments[i] = prefix + "{" + name + "}"
+		default:
+			continue
+		}
+		params = append(params, &OpenAPIParameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   map[string]any{"type": "string"},
+		})
+	}
+	return strings.Join(segments, "/"), params
+}
+
+// routeSecurity 从路由、绑定的服务及插件组中的认证插件推断 security，并登记到 schemes 中
+//
+// 多个认证插件之间为或的关系，每个插件对应一个 security requirement
+func routeSecurity(
+	allResources map[constant.APISIXResource]map[string]*model.ResourceCommonModel,
+	config gjson.Result,
+	schemes map[string]*OpenAPISecurityScheme,
+) []map[string][]string {
+	pluginSources := []gjson.Result{config.Get("plugins")}
+	if service, ok := allResources[constant.Service][config.Get("service_id").String()]; ok {
+		pluginSources = append(pluginSources, gjson.GetBytes(service.Config, "plugins"))
+	}
+	if pluginConfig, ok := allResources[constant.PluginConfig][config.Get("plugin_config_id").String()]; ok {
+		pluginSources = append(pluginSources, gjson.GetBytes(pluginConfig.Config, "plugins"))
+	}
+
+	found := make(map[string]struct{})
+	for _, plugins := range pluginSources {
+		plugins.ForEach(func(pluginName, pluginConfig gjson.Result) bool {
+			if pluginConfig.Get("_meta.disable").Bool() {
+				return true
+			}
+			name, scheme := authSecurityScheme(pluginName.String(), pluginConfig)
+			if scheme == nil {
+				return true
+			}
+			schemes[name] = scheme
+			found[name] = struct{}{}
+			return true
+		})
+	}
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	security := make([]map[string][]string, 0, len(names))
+	for _, name := range names {
+		security = append(security, map[string][]string{name: {}})
+	}
+	return security
+}
+
+// authSecurityScheme 返回认证插件对应的 security scheme 名称及定义，非认证插件返回 nil
+func authSecurityScheme(pluginName string, pluginConfig gjson.Result) (string, *OpenAPISecurityScheme) {
+	switch pluginName {
+	case "key-auth":
+		header := pluginConfig.Get("header").String()
+		if header == "" || header == keyAuthDefaultHeader {
+			return pluginName, &OpenAPISecurityScheme{Type: "apiKey", In: "header", Name: keyAuthDefaultHeader}
+		}
+		return pluginName + "-" + header, &OpenAPISecurityScheme{Type: "apiKey", In: "header", Name: header}
+	case "jwt-auth":
+		return pluginName, &OpenAPISecurityScheme{Type: "http", Scheme: "bearer", BearerFormat: "JWT"}
+	case "basic-auth":
+		return pluginName, &OpenAPISecurityScheme{Type: "http", Scheme: "basic"}
+	}
+	return "", nil
+}
+
+// buildServers 将 hosts 转换为 servers，scheme 作为 server 变量
+func buildServers(hosts []string) []*OpenAPIServer {
+	sortedHosts := lo.Uniq(hosts)
+	sort.Strings(sortedHosts)
+	var servers []*OpenAPIServer
+	for _, host := range sortedHosts {
+		servers = append(servers, &OpenAPIServer{
+			URL: "{scheme}://" + host,
+			Variables: map[string]*OpenAPIServerVariable{
+				"scheme": {Default: "http", Enum: []string{"http", "https"}},
+			},
+		})
+	}
+	return servers
+}
+
+// routeStrings 读取路由中单值字段及对应的数组字段，例如 uri/uris、host/hosts
+func routeStrings(config gjson.Result, keys ...string) []string {
+	var values []string
+	for _, key := range keys {
+		value := config.Get(key)
+		if value.IsArray() {
+			for _, item := range value.Array() {
+				values = append(values, item.String())
+			}
+		} else if value.String() != "" {
+			values = append(values, value.String())
+		}
+	}
+	return values
+}
+
+// MarshalOpenAPI 将文档编码为 json 或 yaml
+func MarshalOpenAPI(doc *OpenAPIDocument, format constant.OpenAPIFormat) ([]byte, error) {
+	jsonData, err := json.MarshalIndent(doc, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	if format != constant.OpenAPIFormatYAML {
+		return jsonData, nil
+	}
+	return yaml.JSONToYAML(jsonData)
+}
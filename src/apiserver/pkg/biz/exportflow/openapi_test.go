@@ -0,0 +1,147 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package exportflow
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"gorm.io/datatypes"
+	"sigs.k8s.io/yaml"
+
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+// newOpenAPITestGateway 创建网关：启用 jwt-auth 的服务，绑定该服务的路由，以及一个独立的路由
+func newOpenAPITestGateway(t *testing.T) context.Context {
+	t.Helper()
+
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = strings.ToLower(t.Name())
+	gateway.Desc = "openapi test"
+	if err := gatewaybiz.CreateGateway(context.Background(), gateway); err != nil {
+		t.Fatal(err)
+	}
+	ctx := ginx.SetGatewayInfoToContext(context.Background(), gateway)
+
+	assert.NoError(t, resourcebiz.CreateService(ctx, model.Service{
+		Name: gateway.Name + "-svc",
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID:        gateway.Name + "-svc",
+			GatewayID: gateway.ID,
+			Config:    datatypes.JSON(`{"name":"` + gateway.Name + `-svc","plugins":{"jwt-auth":{}}}`),
+			Status:    constant.ResourceStatusCreateDraft,
+		},
+	}))
+	routes := map[string]string{
+		"r1": `{"uri":"/users/:id","methods":["GET"],"hosts":["api.example.com"],
+			"desc":"get a user","labels":{"team":"user"},"service_id":"` + gateway.Name + `-svc",
+			"plugins":{"key-auth":{"header":"X-Key"}}}`,
+		"r2": `{"uris":["/static/*","/files/*name"],"host":"cdn.example.com",
+			"upstream":{"type":"roundrobin","nodes":{"127.0.0.1:80":1}}}`,
+		"r3": `{"uri":"/users/:id","methods":["GET","PURGE"],
+			"upstream":{"type":"roundrobin","nodes":{"127.0.0.1:80":1}}}`,
+	}
+	for id, config := range routes {
+		assert.NoError(t, resourcebiz.CreateRoute(ctx, model.Route{
+			Name:      gateway.Name + "-" + id,
+			ServiceID: gjson.Get(config, "service_id").String(),
+			ResourceCommonModel: model.ResourceCommonModel{
+				ID:        gateway.Name + "-" + id,
+				GatewayID: gateway.ID,
+				Config:    datatypes.JSON(config),
+				Status:    constant.ResourceStatusCreateDraft,
+			},
+		}))
+	}
+	return ctx
+}
+
+func TestGenerateOpenAPI(t *testing.T) {
+	ctx := newOpenAPITestGateway(t)
+	gateway := ginx.GetGatewayInfoFromContext(ctx)
+
+	doc, err := GenerateOpenAPI(ctx, &dto.OpenAPIGenerateOptions{State: constant.ExportStateDraft})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "3.0.3", doc.OpenAPI)
+	assert.Equal(t, gateway.Name, doc.Info.Title)
+	assert.Equal(t, "openapi test", doc.Info.Description)
+	if assert.Len(t, doc.Servers, 2) {
+		assert.Equal(t, "{scheme}://api.example.com", doc.Servers[0].URL)
+		assert.Equal(t, "{scheme}://cdn.example.com", doc.Servers[1].URL)
+	}
+
+	getUser := doc.Paths["/users/{id}"]["get"]
+	if assert.NotNil(t, getUser) {
+		assert.Equal(t, gateway.Name+"-r1", getUser.OperationID)
+		assert.Equal(t, "get a user", getUser.Description)
+		assert.Equal(t, []string{"team:user"}, getUser.Tags)
+		assert.Equal(t, gateway.Name+"-r1", getUser.RouteID)
+		assert.Equal(t, "id", getUser.Parameters[0].Name)
+		assert.Equal(t, []map[string][]string{{"jwt-auth": {}}, {"key-auth-X-Key": {}}}, getUser.Security)
+		assert.Len(t, getUser.Servers, 1)
+	}
+	// uri 与 method 相同的路由仅保留一个，PURGE 不是 OpenAPI 支持的方法
+	assert.Len(t, doc.Paths["/users/{id}"], 1)
+
+	assert.Len(t, doc.Paths["/static/{path}"], len(openAPIMethods))
+	static := doc.Paths["/files/{name}"]["post"]
+	if assert.NotNil(t, static) {
+		assert.Equal(t, gateway.Name+"-r2_post_1", static.OperationID)
+		assert.Empty(t, static.Security)
+	}
+
+	assert.Equal(t, "http", doc.Components.SecuritySchemes["jwt-auth"].Type)
+	assert.Equal(t, "bearer", doc.Components.SecuritySchemes["jwt-auth"].Scheme)
+	assert.Equal(t, "X-Key", doc.Components.SecuritySchemes["key-auth-X-Key"].Name)
+
+	yamlData, err := MarshalOpenAPI(doc, constant.OpenAPIFormatYAML)
+	assert.NoError(t, err)
+	var decoded map[string]any
+	assert.NoError(t, yaml.Unmarshal(yamlData, &decoded))
+	assert.Equal(t, "3.0.3", decoded["openapi"])
+
+	// 按服务过滤
+	doc, err = GenerateOpenAPI(ctx, &dto.OpenAPIGenerateOptions{
+		State:     constant.ExportStateDraft,
+		ServiceID: gateway.Name + "-svc",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, doc.Paths, 1)
+
+	// 按 label 过滤
+	doc, err = GenerateOpenAPI(ctx, &dto.OpenAPIGenerateOptions{
+		State:  constant.ExportStateDraft,
+		Labels: map[string][]string{"team": {"order"}},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, doc.Paths)
+	assert.Nil(t, doc.Components)
+}
@@ -0,0 +1,558 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package exportflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	variablebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/variable"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+)
+
+// RouteMatchResult 路由匹配模拟结果
+type RouteMatchResult struct {
+	Matched        *RouteMatchCandidate   `json:"matched"`            // 命中的路由，未命中时为空
+	Candidates     []*RouteMatchCandidate `json:"candidates"`         // uri 匹配请求的其他路由，按匹配顺序排列
+	UnmatchedCount int                    `json:"unmatched_count"`    // uri 不匹配请求的路由数量
+	Warnings       []string               `json:"warnings,omitempty"` // 无法离线模拟的匹配条件等提示
+}
+
+// RouteMatchCandidate uri 匹配请求的路由
+type RouteMatchCandidate struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	URI      string   `json:"uri"`               // 匹配请求路径的 uri
+	Host     string   `json:"host,omitempty"`    // 匹配请求 host 的 host，为空表示未限制 host
+	Priority int      `json:"priority"`          // 路由优先级
+	Order    int      `json:"order"`             // 在 APISIX 中的匹配顺序，从 1 开始
+	Reasons  []string `json:"reasons,omitempty"` // 未命中的原因
+}
+
+// routeCandidate 匹配过程中的路由
+type routeCandidate struct {
+	*RouteMatchCandidate
+	hostRank  int  // 匹配的 host 的精确程度，0 表示未限制 host，-1 表示 host 不匹配
+	exact     bool // uri 是否精确匹配
+	prefixLen int  // 前缀匹配时 uri 中 * 或 : 之前的静态前缀长度
+	failures  []string
+}
+
+// routeMatchRequest 解析后的模拟请求
+type routeMatchRequest struct {
+	method     string
+	host       string
+	path       string
+	query      url.Values
+	headers    map[string]string // key 为转换为小写且 - 替换为 _ 的请求头名，与 APISIX 的 http_* 变量一致
+	remoteAddr string
+	remoteIP   net.IP
+}
+
+// SimulateRouteMatch 离线模拟请求在 ctx 中网关的路由中的匹配结果
+//
+// 按 APISIX 默认的 radixtree_host_uri 规则：先匹配配置了 hosts（未配置时使用绑定服务的 hosts）的路由，
+// host 越精确越优先，再匹配未限制 host 的路由；同一 host 下精确 uri 优先于前缀 uri，前缀越长越优先，
+// 最后按 priority 从高到低，依次校验 methods、remote_addrs 及 vars，第一个全部满足的路由命中。
+// filter_func、script 无法离线执行，视为满足并在 warnings 中提示。
+func SimulateRouteMatch(ctx context.Context, opts *dto.RouteMatchOptions) (*RouteMatchResult, error) {
+	gateway := ginx.GetGatewayInfoFromContext(ctx)
+	if gateway == nil {
+		return nil, ErrGatewayNotInContext
+	}
+	req, err := newRouteMatchRequest(opts)
+	if err != nil {
+		return nil, err
+	}
+	allResources, err := loadResources(ctx, opts.State)
+	if err != nil {
+		return nil, err
+	}
+	// 匹配结果对只读成员可见，敏感变量不解密，保留引用
+	values, err := variablebiz.GetDisplayVariableValues(ctx, gateway.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RouteMatchResult{Candidates: []*RouteMatchCandidate{}}
+	var candidates []*routeCandidate
+	for _, route := range allResources[constant.Route] {
+		// 与发布一致，先替换网关变量
+		config, err := variablebiz.Resolve(json.RawMessage(route.Config), values)
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("路由 %s: %s", route.ID, err.Error()))
+			config = json.RawMessage(route.Config)
+		}
+		candidate := evaluateRoute(req, route.ID, gjson.ParseBytes(config), allResources[constant.Service], result)
+		if candidate == nil {
+			result.UnmatchedCount++
+			continue
+		}
+		candidate.Name = route.GetName(constant.Route)
+		candidates = append(candidates, candidate)
+	}
+	// 顺序不确定的路由按 id 排序，保证结果稳定
+	sort.Slice(candidates, func(i, j int) bool {
+		if c := compareCandidates(candidates[i], candidates[j]); c != 0 {
+			return c < 0
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+
+	var winner *routeCandidate
+	for i, candidate := range candidates {
+		candidate.Order = i + 1
+		switch {
+		case len(candidate.failures) > 0:
+			candidate.Reasons = candidate.failures
+		case winner == nil:
+			winner = candidate
+			result.Matched = candidate.RouteMatchCandidate
+			continue
+		default:
+			reason := shadowedReason(winner, candidate)
+			candidate.Reasons = []string{reason}
+			if compareCandidates(winner, candidate) == 0 {
+				result.Warnings = append(result.Warnings, reason)
+			}
+		}
+		result.Candidates = append(result.Candidates, candidate.RouteMatchCandidate)
+	}
+	return result, nil
+}
+
+// newRouteMatchRequest 校验并解析模拟请求
+func newRouteMatchRequest(opts *dto.RouteMatchOptions) (*routeMatchRequest, error) {
+	req := &routeMatchRequest{
+		method:  strings.ToUpper(opts.Method),
+		headers: make(map[string]string, len(opts.Headers)),
+	}
+	if req.method == "" {
+		req.method = "GET"
+	}
+	path, rawQuery, _ := strings.Cut(opts.Path, "?")
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("%w: path 必须以 / 开头", ErrInvalidRouteMatchRequest)
+	}
+	req.path = path
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%w: query 不合法: %w", ErrInvalidRouteMatchRequest, err)
+	}
+	for key, value := range opts.Query {
+		query.Set(key, value)
+	}
+	req.query = query
+	for key, value := range opts.Headers {
+		req.headers[strings.ReplaceAll(strings.ToLower(key), "-", "_")] = value
+	}
+
+	// host 不包含端口，未指定时使用 Host 请求头
+	host := opts.Host
+	if host == "" {
+		host = req.headers["host"]
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	req.host = strings.ToLower(host)
+
+	if opts.RemoteAddr != "" {
+		addr := opts.RemoteAddr
+		if h, _, err := net.SplitHostPort(addr); err == nil {
+			addr = h
+		}
+		req.remoteIP = net.ParseIP(addr)
+		if req.remoteIP == nil {
+			return nil, fmt.Errorf("%w: 客户端地址 %s 不合法", ErrInvalidRouteMatchRequest, opts.RemoteAddr)
+		}
+		req.remoteAddr = addr
+	}
+	return req, nil
+}
+
+// evaluateRoute 校验路由的各项匹配条件，uri 不匹配时返回 nil
+func evaluateRoute(
+	req *routeMatchRequest,
+	id string,
+	config gjson.Result,
+	services map[string]*model.ResourceCommonModel,
+	result *RouteMatchResult,
+) *routeCandidate {
+	uri, exact, prefixLen, ok := matchURI(routeStrings(config, "uri", "uris"), req.path)
+	if !ok {
+		return nil
+	}
+	candidate := &routeCandidate{
+		RouteMatchCandidate: &RouteMatchCandidate{
+			ID:       id,
+			URI:      uri,
+			Priority: int(config.Get("priority").Int()),
+		},
+		exact:     exact,
+		prefixLen: prefixLen,
+	}
+	if status := config.Get("status"); status.Exists() && status.Int() == 0 {
+		candidate.failures = append(candidate.failures, "路由已禁用")
+	}
+
+	// 路由未配置 hosts 时使用绑定服务的 hosts
+	hosts := routeStrings(config, "host", "hosts")
+	if serviceID := config.Get("service_id").String(); len(hosts) == 0 && serviceID != "" {
+		if service, ok := services[serviceID]; ok {
+			hosts = routeStrings(gjson.ParseBytes(service.Config), "hosts")
+		}
+	}
+	if len(hosts) > 0 {
+		candidate.Host, candidate.hostRank = matchHost(hosts, req.host)
+		if candidate.hostRank < 0 {
+			candidate.failures = append(candidate.failures,
+				fmt.Sprintf("host %q 不匹配 hosts %v", req.host, hosts))
+		}
+	}
+
+	if methods := routeStrings(config, "methods"); len(methods) > 0 && !slices.Contains(methods, req.method) {
+		candidate.failures = append(candidate.failures,
+			fmt.Sprintf("method %s 不在 methods %v 中", req.method, methods))
+	}
+
+	if remoteAddrs := routeStrings(config, "remote_addr", "remote_addrs"); len(remoteAddrs) > 0 {
+		if failure := matchRemoteAddrs(remoteAddrs, req); failure != "" {
+			candidate.failures = append(candidate.failures, failure)
+		}
+	}
+
+	if vars := config.Get("vars"); vars.Exists() {
+		candidate.failures = append(candidate.failures, matchVars(vars.Raw, req, result)...)
+	}
+
+	for _, key := range []string{"filter_func", "script", "script_id"} {
+		if config.Get(key).Exists() {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("路由 %s 的 %s 无法离线判断，视为匹配", id, key))
+		}
+	}
+	return candidate
+}
+
+// compareCandidates 比较两个路由的匹配顺序，小于 0 表示 a 先匹配，等于 0 表示顺序不确定
+func compareCandidates(a, b *routeCandidate) int {
+	switch {
+	case a.hostRank != b.hostRank:
+		return b.hostRank - a.hostRank
+	case a.exact != b.exact:
+		if a.exact {
+			return -1
+		}
+		return 1
+	case a.prefixLen != b.prefixLen:
+		return b.prefixLen - a.prefixLen
+	default:
+		return b.Priority - a.Priority
+	}
+}
+
+// shadowedReason 满足所有条件但排在命中路由之后的原因
+func shadowedReason(winner, candidate *routeCandidate) string {
+	switch {
+	case winner.hostRank != candidate.hostRank && candidate.hostRank == 0:
+		return fmt.Sprintf("路由 %s 的 host %s 匹配，先于未配置 hosts 的路由匹配",
+			winner.ID, winner.Host)
+	case winner.hostRank != candidate.hostRank:
+		return fmt.Sprintf("路由 %s 的 host %s 比 %s 更精确", winner.ID, winner.Host, candidate.Host)
+	case winner.exact != candidate.exact:
+		return fmt.Sprintf("路由 %s 的 uri %s 为精确 uri，先于前缀 uri 匹配", winner.ID, winner.URI)
+	case winner.prefixLen != candidate.prefixLen:
+		return fmt.Sprintf("路由 %s 的 uri 前缀 %s 比 %s 更长", winner.ID, winner.URI, candidate.URI)
+	case winner.Priority != candidate.Priority:
+		return fmt.Sprintf("路由 %s 的优先级更高 (%d > %d)", winner.ID, winner.Priority, candidate.Priority)
+	default:
+		return fmt.Sprintf("路由 %s 的 host、uri 及优先级均相同，APISIX 不保证匹配的先后顺序", winner.ID)
+	}
+}
+
+// matchURI 返回 uris 中与路径匹配的最精确的 uri：精确匹配优先，其次为静态前缀最长的 uri
+//
+// 与 APISIX 的 radixtree 一致，不含 * 与 : 的 uri 为精确匹配，:name 匹配一段路径，* 或 *name 匹配剩余路径
+func matchURI(uris []string, path string) (matched string, exact bool, prefixLen int, ok bool) {
+	for _, uri := range uris {
+		index := strings.IndexAny(uri, "*:")
+		if index < 0 {
+			if uri == path {
+				return uri, true, len(uri), true
+			}
+			continue
+		}
+		if !uriPatternRegexp(uri).MatchString(path) {
+			continue
+		}
+		if !ok || index > prefixLen {
+			matched, prefixLen, ok = uri, index, true
+		}
+	}
+	return matched, false, prefixLen, ok
+}
+
+// uriPatternRegexp 将含 :name、* 的 uri 转换为正则
+func uriPatternRegexp(uri string) *regexp.Regexp {
+	var builder strings.Builder
+	builder.WriteString("^")
+	for i := 0; i < len(uri); i++ {
+		switch uri[i] {
+		case ':', '*':
+			pattern := "[^/]+"
+			if uri[i] == '*' {
+				pattern = ".*"
+			}
+			// 跳过参数名
+			for i+1 < len(uri) && uri[i+1] != '/' {
+				i++
+			}
+			builder.WriteString(pattern)
+		default:
+			builder.WriteString(regexp.QuoteMeta(string(uri[i])))
+		}
+	}
+	builder.WriteString("$")
+	return regexp.MustCompile(builder.String())
+}
+
+// matchHost 返回 hosts 中与请求 host 匹配的最精确的 host 及其精确程度，不匹配时精确程度为 -1
+//
+// 精确程度为匹配部分的长度：精确匹配为 host 的长度，*.example.com 为 .example.com 的长度
+func matchHost(hosts []string, host string) (matched string, rank int) {
+	rank = -1
+	if host == "" {
+		return "", rank
+	}
+	for _, pattern := range hosts {
+		pattern = strings.ToLower(pattern)
+		current := -1
+		switch {
+		case pattern == host:
+			current = len(host)
+		case strings.HasPrefix(pattern, "*"):
+			suffix := pattern[1:]
+			if len(host) > len(suffix) && strings.HasSuffix(host, suffix) {
+				current = len(suffix)
+			}
+		}
+		if current > rank {
+			matched, rank = pattern, current
+		}
+	}
+	return matched, rank
+}
+
+// matchRemoteAddrs 校验客户端地址，返回不匹配的原因
+func matchRemoteAddrs(remoteAddrs []string, req *routeMatchRequest) string {
+	if req.remoteIP == nil {
+		return fmt.Sprintf("路由配置了 remote_addrs %v，需要提供客户端地址", remoteAddrs)
+	}
+	for _, addr := range remoteAddrs {
+		if _, network, err := net.ParseCIDR(addr); err == nil {
+			if network.Contains(req.remoteIP) {
+				return ""
+			}
+			continue
+		}
+		if ip := net.ParseIP(addr); ip != nil && ip.Equal(req.remoteIP) {
+			return ""
+		}
+	}
+	return fmt.Sprintf("客户端地址 %s 不在 remote_addrs %v 中", req.remoteAddr, remoteAddrs)
+}
+
+// matchVars 按 lua-resty-expr 的规则校验 vars 表达式，返回不满足的表达式
+func matchVars(raw string, req *routeMatchRequest, result *RouteMatchResult) []string {
+	var vars []any
+	if err := json.Unmarshal([]byte(raw), &vars); err != nil {
+		return []string{fmt.Sprintf("vars 不合法: %s", err.Error())}
+	}
+	if err := schema.CheckVars(vars); err != nil {
+		return []string{fmt.Sprintf("vars 不合法: %s", err.Error())}
+	}
+	var failures []string
+	for _, item := range vars {
+		expr := item.([]any)
+		name := expr[0].(string)
+		negate := len(expr) == 4
+		op, value := expr[1].(string), expr[2]
+		if negate {
+			op, value = expr[2].(string), expr[3]
+		}
+		left, exists, supported := req.variable(name)
+		if !supported {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("变量 %s 无法离线判断，视为不存在", name))
+		}
+		matched, err := compareVar(left, exists, op, value)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("vars 表达式 %s: %s", formatVarExpr(expr), err.Error()))
+			continue
+		}
+		if matched == negate {
+			actual := "不存在"
+			if exists {
+				actual = "为 " + strconv.Quote(left)
+			}
+			failures = append(failures,
+				fmt.Sprintf("vars 表达式 %s 不满足，%s %s", formatVarExpr(expr), name, actual))
+		}
+	}
+	return failures
+}
+
+// formatVarExpr 将 vars 表达式编码为 json
+func formatVarExpr(expr []any) string {
+	data, _ := json.Marshal(expr)
+	return string(data)
+}
+
+// variable 获取 APISIX 变量的值，返回值、是否存在及是否支持离线模拟
+func (r *routeMatchRequest) variable(name string) (value string, exists bool, supported bool) {
+	switch {
+	case name == "uri":
+		return r.path, true, true
+	case name == "request_uri":
+		if len(r.query) == 0 {
+			return r.path, true, true
+		}
+		return r.path + "?" + r.query.Encode(), true, true
+	case name == "args" || name == "query_string":
+		return r.query.Encode(), len(r.query) > 0, true
+	case name == "host":
+		return r.host, r.host != "", true
+	case name == "request_method":
+		return r.method, true, true
+	case name == "remote_addr":
+		return r.remoteAddr, r.remoteAddr != "", true
+	case strings.HasPrefix(name, "arg_"):
+		values, ok := r.query[strings.TrimPrefix(name, "arg_")]
+		if !ok || len(values) == 0 {
+			return "", false, true
+		}
+		return values[0], true, true
+	case strings.HasPrefix(name, "http_"):
+		value, ok := r.headers[strings.TrimPrefix(name, "http_")]
+		return value, ok, true
+	case strings.HasPrefix(name, "cookie_"):
+		cookies, err := http.ParseCookie(r.headers["cookie"])
+		if err != nil {
+			return "", false, true
+		}
+		for _, cookie := range cookies {
+			if cookie.Name == strings.TrimPrefix(name, "cookie_") {
+				return cookie.Value, true, true
+			}
+		}
+		return "", false, true
+	default:
+		return "", false, false
+	}
+}
+
+// compareVar 按 lua-resty-expr 的规则比较变量值，变量不存在时等同于 nil
+func compareVar(left string, exists bool, op string, value any) (bool, error) {
+	switch op {
+	case "==":
+		return varEqual(left, exists, value), nil
+	case "~=":
+		return !varEqual(left, exists, value), nil
+	case ">", "<":
+		l, err := strconv.ParseFloat(left, 64)
+		if !exists || err != nil {
+			return false, nil
+		}
+		r, ok := toNumber(value)
+		if !ok {
+			return false, nil
+		}
+		if op == ">" {
+			return l > r, nil
+		}
+		return l < r, nil
+	case "~~", "~*":
+		pattern, ok := value.(string)
+		if !exists || !ok {
+			return false, nil
+		}
+		if op == "~*" {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, fmt.Errorf("正则表达式无法离线判断: %w", err)
+		}
+		return re.MatchString(left), nil
+	case "IN":
+		values, ok := value.([]any)
+		if !exists || !ok {
+			return false, nil
+		}
+		return slices.Contains(values, any(left)), nil
+	default:
+		// HAS 要求变量值为数组，模拟请求中的变量均为单个值
+		return false, nil
+	}
+}
+
+// varEqual lua-resty-expr 的 == ：右值为数字时将变量值转换为数字比较
+func varEqual(left string, exists bool, value any) bool {
+	if !exists {
+		return false
+	}
+	switch v := value.(type) {
+	case float64:
+		l, err := strconv.ParseFloat(left, 64)
+		return err == nil && l == v
+	case string:
+		return left == v
+	default:
+		return false
+	}
+}
+
+// toNumber lua 的 tonumber
+func toNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case string:
+		number, err := strconv.ParseFloat(v, 64)
+		return number, err == nil
+	default:
+		return 0, false
+	}
+}
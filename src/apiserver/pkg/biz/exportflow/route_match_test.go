@@ -0,0 +1,207 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package exportflow
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"gorm.io/datatypes"
+
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+// newRouteMatchTestGateway 创建网关及 routes 中的路由，路由 id 为 网关名-key，服务 svc 配置了 hosts
+func newRouteMatchTestGateway(t *testing.T, routes map[string]string) context.Context {
+	t.Helper()
+
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = strings.ToLower(t.Name())
+	if err := gatewaybiz.CreateGateway(context.Background(), gateway); err != nil {
+		t.Fatal(err)
+	}
+	ctx := ginx.SetGatewayInfoToContext(context.Background(), gateway)
+
+	assert.NoError(t, resourcebiz.CreateService(ctx, model.Service{
+		Name: "svc",
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID:        "svc-" + gateway.Name,
+			GatewayID: gateway.ID,
+			Config:    datatypes.JSON(`{"name":"svc","hosts":["svc.example.com"]}`),
+			Status:    constant.ResourceStatusCreateDraft,
+		},
+	}))
+	for id, config := range routes {
+		config = strings.ReplaceAll(config, "{svc}", "svc-"+gateway.Name)
+		config = strings.Replace(config, "{", `{"name":"`+id+`",`, 1)
+		assert.NoError(t, resourcebiz.CreateRoute(ctx, model.Route{
+			Name:      id,
+			ServiceID: gjson.Get(config, "service_id").String(),
+			ResourceCommonModel: model.ResourceCommonModel{
+				ID:        gateway.Name + "-" + id,
+				GatewayID: gateway.ID,
+				Config:    datatypes.JSON(config),
+				Status:    constant.ResourceStatusCreateDraft,
+			},
+		}))
+	}
+	return ctx
+}
+
+// candidateNames 按匹配顺序返回候选路由的名称
+func candidateNames(result *RouteMatchResult) []string {
+	names := make([]string, 0, len(result.Candidates))
+	for _, candidate := range result.Candidates {
+		names = append(names, candidate.Name)
+	}
+	return names
+}
+
+func TestSimulateRouteMatch(t *testing.T) {
+	ctx := newRouteMatchTestGateway(t, map[string]string{
+		"prefix":   `{"uri":"/api/*","priority":10}`,
+		"exact":    `{"uri":"/api/users"}`,
+		"param":    `{"uri":"/api/:name"}`,
+		"host":     `{"uri":"/api/*","hosts":["*.example.com"]}`,
+		"svc-host": `{"uri":"/api/*","service_id":"{svc}"}`,
+		"post":     `{"uris":["/api/users","/other"],"methods":["POST"],"priority":100}`,
+		"other":    `{"uri":"/other"}`,
+	})
+
+	// host 不匹配时，精确 uri 优先于前缀 uri，前缀越长越优先
+	result, err := SimulateRouteMatch(ctx, &dto.RouteMatchOptions{
+		State:  constant.ExportStateDraft,
+		Method: "get",
+		Host:   "foo.test:8080",
+		Path:   "/api/users?page=1",
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.NotNil(t, result.Matched) {
+		assert.Equal(t, "exact", result.Matched.Name)
+		// 优先级更高的 post 先于 exact 匹配
+		assert.Equal(t, 2, result.Matched.Order)
+	}
+	assert.Equal(t, 1, result.UnmatchedCount)
+	assert.Equal(t, []string{"post", "prefix", "param", "host", "svc-host"}, candidateNames(result))
+	for _, candidate := range result.Candidates {
+		switch candidate.Name {
+		case "host":
+			assert.Contains(t, candidate.Reasons[0], "不匹配 hosts")
+		case "svc-host":
+			assert.Contains(t, candidate.Reasons[0], "svc.example.com")
+		case "post":
+			assert.Equal(t, []string{"method GET 不在 methods [POST] 中"}, candidate.Reasons)
+		case "param":
+			assert.Contains(t, candidate.Reasons[0], "uri /api/users 为精确 uri")
+		case "prefix":
+			assert.Equal(t, 3, candidate.Order)
+			assert.Contains(t, candidate.Reasons[0], "uri /api/users 为精确 uri")
+		}
+	}
+
+	// 更精确的 host 优先，未配置 hosts 的路由使用服务的 hosts
+	result, err = SimulateRouteMatch(ctx, &dto.RouteMatchOptions{
+		State: constant.ExportStateDraft,
+		Host:  "svc.example.com",
+		Path:  "/api/users",
+	})
+	if assert.NoError(t, err) && assert.NotNil(t, result.Matched) {
+		assert.Equal(t, "svc-host", result.Matched.Name)
+		assert.Equal(t, "svc.example.com", result.Matched.Host)
+		assert.Equal(t, []string{"host", "post", "exact", "prefix", "param"}, candidateNames(result))
+		assert.Contains(t, result.Candidates[0].Reasons[0], "host svc.example.com 比")
+	}
+
+	// 不存在的路径
+	result, err = SimulateRouteMatch(ctx, &dto.RouteMatchOptions{State: constant.ExportStateDraft, Path: "/none"})
+	if assert.NoError(t, err) {
+		assert.Nil(t, result.Matched)
+		assert.Empty(t, result.Candidates)
+		assert.Equal(t, 7, result.UnmatchedCount)
+	}
+
+	_, err = SimulateRouteMatch(ctx, &dto.RouteMatchOptions{State: constant.ExportStateDraft, Path: "api"})
+	assert.ErrorIs(t, err, ErrInvalidRouteMatchRequest)
+	_, err = SimulateRouteMatch(ctx, &dto.RouteMatchOptions{
+		State:      constant.ExportStateDraft,
+		Path:       "/api",
+		RemoteAddr: "invalid",
+	})
+	assert.ErrorIs(t, err, ErrInvalidRouteMatchRequest)
+}
+
+func TestSimulateRouteMatchConditions(t *testing.T) {
+	ctx := newRouteMatchTestGateway(t, map[string]string{
+		"vars": `{"uri":"/v","priority":3,"vars":[["arg_name","==","json"],["http_x_env","~~","^prod"],
+			["cookie_uid","IN",["1","2"]],["http_x_debug","!","==","1"]]}`,
+		"remote":   `{"uri":"/v","priority":2,"remote_addrs":["10.0.0.0/8","192.168.1.1"]}`,
+		"disabled": `{"uri":"/v","priority":4,"status":0}`,
+		"same":     `{"uri":"/v","priority":1,"filter_func":"function() return true end"}`,
+		"same2":    `{"uri":"/v","priority":1}`,
+	})
+
+	result, err := SimulateRouteMatch(ctx, &dto.RouteMatchOptions{
+		State:      constant.ExportStateDraft,
+		Path:       "/v?name=json",
+		Headers:    map[string]string{"X-Env": "production", "Cookie": "uid=2; other=1"},
+		RemoteAddr: "10.1.2.3:5678",
+	})
+	if !assert.NoError(t, err) || !assert.NotNil(t, result.Matched) {
+		return
+	}
+	assert.Equal(t, "vars", result.Matched.Name)
+	if assert.Len(t, result.Candidates, 4) {
+		assert.Equal(t, []string{"路由已禁用"}, result.Candidates[0].Reasons)
+		assert.Contains(t, result.Candidates[1].Reasons[0], "优先级更高 (3 > 2)")
+	}
+	assert.Contains(t, strings.Join(result.Warnings, "\n"), "filter_func 无法离线判断")
+
+	// vars 与 remote_addrs 不满足
+	result, err = SimulateRouteMatch(ctx, &dto.RouteMatchOptions{
+		State:      constant.ExportStateDraft,
+		Path:       "/v",
+		Query:      map[string]string{"name": "json"},
+		Headers:    map[string]string{"X-Env": "test", "X-Debug": "1"},
+		RemoteAddr: "172.16.0.1",
+	})
+	if !assert.NoError(t, err) || !assert.NotNil(t, result.Matched) {
+		return
+	}
+	assert.Contains(t, []string{"same", "same2"}, result.Matched.Name)
+	if assert.Len(t, result.Candidates, 4) {
+		assert.Len(t, result.Candidates[1].Reasons, 3)
+		assert.Contains(t, result.Candidates[1].Reasons[0], `http_x_env 为 "test"`)
+		assert.Contains(t, result.Candidates[1].Reasons[1], "cookie_uid 不存在")
+		assert.Contains(t, result.Candidates[1].Reasons[2], `["http_x_debug","!","==","1"]`)
+		assert.Equal(t, []string{"客户端地址 172.16.0.1 不在 remote_addrs [10.0.0.0/8 192.168.1.1] 中"},
+			result.Candidates[2].Reasons)
+	}
+	assert.Contains(t, strings.Join(result.Warnings, "\n"), "host、uri 及优先级均相同")
+}
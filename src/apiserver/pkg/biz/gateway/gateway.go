@@ -34,6 +34,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 )
@@ -54,6 +55,22 @@ var gatewayResourceModelNameList = []string{
 	model.StreamRoute{}.TableName(),
 	model.GatewaySyncData{}.TableName(),
 	model.GatewayReleaseVersion{}.TableName(),
+	model.GatewayDriftRecord{}.TableName(),
+	model.GatewayMember{}.TableName(),
+	model.GatewayPublishPolicy{}.TableName(),
+	model.GatewayChangeRequest{}.TableName(),
+	model.GatewayChangeRequestEvent{}.TableName(),
+	model.GatewayMaintenanceWindow{}.TableName(),
+	model.GatewayScheduledPublish{}.TableName(),
+	model.GatewayGroupMember{}.TableName(),
+	model.GatewayVariable{}.TableName(),
+	model.GatewayWebhook{}.TableName(),
+	model.GatewayWebhookDelivery{}.TableName(),
+	model.SSLExpiryAlert{}.TableName(),
+	model.GatewayDataPlaneInstance{}.TableName(),
+	model.GatewayPublishJournal{}.TableName(),
+	model.Credential{}.TableName(),
+	model.Secret{}.TableName(),
 }
 
 // ListGateways queries gateways, optionally filtering by mode.
@@ -77,7 +94,12 @@ func UpdateGateway(ctx context.Context, gateway model.Gateway) error {
 		u.Name, u.Mode, u.Maintainers, u.Desc,
 		u.EtcdConfig, u.Token, u.Updater, u.ReadOnly,
 	).Updates(&gateway)
-	return err
+	if err != nil {
+		return err
+	}
+	// etcd 配置可能已变更，下次使用时重新建立连接
+	storage.DefaultClientPool().Invalidate(gateway.ID)
+	return nil
 }
 
 // SaveGateway saves the gateway row.
@@ -178,7 +200,7 @@ func ListGatewayResourceLabels(
 
 // DeleteGateway removes a gateway and its owned rows.
 func DeleteGateway(ctx context.Context, gateway *model.Gateway) error {
-	return repo.Q.Transaction(func(tx *repo.Query) error {
+	err := repo.Q.Transaction(func(tx *repo.Query) error {
 		for _, tableName := range gatewayResourceModelNameList {
 			err := database.Client().WithContext(ctx).Table(tableName).Where(
 				"gateway_id = ?",
@@ -193,4 +215,9 @@ func DeleteGateway(ctx context.Context, gateway *model.Gateway) error {
 		_, err := u.WithContext(ctx).Delete(gateway)
 		return err
 	})
+	if err != nil {
+		return err
+	}
+	storage.DefaultClientPool().Invalidate(gateway.ID)
+	return nil
 }
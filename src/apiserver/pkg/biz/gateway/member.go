@@ -0,0 +1,161 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gateway
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gookit/goutil/arrutil"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+)
+
+var (
+	// ErrGatewayMemberNotFound 网关成员不存在
+	ErrGatewayMemberNotFound = errors.New("gateway member not found")
+	// ErrGatewayMemberExists 网关成员已存在
+	ErrGatewayMemberExists = errors.New("gateway member already exists")
+	// ErrGatewayLastAdmin 网关至少需要保留一个管理员
+	ErrGatewayLastAdmin = errors.New("gateway must have at least one admin")
+)
+
+// GetGatewayRole 获取用户在网关中的角色，没有任何角色时返回空
+//
+// 成员角色绑定优先；未绑定角色的网关负责人（maintainers）视为管理员
+func GetGatewayRole(ctx context.Context, gateway *model.Gateway, userID string) (constant.GatewayRole, error) {
+	// demo 模式有所有网关的权限
+	if config.IsDemoMode() {
+		return constant.GatewayRoleAdmin, nil
+	}
+	if userID == "" {
+		return "", nil
+	}
+	u := repo.GatewayMember
+	member, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gateway.ID), u.Username.Eq(userID)).First()
+	if err == nil {
+		return member.Role, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", err
+	}
+	if arrutil.HasValue(gateway.Maintainers, userID) {
+		return constant.GatewayRoleAdmin, nil
+	}
+	return "", nil
+}
+
+// ListGatewayMembers 查询网关成员
+func ListGatewayMembers(ctx context.Context, gatewayID int) ([]*model.GatewayMember, error) {
+	u := repo.GatewayMember
+	return u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).Order(u.ID).Find()
+}
+
+// GetGatewayMember 获取网关成员
+func GetGatewayMember(ctx context.Context, gatewayID, id int) (*model.GatewayMember, error) {
+	u := repo.GatewayMember
+	member, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrGatewayMemberNotFound
+	}
+	return member, err
+}
+
+// CreateGatewayMember 添加网关成员
+func CreateGatewayMember(ctx context.Context, gateway *model.Gateway, member *model.GatewayMember) error {
+	u := repo.GatewayMember
+	count, err := u.WithContext(ctx).Where(u.GatewayID.Eq(member.GatewayID), u.Username.Eq(member.Username)).Count()
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrGatewayMemberExists
+	}
+	// 为负责人绑定非管理员角色相当于降级，同样需要保留管理员
+	if member.Role != constant.GatewayRoleAdmin && arrutil.HasValue(gateway.Maintainers, member.Username) {
+		if err := checkOtherAdminExists(ctx, gateway, member.Username); err != nil {
+			return err
+		}
+	}
+	return u.WithContext(ctx).Create(member)
+}
+
+// UpdateGatewayMemberRole 更新网关成员角色
+func UpdateGatewayMemberRole(
+	ctx context.Context,
+	gateway *model.Gateway,
+	member *model.GatewayMember,
+	role constant.GatewayRole,
+	updater string,
+) error {
+	if member.Role == constant.GatewayRoleAdmin && role != constant.GatewayRoleAdmin {
+		if err := checkOtherAdminExists(ctx, gateway, member.Username); err != nil {
+			return err
+		}
+	}
+	u := repo.GatewayMember
+	_, err := u.WithContext(ctx).Where(u.ID.Eq(member.ID)).Updates(map[string]any{
+		"role":    role,
+		"updater": updater,
+	})
+	if err != nil {
+		return err
+	}
+	member.Role = role
+	member.Updater = updater
+	return nil
+}
+
+// DeleteGatewayMember 删除网关成员
+func DeleteGatewayMember(ctx context.Context, gateway *model.Gateway, member *model.GatewayMember) error {
+	// 负责人删除角色绑定后仍为管理员
+	if member.Role == constant.GatewayRoleAdmin && !arrutil.HasValue(gateway.Maintainers, member.Username) {
+		if err := checkOtherAdminExists(ctx, gateway, member.Username); err != nil {
+			return err
+		}
+	}
+	u := repo.GatewayMember
+	_, err := u.WithContext(ctx).Where(u.ID.Eq(member.ID)).Delete()
+	return err
+}
+
+// checkOtherAdminExists 校验除 username 外网关是否还有其它管理员
+func checkOtherAdminExists(ctx context.Context, gateway *model.Gateway, username string) error {
+	members, err := ListGatewayMembers(ctx, gateway.ID)
+	if err != nil {
+		return err
+	}
+	boundUsers := make(map[string]bool, len(members))
+	for _, member := range members {
+		boundUsers[member.Username] = true
+		if member.Username != username && member.Role == constant.GatewayRoleAdmin {
+			return nil
+		}
+	}
+	for _, maintainer := range gateway.Maintainers {
+		if maintainer != username && !boundUsers[maintainer] {
+			return nil
+		}
+	}
+	return ErrGatewayLastAdmin
+}
@@ -0,0 +1,112 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestGetGatewayRole(t *testing.T) {
+	ctx := context.Background()
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = "test-gateway-role"
+	gateway.Maintainers = []string{"maintainer", "bound-maintainer"}
+	assert.NoError(t, CreateGateway(ctx, gateway))
+	assert.NoError(t, CreateGatewayMember(ctx, gateway, &model.GatewayMember{
+		GatewayID: gateway.ID,
+		Username:  "editor",
+		Role:      constant.GatewayRoleEditor,
+	}))
+	assert.NoError(t, CreateGatewayMember(ctx, gateway, &model.GatewayMember{
+		GatewayID: gateway.ID,
+		Username:  "bound-maintainer",
+		Role:      constant.GatewayRolePublisher,
+	}))
+
+	tests := []struct {
+		userID   string
+		expected constant.GatewayRole
+	}{
+		{"maintainer", constant.GatewayRoleAdmin},
+		{"editor", constant.GatewayRoleEditor},
+		// 成员角色绑定优先于负责人
+		{"bound-maintainer", constant.GatewayRolePublisher},
+		{"stranger", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.userID, func(t *testing.T) {
+			role, err := GetGatewayRole(ctx, gateway, tt.userID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, role)
+		})
+	}
+}
+
+func TestGatewayMemberLastAdmin(t *testing.T) {
+	ctx := context.Background()
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = "test-gateway-member-admin"
+	gateway.Maintainers = []string{"maintainer"}
+	assert.NoError(t, CreateGateway(ctx, gateway))
+
+	// 唯一的负责人不能降级
+	err := CreateGatewayMember(ctx, gateway, &model.GatewayMember{
+		GatewayID: gateway.ID,
+		Username:  "maintainer",
+		Role:      constant.GatewayRoleViewer,
+	})
+	assert.ErrorIs(t, err, ErrGatewayLastAdmin)
+
+	admin := &model.GatewayMember{GatewayID: gateway.ID, Username: "admin", Role: constant.GatewayRoleAdmin}
+	assert.NoError(t, CreateGatewayMember(ctx, gateway, admin))
+	err = CreateGatewayMember(ctx, gateway, &model.GatewayMember{
+		GatewayID: gateway.ID,
+		Username:  "admin",
+		Role:      constant.GatewayRoleEditor,
+	})
+	assert.ErrorIs(t, err, ErrGatewayMemberExists)
+
+	// 还有其它管理员时负责人可以降级
+	maintainer := &model.GatewayMember{GatewayID: gateway.ID, Username: "maintainer", Role: constant.GatewayRoleViewer}
+	assert.NoError(t, CreateGatewayMember(ctx, gateway, maintainer))
+
+	// 最后一个管理员不能降级或删除
+	err = UpdateGatewayMemberRole(ctx, gateway, admin, constant.GatewayRoleEditor, "admin")
+	assert.ErrorIs(t, err, ErrGatewayLastAdmin)
+	assert.ErrorIs(t, DeleteGatewayMember(ctx, gateway, admin), ErrGatewayLastAdmin)
+
+	// 删除负责人的角色绑定后负责人恢复为管理员
+	assert.NoError(t, DeleteGatewayMember(ctx, gateway, maintainer))
+	assert.NoError(t, UpdateGatewayMemberRole(ctx, gateway, admin, constant.GatewayRoleEditor, "maintainer"))
+	member, err := GetGatewayMember(ctx, gateway.ID, admin.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.GatewayRoleEditor, member.Role)
+	assert.Equal(t, "maintainer", member.Updater)
+
+	_, err = GetGatewayMember(ctx, gateway.ID, maintainer.ID)
+	assert.ErrorIs(t, err, ErrGatewayMemberNotFound)
+}
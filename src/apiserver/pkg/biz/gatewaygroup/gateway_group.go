@@ -0,0 +1,226 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+// Package gatewaygroup contains gateway group and staged rollout helpers.
+package gatewaygroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+)
+
+// GatewayGroupErrors 定义网关组相关的错误
+var (
+	ErrGatewayGroupNotFound      = errors.New("gateway group not found")
+	ErrGatewayGroupTooFewMembers = errors.New("gateway group should contain at least 2 gateways")
+	ErrGatewayGroupDuplicate     = errors.New("gateway is duplicated in gateway group")
+	ErrGatewayGroupRolloutActive = errors.New("gateway group has an active rollout")
+	ErrGatewayGroupNoPermission  = errors.New("no permission on gateway of gateway group")
+	ErrGatewayGroupNoGateway     = errors.New("gateway of gateway group not found")
+)
+
+// ListGatewayGroups 查询用户有权限查看的网关组：用户需要有组内所有网关的查看权限
+func ListGatewayGroups(ctx context.Context, userID string) ([]*model.GatewayGroup, error) {
+	u := repo.GatewayGroup
+	groups, err := u.WithContext(ctx).Order(u.ID.Desc()).Find()
+	if err != nil {
+		return nil, err
+	}
+	visible := make([]*model.GatewayGroup, 0, len(groups))
+	for _, group := range groups {
+		members, err := ListGatewayGroupMembers(ctx, group.ID)
+		if err != nil {
+			return nil, err
+		}
+		err = CheckGatewayGroupPermission(ctx, members, userID, constant.GatewayPermissionView)
+		if errors.Is(err, ErrGatewayGroupNoPermission) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		visible = append(visible, group)
+	}
+	return visible, nil
+}
+
+// GetGatewayGroup 获取网关组
+func GetGatewayGroup(ctx context.Context, id int) (*model.GatewayGroup, error) {
+	u := repo.GatewayGroup
+	group, err := u.WithContext(ctx).Where(u.ID.Eq(id)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrGatewayGroupNotFound
+	}
+	return group, err
+}
+
+// ListGatewayGroupMembers 查询网关组中的网关，按发布顺序排列
+func ListGatewayGroupMembers(ctx context.Context, groupID int) ([]*model.GatewayGroupMember, error) {
+	u := repo.GatewayGroupMember
+	return u.WithContext(ctx).Where(u.GroupID.Eq(groupID)).Order(u.Priority, u.ID).Find()
+}
+
+// CheckGatewayGroupPermission 校验用户是否拥有组内所有网关的指定权限
+func CheckGatewayGroupPermission(
+	ctx context.Context,
+	members []*model.GatewayGroupMember,
+	userID string,
+	permission constant.GatewayPermission,
+) error {
+	for _, member := range members {
+		gateway, err := getMemberGateway(ctx, member.GatewayID)
+		if err != nil {
+			return err
+		}
+		role, err := gatewaybiz.GetGatewayRole(ctx, gateway, userID)
+		if err != nil {
+			return err
+		}
+		if !role.HasPermission(permission) {
+			return fmt.Errorf("%w: %s", ErrGatewayGroupNoPermission, gateway.Name)
+		}
+	}
+	return nil
+}
+
+// getMemberGateway 获取网关组成员对应的网关
+func getMemberGateway(ctx context.Context, gatewayID int) (*model.Gateway, error) {
+	gateway, err := gatewaybiz.GetGateway(ctx, gatewayID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %d", ErrGatewayGroupNoGateway, gatewayID)
+	}
+	return gateway, err
+}
+
+// validateMembers 校验网关组成员：至少两个网关且不重复，网关需存在
+func validateMembers(ctx context.Context, members []*model.GatewayGroupMember) error {
+	if len(members) < 2 {
+		return ErrGatewayGroupTooFewMembers
+	}
+	gatewayIDs := make(map[int]struct{}, len(members))
+	for _, member := range members {
+		if _, ok := gatewayIDs[member.GatewayID]; ok {
+			return ErrGatewayGroupDuplicate
+		}
+		gatewayIDs[member.GatewayID] = struct{}{}
+		if _, err := getMemberGateway(ctx, member.GatewayID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateGatewayGroup 创建网关组及其成员
+func CreateGatewayGroup(
+	ctx context.Context,
+	group *model.GatewayGroup,
+	members []*model.GatewayGroupMember,
+) error {
+	if err := validateMembers(ctx, members); err != nil {
+		return err
+	}
+	return repo.Q.Transaction(func(tx *repo.Query) error {
+		if err := tx.GatewayGroup.WithContext(ctx).Create(group); err != nil {
+			return err
+		}
+		return createMembers(ctx, tx, group, members)
+	})
+}
+
+// UpdateGatewayGroup 更新网关组并整体替换其成员，分批发布进行中时不允许修改
+func UpdateGatewayGroup(
+	ctx context.Context,
+	group *model.GatewayGroup,
+	members []*model.GatewayGroupMember,
+) error {
+	if err := validateMembers(ctx, members); err != nil {
+		return err
+	}
+	if err := checkNoActiveRollout(ctx, group.ID); err != nil {
+		return err
+	}
+	return repo.Q.Transaction(func(tx *repo.Query) error {
+		u := tx.GatewayGroup
+		_, err := u.WithContext(ctx).Where(u.ID.Eq(group.ID)).Select(
+			u.Name, u.Description, u.SoakSeconds, u.Updater,
+		).Updates(group)
+		if err != nil {
+			return err
+		}
+		m := tx.GatewayGroupMember
+		if _, err := m.WithContext(ctx).Where(m.GroupID.Eq(group.ID)).Delete(); err != nil {
+			return err
+		}
+		return createMembers(ctx, tx, group, members)
+	})
+}
+
+// DeleteGatewayGroup 删除网关组及其成员、分批发布记录，分批发布进行中时不允许删除
+func DeleteGatewayGroup(ctx context.Context, group *model.GatewayGroup) error {
+	if err := checkNoActiveRollout(ctx, group.ID); err != nil {
+		return err
+	}
+	return repo.Q.Transaction(func(tx *repo.Query) error {
+		r := tx.GatewayGroupRollout
+		rollouts, err := r.WithContext(ctx).Where(r.GroupID.Eq(group.ID)).Find()
+		if err != nil {
+			return err
+		}
+		rolloutIDs := make([]int64, 0, len(rollouts))
+		for _, rollout := range rollouts {
+			rolloutIDs = append(rolloutIDs, rollout.ID)
+		}
+		if len(rolloutIDs) > 0 {
+			s := tx.GatewayGroupRolloutStage
+			if _, err := s.WithContext(ctx).Where(s.RolloutID.In(rolloutIDs...)).Delete(); err != nil {
+				return err
+			}
+			if _, err := r.WithContext(ctx).Where(r.ID.In(rolloutIDs...)).Delete(); err != nil {
+				return err
+			}
+		}
+		m := tx.GatewayGroupMember
+		if _, err := m.WithContext(ctx).Where(m.GroupID.Eq(group.ID)).Delete(); err != nil {
+			return err
+		}
+		_, err = tx.GatewayGroup.WithContext(ctx).Where(tx.GatewayGroup.ID.Eq(group.ID)).Delete()
+		return err
+	})
+}
+
+func createMembers(
+	ctx context.Context,
+	tx *repo.Query,
+	group *model.GatewayGroup,
+	members []*model.GatewayGroupMember,
+) error {
+	for _, member := range members {
+		member.ID = 0
+		member.GroupID = group.ID
+		member.Creator = group.Updater
+		member.Updater = group.Updater
+	}
+	return tx.GatewayGroupMember.WithContext(ctx).Create(members...)
+}
@@ -0,0 +1,693 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package gatewaygroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	resty "github.com/go-resty/resty/v2"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	changerequestbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/changerequest"
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	maintenancebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/maintenance"
+	publishbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/publish"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/hostx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/uuidx"
+)
+
+// GatewayGroupRolloutErrors 定义分批发布相关的错误
+var (
+	ErrRolloutNotFound        = errors.New("gateway group rollout not found")
+	ErrRolloutFinished        = errors.New("gateway group rollout is finished")
+	ErrRolloutApprovalEnabled = errors.New("gateway with publish approval policy can not join group rollout")
+)
+
+// healthCheckTimeout 健康检查请求超时时间
+const healthCheckTimeout = 10 * time.Second
+
+// now 当前时间，测试时可替换
+var now = time.Now
+
+// activeRolloutStatuses 未结束的分批发布状态
+var activeRolloutStatuses = []string{
+	string(constant.GroupRolloutStatusPending),
+	string(constant.GroupRolloutStatusSoaking),
+	string(constant.GroupRolloutStatusRunning),
+	string(constant.GroupRolloutStatusRollingBack),
+}
+
+// rolloutLeaseDuration 推进分批发布的租约时长，推进期间定期续约，进程退出后租约过期由其他进程继续推进
+const rolloutLeaseDuration = time.Minute
+
+// rolloutLeaseOwner 当前进程抢占分批发布租约时使用的标识
+var rolloutLeaseOwner = fmt.Sprintf("%s-%d-%s", hostx.GetHostname(), os.Getpid(), uuidx.New()[:8])
+
+// CreateRollout 创建网关组分批发布，按成员的发布顺序生成各批次，由定时任务推进执行
+//
+// 开启发布审批的网关无法自动发布，不允许参与分批发布
+func CreateRollout(
+	ctx context.Context,
+	group *model.GatewayGroup,
+	changelog string,
+) (*model.GatewayGroupRollout, error) {
+	if err := checkNoActiveRollout(ctx, group.ID); err != nil {
+		return nil, err
+	}
+	members, err := ListGatewayGroupMembers(ctx, group.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) < 2 {
+		return nil, ErrGatewayGroupTooFewMembers
+	}
+	for _, member := range members {
+		policy, err := changerequestbiz.GetPublishPolicy(ctx, member.GatewayID)
+		if err != nil {
+			return nil, err
+		}
+		if policy.Enabled {
+			return nil, fmt.Errorf("%w: gateway %d", ErrRolloutApprovalEnabled, member.GatewayID)
+		}
+	}
+	operator := ginx.GetUserIDFromContext(ctx)
+	rollout := &model.GatewayGroupRollout{
+		GroupID:     group.ID,
+		Changelog:   changelog,
+		SoakSeconds: group.SoakSeconds,
+		Status:      constant.GroupRolloutStatusPending,
+		BaseModel:   model.BaseModel{Creator: operator, Updater: operator},
+	}
+	err = repo.Q.Transaction(func(tx *repo.Query) error {
+		if err := tx.GatewayGroupRollout.WithContext(ctx).Create(rollout); err != nil {
+			return err
+		}
+		stages := make([]*model.GatewayGroupRolloutStage, 0, len(members))
+		for i, member := range members {
+			stages = append(stages, &model.GatewayGroupRolloutStage{
+				RolloutID:      rollout.ID,
+				Stage:          i,
+				GatewayID:      member.GatewayID,
+				HealthCheckURL: member.HealthCheckURL,
+				Status:         constant.GroupRolloutStageStatusPending,
+				BaseModel:      model.BaseModel{Creator: operator, Updater: operator},
+			})
+		}
+		return tx.GatewayGroupRolloutStage.WithContext(ctx).Create(stages...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rollout, nil
+}
+
+// ListRollouts 分页查询网关组的分批发布
+func ListRollouts(
+	ctx context.Context,
+	groupID int,
+	page utils.PageParam,
+) ([]*model.GatewayGroupRollout, int64, error) {
+	u := repo.GatewayGroupRollout
+	return u.WithContext(ctx).Where(u.GroupID.Eq(groupID)).Order(u.ID.Desc()).FindByPage(page.Offset, page.Limit)
+}
+
+// GetRollout 获取网关组的分批发布
+func GetRollout(ctx context.Context, groupID int, id int64) (*model.GatewayGroupRollout, error) {
+	u := repo.GatewayGroupRollout
+	rollout, err := u.WithContext(ctx).Where(u.GroupID.Eq(groupID), u.ID.Eq(id)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrRolloutNotFound
+	}
+	return rollout, err
+}
+
+// ListRolloutStages 查询分批发布的各批次
+func ListRolloutStages(ctx context.Context, rolloutID int64) ([]*model.GatewayGroupRolloutStage, error) {
+	u := repo.GatewayGroupRolloutStage
+	return u.WithContext(ctx).Where(u.RolloutID.Eq(rolloutID)).Order(u.Stage).Find()
+}
+
+// CancelRollout 取消分批发布：未开始的直接取消，已开始的回滚已发布的网关
+func CancelRollout(ctx context.Context, rollout *model.GatewayGroupRollout) error {
+	operator := ginx.GetUserIDFromContext(ctx)
+	u := repo.GatewayGroupRollout
+	info, err := u.WithContext(ctx).Where(
+		u.ID.Eq(rollout.ID),
+		u.Status.Eq(string(constant.GroupRolloutStatusPending)),
+	).UpdateSimple(
+		u.Status.Value(string(constant.GroupRolloutStatusCancelled)),
+		u.FinishedAt.Value(now()),
+		u.Updater.Value(operator),
+	)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected > 0 {
+		rollout.Status = constant.GroupRolloutStatusCancelled
+		return skipPendingStages(ctx, rollout.ID)
+	}
+	errMsg := fmt.Sprintf("cancelled by %s", operator)
+	info, err = u.WithContext(ctx).Where(
+		u.ID.Eq(rollout.ID),
+		u.Status.In(string(constant.GroupRolloutStatusSoaking), string(constant.GroupRolloutStatusRunning)),
+	).UpdateSimple(
+		u.Status.Value(string(constant.GroupRolloutStatusRollingBack)),
+		u.Error.Value(errMsg),
+		u.Updater.Value(operator),
+	)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return ErrRolloutFinished
+	}
+	rollout.Status = constant.GroupRolloutStatusRollingBack
+	rollout.Error = errMsg
+	return nil
+}
+
+// AdvanceRollouts 推进所有未结束的分批发布，返回推进的数量
+//
+// 分批发布的状态均保存在数据库中，进程重启后从中断的批次继续执行；
+// 推进前需抢占分批发布的租约，多个进程（或定时任务的多次执行）不会同时推进同一个分批发布
+func AdvanceRollouts(ctx context.Context) (int, error) {
+	u := repo.GatewayGroupRollout
+	rollouts, err := u.WithContext(ctx).Where(u.Status.In(activeRolloutStatuses...)).Order(u.ID).Find()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, rollout := range rollouts {
+		claimed, err := claimRollout(ctx, rollout)
+		if err != nil {
+			logging.ErrorFWithContext(ctx, "claim gateway group rollout %d err: %s", rollout.ID, err.Error())
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		count++
+		if err := advanceRolloutWithLease(ctx, rollout); err != nil {
+			logging.ErrorFWithContext(ctx, "advance gateway group rollout %d err: %s", rollout.ID, err.Error())
+		}
+	}
+	return count, nil
+}
+
+// claimRollout 通过条件更新抢占未结束且租约已过期的分批发布，抢占成功后重新加载最新状态
+func claimRollout(ctx context.Context, rollout *model.GatewayGroupRollout) (bool, error) {
+	current := now()
+	u := repo.GatewayGroupRollout
+	q := u.WithContext(ctx)
+	info, err := q.Where(
+		u.ID.Eq(rollout.ID),
+		u.Status.In(activeRolloutStatuses...),
+		q.Where(u.LeaseExpiredAt.IsNull()).Or(u.LeaseExpiredAt.Lt(current)),
+	).UpdateSimple(
+		u.LeaseOwner.Value(rolloutLeaseOwner),
+		u.LeaseExpiredAt.Value(current.Add(rolloutLeaseDuration)),
+	)
+	if err != nil || info.RowsAffected == 0 {
+		return false, err
+	}
+	latest, err := GetRollout(ctx, rollout.GroupID, rollout.ID)
+	if err != nil {
+		return false, err
+	}
+	*rollout = *latest
+	return true, nil
+}
+
+// advanceRolloutWithLease 持有租约推进分批发布：推进期间定期续约，租约丢失时中止推进，结束后释放租约
+func advanceRolloutWithLease(ctx context.Context, rollout *model.GatewayGroupRollout) error {
+	leaseCtx, cancel := context.WithCancel(ctx)
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		renewRolloutLease(leaseCtx, rollout.ID, cancel)
+	}()
+	err := advanceRollout(leaseCtx, rollout)
+	cancel()
+	<-heartbeatDone
+
+	u := repo.GatewayGroupRollout
+	if _, releaseErr := u.WithContext(context.WithoutCancel(ctx)).Where(
+		u.ID.Eq(rollout.ID),
+		u.LeaseOwner.Eq(rolloutLeaseOwner),
+	).UpdateSimple(u.LeaseExpiredAt.Null()); releaseErr != nil {
+		logging.ErrorFWithContext(ctx, "release lease of gateway group rollout %d err: %s",
+			rollout.ID, releaseErr.Error())
+	}
+	return err
+}
+
+// renewRolloutLease 定期续约，租约已被其他进程抢占时中止推进
+func renewRolloutLease(ctx context.Context, rolloutID int64, cancel context.CancelFunc) {
+	ticker := time.NewTicker(rolloutLeaseDuration / 3)
+	defer ticker.Stop()
+	u := repo.GatewayGroupRollout
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		info, err := u.WithContext(ctx).Where(
+			u.ID.Eq(rolloutID),
+			u.LeaseOwner.Eq(rolloutLeaseOwner),
+		).UpdateSimple(u.LeaseExpiredAt.Value(now().Add(rolloutLeaseDuration)))
+		if err != nil {
+			logging.Errorf("renew lease of gateway group rollout %d err: %s", rolloutID, err)
+			continue
+		}
+		if info.RowsAffected == 0 {
+			logging.Warnf("gateway group rollout %d lease lost, abort", rolloutID)
+			cancel()
+			return
+		}
+	}
+}
+
+// advanceRollout 推进单个分批发布，直到需要等待观察期结束或发布结束
+func advanceRollout(ctx context.Context, rollout *model.GatewayGroupRollout) error {
+	for !rollout.IsFinished() {
+		stages, err := ListRolloutStages(ctx, rollout.ID)
+		if err != nil {
+			return err
+		}
+		if len(stages) == 0 {
+			return updateRollout(ctx, rollout, rollout.Status, constant.GroupRolloutStatusFailed, "no stages")
+		}
+		switch rollout.Status {
+		case constant.GroupRolloutStatusPending:
+			err = runCanaryStage(ctx, rollout, stages[0])
+		case constant.GroupRolloutStatusSoaking:
+			if rollout.SoakUntil != nil && now().Before(*rollout.SoakUntil) {
+				return nil
+			}
+			err = checkCanaryStage(ctx, rollout, stages[0])
+		case constant.GroupRolloutStatusRunning:
+			err = runStages(ctx, rollout, stages)
+		case constant.GroupRolloutStatusRollingBack:
+			err = rollbackStages(ctx, rollout, stages)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runCanaryStage 发布金丝雀网关的草稿资源，并进入观察期
+//
+// 金丝雀网关没有待发布的草稿时，直接使用其最新的发布版本
+func runCanaryStage(
+	ctx context.Context,
+	rollout *model.GatewayGroupRollout,
+	stage *model.GatewayGroupRolloutStage,
+) error {
+	gatewayCtx, err := newGatewayContext(ctx, rollout, stage.GatewayID)
+	if err != nil {
+		return failStage(ctx, rollout, stage, err)
+	}
+	if err := maintenancebiz.CheckPublishAllowed(gatewayCtx); err != nil {
+		return failStage(ctx, rollout, stage, err)
+	}
+	if err := startStage(gatewayCtx, stage); err != nil {
+		return err
+	}
+	if err := publishbiz.PublishAllResource(gatewayCtx, stage.GatewayID); err != nil {
+		return failStage(ctx, rollout, stage, err)
+	}
+	source, err := releasebiz.GetLatestReleaseVersion(ctx, stage.GatewayID)
+	if err != nil {
+		return failStage(ctx, rollout, stage, err)
+	}
+	if source == nil {
+		return failStage(ctx, rollout, stage, errors.New("canary gateway has no release version"))
+	}
+	stage.ReleaseVersionID = source.ID
+	if err := saveStage(ctx, stage); err != nil {
+		return err
+	}
+
+	soakUntil := now().Add(time.Duration(rollout.SoakSeconds) * time.Second)
+	rollout.SourceReleaseVersionID = source.ID
+	rollout.SoakUntil = &soakUntil
+	return updateRollout(ctx, rollout, constant.GroupRolloutStatusPending, constant.GroupRolloutStatusSoaking, "",
+		repo.GatewayGroupRollout.SourceReleaseVersionID.Value(source.ID),
+		repo.GatewayGroupRollout.SoakUntil.Value(soakUntil),
+	)
+}
+
+// checkCanaryStage 观察期结束后检查金丝雀网关的健康状态，通过后开始发布其余网关
+func checkCanaryStage(
+	ctx context.Context,
+	rollout *model.GatewayGroupRollout,
+	stage *model.GatewayGroupRolloutStage,
+) error {
+	gatewayCtx, err := newGatewayContext(ctx, rollout, stage.GatewayID)
+	if err != nil {
+		return failStage(ctx, rollout, stage, err)
+	}
+	if err := checkHealth(gatewayCtx, stage); err != nil {
+		return failStage(ctx, rollout, stage, err)
+	}
+	if err := finishStage(ctx, stage, constant.GroupRolloutStageStatusSucceeded, ""); err != nil {
+		return err
+	}
+	rollout.CurrentStage = 1
+	return updateRollout(ctx, rollout, constant.GroupRolloutStatusSoaking, constant.GroupRolloutStatusRunning, "",
+		repo.GatewayGroupRollout.CurrentStage.Value(1),
+	)
+}
+
+// runStages 依次将金丝雀网关的发布版本应用到其余网关，每个网关发布后检查健康状态
+func runStages(
+	ctx context.Context,
+	rollout *model.GatewayGroupRollout,
+	stages []*model.GatewayGroupRolloutStage,
+) error {
+	source, err := releasebiz.GetReleaseVersion(ctx, stages[0].GatewayID, rollout.SourceReleaseVersionID)
+	if err != nil {
+		return updateRollout(ctx, rollout, rollout.Status, constant.GroupRolloutStatusRollingBack, err.Error())
+	}
+	for _, stage := range stages[1:] {
+		if stage.Status == constant.GroupRolloutStageStatusSucceeded {
+			continue
+		}
+		// 每批发布前确认分批发布未被取消
+		current, err := GetRollout(ctx, rollout.GroupID, rollout.ID)
+		if err != nil {
+			return err
+		}
+		if current.Status != constant.GroupRolloutStatusRunning {
+			*rollout = *current
+			return nil
+		}
+		if err := runStage(ctx, rollout, stage, source); err != nil {
+			return failStage(ctx, rollout, stage, err)
+		}
+		rollout.CurrentStage = stage.Stage + 1
+		_, err = repo.GatewayGroupRollout.WithContext(ctx).Where(
+			repo.GatewayGroupRollout.ID.Eq(rollout.ID),
+		).UpdateSimple(repo.GatewayGroupRollout.CurrentStage.Value(rollout.CurrentStage))
+		if err != nil {
+			return err
+		}
+	}
+	return updateRollout(ctx, rollout, constant.GroupRolloutStatusRunning, constant.GroupRolloutStatusSucceeded, "")
+}
+
+// runStage 将发布版本应用到单个网关并检查健康状态
+func runStage(
+	ctx context.Context,
+	rollout *model.GatewayGroupRollout,
+	stage *model.GatewayGroupRolloutStage,
+	source *model.GatewayReleaseVersion,
+) error {
+	gatewayCtx, err := newGatewayContext(ctx, rollout, stage.GatewayID)
+	if err != nil {
+		return err
+	}
+	if err := maintenancebiz.CheckPublishAllowed(gatewayCtx); err != nil {
+		return err
+	}
+	if err := startStage(gatewayCtx, stage); err != nil {
+		return err
+	}
+	version, err := releasebiz.ApplyReleaseVersion(gatewayCtx, source)
+	if err != nil {
+		return err
+	}
+	stage.ReleaseVersionID = version.ID
+	if err := saveStage(ctx, stage); err != nil {
+		return err
+	}
+	if err := checkHealth(gatewayCtx, stage); err != nil {
+		return err
+	}
+	return finishStage(ctx, stage, constant.GroupRolloutStageStatusSucceeded, "")
+}
+
+// rollbackStages 按发布的逆序将已开始发布的网关回滚到发布前的版本，未开始的批次标记为未执行
+func rollbackStages(
+	ctx context.Context,
+	rollout *model.GatewayGroupRollout,
+	stages []*model.GatewayGroupRolloutStage,
+) error {
+	rollbackFailed := false
+	for i := len(stages) - 1; i >= 0; i-- {
+		stage := stages[i]
+		switch {
+		case stage.Status == constant.GroupRolloutStageStatusPending:
+			if err := finishStage(ctx, stage, constant.GroupRolloutStageStatusSkipped, ""); err != nil {
+				return err
+			}
+			continue
+		case stage.StartedAt == nil,
+			stage.Status == constant.GroupRolloutStageStatusRolledBack,
+			stage.Status == constant.GroupRolloutStageStatusSkipped:
+			continue
+		case stage.Status == constant.GroupRolloutStageStatusRollbackFailed:
+			rollbackFailed = true
+			continue
+		}
+		if err := rollbackStage(ctx, rollout, stage); err != nil {
+			rollbackFailed = true
+			if err := finishStage(ctx, stage, constant.GroupRolloutStageStatusRollbackFailed,
+				joinError(stage.Error, err.Error())); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := finishStage(ctx, stage, constant.GroupRolloutStageStatusRolledBack, stage.Error); err != nil {
+			return err
+		}
+	}
+	status := constant.GroupRolloutStatusRolledBack
+	if rollbackFailed {
+		status = constant.GroupRolloutStatusFailed
+	}
+	return updateRollout(ctx, rollout, constant.GroupRolloutStatusRollingBack, status, rollout.Error)
+}
+
+// rollbackStage 将单个网关回滚到发布前的版本
+func rollbackStage(
+	ctx context.Context,
+	rollout *model.GatewayGroupRollout,
+	stage *model.GatewayGroupRolloutStage,
+) error {
+	if stage.PreviousReleaseVersionID == 0 {
+		return errors.New("gateway has no release version before rollout, can not rollback automatically")
+	}
+	gatewayCtx, err := newGatewayContext(ctx, rollout, stage.GatewayID)
+	if err != nil {
+		return err
+	}
+	gatewayCtx = releasebiz.WithMeta(gatewayCtx, releasebiz.Meta{
+		Trigger:   constant.ReleaseTriggerGroupRollout,
+		Changelog: fmt.Sprintf("网关组分批发布 #%d 自动回滚", rollout.ID),
+	})
+	_, err = releasebiz.RollbackReleaseVersion(gatewayCtx, stage.PreviousReleaseVersionID)
+	return err
+}
+
+// checkHealth 检查网关的健康状态：数据面配置需与发布版本一致，配置了健康检查地址时需返回 2xx
+func checkHealth(ctx context.Context, stage *model.GatewayGroupRolloutStage) error {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	source, err := releasebiz.GetReleaseVersion(ctx, gatewayInfo.ID, stage.ReleaseVersionID)
+	if err != nil {
+		return err
+	}
+	expected, err := source.GetReleaseResources()
+	if err != nil {
+		return err
+	}
+	live, err := releasebiz.ListLiveResources(ctx)
+	if err != nil {
+		return err
+	}
+	if diff := releasebiz.DiffReleaseResources(expected, live); len(diff.Changes) > 0 {
+		return fmt.Errorf("etcd of gateway %s is inconsistent with release version %s: %d changes",
+			gatewayInfo.Name, source.Version, len(diff.Changes))
+	}
+	if stage.HealthCheckURL == "" {
+		return nil
+	}
+	resp, err := resty.New().SetLogger(logging.New()).SetTimeout(healthCheckTimeout).
+		R().SetContext(ctx).Get(stage.HealthCheckURL)
+	if err != nil {
+		return fmt.Errorf("health check of gateway %s failed: %w", gatewayInfo.Name, err)
+	}
+	if resp.IsError() {
+		return fmt.Errorf("health check of gateway %s return status %d", gatewayInfo.Name, resp.StatusCode())
+	}
+	return nil
+}
+
+// newGatewayContext 以分批发布创建人的身份构造网关上下文
+func newGatewayContext(
+	ctx context.Context,
+	rollout *model.GatewayGroupRollout,
+	gatewayID int,
+) (context.Context, error) {
+	gatewayInfo, err := gatewaybiz.GetGateway(ctx, gatewayID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = ginx.SetGatewayInfoToContext(ctx, gatewayInfo)
+	ctx = context.WithValue(ctx, constant.UserIDKey, rollout.Creator)
+	return releasebiz.WithMeta(ctx, releasebiz.Meta{
+		Trigger:   constant.ReleaseTriggerGroupRollout,
+		Changelog: rollout.Changelog,
+	}), nil
+}
+
+// startStage 记录网关发布前的最新版本并将批次标记为发布中；中断后重新执行时保留最初记录的版本
+func startStage(ctx context.Context, stage *model.GatewayGroupRolloutStage) error {
+	if stage.StartedAt == nil {
+		previous, err := releasebiz.GetLatestReleaseVersion(ctx, stage.GatewayID)
+		if err != nil {
+			return err
+		}
+		if previous != nil {
+			stage.PreviousReleaseVersionID = previous.ID
+		}
+		startedAt := now()
+		stage.StartedAt = &startedAt
+	}
+	stage.Status = constant.GroupRolloutStageStatusRunning
+	return saveStage(ctx, stage)
+}
+
+// failStage 标记批次失败，并开始回滚分批发布
+func failStage(
+	ctx context.Context,
+	rollout *model.GatewayGroupRollout,
+	stage *model.GatewayGroupRolloutStage,
+	stageErr error,
+) error {
+	if err := finishStage(ctx, stage, constant.GroupRolloutStageStatusFailed, stageErr.Error()); err != nil {
+		return err
+	}
+	return updateRollout(ctx, rollout, rollout.Status, constant.GroupRolloutStatusRollingBack,
+		fmt.Sprintf("stage %d failed: %s", stage.Stage, stageErr.Error()))
+}
+
+func finishStage(
+	ctx context.Context,
+	stage *model.GatewayGroupRolloutStage,
+	status constant.GroupRolloutStageStatus,
+	errMsg string,
+) error {
+	finishedAt := now()
+	stage.Status = status
+	stage.Error = errMsg
+	stage.FinishedAt = &finishedAt
+	return saveStage(ctx, stage)
+}
+
+func saveStage(ctx context.Context, stage *model.GatewayGroupRolloutStage) error {
+	return repo.GatewayGroupRolloutStage.WithContext(ctx).Save(stage)
+}
+
+// skipPendingStages 将未开始的批次标记为未执行
+func skipPendingStages(ctx context.Context, rolloutID int64) error {
+	u := repo.GatewayGroupRolloutStage
+	_, err := u.WithContext(ctx).Where(
+		u.RolloutID.Eq(rolloutID),
+		u.Status.Eq(string(constant.GroupRolloutStageStatusPending)),
+	).UpdateSimple(
+		u.Status.Value(string(constant.GroupRolloutStageStatusSkipped)),
+		u.FinishedAt.Value(now()),
+	)
+	return err
+}
+
+// updateRollout 按状态条件更新分批发布，状态已被修改（如被取消）时重新加载最新状态
+func updateRollout(
+	ctx context.Context,
+	rollout *model.GatewayGroupRollout,
+	from, to constant.GroupRolloutStatus,
+	errMsg string,
+	columns ...field.AssignExpr,
+) error {
+	u := repo.GatewayGroupRollout
+	columns = append(columns, u.Status.Value(string(to)), u.Error.Value(errMsg))
+	if from == constant.GroupRolloutStatusPending {
+		columns = append(columns, u.StartedAt.Value(now()))
+	}
+	finished := model.GatewayGroupRollout{Status: to}
+	if finished.IsFinished() {
+		columns = append(columns, u.FinishedAt.Value(now()))
+	}
+	info, err := u.WithContext(ctx).Where(u.ID.Eq(rollout.ID), u.Status.Eq(string(from))).UpdateSimple(columns...)
+	if err != nil {
+		return err
+	}
+	current, err := GetRollout(ctx, rollout.GroupID, rollout.ID)
+	if err != nil {
+		return err
+	}
+	*rollout = *current
+	if info.RowsAffected == 0 {
+		logging.WarnFWithCtx(ctx, "gateway group rollout %d status changed from %s to %s concurrently",
+			rollout.ID, from, rollout.Status)
+	}
+	return nil
+}
+
+// checkNoActiveRollout 校验网关组没有未结束的分批发布
+func checkNoActiveRollout(ctx context.Context, groupID int) error {
+	u := repo.GatewayGroupRollout
+	count, err := u.WithContext(ctx).Where(u.GroupID.Eq(groupID), u.Status.In(activeRolloutStatuses...)).Count()
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrGatewayGroupRolloutActive
+	}
+	return nil
+}
+
+func joinError(errs ...string) string {
+	var joined string
+	for _, err := range errs {
+		if err == "" {
+			continue
+		}
+		if joined != "" {
+			joined += "; "
+		}
+		joined += err
+	}
+	return joined
+}
@@ -0,0 +1,275 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package gatewaygroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+var etcdEndpoint string
+
+func TestMain(m *testing.M) {
+	// init crypto
+	err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化embed数据库
+	util.InitEmbedDb()
+
+	_, server, endpoint, err := util.StartEmbedEtcdClientRandom(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	etcdEndpoint = endpoint
+
+	code := m.Run()
+
+	server.Close()
+	os.Exit(code)
+}
+
+// newTestGateway 创建网关并记录一个空的发布版本作为分批发布前的版本
+func newTestGateway(t *testing.T, name string) context.Context {
+	t.Helper()
+
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = name
+	gateway.Maintainers = []string{"admin"}
+	gateway.EtcdConfig.Endpoint = base.Endpoint(etcdEndpoint)
+	gateway.EtcdConfig.Prefix = "/" + name
+	if err := gatewaybiz.CreateGateway(context.Background(), gateway); err != nil {
+		t.Fatal(err)
+	}
+	ctx := ginx.SetGatewayInfoToContext(context.Background(), gateway)
+	ctx = context.WithValue(ctx, constant.UserIDKey, "admin")
+	if _, err := releasebiz.CreateReleaseVersion(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	return ctx
+}
+
+// newTestGroup 创建两个网关组成的网关组，金丝雀网关中有一个待发布的路由
+func newTestGroup(
+	t *testing.T,
+	soakSeconds int,
+	healthCheckURL string,
+) (*model.GatewayGroup, context.Context, context.Context, *model.Route) {
+	t.Helper()
+
+	name := strings.ToLower(strings.ReplaceAll(t.Name(), "_", "-"))
+	canaryCtx := newTestGateway(t, name+"-canary")
+	otherCtx := newTestGateway(t, name+"-other")
+
+	canary := ginx.GetGatewayInfoFromContext(canaryCtx)
+	route := data.Route1WithNoRelationResource(canary, constant.ResourceStatusCreateDraft)
+	if err := resourcebiz.CreateRoute(canaryCtx, *route); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.WithValue(context.Background(), constant.UserIDKey, "admin")
+	group := &model.GatewayGroup{
+		Name:        name,
+		SoakSeconds: soakSeconds,
+		BaseModel:   model.BaseModel{Creator: "admin", Updater: "admin"},
+	}
+	members := []*model.GatewayGroupMember{
+		{GatewayID: ginx.GetGatewayInfoFromContext(otherCtx).ID, Priority: 2, HealthCheckURL: healthCheckURL},
+		{GatewayID: canary.ID, Priority: 1},
+	}
+	if err := CreateGatewayGroup(ctx, group, members); err != nil {
+		t.Fatal(err)
+	}
+	return group, canaryCtx, otherCtx, route
+}
+
+func advance(t *testing.T, group *model.GatewayGroup, rollout *model.GatewayGroupRollout) (
+	*model.GatewayGroupRollout, []*model.GatewayGroupRolloutStage,
+) {
+	t.Helper()
+
+	if _, err := AdvanceRollouts(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	rollout, err := GetRollout(context.Background(), group.ID, rollout.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stages, err := ListRolloutStages(context.Background(), rollout.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rollout, stages
+}
+
+// liveRouteKeys 返回网关 etcd 中生效的路由 key
+func liveRouteKeys(t *testing.T, ctx context.Context) []string {
+	t.Helper()
+
+	resources, err := releasebiz.ListLiveResources(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, resource := range resources {
+		if resource.Type == constant.Route {
+			keys = append(keys, resource.Key)
+		}
+	}
+	return keys
+}
+
+func TestCreateGatewayGroupTooFewMembers(t *testing.T) {
+	err := CreateGatewayGroup(context.Background(), &model.GatewayGroup{Name: "single"},
+		[]*model.GatewayGroupMember{{GatewayID: 1}})
+	assert.ErrorIs(t, err, ErrGatewayGroupTooFewMembers)
+}
+
+func TestRolloutSucceeded(t *testing.T) {
+	group, canaryCtx, otherCtx, route := newTestGroup(t, 0, "")
+	ctx := context.WithValue(context.Background(), constant.UserIDKey, "admin")
+
+	rollout, err := CreateRollout(ctx, group, "release route1")
+	assert.NoError(t, err)
+	_, err = CreateRollout(ctx, group, "again")
+	assert.ErrorIs(t, err, ErrGatewayGroupRolloutActive)
+
+	rollout, stages := advance(t, group, rollout)
+	assert.Equal(t, constant.GroupRolloutStatusSucceeded, rollout.Status, rollout.Error)
+	assert.NotNil(t, rollout.FinishedAt)
+	assert.Equal(t, 2, rollout.CurrentStage)
+	assert.Len(t, stages, 2)
+	assert.Equal(t, ginx.GetGatewayInfoFromContext(canaryCtx).ID, stages[0].GatewayID)
+	for _, stage := range stages {
+		assert.Equal(t, constant.GroupRolloutStageStatusSucceeded, stage.Status, stage.Error)
+		assert.NotZero(t, stage.PreviousReleaseVersionID)
+		assert.NotZero(t, stage.ReleaseVersionID)
+	}
+	assert.Equal(t, stages[0].ReleaseVersionID, rollout.SourceReleaseVersionID)
+
+	expected := []string{fmt.Sprintf("routes/%s", route.ID)}
+	assert.Equal(t, expected, liveRouteKeys(t, canaryCtx))
+	assert.Equal(t, expected, liveRouteKeys(t, otherCtx))
+
+	other := ginx.GetGatewayInfoFromContext(otherCtx)
+	version, err := releasebiz.GetLatestReleaseVersion(otherCtx, other.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ReleaseTriggerGroupRollout, version.TriggerSource)
+	assert.Equal(t, "release route1", version.Changelog)
+	current, err := resourcebiz.GetRoute(otherCtx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusSuccess, current.Status)
+}
+
+func TestRolloutLeasedByOtherProcess(t *testing.T) {
+	group, canaryCtx, _, route := newTestGroup(t, 0, "")
+	ctx := context.WithValue(context.Background(), constant.UserIDKey, "admin")
+
+	rollout, err := CreateRollout(ctx, group, "release route1")
+	assert.NoError(t, err)
+	// 其他进程持有租约时不推进，也不发布金丝雀网关
+	u := repo.GatewayGroupRollout
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(rollout.ID)).
+		UpdateSimple(u.LeaseOwner.Value("other"), u.LeaseExpiredAt.Value(time.Now().Add(time.Minute)))
+	assert.NoError(t, err)
+	rollout, stages := advance(t, group, rollout)
+	assert.Equal(t, constant.GroupRolloutStatusPending, rollout.Status)
+	assert.Equal(t, constant.GroupRolloutStageStatusPending, stages[0].Status)
+	current, err := resourcebiz.GetRoute(canaryCtx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusCreateDraft, current.Status)
+
+	// 租约过期后由当前进程抢占推进，结束后释放租约
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(rollout.ID)).
+		UpdateSimple(u.LeaseExpiredAt.Value(time.Now().Add(-time.Second)))
+	assert.NoError(t, err)
+	rollout, _ = advance(t, group, rollout)
+	assert.Equal(t, constant.GroupRolloutStatusSucceeded, rollout.Status, rollout.Error)
+	assert.Equal(t, rolloutLeaseOwner, rollout.LeaseOwner)
+	assert.Nil(t, rollout.LeaseExpiredAt)
+}
+
+func TestRolloutRollbackOnHealthCheckFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	group, canaryCtx, otherCtx, _ := newTestGroup(t, 0, server.URL)
+	ctx := context.WithValue(context.Background(), constant.UserIDKey, "admin")
+
+	rollout, err := CreateRollout(ctx, group, "")
+	assert.NoError(t, err)
+	rollout, stages := advance(t, group, rollout)
+	assert.Equal(t, constant.GroupRolloutStatusRolledBack, rollout.Status)
+	assert.Contains(t, rollout.Error, "stage 1 failed")
+	assert.Equal(t, constant.GroupRolloutStageStatusRolledBack, stages[0].Status)
+	assert.Equal(t, constant.GroupRolloutStageStatusRolledBack, stages[1].Status)
+	assert.Contains(t, stages[1].Error, "503")
+
+	assert.Empty(t, liveRouteKeys(t, canaryCtx))
+	assert.Empty(t, liveRouteKeys(t, otherCtx))
+}
+
+func TestCancelRolloutWhileSoaking(t *testing.T) {
+	group, canaryCtx, otherCtx, _ := newTestGroup(t, 3600, "")
+	ctx := context.WithValue(context.Background(), constant.UserIDKey, "admin")
+
+	rollout, err := CreateRollout(ctx, group, "")
+	assert.NoError(t, err)
+	rollout, stages := advance(t, group, rollout)
+	assert.Equal(t, constant.GroupRolloutStatusSoaking, rollout.Status)
+	assert.Equal(t, constant.GroupRolloutStageStatusRunning, stages[0].Status)
+	assert.Equal(t, constant.GroupRolloutStageStatusPending, stages[1].Status)
+	assert.Len(t, liveRouteKeys(t, canaryCtx), 1)
+
+	// 观察期内再次推进不会继续发布
+	rollout, _ = advance(t, group, rollout)
+	assert.Equal(t, constant.GroupRolloutStatusSoaking, rollout.Status)
+
+	assert.NoError(t, CancelRollout(ctx, rollout))
+	rollout, stages = advance(t, group, rollout)
+	assert.Equal(t, constant.GroupRolloutStatusRolledBack, rollout.Status)
+	assert.Equal(t, constant.GroupRolloutStageStatusRolledBack, stages[0].Status)
+	assert.Equal(t, constant.GroupRolloutStageStatusSkipped, stages[1].Status)
+	assert.Empty(t, liveRouteKeys(t, canaryCtx))
+	assert.Empty(t, liveRouteKeys(t, otherCtx))
+	assert.ErrorIs(t, CancelRollout(ctx, rollout), ErrRolloutFinished)
+}
@@ -30,6 +30,8 @@ import (
 
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	schemabiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/schema"
+	taskbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/task"
+	unifyopbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/unifyop"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
@@ -199,7 +201,15 @@ func PrepareImportUpload(
 	if err != nil {
 		return nil, err
 	}
-	err = ValidateImportedResources(ctx, validationInput.Add, validationInput.AllResourceIDs, allSchemaMap)
+	return validateImportUpload(ctx, validationInput, allSchemaMap)
+}
+
+func validateImportUpload(
+	ctx context.Context,
+	validationInput *importValidationInput,
+	allSchemaMap map[string]any,
+) (*PreparedImportResources, error) {
+	err := ValidateImportedResources(ctx, validationInput.Add, validationInput.AllResourceIDs, allSchemaMap)
 	if err != nil {
 		return nil, fmt.Errorf("add resources validate failed, err: %w", err)
 	}
@@ -213,6 +223,77 @@ func PrepareImportUpload(
 	}, nil
 }
 
+// ImportUploadResources runs the whole web import flow: it builds the
+// uploaded schema models, validates the resources and writes them as drafts.
+func ImportUploadResources(ctx context.Context, resourcesImport *dto.ImportUploadInfo) error {
+	return importUploadResources(ctx, resourcesImport, false)
+}
+
+// ImportUploadResourcesWithExisting runs the same flow as ImportUploadResources
+// but also accepts associations to resources already stored in the current
+// gateway, so a partial upload may reference them without re-uploading.
+func ImportUploadResourcesWithExisting(ctx context.Context, resourcesImport *dto.ImportUploadInfo) error {
+	return importUploadResources(ctx, resourcesImport, true)
+}
+
+func importUploadResources(
+	ctx context.Context,
+	resourcesImport *dto.ImportUploadInfo,
+	withExisting bool,
+) error {
+	allSchemaMap, err := schemabiz.GetCustomizePluginSchemaMap(ctx)
+	if err != nil {
+		return err
+	}
+	addedSchemaMap, err := BuildImportUploadSchemaModels(ctx, resourcesImport.Add, allSchemaMap)
+	if err != nil {
+		return err
+	}
+	updatedSchemaMap, err := BuildImportUploadSchemaModels(ctx, resourcesImport.Update, allSchemaMap)
+	if err != nil {
+		return err
+	}
+	validationInput, err := prepareImportValidationInput(ctx, resourcesImport,
+		map[constant.APISIXResource][]string{})
+	if err != nil {
+		return err
+	}
+	if withExisting {
+		if err := IncludeExistingResourceIDs(ctx, validationInput.AllResourceIDs); err != nil {
+			return err
+		}
+	}
+	handleResult, err := validateImportUpload(ctx, validationInput, allSchemaMap)
+	if err != nil {
+		return err
+	}
+	if err := taskbiz.ReportProgress(ctx, 50, "resources validated"); err != nil {
+		return err
+	}
+	return unifyopbiz.UploadResources(
+		ctx,
+		handleResult.AddResourceTypeMap,
+		handleResult.UpdateResourceTypeMap,
+		addedSchemaMap,
+		updatedSchemaMap,
+	)
+}
+
+// IncludeExistingResourceIDs adds the keys of all resources stored in the
+// current gateway to resourceIDs, so associations to them pass validation.
+func IncludeExistingResourceIDs(ctx context.Context, resourceIDs map[string]struct{}) error {
+	for _, resourceType := range constant.ResourceTypeList {
+		_, existingResourceIDs, err := loadExistingImportResources(ctx, resourceType)
+		if err != nil {
+			return err
+		}
+		for key := range existingResourceIDs {
+			resourceIDs[key] = struct{}{}
+		}
+	}
+	return nil
+}
+
 // applyImportIgnoreFields overlays the configured ignore-field paths from the
 // existing stored config onto the imported config and returns the merged copy.
 func applyImportIgnoreFields(
@@ -0,0 +1,245 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package importflow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+)
+
+// ErrOpenAPIInvalid OpenAPI 文档无法解析或不受支持
+var ErrOpenAPIInvalid = errors.New("invalid openapi document")
+
+const (
+	openAPIPluginsExtension  = "x-apisix-plugins"
+	openAPIUpstreamExtension = "x-apisix-upstream"
+)
+
+// openAPIMethods 按输出顺序排列的 OpenAPI 操作
+var openAPIMethods = []string{"get", "post", "put", "patch", "delete", "head", "options", "trace"}
+
+var (
+	openAPIPathParamRegex = regexp.MustCompile(`\{[^/{}]+\}`)
+	openAPINameRegex      = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+)
+
+type openAPIDocument struct {
+	Swagger  string                                `json:"swagger"`
+	OpenAPI  string                                `json:"openapi"`
+	BasePath string                                `json:"basePath"`
+	Servers  []openAPIServer                       `json:"servers"`
+	Paths    map[string]map[string]json.RawMessage `json:"paths"`
+	Plugins  map[string]json.RawMessage            `json:"x-apisix-plugins"`
+	Upstream json.RawMessage                       `json:"x-apisix-upstream"`
+}
+
+type openAPIServer struct {
+	URL string `json:"url"`
+}
+
+type openAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Summary     string                     `json:"summary"`
+	Plugins     map[string]json.RawMessage `json:"x-apisix-plugins"`
+	Upstream    json.RawMessage            `json:"x-apisix-upstream"`
+}
+
+// ConvertOpenAPISpec 将 OpenAPI 3.x / Swagger 2.0 文档 (json 或 yaml) 转换为待导入的路由列表
+//
+// 每个 path + method 生成一个路由，路由 ID 由 method 与完整 path 生成，重复上传同一文档时会被识别为更新。
+// path 中的参数模板 ({id}) 转换为前缀匹配的 uri 加上 vars 正则精确匹配，不依赖 APISIX 路由器类型。
+// x-apisix-plugins 可声明在文档、path、操作上，按插件名由内向外覆盖；
+// x-apisix-upstream 优先取操作、path 上的声明，其次为 opts.UpstreamID，最后为文档上的声明。
+func ConvertOpenAPISpec(
+	data []byte,
+	opts dto.OpenAPIImportOptions,
+) (map[constant.APISIXResource][]*dto.ImportResourceInfo, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrOpenAPIInvalid, err.Error())
+	}
+	var doc openAPIDocument
+	if err := json.Unmarshal(jsonData, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrOpenAPIInvalid, err.Error())
+	}
+	basePath, err := doc.basePath()
+	if err != nil {
+		return nil, err
+	}
+	if len(doc.Paths) == 0 {
+		return nil, fmt.Errorf("%w: paths is empty", ErrOpenAPIInvalid)
+	}
+
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var routes []*dto.ImportResourceInfo
+	for _, path := range paths {
+		pathItem := doc.Paths[path]
+		var pathPlugins map[string]json.RawMessage
+		if raw, ok := pathItem[openAPIPluginsExtension]; ok {
+			if err := json.Unmarshal(raw, &pathPlugins); err != nil {
+				return nil, fmt.Errorf("%w: %s %s: %s", ErrOpenAPIInvalid, path, openAPIPluginsExtension, err.Error())
+			}
+		}
+		pathUpstream := pathItem[openAPIUpstreamExtension]
+
+		fullPath := basePath + path
+		for _, method := range openAPIMethods {
+			raw, ok := pathItem[method]
+			if !ok {
+				continue
+			}
+			var operation openAPIOperation
+			if err := json.Unmarshal(raw, &operation); err != nil {
+				return nil, fmt.Errorf("%w: %s %s: %s", ErrOpenAPIInvalid, method, path, err.Error())
+			}
+			route, err := buildOpenAPIRoute(&doc, fullPath, method, &operation, pathPlugins, pathUpstream, opts)
+			if err != nil {
+				return nil, err
+			}
+			routes = append(routes, route)
+		}
+	}
+	if len(routes) == 0 {
+		return nil, fmt.Errorf("%w: no operation found", ErrOpenAPIInvalid)
+	}
+	return map[constant.APISIXResource][]*dto.ImportResourceInfo{constant.Route: routes}, nil
+}
+
+// basePath 返回所有路径的公共前缀：Swagger 2.0 的 basePath 或 OpenAPI 3.x 第一个 server url 的路径
+func (d *openAPIDocument) basePath() (string, error) {
+	var basePath string
+	switch {
+	case strings.HasPrefix(d.Swagger, "2."):
+		basePath = d.BasePath
+	case strings.HasPrefix(d.OpenAPI, "3."):
+		// 含有 server 变量的 url 无法确定路径，忽略
+		if len(d.Servers) > 0 && !strings.Contains(d.Servers[0].URL, "{") {
+			serverURL, err := url.Parse(d.Servers[0].URL)
+			if err != nil {
+				return "", fmt.Errorf("%w: server url: %s", ErrOpenAPIInvalid, err.Error())
+			}
+			basePath = serverURL.Path
+		}
+	default:
+		return "", fmt.Errorf("%w: only openapi 3.x and swagger 2.0 are supported", ErrOpenAPIInvalid)
+	}
+	return strings.TrimSuffix(basePath, "/"), nil
+}
+
+func buildOpenAPIRoute(
+	doc *openAPIDocument,
+	path string,
+	method string,
+	operation *openAPIOperation,
+	pathPlugins map[string]json.RawMessage,
+	pathUpstream json.RawMessage,
+	opts dto.OpenAPIImportOptions,
+) (*dto.ImportResourceInfo, error) {
+	method = strings.ToUpper(method)
+	id := idx.GenStableResourceID(constant.Route, method+" "+path)
+	name := operation.OperationID
+	if name == "" {
+		name = strings.Trim(openAPINameRegex.ReplaceAllString(strings.ToLower(method)+path, "_"), "_")
+	}
+	uri, vars := convertOpenAPIPath(path)
+
+	config := map[string]any{
+		"id":      id,
+		"name":    name,
+		"uri":     uri,
+		"methods": []string{method},
+	}
+	if operation.Summary != "" {
+		config["desc"] = operation.Summary
+	}
+	if vars != nil {
+		config["vars"] = vars
+	}
+	plugins := make(map[string]json.RawMessage)
+	for _, layer := range []map[string]json.RawMessage{doc.Plugins, pathPlugins, operation.Plugins} {
+		for pluginName, pluginConfig := range layer {
+			plugins[pluginName] = pluginConfig
+		}
+	}
+	if len(plugins) > 0 {
+		config["plugins"] = plugins
+	}
+	if opts.ServiceID != "" {
+		config["service_id"] = opts.ServiceID
+	}
+	switch {
+	case len(operation.Upstream) > 0:
+		config["upstream"] = operation.Upstream
+	case len(pathUpstream) > 0:
+		config["upstream"] = pathUpstream
+	case opts.UpstreamID != "":
+		config["upstream_id"] = opts.UpstreamID
+	case len(doc.Upstream) > 0:
+		config["upstream"] = doc.Upstream
+	}
+
+	rawConfig, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s %s: %s", ErrOpenAPIInvalid, method, path, err.Error())
+	}
+	return &dto.ImportResourceInfo{
+		ResourceType: constant.Route,
+		ResourceID:   id,
+		Name:         name,
+		Config:       rawConfig,
+	}, nil
+}
+
+// convertOpenAPIPath 将带参数模板的 path 转换为 APISIX 的匹配方式
+//
+// 无参数时直接使用 path；有参数时 uri 取第一个参数之前的前缀加 *，并通过 vars 正则精确匹配整个 path，
+// 例如 /users/{id}/orders 转换为 uri /users/* 与 vars [["uri", "~~", "^/users/[^/]+/orders$"]]。
+func convertOpenAPIPath(path string) (string, [][]string) {
+	locs := openAPIPathParamRegex.FindAllStringIndex(path, -1)
+	if len(locs) == 0 {
+		return path, nil
+	}
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	last := 0
+	for _, loc := range locs {
+		pattern.WriteString(regexp.QuoteMeta(path[last:loc[0]]))
+		pattern.WriteString("[^/]+")
+		last = loc[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(path[last:]))
+	pattern.WriteString("$")
+	return path[:locs[0][0]] + "*", [][]string{{"uri", "~~", pattern.String()}}
+}
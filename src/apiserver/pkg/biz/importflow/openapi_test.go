@@ -0,0 +1,194 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package importflow
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"gorm.io/datatypes"
+
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+)
+
+const testOpenAPI3Spec = `
+openapi: 3.0.3
+info:
+  title: users
+  version: 1.0.0
+servers:
+  - url: https://api.example.com/v1/
+x-apisix-plugins:
+  cors: {}
+paths:
+  /users:
+    get:
+      operationId: listUsers
+      summary: list users
+    post:
+      x-apisix-plugins:
+        limit-count: {count: 10, time_window: 60, key: remote_addr, policy: local}
+  /users/{id}/orders/{order_id}.json:
+    x-apisix-upstream:
+      type: roundrobin
+      nodes: [{host: 10.0.0.1, port: 80, weight: 1}]
+    get:
+      operationId: getOrder
+      x-apisix-plugins:
+        cors: {allow_origins: "https://example.com"}
+`
+
+func TestConvertOpenAPISpec(t *testing.T) {
+	t.Run("openapi 3 yaml", func(t *testing.T) {
+		resources, err := ConvertOpenAPISpec([]byte(testOpenAPI3Spec), dto.OpenAPIImportOptions{
+			ServiceID:  "svc-1",
+			UpstreamID: "up-1",
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+		routes := resources[constant.Route]
+		if !assert.Len(t, routes, 3) {
+			return
+		}
+
+		listUsers := gjson.ParseBytes(routes[0].Config)
+		assert.Equal(t, idx.GenStableResourceID(constant.Route, "GET /v1/users"), routes[0].ResourceID)
+		assert.Equal(t, routes[0].ResourceID, listUsers.Get("id").String())
+		assert.Equal(t, "listUsers", routes[0].Name)
+		assert.Equal(t, "/v1/users", listUsers.Get("uri").String())
+		assert.JSONEq(t, `["GET"]`, listUsers.Get("methods").Raw)
+		assert.Equal(t, "list users", listUsers.Get("desc").String())
+		assert.JSONEq(t, `{"cors":{}}`, listUsers.Get("plugins").Raw)
+		assert.Equal(t, "svc-1", listUsers.Get("service_id").String())
+		assert.Equal(t, "up-1", listUsers.Get("upstream_id").String())
+		assert.False(t, listUsers.Get("vars").Exists())
+
+		createUser := gjson.ParseBytes(routes[1].Config)
+		assert.Equal(t, "post_v1_users", routes[1].Name)
+		assert.JSONEq(t, `{"cors":{},"limit-count":{"count":10,"time_window":60,"key":"remote_addr","policy":"local"}}`,
+			createUser.Get("plugins").Raw)
+
+		getOrder := gjson.ParseBytes(routes[2].Config)
+		assert.Equal(t, "/v1/users/*", getOrder.Get("uri").String())
+		assert.JSONEq(t, `[["uri","~~","^/v1/users/[^/]+/orders/[^/]+\\.json$"]]`, getOrder.Get("vars").Raw)
+		assert.JSONEq(t, `{"cors":{"allow_origins":"https://example.com"}}`, getOrder.Get("plugins").Raw)
+		assert.Equal(t, "roundrobin", getOrder.Get("upstream.type").String())
+		assert.False(t, getOrder.Get("upstream_id").Exists())
+	})
+
+	t.Run("swagger 2 json", func(t *testing.T) {
+		resources, err := ConvertOpenAPISpec([]byte(`{
+			"swagger": "2.0",
+			"basePath": "/api",
+			"x-apisix-upstream": {"type": "roundrobin", "nodes": {"127.0.0.1:8080": 1}},
+			"paths": {"/pets/{petId}": {"delete": {}, "parameters": []}}
+		}`), dto.OpenAPIImportOptions{})
+		if !assert.NoError(t, err) {
+			return
+		}
+		if !assert.Len(t, resources[constant.Route], 1) {
+			return
+		}
+		route := gjson.ParseBytes(resources[constant.Route][0].Config)
+		assert.Equal(t, "delete_api_pets_petId", route.Get("name").String())
+		assert.Equal(t, "/api/pets/*", route.Get("uri").String())
+		assert.JSONEq(t, `["DELETE"]`, route.Get("methods").Raw)
+		assert.Equal(t, "roundrobin", route.Get("upstream.type").String())
+	})
+
+	t.Run("invalid documents", func(t *testing.T) {
+		for _, spec := range []string{
+			`{"openapi": "3.0.0", "paths": {}}`,
+			`{"openapi": "3.0.0", "paths": {"/a": {"parameters": []}}}`,
+			`{"info": {}, "paths": {"/a": {"get": {}}}}`,
+			`:::`,
+		} {
+			_, err := ConvertOpenAPISpec([]byte(spec), dto.OpenAPIImportOptions{})
+			assert.ErrorIs(t, err, ErrOpenAPIInvalid, spec)
+		}
+	})
+}
+
+func TestConvertOpenAPISpecImportFlow(t *testing.T) {
+	gatewayCtx, gateway := setupImportGatewayContext(t, "import-openapi")
+	err := resourcebiz.CreateUpstream(gatewayCtx, model.Upstream{
+		Name: "openapi-upstream",
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID:        "up-openapi",
+			GatewayID: gateway.ID,
+			Config: datatypes.JSON(
+				`{"id":"up-openapi","name":"openapi-upstream","type":"roundrobin",` +
+					`"nodes":[{"host":"127.0.0.1","port":80,"weight":1}]}`,
+			),
+			Status: constant.ResourceStatusCreateDraft,
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	resources, err := ConvertOpenAPISpec([]byte(testOpenAPI3Spec), dto.OpenAPIImportOptions{UpstreamID: "up-openapi"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	indexResult, err := BuildImportIndex(gatewayCtx, resources)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, IncludeExistingResourceIDs(gatewayCtx, indexResult.AllResourceIDs))
+	assert.NoError(t, ValidateImportedResources(
+		gatewayCtx,
+		indexResult.ResourceTypeMap,
+		indexResult.AllResourceIDs,
+		indexResult.AllSchemaMap,
+	))
+	uploadInfo, err := ClassifyImportResources(resources, indexResult.ExistingResourceIDs, indexResult.AddedSchemaMap)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, uploadInfo.Add[constant.Route], 3)
+	if !assert.NoError(t, ImportUploadResourcesWithExisting(gatewayCtx, uploadInfo)) {
+		return
+	}
+
+	// 再次上传同一文档时，路由被识别为更新
+	resources, err = ConvertOpenAPISpec([]byte(testOpenAPI3Spec), dto.OpenAPIImportOptions{UpstreamID: "up-openapi"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	indexResult, err = BuildImportIndex(gatewayCtx, resources)
+	if !assert.NoError(t, err) {
+		return
+	}
+	uploadInfo, err = ClassifyImportResources(resources, indexResult.ExistingResourceIDs, indexResult.AddedSchemaMap)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Empty(t, uploadInfo.Add[constant.Route])
+	assert.Len(t, uploadInfo.Update[constant.Route], 3)
+	var routeConfig json.RawMessage = uploadInfo.Update[constant.Route][0].Config
+	assert.Equal(t, "up-openapi", gjson.GetBytes(routeConfig, "upstream_id").String())
+}
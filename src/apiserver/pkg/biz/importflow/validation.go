@@ -23,7 +23,9 @@ import (
 	"encoding/json"
 	"fmt"
 
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	resourcevalidationbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resourcevalidation"
+	variablebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/variable"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
@@ -54,10 +56,24 @@ func ValidateImportedResources(
 				resourceType,
 				string(r.Config),
 			)
+			configRawForValidation, err = variablebiz.ResolveForGateway(ctx, configRawForValidation)
+			if err != nil {
+				return err
+			}
 
 			if err = databaseValidator.Validate(configRawForValidation); err != nil {
 				return err
 			}
+			// 插件中引用的 secret 需在编辑区或本次导入的资源中
+			secretRefs, err := resourcebiz.ParseSecretReferences(configRawForValidation)
+			if err != nil {
+				return err
+			}
+			for _, ref := range secretRefs {
+				if _, ok := allResourceIDs[fmt.Sprintf(constant.ResourceKeyFormat, constant.Secret, ref.ID)]; !ok {
+					return fmt.Errorf("referenced secret [%s] not found", ref)
+				}
+			}
 
 			var resourceAssociateIDInfo dto.ResourceAssociateID
 			err = json.Unmarshal(r.Config, &resourceAssociateIDInfo)
@@ -108,6 +124,17 @@ func ValidateImportedResources(
 					)
 				}
 			}
+			if resourceAssociateIDInfo.ConsumerID != "" {
+				if _, ok := allResourceIDs[resourceAssociateIDInfo.GetResourceKey(
+					constant.Consumer,
+					resourceAssociateIDInfo.ConsumerID,
+				)]; !ok {
+					return fmt.Errorf(
+						"associated consumer [id:%s] not found",
+						resourceAssociateIDInfo.ConsumerID,
+					)
+				}
+			}
 		}
 	}
 	return nil
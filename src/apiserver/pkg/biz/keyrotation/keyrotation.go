@@ -0,0 +1,263 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package keyrotation 使用当前主密钥重新加密数据库中存储的密文
+package keyrotation
+
+import (
+	"context"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"gorm.io/gorm"
+
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
+)
+
+// Mode 执行模式
+type Mode string
+
+const (
+	// ModeRotate 重新加密并写回数据库
+	ModeRotate Mode = "rotate"
+	// ModeDryRun 只统计需要重新加密的密文，不写数据库
+	ModeDryRun Mode = "dry-run"
+	// ModeVerify 校验所有密文均可解密且已使用主密钥加密
+	ModeVerify Mode = "verify"
+)
+
+// etcdConfigSecretFields 网关 etcd 配置中加密存储的字段，与 Gateway.HandleEtcdConfig 一致
+var etcdConfigSecretFields = []string{"password", "cert_cert", "cert_key", "ca_cert"}
+
+// Stats 单类数据的统计
+type Stats struct {
+	Target  string `json:"target"`  // 数据来源，格式为 {表名}.{字段}
+	Values  int    `json:"values"`  // 密文总数
+	Pending int    `json:"pending"` // 未使用主密钥加密的密文数量，重新加密后为已处理的数量
+	Failed  int    `json:"failed"`  // 解密或写回失败的数量
+}
+
+// Report 执行结果
+type Report struct {
+	Mode         Mode     `json:"mode"`
+	PrimaryKeyID string   `json:"primary_key_id"`
+	Stats        []*Stats `json:"stats"`
+}
+
+// Failed 失败的密文总数
+func (r *Report) Failed() int {
+	failed := 0
+	for _, stats := range r.Stats {
+		failed += stats.Failed
+	}
+	return failed
+}
+
+// Pending 未使用主密钥加密的密文总数
+func (r *Report) Pending() int {
+	pending := 0
+	for _, stats := range r.Stats {
+		pending += stats.Pending
+	}
+	return pending
+}
+
+// Run 按模式处理网关 etcd 配置及 token、webhook 签名密钥、敏感变量的值，
+// 以及资源配置、发布版本快照、发布日志、同步数据、后台任务参数中的加密字段
+//
+// 重新加密只处理未使用主密钥加密的密文，可重复执行；单个密文处理失败时记录到 Failed 并继续
+func Run(ctx context.Context, mode Mode) (*Report, error) {
+	report := &Report{Mode: mode, PrimaryKeyID: cryptography.PrimaryKeyID()}
+	db := database.Client().WithContext(ctx)
+	if err := processGateways(db, mode, report); err != nil {
+		return nil, err
+	}
+	if err := processColumn(db, mode, model.GatewayWebhook{}.TableName(), "id", "secret", "secret != ''",
+		report); err != nil {
+		return nil, err
+	}
+	if err := processColumn(db, mode, model.GatewayVariable{}.TableName(), "id", "value", "secret = ?",
+		report, true); err != nil {
+		return nil, err
+	}
+	for _, resourceType := range constant.ResourceTypeList {
+		if err := processJSONColumn(db, mode, resourcebiz.ResourceTableName(resourceType), "auto_id", "config",
+			report); err != nil {
+			return nil, err
+		}
+	}
+	if err := processJSONColumn(db, mode, model.GatewayReleaseVersion{}.TableName(), "id", "release_data",
+		report); err != nil {
+		return nil, err
+	}
+	if err := processJSONColumn(db, mode, model.GatewayPublishJournal{}.TableName(), "id", "operations",
+		report); err != nil {
+		return nil, err
+	}
+	if err := processJSONColumn(db, mode, model.GatewaySyncData{}.TableName(), "auto_id", "config",
+		report); err != nil {
+		return nil, err
+	}
+	if err := processJSONColumn(db, mode, repo.Task.TableName(), "id", "args", report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// processValue 处理单个密文，返回新的密文及是否需要写回
+func processValue(mode Mode, stats *Stats, key string, ciphertext string) (string, bool) {
+	stats.Values++
+	plain, err := cryptography.DecryptSecret(ciphertext)
+	if err != nil {
+		stats.Failed++
+		logging.Errorf("decrypt %s [key:%s] failed: %s", stats.Target, key, err.Error())
+		return "", false
+	}
+	if !cryptography.NeedsRotation(ciphertext) {
+		return "", false
+	}
+	stats.Pending++
+	if mode != ModeRotate {
+		return "", false
+	}
+	return cryptography.EncryptSecret(plain), true
+}
+
+// secretRow 含密文的行，Key 为主键
+type secretRow struct {
+	Key   string `gorm:"column:row_key"`
+	Value string `gorm:"column:row_value"`
+}
+
+// queryRows 查询表中的主键及指定字段
+func queryRows(db *gorm.DB, table, keyColumn, column, condition string, args ...any) ([]secretRow, error) {
+	var rows []secretRow
+	err := db.Table(table).Select(keyColumn+" AS row_key", column+" AS row_value").
+		Where(condition, args...).Scan(&rows).Error
+	return rows, err
+}
+
+// updateRow 写回重新加密后的字段，不经过 model 钩子；JSON 字段需以 []byte 写入，与 model 的读取方式一致
+func updateRow(db *gorm.DB, stats *Stats, table, keyColumn, column string, row secretRow, value any) {
+	if err := db.Table(table).Where(keyColumn+" = ?", row.Key).Update(column, value).Error; err != nil {
+		stats.Failed++
+		logging.Errorf("update %s [key:%s] failed: %s", stats.Target, row.Key, err.Error())
+	}
+}
+
+// processColumn 处理表中直接存储密文的字段
+func processColumn(
+	db *gorm.DB,
+	mode Mode,
+	table, keyColumn, column, condition string,
+	report *Report,
+	args ...any,
+) error {
+	stats := &Stats{Target: table + "." + column}
+	report.Stats = append(report.Stats, stats)
+	rows, err := queryRows(db, table, keyColumn, column, condition, args...)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if row.Value == "" {
+			continue
+		}
+		if value, changed := processValue(mode, stats, row.Key, row.Value); changed {
+			updateRow(db, stats, table, keyColumn, column, row, value)
+		}
+	}
+	return nil
+}
+
+// processGateways 处理网关的 token 及 etcd 配置中的证书、密码
+func processGateways(db *gorm.DB, mode Mode, report *Report) error {
+	table := model.Gateway{}.TableName()
+	if err := processColumn(db, mode, table, "id", "token", "token != ''", report); err != nil {
+		return err
+	}
+	stats := &Stats{Target: table + ".etcd_config"}
+	report.Stats = append(report.Stats, stats)
+	rows, err := queryRows(db, table, "id", "etcd_config", "etcd_config IS NOT NULL")
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		config := []byte(row.Value)
+		changed := false
+		for _, field := range etcdConfigSecretFields {
+			ciphertext := gjson.GetBytes(config, field).String()
+			if ciphertext == "" {
+				continue
+			}
+			value, ok := processValue(mode, stats, row.Key, ciphertext)
+			if !ok {
+				continue
+			}
+			if config, err = sjson.SetBytes(config, field, value); err != nil {
+				return err
+			}
+			changed = true
+		}
+		if changed {
+			updateRow(db, stats, table, "id", "etcd_config", row, config)
+		}
+	}
+	return nil
+}
+
+// processJSONColumn 处理 JSON 字段（资源配置、发布版本快照、发布日志等）中加密存储的敏感字段
+func processJSONColumn(db *gorm.DB, mode Mode, table, keyColumn, column string, report *Report) error {
+	stats := &Stats{Target: table + "." + column}
+	report.Stats = append(report.Stats, stats)
+	rows, err := queryRows(db, table, keyColumn, column, column+" LIKE ?",
+		"%"+sensitive.EncryptedValuePrefix+"%")
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		total, pending, err := sensitive.Verify([]byte(row.Value))
+		if err != nil {
+			// 遇到第一个解密失败的值即停止，total 不包含该值
+			stats.Values += total + 1
+			stats.Failed++
+			logging.Errorf("verify %s [key:%s] failed: %s", stats.Target, row.Key, err.Error())
+			continue
+		}
+		stats.Values += total
+		stats.Pending += pending
+		if mode != ModeRotate || pending == 0 {
+			continue
+		}
+		config, _, err := sensitive.Rotate([]byte(row.Value))
+		if err != nil {
+			stats.Failed++
+			logging.Errorf("rotate %s [key:%s] failed: %s", stats.Target, row.Key, err.Error())
+			continue
+		}
+		updateRow(db, stats, table, keyColumn, column, row, config)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package keyrotation
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	taskbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/task"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+const (
+	testKey   = "jxi18GX5w2qgHwfZCFpn07q8FScXJOd3"
+	testNonce = "k2dbCGetyusW"
+	newKey    = "AES256Key32Characters12345678901"
+)
+
+func TestMain(m *testing.M) {
+	// init crypto
+	if err := cryptography.Init(testKey, testNonce); err != nil {
+		panic(err)
+	}
+
+	// 初始化embed数据库
+	util.InitEmbedDb()
+
+	os.Exit(m.Run())
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = "key-rotation-gateway"
+	gateway.Token = "gateway-token"
+	assert.NoError(t, repo.Gateway.WithContext(ctx).Create(gateway))
+	gatewayCtx := ginx.SetGatewayInfoToContext(ctx, gateway)
+
+	// 模拟旧版本使用固定 nonce 加密的存量数据
+	legacy, err := cryptography.NewAESGcm([]byte(testKey), []byte(testNonce))
+	assert.NoError(t, err)
+	assert.NoError(t, database.Client().Table(model.Gateway{}.TableName()).Where("id = ?", gateway.ID).
+		Update("token", legacy.EncryptToBase64("gateway-token")).Error)
+
+	webhook := &model.GatewayWebhook{
+		GatewayID: gateway.ID, Name: "key-rotation", URL: "http://127.0.0.1/hook",
+		Secret: cryptography.EncryptSecret("webhook-secret"),
+	}
+	assert.NoError(t, repo.GatewayWebhook.WithContext(ctx).Create(webhook))
+	variable := &model.GatewayVariable{
+		GatewayID: gateway.ID, Name: "API_KEY", Secret: true, Value: cryptography.EncryptSecret("api-key"),
+	}
+	assert.NoError(t, repo.GatewayVariable.WithContext(ctx).Create(variable))
+	plainVariable := &model.GatewayVariable{GatewayID: gateway.ID, Name: "HOST", Value: "httpbin.org"}
+	assert.NoError(t, repo.GatewayVariable.WithContext(ctx).Create(plainVariable))
+	consumer := data.Consumer1WithNoRelation(gateway, constant.ResourceStatusCreateDraft)
+	assert.NoError(t, resourcebiz.CreateConsumer(gatewayCtx, *consumer))
+	journal := &model.GatewayPublishJournal{GatewayID: gateway.ID, Status: constant.PublishJournalStatusSucceeded}
+	assert.NoError(t, journal.SetOperations([]storage.BatchOperation{
+		{Key: "/routes/r1", Value: `{"id":"r1"}`, PrevValue: `{"id":"r0"}`, PrevExists: true},
+	}))
+	assert.NoError(t, repo.GatewayPublishJournal.WithContext(ctx).Create(journal))
+	synced := &model.GatewaySyncData{
+		ID: consumer.ID, GatewayID: gateway.ID, Type: constant.Consumer, Config: consumer.Config,
+	}
+	assert.NoError(t, repo.GatewaySyncData.WithContext(ctx).Create(synced))
+	importArgs := &dto.ImportUploadInfo{Add: map[constant.APISIXResource][]*dto.ImportResourceInfo{
+		constant.Consumer: {{
+			ResourceType: constant.Consumer, ResourceID: consumer.ID, Config: json.RawMessage(consumer.Config),
+		}},
+	}}
+	assert.NoError(t, importArgs.EncryptSensitive())
+	task, err := taskbiz.Enqueue(gatewayCtx, taskbiz.TaskNameResourceImport, importArgs, 1)
+	assert.NoError(t, err)
+	// 同步数据及任务参数中的敏感字段均加密存储
+	assertEncrypted := func(table, column string, id any) {
+		var raw string
+		assert.NoError(t, database.Client().Table(table).Select(column).Where("id = ?", id).Scan(&raw).Error)
+		assert.Contains(t, raw, sensitive.EncryptedValuePrefix)
+		assert.NotContains(t, raw, "auth-one")
+	}
+	assertEncrypted(model.GatewaySyncData{}.TableName(), "config", synced.ID)
+	assertEncrypted(repo.Task.TableName(), "args", task.ID)
+
+	// 切换主密钥，旧密钥保留用于解密
+	assert.NoError(t, cryptography.InitKeyring(testKey, testNonce, "key2026",
+		[]cryptography.Key{{ID: "key2026", Secret: newKey}}))
+	defer func() {
+		_ = cryptography.Init(testKey, testNonce)
+	}()
+
+	// dry-run 不修改数据
+	report, err := Run(ctx, ModeDryRun)
+	assert.NoError(t, err)
+	assert.Equal(t, "key2026", report.PrimaryKeyID)
+	assert.Equal(t, 0, report.Failed())
+	// token、etcd 密码、webhook 签名密钥、敏感变量、key-auth.key、发布日志中写入的值和写入前的值，
+	// 以及同步数据、导入任务参数中的 key-auth.key
+	assert.Equal(t, 9, report.Pending())
+	var token string
+	assert.NoError(t, database.Client().Table(model.Gateway{}.TableName()).Select("token").
+		Where("id = ?", gateway.ID).Scan(&token).Error)
+	assert.Empty(t, cryptography.KeyID(token))
+
+	report, err = Run(ctx, ModeRotate)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, report.Failed())
+	assert.Equal(t, 9, report.Pending())
+
+	report, err = Run(ctx, ModeVerify)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, report.Failed())
+	assert.Equal(t, 0, report.Pending())
+
+	// 重新加密后的数据可正常解密
+	gotGateway, err := repo.Gateway.WithContext(ctx).Where(repo.Gateway.ID.Eq(gateway.ID)).First()
+	assert.NoError(t, err)
+	assert.Equal(t, "gateway-token", gotGateway.Token)
+	assert.Equal(t, "test", gotGateway.EtcdConfig.Password)
+	gotWebhook, err := repo.GatewayWebhook.WithContext(ctx).Where(repo.GatewayWebhook.ID.Eq(webhook.ID)).First()
+	assert.NoError(t, err)
+	assert.Equal(t, "key2026", cryptography.KeyID(gotWebhook.Secret))
+	secret, err := cryptography.DecryptSecret(gotWebhook.Secret)
+	assert.NoError(t, err)
+	assert.Equal(t, "webhook-secret", secret)
+	gotVariable, err := repo.GatewayVariable.WithContext(ctx).Where(repo.GatewayVariable.ID.Eq(plainVariable.ID)).
+		First()
+	assert.NoError(t, err)
+	assert.Equal(t, "httpbin.org", gotVariable.Value)
+	gotConsumer, err := resourcebiz.GetConsumer(gatewayCtx, consumer.ID)
+	assert.NoError(t, err)
+	config, err := sensitive.Decrypt(gotConsumer.Config)
+	assert.NoError(t, err)
+	assert.Equal(t, "auth-one", gjson.GetBytes(config, "plugins.key-auth.key").String())
+	gotJournal, err := repo.GatewayPublishJournal.WithContext(ctx).
+		Where(repo.GatewayPublishJournal.ID.Eq(journal.ID)).First()
+	assert.NoError(t, err)
+	var ops []storage.BatchOperation
+	assert.NoError(t, gotJournal.GetOperations(&ops))
+	if assert.Len(t, ops, 1) {
+		assert.Equal(t, `{"id":"r1"}`, ops[0].Value)
+		assert.Equal(t, `{"id":"r0"}`, ops[0].PrevValue)
+	}
+	gotSynced, err := repo.GatewaySyncData.WithContext(ctx).Where(repo.GatewaySyncData.AutoID.Eq(synced.AutoID)).
+		First()
+	assert.NoError(t, err)
+	assert.Equal(t, "auth-one", gjson.GetBytes(gotSynced.Config, "plugins.key-auth.key").String())
+	gotTask, err := repo.Task.WithContext(ctx).Where(repo.Task.ID.Eq(task.ID)).First()
+	assert.NoError(t, err)
+	var gotArgs dto.ImportUploadInfo
+	assert.NoError(t, json.Unmarshal(gotTask.Args, &gotArgs))
+	assert.NoError(t, gotArgs.DecryptSensitive())
+	assert.Equal(t, "auth-one",
+		gjson.GetBytes(gotArgs.Add[constant.Consumer][0].Config, "plugins.key-auth.key").String())
+
+	// 无法解密的密文在校验时报告失败
+	assert.NoError(t, database.Client().Table(model.GatewayWebhook{}.TableName()).Where("id = ?", webhook.ID).
+		Update("secret", "v1:removed:AAAA").Error)
+	report, err = Run(ctx, ModeVerify)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Failed())
+}
@@ -0,0 +1,182 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package maintenance contains gateway maintenance window helpers.
+package maintenance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// MaintenanceErrors 定义维护窗口相关的错误
+var (
+	ErrMaintenanceWindowNotFound = errors.New("maintenance window not found")
+	ErrInvalidMaintenanceWindow  = errors.New("invalid maintenance window")
+	ErrOutsideMaintenanceWindow  = errors.New(
+		"publish is not allowed outside the maintenance windows of the gateway, " +
+			"set override_maintenance_window to publish anyway")
+)
+
+// now 当前时间，测试时可替换
+var now = time.Now
+
+type overrideCtxKey struct{}
+
+// WithOverride 在 context 中标记本次发布允许在维护窗口外执行
+func WithOverride(ctx context.Context, override bool) context.Context {
+	return context.WithValue(ctx, overrideCtxKey{}, override)
+}
+
+// IsOverride 本次发布是否允许在维护窗口外执行
+func IsOverride(ctx context.Context) bool {
+	override, _ := ctx.Value(overrideCtxKey{}).(bool)
+	return override
+}
+
+// ValidateMaintenanceWindow 校验维护窗口配置
+func ValidateMaintenanceWindow(window *model.GatewayMaintenanceWindow) error {
+	if _, err := window.Location(); err != nil {
+		return fmt.Errorf("%w: unknown timezone %s", ErrInvalidMaintenanceWindow, window.Timezone)
+	}
+	for _, weekday := range window.Weekdays {
+		if weekday < 0 || weekday > 6 {
+			return fmt.Errorf("%w: weekday should be between 0 and 6", ErrInvalidMaintenanceWindow)
+		}
+	}
+	if window.StartTime == window.EndTime {
+		return fmt.Errorf("%w: start time and end time should not be equal", ErrInvalidMaintenanceWindow)
+	}
+	// 校验时间格式
+	if _, err := window.Contains(now()); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidMaintenanceWindow, err.Error())
+	}
+	return nil
+}
+
+// ListMaintenanceWindows 查询网关的维护窗口
+func ListMaintenanceWindows(ctx context.Context, gatewayID int) ([]*model.GatewayMaintenanceWindow, error) {
+	u := repo.GatewayMaintenanceWindow
+	return u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).Order(u.ID).Find()
+}
+
+// GetMaintenanceWindow 获取网关的维护窗口
+func GetMaintenanceWindow(ctx context.Context, gatewayID int, id int) (*model.GatewayMaintenanceWindow, error) {
+	u := repo.GatewayMaintenanceWindow
+	window, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrMaintenanceWindowNotFound
+	}
+	return window, err
+}
+
+// CreateMaintenanceWindow 创建维护窗口
+func CreateMaintenanceWindow(ctx context.Context, window *model.GatewayMaintenanceWindow) error {
+	if err := ValidateMaintenanceWindow(window); err != nil {
+		return err
+	}
+	return repo.GatewayMaintenanceWindow.WithContext(ctx).Create(window)
+}
+
+// UpdateMaintenanceWindow 更新维护窗口
+func UpdateMaintenanceWindow(ctx context.Context, window *model.GatewayMaintenanceWindow) error {
+	if err := ValidateMaintenanceWindow(window); err != nil {
+		return err
+	}
+	return repo.GatewayMaintenanceWindow.WithContext(ctx).Save(window)
+}
+
+// DeleteMaintenanceWindow 删除维护窗口
+func DeleteMaintenanceWindow(ctx context.Context, window *model.GatewayMaintenanceWindow) error {
+	u := repo.GatewayMaintenanceWindow
+	_, err := u.WithContext(ctx).Where(u.ID.Eq(window.ID)).Delete()
+	return err
+}
+
+// InMaintenanceWindow 判断当前是否允许发布：未配置启用的维护窗口，或当前处于任一维护窗口内
+func InMaintenanceWindow(ctx context.Context, gatewayID int) (bool, error) {
+	u := repo.GatewayMaintenanceWindow
+	windows, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.Enabled.Is(true)).Find()
+	if err != nil {
+		return false, err
+	}
+	if len(windows) == 0 {
+		return true, nil
+	}
+	current := now()
+	for _, window := range windows {
+		contains, err := window.Contains(current)
+		if err != nil {
+			return false, err
+		}
+		if contains {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// CheckPublishAllowed 校验当前网关是否允许发布，维护窗口外强制发布时记录审计
+func CheckPublishAllowed(ctx context.Context) error {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if gatewayInfo == nil {
+		return errors.New("gateway not found in context")
+	}
+	allowed, err := InMaintenanceWindow(ctx, gatewayInfo.ID)
+	if err != nil {
+		return err
+	}
+	if allowed {
+		return nil
+	}
+	if !IsOverride(ctx) {
+		return ErrOutsideMaintenanceWindow
+	}
+	return addOverrideAuditLog(ctx, gatewayInfo.ID)
+}
+
+// addOverrideAuditLog 添加维护窗口外强制发布审计
+func addOverrideAuditLog(ctx context.Context, gatewayID int) error {
+	dataAfter, err := json.Marshal([]model.BatchOperationData{{
+		ID:     strconv.Itoa(gatewayID),
+		Config: json.RawMessage(fmt.Sprintf(`{"published_at":%d}`, now().Unix())),
+	}})
+	if err != nil {
+		return err
+	}
+	return repo.OperationAuditLog.WithContext(ctx).Create(&model.OperationAuditLog{
+		GatewayID:     gatewayID,
+		ResourceType:  constant.Gateway,
+		OperationType: constant.OperationTypeMaintenanceOverride,
+		ResourceIDs:   strconv.Itoa(gatewayID),
+		DataBefore:    []byte("[]"),
+		DataAfter:     dataAfter,
+		Operator:      ginx.GetUserIDFromContext(ctx),
+	})
+}
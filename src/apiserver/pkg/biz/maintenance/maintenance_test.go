@@ -0,0 +1,103 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package maintenance
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+func TestMain(m *testing.M) {
+	// 初始化embed数据库
+	util.InitEmbedDb()
+	os.Exit(m.Run())
+}
+
+func TestValidateMaintenanceWindow(t *testing.T) {
+	valid := &model.GatewayMaintenanceWindow{StartTime: "22:00", EndTime: "06:00", Timezone: "UTC"}
+	assert.NoError(t, ValidateMaintenanceWindow(valid))
+
+	invalidList := []*model.GatewayMaintenanceWindow{
+		{StartTime: "22:00", EndTime: "22:00"},
+		{StartTime: "22:00", EndTime: "6:00am"},
+		{StartTime: "22:00", EndTime: "06:00", Timezone: "Mars/Base"},
+		{StartTime: "22:00", EndTime: "06:00", Weekdays: []int64{7}},
+	}
+	for _, window := range invalidList {
+		assert.ErrorIs(t, ValidateMaintenanceWindow(window), ErrInvalidMaintenanceWindow)
+	}
+}
+
+func TestCheckPublishAllowed(t *testing.T) {
+	gatewayInfo := &model.Gateway{ID: 20001}
+	ctx := ginx.SetGatewayInfoToContext(context.Background(), gatewayInfo)
+	ctx = context.WithValue(ctx, constant.UserIDKey, "admin")
+
+	defer func(original func() time.Time) { now = original }(now)
+	now = func() time.Time { return time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC) }
+
+	// 未配置维护窗口时允许发布
+	assert.NoError(t, CheckPublishAllowed(ctx))
+
+	window := &model.GatewayMaintenanceWindow{
+		GatewayID: gatewayInfo.ID,
+		Name:      "nightly",
+		StartTime: "22:00",
+		EndTime:   "06:00",
+		Timezone:  "UTC",
+		Enabled:   true,
+	}
+	assert.NoError(t, CreateMaintenanceWindow(ctx, window))
+	assert.ErrorIs(t, CheckPublishAllowed(ctx), ErrOutsideMaintenanceWindow)
+
+	// 强制发布时记录审计
+	assert.NoError(t, CheckPublishAllowed(WithOverride(ctx, true)))
+	u := repo.OperationAuditLog
+	logs, err := u.WithContext(ctx).Where(
+		u.GatewayID.Eq(gatewayInfo.ID),
+		u.OperationType.Eq(string(constant.OperationTypeMaintenanceOverride)),
+	).Find()
+	assert.NoError(t, err)
+	if assert.Len(t, logs, 1) {
+		assert.Equal(t, "admin", logs[0].Operator)
+	}
+
+	now = func() time.Time { return time.Date(2026, 10, 16, 23, 0, 0, 0, time.UTC) }
+	assert.NoError(t, CheckPublishAllowed(ctx))
+
+	// 停用的维护窗口不生效
+	now = func() time.Time { return time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC) }
+	window.Enabled = false
+	assert.NoError(t, UpdateMaintenanceWindow(ctx, window))
+	assert.NoError(t, CheckPublishAllowed(ctx))
+
+	assert.NoError(t, DeleteMaintenanceWindow(ctx, window))
+	_, err = GetMaintenanceWindow(ctx, gatewayInfo.ID, window.ID)
+	assert.ErrorIs(t, err, ErrMaintenanceWindowNotFound)
+}
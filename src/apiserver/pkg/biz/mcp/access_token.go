@@ -36,6 +36,7 @@ import (
 
 	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
@@ -270,7 +271,15 @@ func CreateMCPAccessToken(ctx context.Context, token *model.MCPAccessToken) erro
 			return err
 		}
 
-		return nil
+		return model.EnqueueWebhookEvent(tx, token.GatewayID, constant.WebhookEventTokenCreated,
+			dto.WebhookTokenEventData{
+				ID:          token.ID,
+				Name:        token.Name,
+				MaskedToken: token.MaskedToken,
+				AccessScope: string(token.AccessScope),
+				ExpiredAt:   token.ExpiredAt.Unix(),
+				Creator:     token.Creator,
+			})
 	}); err != nil {
 		return err
 	}
@@ -27,12 +27,14 @@ import (
 	"gorm.io/gorm"
 
 	publishbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/publish"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 // buildCommonDbQuery 获取通用查询
@@ -120,12 +122,16 @@ func RevertResource(
 		return fmt.Errorf("synced data not found: %w", err)
 	}
 
-	// Update resource with synced config
+	// Update resource with synced config, sensitive fields are encrypted since model hooks are skipped here
+	config, err := sensitive.Encrypt(resourceType, syncedData.Config)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt synced config: %w", err)
+	}
 	result := database.Client().WithContext(ctx).
 		Table(resourcebiz.ResourceTableName(resourceType)).
 		Where("gateway_id = ? AND id = ?", gatewayID, resourceID).
 		Updates(map[string]any{
-			"config": syncedData.Config,
+			"config": datatypes.JSON(config),
 			"status": constant.ResourceStatusSuccess,
 		})
 	if result.Error != nil {
@@ -185,6 +191,7 @@ func PublishResourcesByType(
 
 	// Set gateway info in context
 	ctx = ginx.SetGatewayInfoToContext(ctx, gateway)
+	ctx = releasebiz.WithMeta(ctx, releasebiz.Meta{Trigger: constant.ReleaseTriggerMCP})
 
 	// Use existing PublishResource function
 	return publishbiz.PublishResource(ctx, resourceType, resourceIDs)
@@ -353,6 +360,20 @@ func CheckResourceReferences(
 				})
 			}
 		}
+
+	case constant.Consumer:
+		// Consumers are referenced by credentials via consumer_id
+		credentials, err := resourcebiz.QueryCredentials(ctx, map[string]any{"consumer_id": resourceIDs})
+		if err != nil {
+			return nil, err
+		}
+		for _, credential := range credentials {
+			result[credential.ConsumerID] = append(result[credential.ConsumerID], ResourceReference{
+				ResourceType: constant.Credential.String(),
+				ResourceID:   credential.ID,
+				ResourceName: credential.Name,
+			})
+		}
 	}
 
 	return result, nil
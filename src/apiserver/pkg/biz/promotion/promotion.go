@@ -0,0 +1,305 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+// Package promotion contains helpers to promote editor resources between gateways.
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	importflowbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/importflow"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
+)
+
+// PromotionErrors 定义资源晋级相关的错误
+var (
+	ErrGatewayNotInContext = errors.New("gateway not found in context")
+	ErrSameGateway         = errors.New("源网关不能与目标网关相同")
+	ErrEmptySelection      = errors.New("resource_types、resource_ids 及 labels 不能同时为空")
+	ErrNoResourceSelected  = errors.New("源网关中没有符合筛选条件的资源")
+	ErrPromotionConflict   = errors.New("资源与目标网关中的资源冲突")
+)
+
+// promotedResource 待晋级的源网关资源
+type promotedResource struct {
+	Type       constant.APISIXResource
+	Resource   *model.ResourceCommonModel
+	Dependency bool
+}
+
+// Plan 计算将源网关的资源晋级到 ctx 中的目标网关的计划：按选择条件及依赖关系选出资源，
+// 完成变量替换及配置覆盖后与目标网关编辑区中的资源对比
+func Plan(ctx context.Context, source *model.Gateway, req *dto.PromotionRequest) (*dto.PromotionPlan, error) {
+	target := ginx.GetGatewayInfoFromContext(ctx)
+	if target == nil {
+		return nil, ErrGatewayNotInContext
+	}
+	if source.ID == target.ID {
+		return nil, ErrSameGateway
+	}
+	if len(req.ResourceTypes) == 0 && len(req.ResourceIDs) == 0 && len(req.Labels) == 0 {
+		return nil, ErrEmptySelection
+	}
+	resources, err := selectResources(ginx.SetGatewayInfoToContext(ctx, source), req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resources) == 0 {
+		return nil, ErrNoResourceSelected
+	}
+
+	plan := &dto.PromotionPlan{
+		SourceGatewayID: source.ID,
+		TargetGatewayID: target.ID,
+		Items:           make([]dto.PromotionItem, 0, len(resources)),
+	}
+	targetResources := make(map[constant.APISIXResource][]model.ResourceCommonModel)
+	for _, resource := range resources {
+		existing, ok := targetResources[resource.Type]
+		if !ok {
+			existing, err = resourcebiz.GetResourceByIDs(ctx, resource.Type, []string{})
+			if err != nil {
+				return nil, err
+			}
+			targetResources[resource.Type] = existing
+		}
+		config, err := renderConfig(resource, req)
+		if err != nil {
+			return nil, err
+		}
+		item := diffTargetResource(resource, config, existing)
+		switch item.Action {
+		case constant.PromotionActionCreate:
+			plan.CreatedCount++
+		case constant.PromotionActionUpdate:
+			plan.UpdatedCount++
+		case constant.PromotionActionUnchanged:
+			plan.UnchangedCount++
+		case constant.PromotionActionConflict:
+			plan.ConflictCount++
+		}
+		plan.Items = append(plan.Items, item)
+	}
+	return plan, nil
+}
+
+// Promote 将源网关的资源晋级到 ctx 中的目标网关：新增及有变化的资源以草稿写入目标网关，写入前按目标网关的 schema 校验
+func Promote(ctx context.Context, source *model.Gateway, req *dto.PromotionRequest) (*dto.PromotionPlan, error) {
+	plan, err := Plan(ctx, source, req)
+	if err != nil {
+		return nil, err
+	}
+	if plan.ConflictCount > 0 {
+		return plan, ErrPromotionConflict
+	}
+	uploadInfo := &dto.ImportUploadInfo{
+		Add:    make(map[constant.APISIXResource][]*dto.ImportResourceInfo),
+		Update: make(map[constant.APISIXResource][]*dto.ImportResourceInfo),
+	}
+	for _, item := range plan.Items {
+		importResource := &dto.ImportResourceInfo{
+			ResourceType: item.ResourceType,
+			ResourceID:   item.ResourceID,
+			Name:         item.Name,
+			Config:       item.After,
+		}
+		switch item.Action {
+		case constant.PromotionActionCreate:
+			importResource.Status = constant.UploadStatusAdd
+			uploadInfo.Add[item.ResourceType] = append(uploadInfo.Add[item.ResourceType], importResource)
+		case constant.PromotionActionUpdate:
+			importResource.Status = constant.UploadStatusUpdate
+			uploadInfo.Update[item.ResourceType] = append(uploadInfo.Update[item.ResourceType], importResource)
+		}
+	}
+	if len(uploadInfo.Add) == 0 && len(uploadInfo.Update) == 0 {
+		return plan, nil
+	}
+	if err := importflowbiz.ImportUploadResourcesWithExisting(ctx, uploadInfo); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// selectResources 选出源网关中满足条件的资源及其依赖的资源，待删除的资源不参与晋级
+func selectResources(ctx context.Context, req *dto.PromotionRequest) ([]*promotedResource, error) {
+	sourceResources := make(map[constant.APISIXResource]map[string]*model.ResourceCommonModel)
+	for _, resourceType := range constant.ResourceTypeList {
+		resources, err := resourcebiz.BatchGetResources(ctx, resourceType, []string{})
+		if err != nil {
+			return nil, err
+		}
+		resourceMap := make(map[string]*model.ResourceCommonModel, len(resources))
+		for _, resource := range resources {
+			if resource.Status == constant.ResourceStatusDeleteDraft {
+				continue
+			}
+			resourceMap[resource.ID] = resource
+		}
+		sourceResources[resourceType] = resourceMap
+	}
+
+	selected := make(map[string]*promotedResource)
+	var queue []*promotedResource
+	for _, resourceType := range constant.ResourceTypeList {
+		if len(req.ResourceTypes) > 0 && !slices.Contains(req.ResourceTypes, resourceType) {
+			continue
+		}
+		for id, resource := range sourceResources[resourceType] {
+			if len(req.ResourceIDs) > 0 && !slices.Contains(req.ResourceIDs, id) {
+				continue
+			}
+			if !matchLabels(resource, req.Labels) {
+				continue
+			}
+			promoted := &promotedResource{Type: resourceType, Resource: resource}
+			selected[resource.GetResourceKey(resourceType)] = promoted
+			queue = append(queue, promoted)
+		}
+	}
+
+	// 依赖的资源一并晋级；源网关中不存在的依赖交由目标网关的校验处理
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, field := range constant.ResourceDependencyFields {
+			id := gjson.GetBytes(current.Resource.Config, field.Path).String()
+			if id == "" {
+				continue
+			}
+			resource, ok := sourceResources[field.Type][id]
+			if !ok {
+				continue
+			}
+			key := resource.GetResourceKey(field.Type)
+			if _, ok := selected[key]; ok {
+				continue
+			}
+			promoted := &promotedResource{Type: field.Type, Resource: resource, Dependency: true}
+			selected[key] = promoted
+			queue = append(queue, promoted)
+		}
+	}
+
+	result := make([]*promotedResource, 0, len(selected))
+	for _, resource := range selected {
+		result = append(result, resource)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		ti := slices.Index(constant.ResourceTypeList, result[i].Type)
+		tj := slices.Index(constant.ResourceTypeList, result[j].Type)
+		if ti != tj {
+			return ti < tj
+		}
+		return result[i].Resource.ID < result[j].Resource.ID
+	})
+	return result, nil
+}
+
+// matchLabels 判断资源是否包含所有指定的 label
+func matchLabels(resource *model.ResourceCommonModel, labels map[string]string) bool {
+	for key, value := range labels {
+		if gjson.GetBytes(resource.Config, "labels."+gjson.Escape(key)).String() != value {
+			return false
+		}
+	}
+	return true
+}
+
+// renderConfig 对源资源的配置进行变量替换及配置覆盖
+func renderConfig(resource *promotedResource, req *dto.PromotionRequest) ([]byte, error) {
+	config := string(resource.Resource.Config)
+	for name, value := range req.Variables {
+		// 变量值作为 json 字符串的一部分，需要转义
+		escaped, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		config = strings.ReplaceAll(config, "${"+name+"}", string(escaped[1:len(escaped)-1]))
+	}
+	result := []byte(config)
+	for _, override := range req.Overrides {
+		if override.ResourceType != resource.Type {
+			continue
+		}
+		if override.ResourceID != "" && override.ResourceID != resource.Resource.ID {
+			continue
+		}
+		var err error
+		result, err = sjson.SetRawBytes(result, override.Path, override.Value)
+		if err != nil {
+			return nil, fmt.Errorf("覆盖 %s %s 的 %s 失败: %w",
+				resource.Type, resource.Resource.ID, override.Path, err)
+		}
+	}
+	if !gjson.ValidBytes(result) {
+		return nil, fmt.Errorf("%s %s 替换变量后的配置不合法",
+			resource.Type, resource.Resource.ID)
+	}
+	return result, nil
+}
+
+// diffTargetResource 对比晋级后的配置与目标网关中的资源
+func diffTargetResource(
+	resource *promotedResource,
+	config []byte,
+	existing []model.ResourceCommonModel,
+) dto.PromotionItem {
+	item := dto.PromotionItem{
+		ResourceType: resource.Type,
+		ResourceID:   resource.Resource.ID,
+		Name:         gjson.GetBytes(config, model.GetResourceNameKey(resource.Type)).String(),
+		Dependency:   resource.Dependency,
+		After:        config,
+		Action:       constant.PromotionActionCreate,
+	}
+	key := resource.Resource.GetResourceKey(resource.Type)
+	for _, targetResource := range existing {
+		if targetResource.GetResourceKey(resource.Type) == key {
+			item.Before = []byte(targetResource.Config)
+			item.Action = constant.PromotionActionUpdate
+			if sensitive.IsEqual(item.Before, item.After) {
+				item.Action = constant.PromotionActionUnchanged
+			}
+			return item
+		}
+	}
+	// 目标网关中同名的其他资源无法与晋级的资源共存
+	for _, targetResource := range existing {
+		if item.Name != "" && targetResource.GetName(resource.Type) == item.Name {
+			item.Action = constant.PromotionActionConflict
+			item.Reason = fmt.Sprintf("名称 %s 已被目标网关中的 %s 使用", item.Name, targetResource.ID)
+			item.Before = []byte(targetResource.Config)
+			return item
+		}
+	}
+	return item
+}
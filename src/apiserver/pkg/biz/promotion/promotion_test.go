@@ -0,0 +1,181 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package promotion
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+func TestMain(m *testing.M) {
+	// init crypto
+	err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化embed数据库
+	util.InitEmbedDb()
+
+	os.Exit(m.Run())
+}
+
+func newTestGatewayContext(t *testing.T, name string) context.Context {
+	t.Helper()
+
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = name
+	if err := gatewaybiz.CreateGateway(context.Background(), gateway); err != nil {
+		t.Fatal(err)
+	}
+	ctx := ginx.SetGatewayInfoToContext(context.Background(), gateway)
+	return context.WithValue(ctx, constant.UserIDKey, "admin")
+}
+
+// newTestSource 创建源网关及一个引用上游的路由，路由的 hosts 使用 ${DOMAIN} 变量
+func newTestSource(t *testing.T) (context.Context, *model.Route, *model.Upstream) {
+	t.Helper()
+
+	ctx := newTestGatewayContext(t, strings.ToLower(t.Name())+"-dev")
+	gateway := ginx.GetGatewayInfoFromContext(ctx)
+
+	upstream := data.Upstream1WithNoRelation(gateway, constant.ResourceStatusSuccess)
+	if err := resourcebiz.CreateUpstream(ctx, *upstream); err != nil {
+		t.Fatal(err)
+	}
+	route := data.Route1WithNoRelationResource(gateway, constant.ResourceStatusCreateDraft)
+	route.UpstreamID = upstream.ID
+	route.Config, _ = sjson.DeleteBytes(route.Config, "upstream")
+	route.Config, _ = sjson.SetBytes(route.Config, "hosts", []string{"${DOMAIN}"})
+	if err := resourcebiz.CreateRoute(ctx, *route); err != nil {
+		t.Fatal(err)
+	}
+	// 未被选中的路由不参与晋级
+	other := data.Route2WithNoRelationResource(gateway, constant.ResourceStatusCreateDraft)
+	if err := resourcebiz.CreateRoute(ctx, *other); err != nil {
+		t.Fatal(err)
+	}
+	return ctx, route, upstream
+}
+
+func TestPromote(t *testing.T) {
+	sourceCtx, route, upstream := newTestSource(t)
+	source := ginx.GetGatewayInfoFromContext(sourceCtx)
+	targetCtx := newTestGatewayContext(t, strings.ToLower(t.Name())+"-prod")
+
+	req := &dto.PromotionRequest{
+		SourceGatewayID: source.ID,
+		ResourceIDs:     []string{route.ID},
+		Labels:          map[string]string{"env": "4"},
+		Variables:       map[string]string{"DOMAIN": "api.example.com"},
+		Overrides: []dto.PromotionOverride{{
+			ResourceType: constant.Upstream,
+			Path:         "nodes",
+			Value:        []byte(`[{"host":"prod.internal","port":8080,"weight":1}]`),
+		}},
+	}
+	plan, err := Plan(targetCtx, source, req)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, plan.CreatedCount)
+	assert.Len(t, plan.Items, 2)
+	assert.Equal(t, constant.Route, plan.Items[0].ResourceType)
+	assert.False(t, plan.Items[0].Dependency)
+	assert.Equal(t, constant.Upstream, plan.Items[1].ResourceType)
+	assert.Equal(t, upstream.ID, plan.Items[1].ResourceID)
+	assert.True(t, plan.Items[1].Dependency)
+
+	plan, err = Promote(targetCtx, source, req)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, plan.CreatedCount)
+
+	promotedRoute, err := resourcebiz.GetRoute(targetCtx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusCreateDraft, promotedRoute.Status)
+	assert.Equal(t, ginx.GetGatewayInfoFromContext(targetCtx).ID, promotedRoute.GatewayID)
+	assert.Equal(t, "api.example.com", gjson.GetBytes(promotedRoute.Config, "hosts.0").String())
+	assert.Equal(t, upstream.ID, promotedRoute.UpstreamID)
+	promotedUpstream, err := resourcebiz.GetUpstream(targetCtx, upstream.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "prod.internal", gjson.GetBytes(promotedUpstream.Config, "nodes.0.host").String())
+
+	// 源网关未变化时再次晋级无变更
+	plan, err = Plan(targetCtx, source, req)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, plan.UnchangedCount)
+
+	req.Variables["DOMAIN"] = "www.example.com"
+	plan, err = Promote(targetCtx, source, req)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, plan.UpdatedCount)
+	assert.Equal(t, constant.PromotionActionUpdate, plan.Items[0].Action)
+	assert.Equal(t, "api.example.com", gjson.GetBytes(plan.Items[0].Before, "hosts.0").String())
+	promotedRoute, err = resourcebiz.GetRoute(targetCtx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "www.example.com", gjson.GetBytes(promotedRoute.Config, "hosts.0").String())
+}
+
+func TestPromoteConflict(t *testing.T) {
+	sourceCtx, route, _ := newTestSource(t)
+	source := ginx.GetGatewayInfoFromContext(sourceCtx)
+	targetCtx := newTestGatewayContext(t, strings.ToLower(t.Name())+"-prod")
+
+	// 目标网关中已有同名的其他路由
+	existing := data.Route1WithNoRelationResource(ginx.GetGatewayInfoFromContext(targetCtx),
+		constant.ResourceStatusSuccess)
+	assert.NoError(t, resourcebiz.CreateRoute(targetCtx, *existing))
+
+	req := &dto.PromotionRequest{SourceGatewayID: source.ID, ResourceIDs: []string{route.ID}}
+	plan, err := Promote(targetCtx, source, req)
+	assert.ErrorIs(t, err, ErrPromotionConflict)
+	assert.Equal(t, 1, plan.ConflictCount)
+	assert.Equal(t, constant.PromotionActionConflict, plan.Items[0].Action)
+	assert.Contains(t, plan.Items[0].Reason, existing.ID)
+
+	_, err = resourcebiz.GetRoute(targetCtx, route.ID)
+	assert.Error(t, err)
+}
+
+func TestPlanInvalidSelection(t *testing.T) {
+	sourceCtx, _, _ := newTestSource(t)
+	source := ginx.GetGatewayInfoFromContext(sourceCtx)
+
+	_, err := Plan(sourceCtx, source, &dto.PromotionRequest{ResourceIDs: []string{"x"}})
+	assert.ErrorIs(t, err, ErrSameGateway)
+
+	targetCtx := newTestGatewayContext(t, strings.ToLower(t.Name())+"-prod")
+	_, err = Plan(targetCtx, source, &dto.PromotionRequest{})
+	assert.ErrorIs(t, err, ErrEmptySelection)
+	_, err = Plan(targetCtx, source, &dto.PromotionRequest{Labels: map[string]string{"env": "none"}})
+	assert.ErrorIs(t, err, ErrNoResourceSelected)
+}
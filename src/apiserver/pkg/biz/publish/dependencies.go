@@ -39,6 +39,10 @@ type consumerPublishDependencies struct {
 	ConsumerGroupIDs []string
 }
 
+type credentialPublishDependencies struct {
+	ConsumerIDs []string
+}
+
 type streamRoutePublishDependencies struct {
 	ServiceIDs  []string
 	UpstreamIDs []string
@@ -112,6 +116,17 @@ func collectConsumerPublishDependencies(consumers []*model.Consumer) consumerPub
 	return deps
 }
 
+func collectCredentialPublishDependencies(credentials []*model.Credential) credentialPublishDependencies {
+	deps := credentialPublishDependencies{}
+	consumerIDsSeen := make(map[string]struct{})
+	for _, credential := range credentials {
+		if credential.ConsumerID != "" {
+			deps.ConsumerIDs = appendUniqueString(deps.ConsumerIDs, consumerIDsSeen, credential.ConsumerID)
+		}
+	}
+	return deps
+}
+
 func collectStreamRoutePublishDependencies(streamRoutes []*model.StreamRoute) streamRoutePublishDependencies {
 	deps := streamRoutePublishDependencies{}
 	serviceIDsSeen := make(map[string]struct{})
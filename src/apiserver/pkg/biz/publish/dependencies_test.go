@@ -132,3 +132,17 @@ func TestCollectStreamRoutePublishDependencies(t *testing.T) {
 	assert.Equal(t, []string{"service-1", "service-2"}, deps.ServiceIDs)
 	assert.Equal(t, []string{"upstream-1"}, deps.UpstreamIDs)
 }
+
+func TestCollectCredentialPublishDependencies(t *testing.T) {
+	t.Parallel()
+
+	credentials := []*model.Credential{
+		{ConsumerID: "consumer-1"},
+		{ConsumerID: "consumer-1"},
+		{ConsumerID: ""},
+		{ConsumerID: "consumer-2"},
+	}
+
+	deps := collectCredentialPublishDependencies(credentials)
+	assert.Equal(t, []string{"consumer-1", "consumer-2"}, deps.ConsumerIDs)
+}
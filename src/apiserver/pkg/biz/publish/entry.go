@@ -21,14 +21,19 @@ package publish
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/auditlog"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	taskbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/task"
 	unifyopbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/unifyop"
+	webhookbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/webhook"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
@@ -69,6 +74,10 @@ var publishResourceHandlerMap = map[constant.APISIXResource]publishResourceHandl
 		delete: deleteConsumerGroups,
 		put:    putConsumerGroups,
 	},
+	constant.Credential: {
+		delete: deleteCredentials,
+		put:    putCredentials,
+	},
 	constant.GlobalRule: {
 		delete: deleteGlobalRules,
 		put:    putGlobalRules,
@@ -89,6 +98,10 @@ var publishResourceHandlerMap = map[constant.APISIXResource]publishResourceHandl
 		delete: deleteStreamRoutes,
 		put:    putStreamRoutes,
 	},
+	constant.Secret: {
+		delete: deleteSecrets,
+		put:    putSecrets,
+	},
 }
 
 func init() {
@@ -103,7 +116,21 @@ func init() {
 }
 
 // PublishResource 资源发布
-func PublishResource(ctx context.Context, resourceType constant.APISIXResource, resourceIDs []string) error {
+func PublishResource(ctx context.Context, resourceType constant.APISIXResource, resourceIDs []string) (err error) {
+	defer func() {
+		notifyPublished(ctx, dto.WebhookPublishEventData{
+			Resources: map[constant.APISIXResource][]string{resourceType: resourceIDs},
+		}, err)
+	}()
+	ctx, recorder := publisher.WithOperationRecorder(ctx)
+	if err := publishResource(ctx, resourceType, resourceIDs); err != nil {
+		return err
+	}
+	return recordReleaseVersion(ctx, recorder)
+}
+
+// publishResource 发布单类资源，不记录发布版本
+func publishResource(ctx context.Context, resourceType constant.APISIXResource, resourceIDs []string) error {
 	handlers, ok := publishResourceHandlerMap[resourceType]
 	if !ok {
 		return fmt.Errorf("unsupported resource type: %s", resourceType)
@@ -200,8 +227,22 @@ func publishResourcesWithHandlers(
 }
 
 // PublishAllResource 资源一键发布
-func PublishAllResource(ctx context.Context, gatewayID int) error {
-	for _, resourceType := range constant.ResourceTypeList {
+func PublishAllResource(ctx context.Context, gatewayID int) (err error) {
+	published := false
+	publishedResources := make(map[constant.APISIXResource][]string)
+	defer func() {
+		notifyPublished(ctx, dto.WebhookPublishEventData{All: true, Resources: publishedResources}, err)
+	}()
+	ctx, recorder := publisher.WithOperationRecorder(ctx)
+	for i, resourceType := range constant.ResourceTypeList {
+		// 通过任务队列执行时上报进度，任务被取消时中止发布，已发布的资源仍记录发布版本
+		progress := i * 100 / len(constant.ResourceTypeList)
+		if err := taskbiz.ReportProgress(ctx, progress, "publishing "+resourceType.String()); err != nil {
+			if published {
+				return errors.Join(err, recordReleaseVersion(ctx, recorder))
+			}
+			return err
+		}
 		resources, err := resourcebiz.QueryResource(ctx, resourceType,
 			map[string]any{
 				"gateway_id": gatewayID,
@@ -222,14 +263,93 @@ func PublishAllResource(ctx context.Context, gatewayID int) error {
 		for _, resource := range resources {
 			resourceIDs = append(resourceIDs, resource.ID)
 		}
-		err = PublishResource(ctx, resourceType, resourceIDs)
+		err = publishResource(ctx, resourceType, resourceIDs)
 		if err != nil {
 			return err
 		}
+		published = true
+		publishedResources[resourceType] = resourceIDs
+	}
+	// 一键发布只记录一个发布版本
+	if published {
+		return recordReleaseVersion(ctx, recorder)
 	}
 	return nil
 }
 
+// PublishResources 按资源类型顺序发布指定的草稿资源，只记录一个发布版本
+//
+// 发布资源时会连带发布其依赖的资源，因此每类资源发布前重新查询，跳过已不是草稿状态的资源
+func PublishResources(ctx context.Context, resources map[constant.APISIXResource][]string) (err error) {
+	published := false
+	defer func() {
+		notifyPublished(ctx, dto.WebhookPublishEventData{Resources: resources}, err)
+	}()
+	ctx, recorder := publisher.WithOperationRecorder(ctx)
+	for _, resourceType := range constant.ResourceTypeList {
+		if len(resources[resourceType]) == 0 {
+			continue
+		}
+		drafts, err := resourcebiz.QueryResource(ctx, resourceType,
+			map[string]any{
+				"id": resources[resourceType],
+				"status": []constant.ResourceStatus{
+					constant.ResourceStatusCreateDraft,
+					constant.ResourceStatusUpdateDraft,
+					constant.ResourceStatusDeleteDraft,
+				},
+			}, "")
+		if err != nil {
+			logging.ErrorFWithContext(ctx, "%s query err: %s", resourceType, err.Error())
+			return fmt.Errorf("%s 查询错误: %w", constant.ResourceTypeMap[resourceType], err)
+		}
+		if len(drafts) == 0 {
+			continue
+		}
+		resourceIDs := make([]string, 0, len(drafts))
+		for _, resource := range drafts {
+			resourceIDs = append(resourceIDs, resource.ID)
+		}
+		if err := publishResource(ctx, resourceType, resourceIDs); err != nil {
+			return err
+		}
+		published = true
+	}
+	if published {
+		return recordReleaseVersion(ctx, recorder)
+	}
+	return nil
+}
+
+// recordReleaseVersion 以本次发布写入 etcd 的操作记录发布后的全量快照
+//
+// 回滚、漂移检测及网关组分批发布均依赖最新的发布版本，记录失败时返回错误，避免发布结果显示成功而版本已过期
+func recordReleaseVersion(ctx context.Context, recorder *publisher.OperationRecorder) error {
+	if ginx.GetGatewayInfoFromContext(ctx) == nil {
+		return nil
+	}
+	if _, err := releasebiz.CreateReleaseVersion(ctx, recorder.Operations()); err != nil {
+		logging.ErrorFWithContext(ctx, "record release version err: %s", err.Error())
+		return fmt.Errorf("资源已发布到 etcd，但记录发布版本失败，请重新发布以生成版本: %w", err)
+	}
+	return nil
+}
+
+// notifyPublished 投递发布成功/失败的 webhook 事件，失败不影响发布结果
+func notifyPublished(ctx context.Context, data dto.WebhookPublishEventData, err error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if gatewayInfo == nil {
+		return
+	}
+	event := constant.WebhookEventPublishSucceeded
+	if err != nil {
+		event = constant.WebhookEventPublishFailed
+		data.Error = err.Error()
+	}
+	data.Operator = ginx.GetUserIDFromContext(ctx)
+	webhookbiz.Notify(ctx, gatewayInfo.ID, event, data)
+}
+
 // formatResourceIDNameList 格式化资源 ID 和名称列表
 func formatResourceIDNameList(resources any, resourceType constant.APISIXResource) []string {
 	switch resourceType {
@@ -263,6 +383,26 @@ func formatResourceIDNameList(resources any, resourceType constant.APISIXResourc
 			)
 		}
 		return consumerDetails
+	case constant.Credential:
+		credentials := resources.([]*model.Credential) //nolint:forcetypeassert
+		credentialDetails := make([]string, 0, len(credentials))
+		for _, credential := range credentials {
+			credentialDetails = append(
+				credentialDetails,
+				fmt.Sprintf("%s(%s)", credential.ID, credential.Name),
+			)
+		}
+		return credentialDetails
+	case constant.Secret:
+		secrets := resources.([]*model.Secret) //nolint:forcetypeassert
+		secretDetails := make([]string, 0, len(secrets))
+		for _, secret := range secrets {
+			secretDetails = append(
+				secretDetails,
+				fmt.Sprintf("%s(%s)", secret.ID, secret.Name),
+			)
+		}
+		return secretDetails
 	case constant.Service:
 		services := resources.([]*model.Service) //nolint:forcetypeassert
 		serviceDetails := make([]string, 0, len(services))
@@ -302,6 +442,7 @@ func batchDeleteEtcdResource(ctx context.Context, resourceType constant.APISIXRe
 	if err != nil {
 		return err
 	}
+	defer pub.Close()
 	var ops []publisher.ResourceOperation
 	for _, id := range ids {
 		ops = append(ops, publisher.ResourceOperation{
@@ -433,8 +574,15 @@ func deletePluginMetadatas(ctx context.Context, pluginMetadataIDs []string) erro
 
 // deleteConsumers 删除 consumer
 func deleteConsumers(ctx context.Context, consumerIDs []string) error {
+	credentials, err := resourcebiz.QueryCredentials(ctx, map[string]any{"consumer_id": consumerIDs})
+	if err != nil {
+		return err
+	}
+	if len(credentials) > 0 {
+		return fmt.Errorf("消费者不可删除, 存在关联的凭证资源 %v", formatResourceIDNameList(credentials, constant.Credential))
+	}
 	// 先删除 etcd 的数据
-	err := batchDeleteEtcdResource(ctx, constant.Consumer, consumerIDs)
+	err = batchDeleteEtcdResource(ctx, constant.Consumer, consumerIDs)
 	if err != nil {
 		return err
 	}
@@ -461,6 +609,48 @@ func deleteConsumerGroups(ctx context.Context, consumerGroupIDs []string) error
 	return resourcebiz.BatchDeleteConsumerGroups(ctx, consumerGroupIDs)
 }
 
+// deleteCredentials 删除 credential
+func deleteCredentials(ctx context.Context, credentialIDs []string) error {
+	credentials, err := resourcebiz.QueryCredentials(ctx, map[string]any{"id": credentialIDs})
+	if err != nil {
+		return err
+	}
+	// credential 的 etcd key 包含所属 consumer，需要按 consumer_id 拼接
+	keys := make([]string, 0, len(credentials))
+	for _, credential := range credentials {
+		keys = append(keys, fmt.Sprintf(constant.CredentialKeyFormat, credential.ConsumerID, credential.ID))
+	}
+	// 先删除 etcd 的数据
+	err = batchDeleteEtcdResource(ctx, constant.Credential, keys)
+	if err != nil {
+		return err
+	}
+
+	// 删除数据库数据
+	return resourcebiz.BatchDeleteCredentials(ctx, credentialIDs)
+}
+
+// deleteSecrets 删除 secret
+func deleteSecrets(ctx context.Context, secretIDs []string) error {
+	secrets, err := resourcebiz.QuerySecrets(ctx, map[string]any{"id": secretIDs})
+	if err != nil {
+		return err
+	}
+	// secret 的 etcd key 包含密钥管理器，需要按 manager 拼接
+	keys := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		keys = append(keys, fmt.Sprintf(constant.SecretKeyFormat, secret.Manager, secret.ID))
+	}
+	// 先删除 etcd 的数据
+	err = batchDeleteEtcdResource(ctx, constant.Secret, keys)
+	if err != nil {
+		return err
+	}
+
+	// 删除数据库数据
+	return resourcebiz.BatchDeleteSecrets(ctx, secretIDs)
+}
+
 // deleteGlobalRules 删除 globalRule
 func deleteGlobalRules(ctx context.Context, globalRuleIDs []string) error {
 	// 先删除 etcd 的数据
@@ -827,6 +1017,85 @@ func putConsumerGroups(ctx context.Context, consumerGroupIDs []string) error {
 	)
 }
 
+// putCredentials ...
+func putCredentials(ctx context.Context, credentialIDs []string) error {
+	credentials, err := resourcebiz.QueryCredentials(ctx, map[string]any{"id": credentialIDs})
+	if err != nil {
+		return err
+	}
+	if len(credentials) == 0 {
+		logging.ErrorFWithContext(ctx, "no credentials found for the specified credentialIDs %v", credentialIDs)
+		return fmt.Errorf("未找到指定的消费者凭证资源 IDs %v", credentialIDs)
+	}
+
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	apisixVersion := gatewayInfo.GetAPISIXVersionX()
+
+	deps := collectCredentialPublishDependencies(credentials)
+	var credentialOps []publisher.ResourceOperation
+	for _, credential := range credentials {
+		op, err := buildPublishResourceOperation(publishResourceOperationInput{
+			ResourceType: constant.Credential,
+			ResourceKey:  fmt.Sprintf(constant.CredentialKeyFormat, credential.ConsumerID, credential.ID),
+			BaseInfo: entity.BaseInfo{
+				ID:         credential.ID,
+				CreateTime: credential.CreatedAt.Unix(),
+				UpdateTime: credential.UpdatedAt.Unix(),
+			},
+			Version:   apisixVersion,
+			RawConfig: json.RawMessage(credential.Config),
+		})
+		if err != nil {
+			return err
+		}
+		credentialOps = append(credentialOps, op)
+	}
+
+	// credential 依赖所属 consumer，需先发布 consumer
+	if len(deps.ConsumerIDs) > 0 {
+		err = putConsumers(ctx, deps.ConsumerIDs)
+		if err != nil {
+			return err
+		}
+	}
+	return persistPublishedOperations(ctx, constant.Credential, credentialIDs, credentialOps, "消费者凭证发布错误")
+}
+
+// putSecrets ...
+func putSecrets(ctx context.Context, secretIDs []string) error {
+	secrets, err := resourcebiz.QuerySecrets(ctx, map[string]any{"id": secretIDs})
+	if err != nil {
+		return err
+	}
+	if len(secrets) == 0 {
+		logging.ErrorFWithContext(ctx, "no secrets found for the specified secretIDs %v", secretIDs)
+		return fmt.Errorf("未找到指定的密钥资源 IDs %v", secretIDs)
+	}
+
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	apisixVersion := gatewayInfo.GetAPISIXVersionX()
+
+	var secretOps []publisher.ResourceOperation
+	for _, secret := range secrets {
+		op, err := buildPublishResourceOperation(publishResourceOperationInput{
+			ResourceType: constant.Secret,
+			ResourceKey:  fmt.Sprintf(constant.SecretKeyFormat, secret.Manager, secret.ID),
+			BaseInfo: entity.BaseInfo{
+				ID:         secret.ID,
+				CreateTime: secret.CreatedAt.Unix(),
+				UpdateTime: secret.UpdatedAt.Unix(),
+			},
+			Version:   apisixVersion,
+			RawConfig: json.RawMessage(secret.Config),
+		})
+		if err != nil {
+			return err
+		}
+		secretOps = append(secretOps, op)
+	}
+	return persistPublishedOperations(ctx, constant.Secret, secretIDs, secretOps, "密钥发布错误")
+}
+
 // putGlobalRules ...
 func putGlobalRules(ctx context.Context, globalRuleIDs []string) error {
 	globalRules, err := resourcebiz.QueryGlobalRules(ctx, map[string]any{"id": globalRuleIDs})
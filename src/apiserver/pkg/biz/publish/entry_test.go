@@ -20,6 +20,7 @@ package publish
 
 import (
 	"context"
+	"errors"
 	"os"
 	"strings"
 	"testing"
@@ -33,12 +34,14 @@ import (
 
 	diffbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/diff"
 	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	syncdatabiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/syncdata"
 	unifyopbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/unifyop"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
@@ -190,6 +193,28 @@ func TestPublishResourceTriggersBackgroundSync(t *testing.T) {
 	}
 }
 
+func TestPublishResourceReturnsReleaseVersionError(t *testing.T) {
+	gateway, ctx := newPublishGatewayContext(t, "3.11.0")
+	route := data.Route1WithNoRelationResource(gateway, constant.ResourceStatusCreateDraft)
+	if err := resourcebiz.CreateRoute(ctx, *route); err != nil {
+		t.Fatal(err)
+	}
+
+	versionErr := errors.New("snapshot failed")
+	patches := gomonkey.ApplyFunc(
+		releasebiz.CreateReleaseVersion,
+		func(context.Context, []publisher.RecordedOperation) (*model.GatewayReleaseVersion, error) {
+			return nil, versionErr
+		},
+	)
+	defer patches.Reset()
+
+	// 资源已写入 etcd，但发布版本未记录时发布返回错误
+	err := PublishResource(ctx, constant.Route, []string{route.ID})
+	assert.ErrorIs(t, err, versionErr)
+	assert.Contains(t, err.Error(), "记录发布版本失败")
+}
+
 func TestPublishResourceWritesAuditLog(t *testing.T) {
 	gateway, ctx := newPublishGatewayContext(t, "3.11.0")
 	ctx = context.WithValue(ctx, constant.UserIDKey, "publish-audit-tester")
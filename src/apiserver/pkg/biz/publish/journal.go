@@ -0,0 +1,159 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package publish
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gen"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+)
+
+// PublishJournalErrors 定义分批发布日志相关的错误
+var (
+	ErrPublishJournalNotFound       = errors.New("publish journal not found")
+	ErrPublishJournalNotRecoverable = errors.New("publish journal is not interrupted or is being recovered")
+)
+
+const (
+	// journalInterruptedAfter 写入中的日志超过该时间未更新，视为进程已在写入过程中退出
+	journalInterruptedAfter = time.Minute
+	// journalAutoRevertAfter 后台任务自动回滚超过该时间未处理的中断发布
+	journalAutoRevertAfter = 10 * time.Minute
+)
+
+// ListPublishJournals 查询网关的分批发布日志
+func ListPublishJournals(
+	ctx context.Context,
+	gatewayID int,
+	queryParam map[string]any,
+	page utils.PageParam,
+) ([]*model.GatewayPublishJournal, int64, error) {
+	u := repo.GatewayPublishJournal
+	conds := []gen.Condition{u.GatewayID.Eq(gatewayID)}
+	if status, ok := queryParam["status"].(string); ok && status != "" {
+		conds = append(conds, u.Status.Eq(status))
+	}
+	return u.WithContext(ctx).
+		Omit(u.Operations).
+		Where(conds...).
+		Order(u.ID.Desc()).
+		FindByPage(page.Offset, page.Limit)
+}
+
+// ResumePublishJournal 继续写入中断的发布：已写入的 key 跳过，其余 key 在未被修改时写入
+//
+// 继续写入只保证 etcd 中的配置完整，编辑区的资源仍为待发布状态，需要重新发布以更新状态
+func ResumePublishJournal(ctx context.Context, gateway *model.Gateway, id int64) error {
+	return recoverPublishJournal(ctx, gateway, id, true)
+}
+
+// RevertPublishJournal 回滚中断或回滚失败的发布：仍为本次写入结果的 key 恢复为写入前的值
+func RevertPublishJournal(ctx context.Context, gateway *model.Gateway, id int64) error {
+	return recoverPublishJournal(ctx, gateway, id, false)
+}
+
+// RecoverInterruptedPublishJournals 回滚长时间未处理的中断发布，返回回滚的数量
+//
+// 仅处理写入中的日志：BatchApply 的日志与 commit 在同一事务中标记为写入成功，commit 已提交的发布不会被回滚
+func RecoverInterruptedPublishJournals(ctx context.Context) (int, error) {
+	u := repo.GatewayPublishJournal
+	journals, err := u.WithContext(ctx).Select(u.ID, u.GatewayID).Where(
+		u.Status.Eq(string(constant.PublishJournalStatusRunning)),
+		u.UpdatedAt.Lt(time.Now().Add(-journalAutoRevertAfter)),
+	).Find()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, journal := range journals {
+		gateway, err := repo.Gateway.WithContext(ctx).Where(repo.Gateway.ID.Eq(journal.GatewayID)).Take()
+		if err != nil {
+			logging.ErrorFWithContext(ctx, "get gateway %d of publish journal %d err: %s",
+				journal.GatewayID, journal.ID, err.Error())
+			continue
+		}
+		if err := RevertPublishJournal(ctx, gateway, journal.ID); err != nil {
+			logging.ErrorFWithContext(ctx, "revert publish journal %d err: %s", journal.ID, err.Error())
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// recoverPublishJournal 继续写入或回滚中断的发布，先更新日志的更新时间以避免并发处理
+func recoverPublishJournal(ctx context.Context, gateway *model.Gateway, id int64, resume bool) error {
+	u := repo.GatewayPublishJournal
+	now := time.Now()
+	result, err := u.WithContext(ctx).Where(
+		u.ID.Eq(id),
+		u.GatewayID.Eq(gateway.ID),
+		u.Status.In(string(constant.PublishJournalStatusRunning), string(constant.PublishJournalStatusFailed)),
+		u.UpdatedAt.Lt(now.Add(-journalInterruptedAfter)),
+	).UpdateSimple(u.UpdatedAt.Value(now))
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		if _, err := u.WithContext(ctx).Where(u.ID.Eq(id), u.GatewayID.Eq(gateway.ID)).Take(); err != nil {
+			return ErrPublishJournalNotFound
+		}
+		return ErrPublishJournalNotRecoverable
+	}
+	journal, err := u.WithContext(ctx).Where(u.ID.Eq(id)).Take()
+	if err != nil {
+		return err
+	}
+	var ops []storage.BatchOperation
+	if err := journal.GetOperations(&ops); err != nil {
+		return err
+	}
+
+	etcdStore, err := storage.NewGatewayEtcdStorage(gateway.ID, gateway.EtcdConfig.EtcdConfig)
+	if err != nil {
+		return err
+	}
+	defer etcdStore.Close()
+	status := constant.PublishJournalStatusRolledBack
+	if resume {
+		status = constant.PublishJournalStatusSucceeded
+		err = storage.ResumeBatchOperations(ctx, etcdStore.GetClient(), ops)
+	} else {
+		err = storage.RevertBatchOperations(ctx, etcdStore.GetClient(), ops)
+	}
+	message := ""
+	if err != nil {
+		status = constant.PublishJournalStatusFailed
+		message = err.Error()
+	}
+	if _, updateErr := u.WithContext(ctx).Where(u.ID.Eq(id)).
+		UpdateSimple(u.Status.Value(string(status)), u.Error.Value(message)); updateErr != nil {
+		logging.ErrorFWithContext(ctx, "update publish journal %d err: %s", id, updateErr.Error())
+	}
+	return err
+}
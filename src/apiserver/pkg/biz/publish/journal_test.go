@@ -0,0 +1,129 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package publish
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+)
+
+// newInterruptedJournal 记录一个写入了一半后中断的发布：前 count/2 个 key 已写入
+func newInterruptedJournal(t *testing.T, client *clientv3.Client, name string, count int,
+	updatedAt time.Time,
+) (*model.GatewayPublishJournal, []storage.BatchOperation) {
+	t.Helper()
+
+	ops := make([]storage.BatchOperation, 0, count)
+	for i := range count {
+		key := fmt.Sprintf("%s/routes/%s-%d", gatewayInfo.EtcdConfig.Prefix, name, i)
+		ops = append(ops, storage.BatchOperation{Key: key, Value: `{"id":"new"}`})
+	}
+	for _, op := range ops[:count/2] {
+		if _, err := client.Put(context.Background(), op.Key, op.Value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	journal := &model.GatewayPublishJournal{
+		GatewayID:  gatewayInfo.ID,
+		Status:     constant.PublishJournalStatusRunning,
+		ChunkCount: 1,
+		CreatedAt:  updatedAt,
+		UpdatedAt:  updatedAt,
+	}
+	if err := journal.SetOperations(ops); err != nil {
+		t.Fatal(err)
+	}
+	// 写入的值加密存储
+	assert.NotContains(t, string(journal.Operations), `{\"id\":\"new\"}`)
+	if err := repo.GatewayPublishJournal.WithContext(context.Background()).Create(journal); err != nil {
+		t.Fatal(err)
+	}
+	return journal, ops
+}
+
+func countKeys(t *testing.T, client *clientv3.Client, ops []storage.BatchOperation) int {
+	t.Helper()
+
+	count := 0
+	for _, op := range ops {
+		resp, err := client.Get(context.Background(), op.Key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		count += len(resp.Kvs)
+	}
+	return count
+}
+
+func getJournalStatus(t *testing.T, id int64) constant.PublishJournalStatus {
+	t.Helper()
+
+	u := repo.GatewayPublishJournal
+	journal, err := u.WithContext(context.Background()).Where(u.ID.Eq(id)).Take()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return journal.Status
+}
+
+func TestRecoverPublishJournal(t *testing.T) {
+	client, err := clientv3.New(clientv3.Config{Endpoints: []string{etcdEndpoint}})
+	assert.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+
+	// 仍在写入中的日志不能处理
+	running, _ := newInterruptedJournal(t, client, "running", 4, time.Now())
+	err = RevertPublishJournal(ctx, gatewayInfo, running.ID)
+	assert.ErrorIs(t, err, ErrPublishJournalNotRecoverable)
+	assert.ErrorIs(t, ResumePublishJournal(ctx, gatewayInfo, 0), ErrPublishJournalNotFound)
+
+	resumed, ops := newInterruptedJournal(t, client, "resume", 4, time.Now().Add(-time.Hour))
+	assert.NoError(t, ResumePublishJournal(ctx, gatewayInfo, resumed.ID))
+	assert.Equal(t, constant.PublishJournalStatusSucceeded, getJournalStatus(t, resumed.ID))
+	assert.Equal(t, 4, countKeys(t, client, ops))
+	assert.ErrorIs(t, RevertPublishJournal(ctx, gatewayInfo, resumed.ID), ErrPublishJournalNotRecoverable)
+
+	reverted, ops := newInterruptedJournal(t, client, "revert", 4, time.Now().Add(-time.Hour))
+	count, err := RecoverInterruptedPublishJournals(ctx)
+	assert.NoError(t, err)
+	assert.Positive(t, count)
+	assert.Equal(t, constant.PublishJournalStatusRolledBack, getJournalStatus(t, reverted.ID))
+	assert.Equal(t, 0, countKeys(t, client, ops))
+	assert.Equal(t, constant.PublishJournalStatusRunning, getJournalStatus(t, running.ID))
+
+	journals, total, err := ListPublishJournals(ctx, gatewayInfo.ID,
+		map[string]any{"status": string(constant.PublishJournalStatusRolledBack)},
+		utils.PageParam{Offset: 0, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, reverted.ID, journals[0].ID)
+	assert.Empty(t, journals[0].Operations)
+}
@@ -21,10 +21,12 @@ package publish
 import (
 	"encoding/json"
 
+	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	entity "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/apisix"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
 )
@@ -58,6 +60,10 @@ var publishPayloadCleanupRules = map[constant.APISIXResource][]publishPayloadCle
 		{Field: "id", VersionGated: true},
 		{Field: "name", VersionGated: true},
 	},
+	constant.Credential: {
+		{Field: "name", VersionGated: true},
+		{Field: "consumer_id", VersionGated: true},
+	},
 	constant.GlobalRule: {
 		{Field: "name", VersionGated: true},
 	},
@@ -70,6 +76,11 @@ var publishPayloadCleanupRules = map[constant.APISIXResource][]publishPayloadCle
 	constant.Proto: {
 		{Field: "name", VersionGated: true},
 	},
+	constant.Secret: {
+		// manager 为 etcd key 的一部分，不下发到 APISIX
+		{Field: "name", VersionGated: true},
+		{Field: "manager"},
+	},
 	constant.SSL: {
 		{Field: "name", VersionGated: true},
 		{Field: "validity_start"},
@@ -117,3 +128,41 @@ func buildPublishResourceOperation(input publishResourceOperationInput) (publish
 		Type:   input.ResourceType,
 	}, nil
 }
+
+// BuildResourcePayload 按发布时的规则生成资源写入 etcd 的配置
+func BuildResourcePayload(
+	version constant.APISIXVersion,
+	resourceType constant.APISIXResource,
+	resource *model.ResourceCommonModel,
+) (json.RawMessage, error) {
+	baseInfo := entity.BaseInfo{
+		ID:         resource.ID,
+		CreateTime: resource.CreatedAt.Unix(),
+		UpdateTime: resource.UpdatedAt.Unix(),
+	}
+	switch resourceType {
+	case constant.Route:
+		baseInfo.Name = resource.GetName(resourceType)
+	case constant.Service:
+		// 未关联上游的服务按原配置发布
+		if gjson.GetBytes(resource.Config, "upstream_id").String() == "" {
+			return json.RawMessage(resource.Config), nil
+		}
+	case constant.PluginMetadata:
+		// 插件元数据的 id 为插件名
+		baseInfo.ID = resource.GetName(resourceType)
+	case constant.Consumer:
+		baseInfo.ID = nil
+	}
+	op, err := buildPublishResourceOperation(publishResourceOperationInput{
+		ResourceType: resourceType,
+		ResourceKey:  resource.ID,
+		BaseInfo:     baseInfo,
+		Version:      version,
+		RawConfig:    json.RawMessage(resource.Config),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return op.Config, nil
+}
@@ -23,9 +23,12 @@ import (
 	"fmt"
 
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	variablebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/variable"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 var batchUpdateResourceStatus = resourcebiz.BatchUpdateResourceStatus
@@ -35,6 +38,7 @@ func batchCreateEtcdResource(ctx context.Context, ops []publisher.ResourceOperat
 	if err != nil {
 		return err
 	}
+	defer etcdPublisher.Close()
 	return etcdPublisher.BatchCreate(ctx, ops)
 }
 
@@ -45,6 +49,19 @@ func persistPublishedOperations(
 	ops []publisher.ResourceOperation,
 	errMessage string,
 ) error {
+	ops, err := decryptPublishSensitiveFields(ops)
+	if err != nil {
+		return fmt.Errorf("%s：%w", errMessage, err)
+	}
+	ops, err = resolvePublishVariables(ctx, ops)
+	if err != nil {
+		return fmt.Errorf("%s：%w", errMessage, err)
+	}
+	if resourceType != constant.Secret {
+		if err := putReferencedSecrets(ctx, ops); err != nil {
+			return fmt.Errorf("%s：%w", errMessage, err)
+		}
+	}
 	if err := batchCreateEtcdResource(ctx, ops); err != nil {
 		return err
 	}
@@ -59,3 +76,75 @@ func persistPublishedOperations(
 	}
 	return nil
 }
+
+// decryptPublishSensitiveFields 解密发布配置中加密存储的敏感字段，敏感字段仅在写入 etcd 前解密
+func decryptPublishSensitiveFields(ops []publisher.ResourceOperation) ([]publisher.ResourceOperation, error) {
+	for i := range ops {
+		config, err := sensitive.Decrypt(ops[i].Config)
+		if err != nil {
+			return nil, fmt.Errorf("%s [id:%s]: %w", ops[i].Type, ops[i].Key, err)
+		}
+		ops[i].Config = config
+	}
+	return ops, nil
+}
+
+// resolvePublishVariables 将发布配置中引用的网关变量替换为变量值，引用了未定义的变量时发布失败
+func resolvePublishVariables(
+	ctx context.Context,
+	ops []publisher.ResourceOperation,
+) ([]publisher.ResourceOperation, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if gatewayInfo == nil {
+		return ops, nil
+	}
+	var values map[string]string
+	for i := range ops {
+		if !variablebiz.HasReference(ops[i].Config) {
+			continue
+		}
+		if values == nil {
+			var err error
+			values, err = variablebiz.GetVariableValues(ctx, gatewayInfo.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		config, err := variablebiz.Resolve(ops[i].Config, values)
+		if err != nil {
+			return nil, fmt.Errorf("%s [id:%s]: %w", ops[i].Type, ops[i].Key, err)
+		}
+		ops[i].Config = config
+	}
+	return ops, nil
+}
+
+// putReferencedSecrets 发布配置中插件通过 $secret:// 引用的 secret，保证数据面解析引用时 secret 已存在
+func putReferencedSecrets(ctx context.Context, ops []publisher.ResourceOperation) error {
+	var refs []resourcebiz.SecretReference
+	seen := make(map[string]struct{})
+	for _, op := range ops {
+		opRefs, err := resourcebiz.ParseSecretReferences(op.Config)
+		if err != nil {
+			return fmt.Errorf("%s [id:%s]: %w", op.Type, op.Key, err)
+		}
+		for _, ref := range opRefs {
+			if _, ok := seen[ref.String()]; !ok {
+				seen[ref.String()] = struct{}{}
+				refs = append(refs, ref)
+			}
+		}
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+	if err := resourcebiz.CheckSecretReferencesExist(ctx, refs); err != nil {
+		return err
+	}
+	var secretIDs []string
+	secretIDsSeen := make(map[string]struct{})
+	for _, ref := range refs {
+		secretIDs = appendUniqueString(secretIDs, secretIDsSeen, ref.ID)
+	}
+	return putSecrets(ctx, secretIDs)
+}
@@ -27,8 +27,12 @@ import (
 	gomonkey "github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
 
+	variablebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/variable"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 func TestPersistPublishedOperations(t *testing.T) {
@@ -136,3 +140,91 @@ func TestPersistPublishedOperationsReturnsStatusError(t *testing.T) {
 	assert.ErrorIs(t, err, expectedErr)
 	assert.Contains(t, err.Error(), "插件组发布错误")
 }
+
+func TestPersistPublishedOperationsResolvesVariables(t *testing.T) {
+	gateway := &model.Gateway{ID: 3001}
+	ctx := ginx.SetGatewayInfoToContext(context.Background(), gateway)
+	assert.NoError(t, variablebiz.CreateVariable(ctx, &model.GatewayVariable{
+		GatewayID: gateway.ID, Name: "UPSTREAM_HOST", Value: "httpbin.org", Secret: true,
+	}))
+
+	var created []publisher.ResourceOperation
+	patches := gomonkey.ApplyFunc(
+		batchCreateEtcdResource,
+		func(_ context.Context, ops []publisher.ResourceOperation) error {
+			created = ops
+			return nil
+		},
+	)
+	defer patches.Reset()
+	patches.ApplyFunc(
+		batchUpdateResourceStatus,
+		func(context.Context, constant.APISIXResource, []string, constant.ResourceStatus) error {
+			return nil
+		},
+	)
+
+	err := persistPublishedOperations(
+		ctx,
+		constant.Upstream,
+		[]string{"u-id"},
+		[]publisher.ResourceOperation{{
+			Type:   constant.Upstream,
+			Key:    "u-id",
+			Config: json.RawMessage(`{"nodes":[{"host":"${UPSTREAM_HOST}","port":80,"weight":1}]}`),
+		}},
+		"上游发布错误",
+	)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"nodes":[{"host":"httpbin.org","port":80,"weight":1}]}`, string(created[0].Config))
+
+	// 引用了未定义的变量时不发布
+	created = nil
+	err = persistPublishedOperations(
+		ctx,
+		constant.Upstream,
+		[]string{"u-id"},
+		[]publisher.ResourceOperation{{
+			Type:   constant.Upstream,
+			Key:    "u-id",
+			Config: json.RawMessage(`{"nodes":[{"host":"${UNKNOWN_HOST}","port":80,"weight":1}]}`),
+		}},
+		"上游发布错误",
+	)
+	assert.ErrorIs(t, err, variablebiz.ErrVariableUndefined)
+	assert.Nil(t, created)
+}
+
+func TestPersistPublishedOperationsDecryptsSensitiveFields(t *testing.T) {
+	ctx := ginx.SetGatewayInfoToContext(context.Background(), &model.Gateway{ID: 3002})
+	config, err := sensitive.Encrypt(constant.Consumer,
+		[]byte(`{"username":"jack","plugins":{"key-auth":{"key":"auth-one"}}}`))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(config), "auth-one")
+
+	var created []publisher.ResourceOperation
+	patches := gomonkey.ApplyFunc(
+		batchCreateEtcdResource,
+		func(_ context.Context, ops []publisher.ResourceOperation) error {
+			created = ops
+			return nil
+		},
+	)
+	defer patches.Reset()
+	patches.ApplyFunc(
+		batchUpdateResourceStatus,
+		func(context.Context, constant.APISIXResource, []string, constant.ResourceStatus) error {
+			return nil
+		},
+	)
+
+	err = persistPublishedOperations(
+		ctx,
+		constant.Consumer,
+		[]string{"c-id"},
+		[]publisher.ResourceOperation{{Type: constant.Consumer, Key: "jack", Config: json.RawMessage(config)}},
+		"消费者发布错误",
+	)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"username":"jack","plugins":{"key-auth":{"key":"auth-one"}}}`, string(created[0].Config))
+}
@@ -0,0 +1,864 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package release contains gateway release version snapshot, diff and rollback helpers.
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	driftbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/drift"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	unifyopbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/unifyop"
+	variablebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/variable"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/goroutinex"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
+)
+
+// ReleaseVersionErrors 定义发布版本相关的错误
+var (
+	ErrReleaseVersionNotFound = errors.New("发布版本不存在")
+	ErrGatewayNotInContext    = errors.New("gateway not found in context")
+)
+
+// errLatestReleaseVersionChanged 生成快照后网关的最新版本发生变化，需要基于新的最新版本重新生成
+var errLatestReleaseVersionChanged = errors.New("最新发布版本已变化")
+
+// createReleaseVersionRetries 多个实例并发发布时版本号冲突的重试次数
+const createReleaseVersionRetries = 3
+
+type releaseMetaCtxKey struct{}
+
+// Meta 发布版本元信息：触发来源及变更说明
+type Meta struct {
+	Trigger   constant.ReleaseTrigger
+	Changelog string
+}
+
+// WithMeta 将发布版本元信息写入 context，发布完成后记录版本时使用
+func WithMeta(ctx context.Context, meta Meta) context.Context {
+	return context.WithValue(ctx, releaseMetaCtxKey{}, meta)
+}
+
+// GetMeta 从 context 中获取发布版本元信息
+func GetMeta(ctx context.Context) Meta {
+	meta, ok := ctx.Value(releaseMetaCtxKey{}).(Meta)
+	if !ok {
+		return Meta{}
+	}
+	return meta
+}
+
+// CreateReleaseVersion 记录发布后网关生效资源的全量快照：在最新版本的快照上应用本次发布写入 etcd 的操作 ops，
+// 并关闭这些 key 的漂移记录。不重新读取 etcd，避免把其他 key 上未经发布的修改当作新的漂移检测基线；
+// 网关还没有发布版本时以 etcd 当前的配置为快照
+func CreateReleaseVersion(
+	ctx context.Context,
+	ops []publisher.RecordedOperation,
+) (*model.GatewayReleaseVersion, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if gatewayInfo == nil {
+		return nil, ErrGatewayNotInContext
+	}
+	written, err := buildWrittenResources(ctx, gatewayInfo, ops)
+	if err != nil {
+		return nil, err
+	}
+	if err := attachEditorConfigs(ctx, written); err != nil {
+		return nil, err
+	}
+	version, err := createReleaseVersion(ctx, 0, func(latest *model.GatewayReleaseVersion) (
+		[]model.ReleaseResource, error,
+	) {
+		if latest == nil {
+			resources, err := ListLiveResources(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return resources, attachEditorConfigs(ctx, resources)
+		}
+		resources, err := latest.GetReleaseResources()
+		if err != nil {
+			return nil, err
+		}
+		return applyWrittenResources(resources, ops, written), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(ops))
+	for _, op := range ops {
+		keys = append(keys, op.GetKey())
+	}
+	if err := driftbiz.ResolveDriftRecordsByKeys(ctx, gatewayInfo.ID, keys); err != nil {
+		logging.ErrorFWithContext(ctx, "resolve drift records of gateway %d err: %s", gatewayInfo.ID, err.Error())
+	}
+	return version, nil
+}
+
+// buildWrittenResources 将本次发布写入 etcd 的值转换为快照中的资源
+func buildWrittenResources(
+	ctx context.Context,
+	gatewayInfo *model.Gateway,
+	ops []publisher.RecordedOperation,
+) ([]model.ReleaseResource, error) {
+	prefix := gatewayInfo.GetEtcdPrefixForList()
+	kvList := make([]storage.KeyValuePair, 0, len(ops))
+	for _, op := range ops {
+		if op.Delete {
+			continue
+		}
+		kvList = append(kvList, storage.KeyValuePair{Key: prefix + op.GetKey(), Value: string(op.Config)})
+	}
+	if len(kvList) == 0 {
+		return nil, nil
+	}
+	return buildReleaseResources(ctx, gatewayInfo, kvList)
+}
+
+// attachEditorConfigs 为快照中的资源记录编辑区中已发布的配置（保留变量引用），编辑区中有未发布修改的资源不记录
+func attachEditorConfigs(ctx context.Context, resources []model.ReleaseResource) error {
+	typeIndexes := make(map[constant.APISIXResource][]int)
+	for i := range resources {
+		typeIndexes[resources[i].Type] = append(typeIndexes[resources[i].Type], i)
+	}
+	for resourceType, indexes := range typeIndexes {
+		ids := make([]string, 0, len(indexes))
+		for _, i := range indexes {
+			ids = append(ids, resources[i].ID)
+		}
+		editorResources, err := resourcebiz.BatchGetResources(ctx, resourceType, ids)
+		if err != nil {
+			return err
+		}
+		editorConfigs := make(map[string]json.RawMessage, len(editorResources))
+		for _, resource := range editorResources {
+			if resource.Status == constant.ResourceStatusSuccess {
+				editorConfigs[resource.ID] = json.RawMessage(resource.Config)
+			}
+		}
+		for _, i := range indexes {
+			resources[i].EditorConfig = editorConfigs[resources[i].ID]
+		}
+	}
+	return nil
+}
+
+// applyWrittenResources 在 base 快照上应用本次发布的删除操作及写入的资源
+func applyWrittenResources(
+	base []model.ReleaseResource,
+	ops []publisher.RecordedOperation,
+	written []model.ReleaseResource,
+) []model.ReleaseResource {
+	resourceMap := make(map[string]model.ReleaseResource, len(base)+len(written))
+	for _, resource := range base {
+		resourceMap[resource.Key] = resource
+	}
+	for _, op := range ops {
+		if op.Delete {
+			delete(resourceMap, op.GetKey())
+		}
+	}
+	for _, resource := range written {
+		resourceMap[resource.Key] = resource
+	}
+	resources := make([]model.ReleaseResource, 0, len(resourceMap))
+	for _, resource := range resourceMap {
+		resources = append(resources, resource)
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Key < resources[j].Key
+	})
+	return resources
+}
+
+// createReleaseVersion 以 build 基于最新版本（不存在时为 nil）生成的快照记录新版本
+//
+// 多个实例并发发布时，最新版本在生成快照后发生变化或版本号唯一索引冲突的事务失败，重新生成快照及版本号
+func createReleaseVersion(
+	ctx context.Context,
+	rollbackFromID int64,
+	build func(latest *model.GatewayReleaseVersion) ([]model.ReleaseResource, error),
+) (*model.GatewayReleaseVersion, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if gatewayInfo == nil {
+		return nil, ErrGatewayNotInContext
+	}
+	meta := GetMeta(ctx)
+	var version *model.GatewayReleaseVersion
+	var err error
+	for i := 0; ; i++ {
+		version = &model.GatewayReleaseVersion{
+			GatewayID:      gatewayInfo.ID,
+			TriggerSource:  meta.Trigger,
+			Changelog:      meta.Changelog,
+			RollbackFromID: rollbackFromID,
+			BaseModel: model.BaseModel{
+				Creator: ginx.GetUserIDFromContext(ctx),
+				Updater: ginx.GetUserIDFromContext(ctx),
+			},
+		}
+		err = createReleaseVersionOnce(ctx, gatewayInfo.ID, version, build)
+		if err == nil || i >= createReleaseVersionRetries-1 ||
+			!(isDuplicateReleaseVersionErr(err) || errors.Is(err, errLatestReleaseVersionChanged)) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+// createReleaseVersionOnce 基于当前最新版本生成快照，并在锁定最新版本的事务中确认其未变化后写入新版本
+func createReleaseVersionOnce(
+	ctx context.Context,
+	gatewayID int,
+	version *model.GatewayReleaseVersion,
+	build func(latest *model.GatewayReleaseVersion) ([]model.ReleaseResource, error),
+) error {
+	latest, err := GetLatestReleaseVersion(ctx, gatewayID)
+	if err != nil {
+		return err
+	}
+	var latestID int64
+	if latest != nil {
+		latestID = latest.ID
+		if latest, err = GetReleaseVersion(ctx, gatewayID, latestID); err != nil {
+			return err
+		}
+	}
+	resources, err := build(latest)
+	if err != nil {
+		return err
+	}
+	if err := version.SetReleaseResources(resources); err != nil {
+		return err
+	}
+	return repo.Q.Transaction(func(tx *repo.Query) error {
+		next, lockedID, err := nextReleaseVersion(ctx, tx, gatewayID)
+		if err != nil {
+			return err
+		}
+		if lockedID != latestID {
+			return errLatestReleaseVersionChanged
+		}
+		version.Version = next
+		return tx.GatewayReleaseVersion.WithContext(ctx).Create(version)
+	})
+}
+
+// nextReleaseVersion 在事务中锁定网关最新的发布版本，返回下一个版本号及最新版本的 ID（不存在时为 0）
+func nextReleaseVersion(ctx context.Context, tx *repo.Query, gatewayID int) (string, int64, error) {
+	u := tx.GatewayReleaseVersion
+	latest, err := u.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select(u.ID, u.Version).
+		Where(u.GatewayID.Eq(gatewayID)).
+		Order(u.ID.Desc()).
+		Limit(1).
+		Find()
+	if err != nil {
+		return "", 0, err
+	}
+	if len(latest) == 0 {
+		return "v1", 0, nil
+	}
+	number, err := strconv.Atoi(strings.TrimPrefix(latest[0].Version, "v"))
+	if err != nil {
+		return "", 0, fmt.Errorf("发布版本号 %s 无效: %w", latest[0].Version, err)
+	}
+	return fmt.Sprintf("v%d", number+1), latest[0].ID, nil
+}
+
+// isDuplicateReleaseVersionErr 判断是否为版本号唯一索引冲突
+func isDuplicateReleaseVersionErr(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "idx_release_version_unique") ||
+		strings.Contains(msg, "gateway_release_version.gateway_id, gateway_release_version.version")
+}
+
+// ListLiveResources 获取网关在 etcd 中当前生效的全部资源
+func ListLiveResources(ctx context.Context) ([]model.ReleaseResource, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if gatewayInfo == nil {
+		return nil, ErrGatewayNotInContext
+	}
+	etcdStore, err := storage.NewGatewayEtcdStorage(gatewayInfo.ID, gatewayInfo.EtcdConfig.EtcdConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer etcdStore.Close()
+
+	prefix := gatewayInfo.GetEtcdPrefixForList()
+	kvList, err := etcdStore.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	return buildReleaseResources(ctx, gatewayInfo, kvList)
+}
+
+// buildReleaseResources 将 etcd 中的 key-value 转换为按 key 排序的快照资源
+func buildReleaseResources(
+	ctx context.Context,
+	gatewayInfo *model.Gateway,
+	kvList []storage.KeyValuePair,
+) ([]model.ReleaseResource, error) {
+	prefix := gatewayInfo.GetEtcdPrefixForList()
+	kvValueMap := make(map[string]string, len(kvList))
+	for _, kv := range kvList {
+		kvValueMap[strings.TrimPrefix(kv.Key, prefix)] = kv.Value
+	}
+	syncedResources, err := unifyopbiz.BuildSyncedResourcesFromKVs(ctx, gatewayInfo, kvList)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]model.ReleaseResource, 0, len(syncedResources))
+	for _, syncedResource := range syncedResources {
+		key := syncedResource.GetEtcdKey()
+		value, ok := kvValueMap[key]
+		if !ok {
+			continue
+		}
+		resources = append(resources, model.ReleaseResource{
+			Type:   syncedResource.Type,
+			ID:     syncedResource.ID,
+			Name:   syncedResource.GetName(),
+			Key:    key,
+			Config: json.RawMessage(syncedResource.Config),
+			Value:  json.RawMessage(value),
+		})
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Key < resources[j].Key
+	})
+	return resources, nil
+}
+
+// ListReleaseVersions 分页查询网关发布版本（不包含快照数据）
+func ListReleaseVersions(
+	ctx context.Context,
+	gatewayID int,
+	page utils.PageParam,
+) ([]*model.GatewayReleaseVersion, int64, error) {
+	u := repo.GatewayReleaseVersion
+	return u.WithContext(ctx).
+		Omit(u.ReleaseData).
+		Where(u.GatewayID.Eq(gatewayID)).
+		Order(u.ID.Desc()).
+		FindByPage(page.Offset, page.Limit)
+}
+
+// GetReleaseVersion 获取网关发布版本详情
+func GetReleaseVersion(ctx context.Context, gatewayID int, id int64) (*model.GatewayReleaseVersion, error) {
+	u := repo.GatewayReleaseVersion
+	version, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReleaseVersionNotFound
+		}
+		return nil, err
+	}
+	return version, nil
+}
+
+// GetLatestReleaseVersion 获取网关最新的发布版本，不存在时返回 nil
+func GetLatestReleaseVersion(ctx context.Context, gatewayID int) (*model.GatewayReleaseVersion, error) {
+	u := repo.GatewayReleaseVersion
+	versions, err := u.WithContext(ctx).
+		Omit(u.ReleaseData).
+		Where(u.GatewayID.Eq(gatewayID)).
+		Order(u.ID.Desc()).
+		Limit(1).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, nil
+	}
+	return versions[0], nil
+}
+
+// DiffReleaseVersion 对比两个发布版本，compareID 为 0 时与当前 etcd 生效的配置对比
+//
+// 返回的差异以 compareID 对应的配置为基准，描述切换到 id 对应版本时的变更
+func DiffReleaseVersion(ctx context.Context, id int64, compareID int64) (*dto.ReleaseVersionDiff, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if gatewayInfo == nil {
+		return nil, ErrGatewayNotInContext
+	}
+	target, err := GetReleaseVersion(ctx, gatewayInfo.ID, id)
+	if err != nil {
+		return nil, err
+	}
+	targetResources, err := target.GetReleaseResources()
+	if err != nil {
+		return nil, err
+	}
+	var baseResources []model.ReleaseResource
+	if compareID == 0 {
+		baseResources, err = ListLiveResources(ctx)
+	} else {
+		var base *model.GatewayReleaseVersion
+		base, err = GetReleaseVersion(ctx, gatewayInfo.ID, compareID)
+		if err == nil {
+			baseResources, err = base.GetReleaseResources()
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	diff := DiffReleaseResources(baseResources, targetResources)
+	diff.BaseVersionID = compareID
+	diff.TargetVersionID = id
+	return diff, nil
+}
+
+// DiffReleaseResources 对比两份资源快照，返回从 base 切换到 target 的变更
+func DiffReleaseResources(base, target []model.ReleaseResource) *dto.ReleaseVersionDiff {
+	diff := &dto.ReleaseVersionDiff{
+		Changes: make([]dto.ReleaseResourceDiff, 0),
+	}
+	baseMap := make(map[string]model.ReleaseResource, len(base))
+	for _, resource := range base {
+		baseMap[resource.Key] = resource
+	}
+	targetMap := make(map[string]model.ReleaseResource, len(target))
+	for _, resource := range target {
+		targetMap[resource.Key] = resource
+		baseResource, ok := baseMap[resource.Key]
+		if !ok {
+			diff.AddedCount++
+			diff.Changes = append(diff.Changes, newReleaseResourceDiff(resource, constant.OperationTypeCreate,
+				nil, resource.Config))
+			continue
+		}
+		if jsonx.IsJSONEqual(baseResource.Config, resource.Config) {
+			continue
+		}
+		diff.UpdatedCount++
+		diff.Changes = append(diff.Changes, newReleaseResourceDiff(resource, constant.OperationTypeUpdate,
+			baseResource.Config, resource.Config))
+	}
+	for _, resource := range base {
+		if _, ok := targetMap[resource.Key]; ok {
+			continue
+		}
+		diff.DeletedCount++
+		diff.Changes = append(diff.Changes, newReleaseResourceDiff(resource, constant.OperationTypeDelete,
+			resource.Config, nil))
+	}
+	sort.SliceStable(diff.Changes, func(i, j int) bool {
+		return diff.Changes[i].Key < diff.Changes[j].Key
+	})
+	return diff
+}
+
+// MaskReleaseResources 脱敏快照资源的编辑区配置及 etcd 原始配置中的敏感字段，用于接口返回
+func MaskReleaseResources(resources []model.ReleaseResource) []model.ReleaseResource {
+	masked := make([]model.ReleaseResource, 0, len(resources))
+	for _, resource := range resources {
+		resource.Config = sensitive.Mask(resource.Type, resource.Config)
+		resource.Value = sensitive.Mask(resource.Type, resource.Value)
+		resource.EditorConfig = sensitive.Mask(resource.Type, resource.EditorConfig)
+		masked = append(masked, resource)
+	}
+	return masked
+}
+
+// newReleaseResourceDiff 构造资源变更，配置中的敏感字段脱敏后返回
+func newReleaseResourceDiff(
+	resource model.ReleaseResource,
+	action constant.OperationType,
+	before, after json.RawMessage,
+) dto.ReleaseResourceDiff {
+	return dto.ReleaseResourceDiff{
+		ResourceType: resource.Type,
+		ResourceID:   resource.ID,
+		Name:         resource.Name,
+		Key:          resource.Key,
+		Action:       action,
+		Before:       sensitive.Mask(resource.Type, before),
+		After:        sensitive.Mask(resource.Type, after),
+	}
+}
+
+// RollbackReleaseVersion 将数据面 (etcd) 与编辑区一键回滚到指定版本，并记录一个新的发布版本
+func RollbackReleaseVersion(ctx context.Context, id int64) (*model.GatewayReleaseVersion, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if gatewayInfo == nil {
+		return nil, ErrGatewayNotInContext
+	}
+	target, err := GetReleaseVersion(ctx, gatewayInfo.ID, id)
+	if err != nil {
+		return nil, err
+	}
+	meta := GetMeta(ctx)
+	if meta.Changelog == "" {
+		meta.Changelog = fmt.Sprintf("回滚至版本 %s", target.Version)
+	}
+	targetResources, err := target.GetReleaseResources()
+	if err != nil {
+		return nil, err
+	}
+	return applyReleaseVersion(WithMeta(ctx, meta), target, targetResources, constant.OperationTypeRollback, target.ID)
+}
+
+// ApplyReleaseVersion 将其他网关的发布版本应用到 ctx 中的网关，并记录一个新的发布版本，用于网关组分批发布
+//
+// 引用了变量的资源按 ctx 中网关的变量重新替换，编辑区保留变量引用
+func ApplyReleaseVersion(
+	ctx context.Context,
+	source *model.GatewayReleaseVersion,
+) (*model.GatewayReleaseVersion, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	if gatewayInfo == nil {
+		return nil, ErrGatewayNotInContext
+	}
+	resources, err := source.GetReleaseResources()
+	if err != nil {
+		return nil, err
+	}
+	resources, err = rebindVariables(ctx, gatewayInfo.ID, resources)
+	if err != nil {
+		return nil, err
+	}
+	return applyReleaseVersion(ctx, source, resources, constant.OperationTypeGroupRollout, 0)
+}
+
+// rebindVariables 按网关的变量重新替换快照中引用了变量的资源：编辑区配置中引用了变量的顶层字段
+// 覆盖 etcd 配置及 diff 配置中的同名字段后，再替换为该网关的变量值
+func rebindVariables(
+	ctx context.Context,
+	gatewayID int,
+	resources []model.ReleaseResource,
+) ([]model.ReleaseResource, error) {
+	var values map[string]string
+	for i := range resources {
+		if len(resources[i].EditorConfig) == 0 || !variablebiz.HasReference(resources[i].EditorConfig) {
+			continue
+		}
+		if values == nil {
+			var err error
+			if values, err = variablebiz.GetVariableValues(ctx, gatewayID); err != nil {
+				return nil, err
+			}
+		}
+		value, config := resources[i].Value, resources[i].Config
+		var err error
+		gjson.ParseBytes(resources[i].EditorConfig).ForEach(func(key, field gjson.Result) bool {
+			if !variablebiz.HasReference(json.RawMessage(field.Raw)) {
+				return true
+			}
+			path := gjson.Escape(key.String())
+			if gjson.GetBytes(value, path).Exists() {
+				if value, err = sjson.SetRawBytes(value, path, []byte(field.Raw)); err != nil {
+					return false
+				}
+			}
+			if gjson.GetBytes(config, path).Exists() {
+				if config, err = sjson.SetRawBytes(config, path, []byte(field.Raw)); err != nil {
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resources[i].Value, err = variablebiz.Resolve(value, values); err != nil {
+			return nil, fmt.Errorf("%s [id:%s]: %w", resources[i].Type, resources[i].ID, err)
+		}
+		if resources[i].Config, err = variablebiz.Resolve(config, values); err != nil {
+			return nil, fmt.Errorf("%s [id:%s]: %w", resources[i].Type, resources[i].ID, err)
+		}
+	}
+	return resources, nil
+}
+
+// applyReleaseVersion 将数据面 (etcd) 与编辑区切换到 target 版本的快照 targetResources，并记录一个新的发布版本
+func applyReleaseVersion(
+	ctx context.Context,
+	target *model.GatewayReleaseVersion,
+	targetResources []model.ReleaseResource,
+	operationType constant.OperationType,
+	rollbackFromID int64,
+) (*model.GatewayReleaseVersion, error) {
+	gatewayInfo := ginx.GetGatewayInfoFromContext(ctx)
+	liveResources, err := ListLiveResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := GetLatestReleaseVersion(ctx, gatewayInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := publisher.NewEtcdPublisher(ctx, gatewayInfo)
+	if err != nil {
+		return nil, err
+	}
+	defer pub.Close()
+	// 先切换数据面，再在事务中切换编辑区，编辑区切换失败时回滚数据面，保证两者一致
+	puts, deletes := diffEtcdResources(liveResources, targetResources)
+	err = pub.BatchApply(ctx, puts, deletes, func(tx *gorm.DB) error {
+		txCtx := ginx.SetTx(ctx, repo.Use(tx))
+		if err := rollbackEditorResources(txCtx, tx, gatewayInfo.ID, targetResources); err != nil {
+			return fmt.Errorf("回滚编辑区数据错误: %w", err)
+		}
+		return addReleaseAuditLog(txCtx, operationType, gatewayInfo.ID, latest, target)
+	})
+	if err != nil {
+		logging.ErrorFWithContext(ctx, "apply release version %d err: %s", target.ID, err.Error())
+		return nil, fmt.Errorf("回滚发布版本错误: %w", err)
+	}
+
+	// 数据面已整体切换到 target，以 target 的快照为新的基线，关闭网关所有的漂移记录
+	newVersion, err := createReleaseVersion(ctx, rollbackFromID,
+		func(*model.GatewayReleaseVersion) ([]model.ReleaseResource, error) {
+			return targetResources, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	if err := driftbiz.ResolveDriftRecords(ctx, gatewayInfo.ID); err != nil {
+		logging.ErrorFWithContext(ctx, "resolve drift records of gateway %d err: %s", gatewayInfo.ID, err.Error())
+	}
+
+	// 主动同步一下资源
+	goroutinex.GoroutineWithRecovery(ctx, func() {
+		time.Sleep(time.Second * 1)
+		if _, syncErr := unifyopbiz.SyncResources(ginx.CloneCtx(ctx), ""); syncErr != nil {
+			logging.Errorf("sync resources failed, err: %v", syncErr)
+		}
+	})
+	return newVersion, nil
+}
+
+// diffEtcdResources 计算将 etcd 中的资源切换到 target 的操作：写入有差异的资源，删除 target 中不存在的资源
+func diffEtcdResources(live, target []model.ReleaseResource) (puts, deletes []publisher.ResourceOperation) {
+	liveMap := make(map[string]model.ReleaseResource, len(live))
+	for _, resource := range live {
+		liveMap[resource.Key] = resource
+	}
+	targetKeys := make(map[string]struct{}, len(target))
+	for _, resource := range target {
+		targetKeys[resource.Key] = struct{}{}
+		if liveResource, ok := liveMap[resource.Key]; ok && jsonx.IsJSONEqual(liveResource.Value, resource.Value) {
+			continue
+		}
+		puts = append(puts, newResourceOperation(resource))
+	}
+	for _, resource := range live {
+		if _, ok := targetKeys[resource.Key]; ok {
+			continue
+		}
+		deletes = append(deletes, newResourceOperation(resource))
+	}
+	return puts, deletes
+}
+
+func newResourceOperation(resource model.ReleaseResource) publisher.ResourceOperation {
+	return publisher.ResourceOperation{
+		Type:   resource.Type,
+		Key:    strings.TrimPrefix(resource.Key, constant.ResourceTypePrefixMap[resource.Type]+"/"),
+		Config: resource.Value,
+	}
+}
+
+// rollbackEditorResources 将编辑区回滚到 target：
+// 快照中的资源以发布成功状态覆盖写入，快照之外已发布过的资源被删除，仅存在于编辑区的新增草稿保留；
+// 优先使用保留了变量引用的编辑区配置，旧版本的快照中没有时使用 etcd 中的配置
+func rollbackEditorResources(
+	ctx context.Context,
+	tx *gorm.DB,
+	gatewayID int,
+	target []model.ReleaseResource,
+) error {
+	typeResourceMap := make(map[constant.APISIXResource][]model.ReleaseResource)
+	for _, resource := range target {
+		typeResourceMap[resource.Type] = append(typeResourceMap[resource.Type], resource)
+	}
+	operator := ginx.GetUserIDFromContext(ctx)
+	for _, resourceType := range constant.ResourceTypeList {
+		tableName := resourcebiz.ResourceTableName(resourceType)
+		var current []*model.ResourceCommonModel
+		if err := tx.Table(tableName).Where("gateway_id = ?", gatewayID).Find(&current).Error; err != nil {
+			return err
+		}
+		targetIDs := make(map[string]struct{}, len(typeResourceMap[resourceType]))
+		targetNames := make(map[string]struct{}, len(typeResourceMap[resourceType]))
+		for _, resource := range typeResourceMap[resourceType] {
+			targetIDs[resource.ID] = struct{}{}
+			targetNames[resource.Name] = struct{}{}
+		}
+		var deleteIDs []string
+		for _, resource := range current {
+			_, inTarget := targetIDs[resource.ID]
+			if inTarget || resource.Status != constant.ResourceStatusCreateDraft {
+				deleteIDs = append(deleteIDs, resource.ID)
+				continue
+			}
+			// 保留的新增草稿不能与回滚的资源重名
+			if _, ok := targetNames[resource.GetName(resourceType)]; ok {
+				return fmt.Errorf("%s: %s 与回滚版本中的资源重名",
+					constant.ResourceTypeMap[resourceType], resource.GetName(resourceType))
+			}
+		}
+		for i := 0; i < len(deleteIDs); i += constant.DBConditionIDMaxLength {
+			batchIDs := deleteIDs[i:min(i+constant.DBConditionIDMaxLength, len(deleteIDs))]
+			err := tx.Table(tableName).
+				Where("gateway_id = ? AND id IN ?", gatewayID, batchIDs).
+				Delete(&model.ResourceCommonModel{}).Error
+			if err != nil {
+				return err
+			}
+			err = tx.Where("gateway_id = ? AND resource_type = ? AND resource_id IN ?",
+				gatewayID, resourceType, batchIDs).
+				Delete(&model.GatewayResourceSchemaAssociation{}).Error
+			if err != nil {
+				return err
+			}
+		}
+		for _, resource := range typeResourceMap[resourceType] {
+			editorConfig := resource.EditorConfig
+			if len(editorConfig) == 0 {
+				editorConfig = resource.Config
+			}
+			typedResource, err := resourcebiz.NewTypedResourceModel(resourceType, model.ResourceCommonModel{
+				ID:        resource.ID,
+				GatewayID: gatewayID,
+				Config:    []byte(editorConfig),
+				Status:    constant.ResourceStatusSuccess,
+				BaseModel: model.BaseModel{
+					Creator: operator,
+					Updater: operator,
+				},
+			})
+			if err != nil {
+				return err
+			}
+			// 回滚统一记录一条审计，这里跳过模型钩子，手动处理特殊字段、敏感字段加密及自定义插件关联
+			if handler, ok := typedResource.(interface{ HandleConfig() error }); ok {
+				if err := handler.HandleConfig(); err != nil {
+					return err
+				}
+			}
+			configField := reflect.ValueOf(typedResource).Elem().FieldByName("Config")
+			config, err := sensitive.Encrypt(resourceType, configField.Bytes())
+			if err != nil {
+				return err
+			}
+			configField.SetBytes(config)
+			if err := tx.Session(&gorm.Session{SkipHooks: true}).Create(typedResource).Error; err != nil {
+				return err
+			}
+			if err := model.ResourceSchemaCallback(tx, gatewayID, resource.ID, resourceType, config); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addReleaseAuditLog 添加版本回滚/应用审计，to 可能是其他网关的版本
+func addReleaseAuditLog(
+	ctx context.Context,
+	operationType constant.OperationType,
+	gatewayID int,
+	from, to *model.GatewayReleaseVersion,
+) error {
+	var dataBefore []model.BatchOperationData
+	if from != nil {
+		config, err := buildReleaseVersionAuditConfig(from)
+		if err != nil {
+			return err
+		}
+		dataBefore = append(dataBefore, model.BatchOperationData{
+			ID:     strconv.FormatInt(from.ID, 10),
+			Config: config,
+		})
+	}
+	config, err := buildReleaseVersionAuditConfig(to)
+	if err != nil {
+		return err
+	}
+	dataAfter := []model.BatchOperationData{{
+		ID:     strconv.FormatInt(to.ID, 10),
+		Config: config,
+	}}
+	dataBeforeRaw, err := json.Marshal(dataBefore)
+	if err != nil {
+		return err
+	}
+	dataAfterRaw, err := json.Marshal(dataAfter)
+	if err != nil {
+		return err
+	}
+	operationAuditLog := &model.OperationAuditLog{
+		GatewayID:     gatewayID,
+		ResourceType:  constant.Gateway,
+		OperationType: operationType,
+		ResourceIDs:   strconv.FormatInt(to.ID, 10),
+		DataBefore:    dataBeforeRaw,
+		DataAfter:     dataAfterRaw,
+		Operator:      ginx.GetUserIDFromContext(ctx),
+	}
+	if ginx.GetTx(ctx) != nil {
+		return ginx.GetTx(ctx).OperationAuditLog.WithContext(ctx).Create(operationAuditLog)
+	}
+	return repo.OperationAuditLog.WithContext(ctx).Create(operationAuditLog)
+}
+
+func buildReleaseVersionAuditConfig(version *model.GatewayReleaseVersion) (json.RawMessage, error) {
+	return json.Marshal(map[string]any{
+		"version":        version.Version,
+		"resource_count": version.ResourceCount,
+		"changelog":      version.Changelog,
+	})
+}
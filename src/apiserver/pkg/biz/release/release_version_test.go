@@ -0,0 +1,490 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"gorm.io/gorm"
+
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	variablebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/variable"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/publisher"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+var etcdEndpoint string
+
+func TestMain(m *testing.M) {
+	// init crypto
+	err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化embed数据库
+	util.InitEmbedDb()
+
+	_, server, endpoint, err := util.StartEmbedEtcdClientRandom(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	etcdEndpoint = endpoint
+
+	code := m.Run()
+
+	server.Close()
+	os.Exit(code)
+}
+
+func newReleaseGatewayContext(t *testing.T, name string) (*model.Gateway, context.Context) {
+	t.Helper()
+
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = name
+	gateway.EtcdConfig.Endpoint = base.Endpoint(etcdEndpoint)
+	gateway.EtcdConfig.Prefix = "/" + name
+	if err := gatewaybiz.CreateGateway(context.Background(), gateway); err != nil {
+		t.Fatal(err)
+	}
+	return gateway, ginx.SetGatewayInfoToContext(context.Background(), gateway)
+}
+
+func putEtcdRoute(t *testing.T, ctx context.Context, id, name, uri string) {
+	t.Helper()
+
+	pub, err := publisher.NewEtcdPublisher(ctx, ginx.GetGatewayInfoFromContext(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, _ := json.Marshal(map[string]any{
+		"id":   id,
+		"name": name,
+		"uris": []string{uri},
+		"upstream": map[string]any{
+			"type":  "roundrobin",
+			"nodes": []map[string]any{{"host": "httpbin.org", "port": 80, "weight": 1}},
+		},
+	})
+	err = pub.BatchCreate(ctx, []publisher.ResourceOperation{{Type: constant.Route, Key: id, Config: value}})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func deleteEtcdRoute(t *testing.T, ctx context.Context, id string) {
+	t.Helper()
+
+	pub, err := publisher.NewEtcdPublisher(ctx, ginx.GetGatewayInfoFromContext(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pub.BatchDelete(ctx, []publisher.ResourceOperation{{Type: constant.Route, Key: id}}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateReleaseVersion(t *testing.T) {
+	gateway, ctx := newReleaseGatewayContext(t, "release-create")
+	routeID := idx.GenResourceID(constant.Route)
+	putEtcdRoute(t, ctx, routeID, "route-a", "/a")
+
+	ctx = WithMeta(ctx, Meta{Trigger: constant.ReleaseTriggerWeb, Changelog: "first"})
+	version, err := CreateReleaseVersion(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", version.Version)
+	assert.Equal(t, constant.ReleaseTriggerWeb, version.TriggerSource)
+	assert.Equal(t, "first", version.Changelog)
+	assert.Equal(t, 1, version.ResourceCount)
+
+	saved, err := GetReleaseVersion(ctx, gateway.ID, version.ID)
+	assert.NoError(t, err)
+	resources, err := saved.GetReleaseResources()
+	assert.NoError(t, err)
+	assert.Len(t, resources, 1)
+	assert.Equal(t, "routes/"+routeID, resources[0].Key)
+	assert.Equal(t, "route-a", resources[0].Name)
+	assert.Equal(t, "/a", gjson.GetBytes(resources[0].Value, "uris.0").String())
+
+	version2, err := CreateReleaseVersion(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", version2.Version)
+
+	versions, total, err := ListReleaseVersions(ctx, gateway.ID, utils.PageParam{Offset: 0, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, version2.ID, versions[0].ID)
+
+	_, err = GetReleaseVersion(ctx, gateway.ID+1000, version.ID)
+	assert.ErrorIs(t, err, ErrReleaseVersionNotFound)
+
+	// 删除旧版本后版本号不复用
+	u := repo.GatewayReleaseVersion
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(version.ID)).Delete()
+	assert.NoError(t, err)
+	version3, err := CreateReleaseVersion(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "v3", version3.Version)
+
+	// 同一网关的版本号唯一
+	err = u.WithContext(ctx).Create(&model.GatewayReleaseVersion{GatewayID: gateway.ID, Version: "v3"})
+	assert.True(t, isDuplicateReleaseVersionErr(err))
+}
+
+func TestCreateReleaseVersionKeepsDriftBaseline(t *testing.T) {
+	gateway, ctx := newReleaseGatewayContext(t, "release-drift-baseline")
+	routeA := idx.GenResourceID(constant.Route)
+	routeB := idx.GenResourceID(constant.Route)
+	putEtcdRoute(t, ctx, routeA, "route-a", "/a")
+	putEtcdRoute(t, ctx, routeB, "route-b", "/b")
+	_, err := CreateReleaseVersion(ctx, nil)
+	assert.NoError(t, err)
+
+	// route-b 在 etcd 中被直接修改并记录为漂移，之后只发布 route-a
+	putEtcdRoute(t, ctx, routeB, "route-b", "/b-patched")
+	for _, key := range []string{"routes/" + routeA, "routes/" + routeB} {
+		assert.NoError(t, repo.GatewayDriftRecord.WithContext(ctx).Create(&model.GatewayDriftRecord{
+			GatewayID: gateway.ID,
+			EtcdKey:   key,
+			Status:    constant.DriftStatusDrifted,
+			DriftType: constant.DriftTypeModified,
+		}))
+	}
+	publishCtx, recorder := publisher.WithOperationRecorder(ctx)
+	putEtcdRoute(t, publishCtx, routeA, "route-a", "/a-published")
+	v2, err := CreateReleaseVersion(ctx, recorder.Operations())
+	assert.NoError(t, err)
+
+	// 新快照中 route-a 为发布写入的值，route-b 仍为上一个版本的值
+	resources, err := v2.GetReleaseResources()
+	if assert.NoError(t, err) && assert.Len(t, resources, 2) {
+		uris := map[string]string{}
+		for _, resource := range resources {
+			uris[resource.ID] = gjson.GetBytes(resource.Value, "uris.0").String()
+		}
+		assert.Equal(t, map[string]string{routeA: "/a-published", routeB: "/b"}, uris)
+	}
+	// 只关闭 route-a 的漂移记录
+	u := repo.GatewayDriftRecord
+	drifted, err := u.WithContext(ctx).Where(
+		u.GatewayID.Eq(gateway.ID),
+		u.Status.Eq(string(constant.DriftStatusDrifted)),
+	).Find()
+	if assert.NoError(t, err) && assert.Len(t, drifted, 1) {
+		assert.Equal(t, "routes/"+routeB, drifted[0].EtcdKey)
+	}
+}
+
+func TestCreateReleaseVersionWithoutGateway(t *testing.T) {
+	_, err := CreateReleaseVersion(context.Background(), nil)
+	assert.ErrorIs(t, err, ErrGatewayNotInContext)
+}
+
+func TestDiffReleaseVersion(t *testing.T) {
+	_, ctx := newReleaseGatewayContext(t, "release-diff")
+	routeA := idx.GenResourceID(constant.Route)
+	routeB := idx.GenResourceID(constant.Route)
+	putEtcdRoute(t, ctx, routeA, "route-a", "/a")
+	putEtcdRoute(t, ctx, routeB, "route-b", "/b")
+	v1, err := CreateReleaseVersion(ctx, nil)
+	assert.NoError(t, err)
+
+	routeC := idx.GenResourceID(constant.Route)
+	publishCtx, recorder := publisher.WithOperationRecorder(ctx)
+	deleteEtcdRoute(t, publishCtx, routeB)
+	putEtcdRoute(t, publishCtx, routeA, "route-a", "/a-changed")
+	putEtcdRoute(t, publishCtx, routeC, "route-c", "/c")
+	v2, err := CreateReleaseVersion(ctx, recorder.Operations())
+	assert.NoError(t, err)
+
+	diff, err := DiffReleaseVersion(ctx, v2.ID, v1.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, diff.AddedCount)
+	assert.Equal(t, 1, diff.UpdatedCount)
+	assert.Equal(t, 1, diff.DeletedCount)
+	actions := map[string]constant.OperationType{}
+	for _, change := range diff.Changes {
+		actions[change.ResourceID] = change.Action
+	}
+	assert.Equal(t, constant.OperationTypeUpdate, actions[routeA])
+	assert.Equal(t, constant.OperationTypeDelete, actions[routeB])
+	assert.Equal(t, constant.OperationTypeCreate, actions[routeC])
+
+	// 与当前生效配置对比，v2 即当前配置
+	diff, err = DiffReleaseVersion(ctx, v2.ID, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, diff.Changes)
+}
+
+func TestRollbackReleaseVersion(t *testing.T) {
+	gateway, ctx := newReleaseGatewayContext(t, "release-rollback")
+	routeA := idx.GenResourceID(constant.Route)
+	putEtcdRoute(t, ctx, routeA, "route-a", "/a")
+	v1, err := CreateReleaseVersion(ctx, nil)
+	assert.NoError(t, err)
+
+	// v1 之后：修改 route-a，新增 route-b 并记录到编辑区
+	routeB := idx.GenResourceID(constant.Route)
+	publishCtx, recorder := publisher.WithOperationRecorder(ctx)
+	putEtcdRoute(t, publishCtx, routeA, "route-a", "/a-changed")
+	putEtcdRoute(t, publishCtx, routeB, "route-b", "/b")
+	_, err = CreateReleaseVersion(ctx, recorder.Operations())
+	assert.NoError(t, err)
+	for _, route := range []*model.Route{
+		{Name: "route-a", ResourceCommonModel: model.ResourceCommonModel{
+			ID: routeA, GatewayID: gateway.ID, Status: constant.ResourceStatusUpdateDraft,
+			Config: []byte(`{"name":"route-a","uris":["/a-draft"]}`),
+		}},
+		{Name: "route-b", ResourceCommonModel: model.ResourceCommonModel{
+			ID: routeB, GatewayID: gateway.ID, Status: constant.ResourceStatusSuccess,
+			Config: []byte(`{"name":"route-b","uris":["/b"]}`),
+		}},
+		{Name: "route-draft", ResourceCommonModel: model.ResourceCommonModel{
+			ID: idx.GenResourceID(constant.Route), GatewayID: gateway.ID, Status: constant.ResourceStatusCreateDraft,
+			Config: []byte(`{"name":"route-draft","uris":["/draft"]}`),
+		}},
+	} {
+		assert.NoError(t, repo.Route.WithContext(ctx).Create(route))
+	}
+
+	ctx = WithMeta(ctx, Meta{Trigger: constant.ReleaseTriggerWeb})
+	v3, err := RollbackReleaseVersion(ctx, v1.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "v3", v3.Version)
+	assert.Equal(t, v1.ID, v3.RollbackFromID)
+	assert.Equal(t, "回滚至版本 v1", v3.Changelog)
+
+	// 数据面与 v1 一致
+	live, err := ListLiveResources(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, live, 1)
+	assert.Equal(t, "/a", gjson.GetBytes(live[0].Value, "uris.0").String())
+
+	// 编辑区：route-a 恢复为已发布状态，route-b 被删除，新增草稿保留
+	routes, err := repo.Route.WithContext(ctx).Where(repo.Route.GatewayID.Eq(gateway.ID)).Find()
+	assert.NoError(t, err)
+	routeStatus := map[string]constant.ResourceStatus{}
+	for _, route := range routes {
+		routeStatus[route.Name] = route.Status
+	}
+	assert.Equal(t, map[string]constant.ResourceStatus{
+		"route-a":     constant.ResourceStatusSuccess,
+		"route-draft": constant.ResourceStatusCreateDraft,
+	}, routeStatus)
+
+	// 回滚审计
+	auditLogs, err := repo.OperationAuditLog.WithContext(ctx).Where(
+		repo.OperationAuditLog.GatewayID.Eq(gateway.ID),
+		repo.OperationAuditLog.OperationType.Eq(string(constant.OperationTypeRollback)),
+	).Find()
+	assert.NoError(t, err)
+	assert.Len(t, auditLogs, 1)
+}
+
+func TestRollbackReleaseVersionRevertsEtcdWhenEditorFails(t *testing.T) {
+	gateway, ctx := newReleaseGatewayContext(t, "release-rollback-revert")
+	routeA := idx.GenResourceID(constant.Route)
+	putEtcdRoute(t, ctx, routeA, "route-a", "/a")
+	v1, err := CreateReleaseVersion(ctx, nil)
+	assert.NoError(t, err)
+	routeB := idx.GenResourceID(constant.Route)
+	putEtcdRoute(t, ctx, routeA, "route-a", "/a-changed")
+	putEtcdRoute(t, ctx, routeB, "route-b", "/b")
+
+	live, err := ListLiveResources(ctx)
+	assert.NoError(t, err)
+	target, err := v1.GetReleaseResources()
+	assert.NoError(t, err)
+	puts, deletes := diffEtcdResources(live, target)
+	assert.Len(t, puts, 1)
+	assert.Len(t, deletes, 1)
+
+	pub, err := publisher.NewEtcdPublisher(ctx, gateway)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer pub.Close()
+	// 编辑区切换失败时，etcd 恢复为切换前的数据，并记录已回滚的发布日志
+	commitErr := errors.New("editor failed")
+	err = pub.BatchApply(ctx, puts, deletes, func(*gorm.DB) error { return commitErr })
+	assert.ErrorIs(t, err, commitErr)
+
+	after, err := ListLiveResources(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, after, 2)
+	for _, resource := range after {
+		if resource.ID == routeA {
+			assert.Equal(t, "/a-changed", gjson.GetBytes(resource.Value, "uris.0").String())
+		}
+	}
+	u := repo.GatewayPublishJournal
+	journal, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gateway.ID)).Take()
+	assert.NoError(t, err)
+	assert.Equal(t, constant.PublishJournalStatusRolledBack, journal.Status)
+	assert.Contains(t, journal.Error, "editor failed")
+}
+
+func TestBatchApplyMarksJournalSucceededInCommitTransaction(t *testing.T) {
+	gateway, ctx := newReleaseGatewayContext(t, "release-batch-apply-journal")
+	routeA := idx.GenResourceID(constant.Route)
+	putEtcdRoute(t, ctx, routeA, "route-a", "/a")
+	v1, err := CreateReleaseVersion(ctx, nil)
+	assert.NoError(t, err)
+	putEtcdRoute(t, ctx, routeA, "route-a", "/a-changed")
+
+	live, err := ListLiveResources(ctx)
+	assert.NoError(t, err)
+	target, err := v1.GetReleaseResources()
+	assert.NoError(t, err)
+	puts, deletes := diffEtcdResources(live, target)
+
+	pub, err := publisher.NewEtcdPublisher(ctx, gateway)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer pub.Close()
+	u := repo.GatewayPublishJournal
+	// commit 执行时日志仍为写入中，与 commit 同一事务提交后为写入成功，不会被自动回滚
+	err = pub.BatchApply(ctx, puts, deletes, func(tx *gorm.DB) error {
+		journal, err := repo.Use(tx).GatewayPublishJournal.WithContext(ctx).Where(u.GatewayID.Eq(gateway.ID)).Take()
+		assert.NoError(t, err)
+		assert.Equal(t, constant.PublishJournalStatusRunning, journal.Status)
+		return nil
+	})
+	assert.NoError(t, err)
+	journal, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gateway.ID)).Take()
+	assert.NoError(t, err)
+	assert.Equal(t, constant.PublishJournalStatusSucceeded, journal.Status)
+}
+
+func TestReleaseVersionKeepsVariableReferences(t *testing.T) {
+	gateway, ctx := newReleaseGatewayContext(t, "release-variable")
+	other, otherCtx := newReleaseGatewayContext(t, "release-variable-other")
+	for gatewayID, host := range map[int]string{gateway.ID: "a.example.com", other.ID: "b.example.com"} {
+		assert.NoError(t, variablebiz.CreateVariable(ctx, &model.GatewayVariable{
+			GatewayID: gatewayID, Name: "API_HOST", Value: host,
+		}))
+	}
+
+	// 编辑区中引用变量的路由已发布，etcd 中为替换后的配置
+	routeID := idx.GenResourceID(constant.Route)
+	assert.NoError(t, repo.Route.WithContext(ctx).Create(&model.Route{
+		Name: "route-a",
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID: routeID, GatewayID: gateway.ID, Status: constant.ResourceStatusSuccess,
+			Config: []byte(`{"name":"route-a","uris":["/a"],"host":"${API_HOST}",
+				"upstream":{"type":"roundrobin","nodes":[{"host":"httpbin.org","port":80,"weight":1}]}}`),
+		},
+	}))
+	pub, err := publisher.NewEtcdPublisher(ctx, gateway)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer pub.Close()
+	assert.NoError(t, pub.BatchCreate(ctx, []publisher.ResourceOperation{{
+		Type: constant.Route,
+		Key:  routeID,
+		Config: json.RawMessage(`{"id":"` + routeID + `","name":"route-a","uris":["/a"],` +
+			`"host":"a.example.com","upstream":{"type":"roundrobin","nodes":{"httpbin.org:80":1}}}`),
+	}}))
+	v1, err := CreateReleaseVersion(ctx, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	resources, err := v1.GetReleaseResources()
+	if assert.NoError(t, err) && assert.Len(t, resources, 1) {
+		assert.Equal(t, "${API_HOST}", gjson.GetBytes(resources[0].EditorConfig, "host").String())
+		assert.Equal(t, "a.example.com", gjson.GetBytes(resources[0].Value, "host").String())
+	}
+
+	// 应用到其他网关时按其变量重新替换，编辑区保留变量引用
+	_, err = ApplyReleaseVersion(WithMeta(otherCtx, Meta{Trigger: constant.ReleaseTriggerGroupRollout}), v1)
+	assert.NoError(t, err)
+	live, err := ListLiveResources(otherCtx)
+	if assert.NoError(t, err) && assert.Len(t, live, 1) {
+		assert.Equal(t, "b.example.com", gjson.GetBytes(live[0].Value, "host").String())
+	}
+	route, err := repo.Route.WithContext(ctx).Where(repo.Route.GatewayID.Eq(other.ID)).Take()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "${API_HOST}", gjson.GetBytes(route.Config, "host").String())
+	}
+
+	// 回滚时编辑区恢复为保留变量引用的配置
+	_, err = RollbackReleaseVersion(WithMeta(ctx, Meta{Trigger: constant.ReleaseTriggerWeb}), v1.ID)
+	assert.NoError(t, err)
+	route, err = repo.Route.WithContext(ctx).Where(repo.Route.GatewayID.Eq(gateway.ID)).Take()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "${API_HOST}", gjson.GetBytes(route.Config, "host").String())
+	}
+
+	// 目标网关未定义引用的变量时不应用
+	_, undefinedCtx := newReleaseGatewayContext(t, "release-variable-undefined")
+	_, err = ApplyReleaseVersion(undefinedCtx, v1)
+	assert.ErrorIs(t, err, variablebiz.ErrVariableUndefined)
+}
+
+func TestReleaseVersionSensitiveFields(t *testing.T) {
+	gateway, ctx := newReleaseGatewayContext(t, "release-sensitive")
+	pub, err := publisher.NewEtcdPublisher(ctx, gateway)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer pub.Close()
+	err = pub.BatchCreate(ctx, []publisher.ResourceOperation{{
+		Type:   constant.Consumer,
+		Key:    "jack",
+		Config: json.RawMessage(`{"username":"jack","plugins":{"key-auth":{"key":"secret-key"}}}`),
+	}})
+	if !assert.NoError(t, err) {
+		return
+	}
+	version, err := CreateReleaseVersion(ctx, nil)
+	assert.NoError(t, err)
+
+	// 快照中的敏感字段加密存储，读取时解密
+	saved, err := GetReleaseVersion(ctx, gateway.ID, version.ID)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(saved.ReleaseData), "secret-key")
+	resources, err := saved.GetReleaseResources()
+	if !assert.NoError(t, err) || !assert.Len(t, resources, 1) {
+		return
+	}
+	assert.Equal(t, "secret-key", gjson.GetBytes(resources[0].Value, "plugins.key-auth.key").String())
+	assert.Equal(t, "secret-key", gjson.GetBytes(resources[0].Config, "plugins.key-auth.key").String())
+
+	masked := MaskReleaseResources(resources)
+	assert.Equal(t, constant.SensitiveInfoFiledDisplay, gjson.GetBytes(masked[0].Value, "plugins.key-auth.key").String())
+	assert.Equal(t, constant.SensitiveInfoFiledDisplay, gjson.GetBytes(masked[0].Config, "plugins.key-auth.key").String())
+	assert.Equal(t, "secret-key", gjson.GetBytes(resources[0].Value, "plugins.key-auth.key").String())
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/status"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 var resourceTableMap = map[constant.APISIXResource]string{
@@ -45,6 +46,8 @@ var resourceTableMap = map[constant.APISIXResource]string{
 	constant.Upstream:       model.Upstream{}.TableName(),
 	constant.Consumer:       model.Consumer{}.TableName(),
 	constant.ConsumerGroup:  model.ConsumerGroup{}.TableName(),
+	constant.Credential:     model.Credential{}.TableName(),
+	constant.Secret:         model.Secret{}.TableName(),
 	constant.PluginConfig:   model.PluginConfig{}.TableName(),
 	constant.GlobalRule:     model.GlobalRule{}.TableName(),
 	constant.PluginMetadata: model.PluginMetadata{}.TableName(),
@@ -60,6 +63,8 @@ var resourceModelSliceMap = map[constant.APISIXResource]any{
 	constant.Upstream:       &[]model.Upstream{},
 	constant.Consumer:       &[]model.Consumer{},
 	constant.ConsumerGroup:  &[]model.ConsumerGroup{},
+	constant.Credential:     &[]model.Credential{},
+	constant.Secret:         &[]model.Secret{},
 	constant.PluginConfig:   &[]model.PluginConfig{},
 	constant.GlobalRule:     &[]model.GlobalRule{},
 	constant.PluginMetadata: &[]model.PluginMetadata{},
@@ -74,6 +79,8 @@ var resourceModelMap = map[constant.APISIXResource]any{
 	constant.Upstream:       &model.Upstream{},
 	constant.Consumer:       &model.Consumer{},
 	constant.ConsumerGroup:  &model.ConsumerGroup{},
+	constant.Credential:     &model.Credential{},
+	constant.Secret:         &model.Secret{},
 	constant.PluginConfig:   &model.PluginConfig{},
 	constant.GlobalRule:     &model.GlobalRule{},
 	constant.PluginMetadata: &model.PluginMetadata{},
@@ -244,6 +251,20 @@ func BatchDeleteResourceByIDs(
 		}
 		_, err := ginx.GetTx(ctx).ConsumerGroup.WithContext(ctx).Where(fieldAttr).Delete(&model.ConsumerGroup{})
 		return err
+	case constant.Credential:
+		if ginx.GetTx(ctx) == nil {
+			_, err := repo.Credential.WithContext(ctx).Where(fieldAttr).Delete(&model.Credential{})
+			return err
+		}
+		_, err := ginx.GetTx(ctx).Credential.WithContext(ctx).Where(fieldAttr).Delete(&model.Credential{})
+		return err
+	case constant.Secret:
+		if ginx.GetTx(ctx) == nil {
+			_, err := repo.Secret.WithContext(ctx).Where(fieldAttr).Delete(&model.Secret{})
+			return err
+		}
+		_, err := ginx.GetTx(ctx).Secret.WithContext(ctx).Where(fieldAttr).Delete(&model.Secret{})
+		return err
 	}
 	return nil
 }
@@ -276,6 +297,10 @@ func BatchDeleteResourceWithAuditLog(
 		return BatchDeleteConsumers(ctx, ids)
 	case constant.ConsumerGroup:
 		return BatchDeleteConsumerGroups(ctx, ids)
+	case constant.Credential:
+		return BatchDeleteCredentials(ctx, ids)
+	case constant.Secret:
+		return BatchDeleteSecrets(ctx, ids)
 	case constant.PluginMetadata:
 		return BatchDeletePluginMetadatas(ctx, ids)
 	case constant.GlobalRule:
@@ -455,6 +480,17 @@ func IsResourceConfigChanged(
 	}
 	currentConfigJson := json.RawMessage(resource.Config)
 
+	// 敏感字段加密存储且返回时脱敏，对比前还原输入中的脱敏占位值并解密
+	if inputConfigJson, err = sensitive.RestoreMasked(resourceType, inputConfigJson, currentConfigJson); err != nil {
+		return true
+	}
+	if inputConfigJson, err = sensitive.Decrypt(inputConfigJson); err != nil {
+		return true
+	}
+	if currentConfigJson, err = sensitive.Decrypt(currentConfigJson); err != nil {
+		return true
+	}
+
 	// reference: GetResourceConfigDiffDetail
 	// For PluginMetadata, remove the "name" field before comparison
 	if resourceType == constant.PluginMetadata {
@@ -552,6 +588,30 @@ func IsResourceChanged(
 			return true
 		}
 
+	case constant.Credential:
+		credential, err := GetCredential(ctx, id)
+		if err != nil {
+			return true
+		}
+		if name, ok := extraFields["name"].(string); ok && credential.Name != name {
+			return true
+		}
+		if consumerID, ok := extraFields["consumer_id"].(string); ok && credential.ConsumerID != consumerID {
+			return true
+		}
+
+	case constant.Secret:
+		secret, err := GetSecret(ctx, id)
+		if err != nil {
+			return true
+		}
+		if name, ok := extraFields["name"].(string); ok && secret.Name != name {
+			return true
+		}
+		if manager, ok := extraFields["manager"].(string); ok && secret.Manager != manager {
+			return true
+		}
+
 	case constant.StreamRoute:
 		streamRoute, err := GetStreamRoute(ctx, id)
 		if err != nil {
@@ -152,6 +152,19 @@ func QueryConsumers(ctx context.Context, param map[string]any) ([]*model.Consume
 	return buildConsumerQuery(ctx).Where(field.Attrs(param)).Find()
 }
 
+// ExistsConsumer 查询 Consumer 是否存在
+func ExistsConsumer(ctx context.Context, id string) bool {
+	u := repo.Consumer
+	consumers, err := buildConsumerQuery(ctx).Where(u.ID.Eq(id)).Find()
+	if err != nil {
+		return false
+	}
+	if len(consumers) == 0 {
+		return false
+	}
+	return true
+}
+
 // BatchDeleteConsumers 批量删除 consumer 并添加审计日志
 func BatchDeleteConsumers(ctx context.Context, ids []string) error {
 	u := repo.Consumer
@@ -20,11 +20,14 @@ package resource
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
 	"gorm.io/datatypes"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
@@ -32,6 +35,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
 )
 
@@ -253,3 +257,72 @@ func TestQueryConsumersFiltersByGateway(t *testing.T) {
 		assert.Equal(t, consumer2.ID, got2[0].ID)
 	}
 }
+
+func TestConsumerSensitiveFieldsEncrypted(t *testing.T) {
+	consumer := data.Consumer1WithNoRelation(gatewayInfo, constant.ResourceStatusCreateDraft)
+	consumer.Username = "consumer-sensitive"
+	assert.NoError(t, CreateConsumer(gatewayCtx, *consumer))
+
+	got, err := GetConsumer(gatewayCtx, consumer.ID)
+	assert.NoError(t, err)
+	key := gjson.GetBytes(got.Config, "plugins.key-auth.key").String()
+	assert.True(t, sensitive.IsEncrypted(key), "敏感字段应加密存储")
+	assert.Equal(t, "remote_addr", gjson.GetBytes(got.Config, "plugins.limit-count.key").String())
+
+	// 返回给前端的脱敏配置原样提交，不视为变更，也不覆盖原值
+	masked := sensitive.Mask(constant.Consumer, got.Config)
+	assert.Equal(t, constant.SensitiveInfoFiledDisplay, gjson.GetBytes(masked, "plugins.key-auth.key").String())
+	assert.False(t, IsResourceChanged(gatewayCtx, constant.Consumer, consumer.ID, json.RawMessage(masked),
+		map[string]any{"username": got.Username}))
+
+	got.Config = datatypes.JSON(masked)
+	assert.NoError(t, UpdateConsumer(gatewayCtx, *got))
+	updated, err := GetConsumer(gatewayCtx, consumer.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, key, gjson.GetBytes(updated.Config, "plugins.key-auth.key").String())
+	config, err := sensitive.Decrypt(updated.Config)
+	assert.NoError(t, err)
+	assert.Equal(t, "auth-one", gjson.GetBytes(config, "plugins.key-auth.key").String())
+
+	// 修改明文值视为变更
+	changed, err := sjson.SetBytes(masked, "plugins.key-auth.key", "auth-two")
+	assert.NoError(t, err)
+	assert.True(t, IsResourceChanged(gatewayCtx, constant.Consumer, consumer.ID, json.RawMessage(changed),
+		map[string]any{"username": got.Username}))
+}
+
+func TestConsumerMaskedValueRejected(t *testing.T) {
+	masked := []byte(`{"username":"consumer-masked","plugins":{"key-auth":{"key":"` +
+		constant.SensitiveInfoFiledDisplay + `"}}}`)
+
+	// 没有原值可还原的脱敏占位值（如导入脱敏后的导出数据）拒绝写入
+	consumer := data.Consumer1WithNoRelation(gatewayInfo, constant.ResourceStatusCreateDraft)
+	consumer.Username = "consumer-masked"
+	consumer.Config = datatypes.JSON(masked)
+	assert.ErrorIs(t, CreateConsumer(gatewayCtx, *consumer), sensitive.ErrMaskedValue)
+
+	// 原值只在当前网关下查找，不会还原为其他网关同 ID 资源的值
+	origin := data.Consumer1WithNoRelation(gatewayInfo, constant.ResourceStatusCreateDraft)
+	origin.Username = "consumer-masked-origin"
+	assert.NoError(t, CreateConsumer(gatewayCtx, *origin))
+
+	suffix := time.Now().UnixNano()
+	other := &model.Gateway{
+		ID:            int(suffix%1000000 + 2),
+		Name:          fmt.Sprintf("gateway-consumer-masked-%d", suffix),
+		APISIXType:    constant.APISIXTypeAPISIX,
+		APISIXVersion: string(constant.APISIXVersion313),
+	}
+	assert.NoError(t, repo.Gateway.WithContext(context.Background()).Create(other))
+	otherConsumer := model.Consumer{
+		Username: "consumer-masked-origin",
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID:        origin.ID,
+			GatewayID: other.ID,
+			Config:    datatypes.JSON(masked),
+			Status:    constant.ResourceStatusCreateDraft,
+		},
+	}
+	assert.ErrorIs(t, CreateConsumer(ginx.SetGatewayInfoToContext(context.Background(), other), otherConsumer),
+		sensitive.ErrMaskedValue)
+}
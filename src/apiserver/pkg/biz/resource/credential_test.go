@@ -0,0 +1,94 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package resource
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestCredentialCRUD(t *testing.T) {
+	consumer := data.Consumer1WithNoRelation(gatewayInfo, constant.ResourceStatusCreateDraft)
+	consumer.Username = "credential-crud-consumer"
+	assert.NoError(t, CreateConsumer(gatewayCtx, *consumer))
+
+	credential := data.Credential1(gatewayInfo, consumer.ID, constant.ResourceStatusCreateDraft)
+	assert.NoError(t, CreateCredential(gatewayCtx, *credential))
+
+	got, err := GetCredential(gatewayCtx, credential.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, consumer.ID, got.ConsumerID)
+	assert.Equal(t, consumer.ID, got.GetConsumerID(), "consumer_id 应写入 config")
+	assert.Equal(t, "credential1", got.GetName(constant.Credential))
+	assert.True(t, ExistsCredential(gatewayCtx, credential.ID))
+
+	credentials, err := QueryCredentials(gatewayCtx, map[string]any{"consumer_id": consumer.ID})
+	assert.NoError(t, err)
+	assert.Len(t, credentials, 1)
+
+	assert.False(t, IsResourceChanged(gatewayCtx, constant.Credential, credential.ID, json.RawMessage(got.Config),
+		map[string]any{"name": got.Name, "consumer_id": got.ConsumerID}))
+	assert.True(t, IsResourceChanged(gatewayCtx, constant.Credential, credential.ID, json.RawMessage(got.Config),
+		map[string]any{"name": got.Name, "consumer_id": "other-consumer"}))
+
+	got.Name = "credential1-updated"
+	assert.NoError(t, UpdateCredential(gatewayCtx, *got))
+	updated, err := GetCredential(gatewayCtx, credential.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "credential1-updated", updated.Name)
+
+	assert.NoError(t, BatchDeleteCredentials(gatewayCtx, []string{credential.ID}))
+	assert.False(t, ExistsCredential(gatewayCtx, credential.ID))
+}
+
+func TestBatchRevertCredentials(t *testing.T) {
+	consumer := data.Consumer1WithNoRelation(gatewayInfo, constant.ResourceStatusSuccess)
+	consumer.Username = "credential-revert-consumer"
+	assert.NoError(t, CreateConsumer(gatewayCtx, *consumer))
+
+	credential := data.Credential1(gatewayInfo, consumer.ID, constant.ResourceStatusUpdateDraft)
+	credential.Name = "credential-revert"
+	assert.NoError(t, CreateCredential(gatewayCtx, *credential))
+
+	syncData := &model.GatewaySyncData{
+		GatewayID: gatewayInfo.ID,
+		ID:        credential.ID,
+		Type:      constant.Credential,
+		Config: []byte(`{"id":"` + credential.ID + `","consumer_id":"` + consumer.ID +
+			`","name":"credential-revert","plugins":{"key-auth":{"key":"synced"}}}`),
+	}
+	assert.Equal(t, "consumers/"+consumer.ID+"/credentials/"+credential.ID, syncData.GetEtcdKey())
+	assert.NoError(t, BatchRevertCredentials(gatewayCtx, []*model.GatewaySyncData{syncData}))
+
+	got, err := GetCredential(gatewayCtx, credential.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusSuccess, got.Status)
+	assert.Equal(t, consumer.ID, got.ConsumerID)
+	assert.True(t, sensitive.IsEncrypted(gjson.GetBytes(got.Config, "plugins.key-auth.key").String()))
+	config, err := sensitive.Decrypt(got.Config)
+	assert.NoError(t, err)
+	assert.Equal(t, "synced", gjson.GetBytes(config, "plugins.key-auth.key").String())
+}
@@ -0,0 +1,161 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// envReferenceNameRegexp 环境变量名称
+var envReferenceNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SecretReference 插件配置中对 secret 资源的引用，格式为 $secret://{manager}/{id}/{key}
+type SecretReference struct {
+	Manager string
+	ID      string
+	Key     string
+}
+
+// String ...
+func (r SecretReference) String() string {
+	return fmt.Sprintf(constant.SecretKeyFormat, r.Manager, r.ID)
+}
+
+// ParseSecretReferences 解析配置中插件引用的 $secret:// 及 $env://，引用格式错误时返回错误；
+// 返回的 secret 引用按 manager/id 去重，$env:// 引用只校验格式
+func ParseSecretReferences(config json.RawMessage) ([]SecretReference, error) {
+	plugins := gjson.GetBytes(config, "plugins")
+	if !plugins.Exists() || !strings.Contains(plugins.Raw, "$") {
+		return nil, nil
+	}
+	var refs []SecretReference
+	seen := make(map[string]struct{})
+	err := walkReferences("plugins", plugins, func(ref SecretReference) {
+		if _, ok := seen[ref.String()]; !ok {
+			seen[ref.String()] = struct{}{}
+			refs = append(refs, ref)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// walkReferences 递归遍历配置中的字符串，对其中的 secret 引用调用 collect
+func walkReferences(path string, value gjson.Result, collect func(SecretReference)) error {
+	if value.IsObject() || value.IsArray() {
+		var err error
+		index := 0
+		value.ForEach(func(key, item gjson.Result) bool {
+			itemPath := path + "." + key.String()
+			if value.IsArray() {
+				itemPath = fmt.Sprintf("%s.%d", path, index)
+				index++
+			}
+			err = walkReferences(itemPath, item, collect)
+			return err == nil
+		})
+		return err
+	}
+	if value.Type != gjson.String {
+		return nil
+	}
+	ref, isSecret, err := parseReference(value.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if isSecret {
+		collect(ref)
+	}
+	return nil
+}
+
+// parseReference 解析单个字符串中的引用，仅以 $secret:// 或 $env:// 开头的字符串会被 APISIX 视为引用
+func parseReference(value string) (SecretReference, bool, error) {
+	switch {
+	case strings.HasPrefix(value, constant.SecretReferencePrefix):
+		// $secret://{manager}/{id}/{key}，key 中可以包含 /
+		parts := strings.SplitN(strings.TrimPrefix(value, constant.SecretReferencePrefix), "/", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			return SecretReference{}, false, fmt.Errorf(
+				"invalid secret reference %q, expected $secret://{manager}/{id}/{key}", value)
+		}
+		if !slices.Contains(constant.SecretManagerList, constant.SecretManager(parts[0])) {
+			return SecretReference{}, false, fmt.Errorf(
+				"invalid secret reference %q, unsupported secret manager: %s", value, parts[0])
+		}
+		return SecretReference{Manager: parts[0], ID: parts[1], Key: parts[2]}, true, nil
+	case strings.HasPrefix(value, constant.EnvReferencePrefix):
+		// $env://{name} 或 $env://{name}/{key}
+		name, _, _ := strings.Cut(strings.TrimPrefix(value, constant.EnvReferencePrefix), "/")
+		if !envReferenceNameRegexp.MatchString(name) {
+			return SecretReference{}, false, fmt.Errorf(
+				"invalid env reference %q, expected $env://{name}[/{key}]", value)
+		}
+	}
+	return SecretReference{}, false, nil
+}
+
+// CheckSecretReferences 校验配置中插件引用的 secret 在 ctx 中的网关下存在；
+// ctx 中没有网关时只校验引用格式
+func CheckSecretReferences(ctx context.Context, config json.RawMessage) error {
+	refs, err := ParseSecretReferences(config)
+	if err != nil {
+		return err
+	}
+	if ginx.GetGatewayInfoFromContext(ctx) == nil {
+		return nil
+	}
+	return CheckSecretReferencesExist(ctx, refs)
+}
+
+// CheckSecretReferencesExist 校验引用的 secret 在 ctx 中的网关下存在，且密钥管理器一致
+func CheckSecretReferencesExist(ctx context.Context, refs []SecretReference) error {
+	if len(refs) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		ids = append(ids, ref.ID)
+	}
+	secrets, err := QuerySecrets(ctx, map[string]any{"id": ids})
+	if err != nil {
+		return err
+	}
+	secretManagerMap := make(map[string]string, len(secrets))
+	for _, secret := range secrets {
+		secretManagerMap[secret.ID] = secret.Manager
+	}
+	for _, ref := range refs {
+		if manager, ok := secretManagerMap[ref.ID]; !ok || manager != ref.Manager {
+			return fmt.Errorf("referenced secret [%s] not found", ref)
+		}
+	}
+	return nil
+}
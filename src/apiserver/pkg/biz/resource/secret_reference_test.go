@@ -0,0 +1,104 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestParseSecretReferences(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		want    []SecretReference
+		wantErr bool
+	}{
+		{
+			name:   "no plugins",
+			config: `{"uri":"/test"}`,
+		},
+		{
+			name:   "plain values are ignored",
+			config: `{"plugins":{"key-auth":{"key":"abc$secret://vault/1/key"}}}`,
+		},
+		{
+			name: "nested secret references are deduplicated",
+			config: `{"plugins":{"jwt-auth":{"secret":"$secret://vault/s1/jwt/secret",` +
+				`"keys":["$secret://vault/s1/other","$secret://aws/s2/key"]}}}`,
+			want: []SecretReference{
+				{Manager: "vault", ID: "s1", Key: "jwt/secret"},
+				{Manager: "aws", ID: "s2", Key: "key"},
+			},
+		},
+		{
+			name:   "valid env reference",
+			config: `{"plugins":{"key-auth":{"key":"$env://AUTH_KEY/sub"}}}`,
+		},
+		{
+			name:    "secret reference without key",
+			config:  `{"plugins":{"key-auth":{"key":"$secret://vault/s1"}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported secret manager",
+			config:  `{"plugins":{"key-auth":{"key":"$secret://kms/s1/key"}}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid env name",
+			config:  `{"plugins":{"key-auth":{"key":"$env://1-key"}}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSecretReferences(json.RawMessage(tt.config))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCheckSecretReferences(t *testing.T) {
+	secret := data.Secret1(gatewayInfo, constant.ResourceStatusCreateDraft)
+	secret.Name = "secret-reference"
+	assert.NoError(t, CreateSecret(gatewayCtx, *secret))
+
+	config := json.RawMessage(`{"plugins":{"key-auth":{"key":"$secret://vault/` + secret.ID + `/key"}}}`)
+	assert.NoError(t, CheckSecretReferences(gatewayCtx, config))
+
+	wrongManager := json.RawMessage(`{"plugins":{"key-auth":{"key":"$secret://aws/` + secret.ID + `/key"}}}`)
+	assert.ErrorContains(t, CheckSecretReferences(gatewayCtx, wrongManager), "not found")
+
+	missing := json.RawMessage(`{"plugins":{"key-auth":{"key":"$secret://vault/not-exist/key"}}}`)
+	assert.ErrorContains(t, CheckSecretReferences(gatewayCtx, missing), "not found")
+	// 没有网关上下文时只校验格式
+	assert.NoError(t, CheckSecretReferences(context.Background(), missing))
+}
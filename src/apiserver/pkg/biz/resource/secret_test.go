@@ -0,0 +1,86 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package resource
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestSecretCRUD(t *testing.T) {
+	secret := data.Secret1(gatewayInfo, constant.ResourceStatusCreateDraft)
+	secret.Name = "secret-crud"
+	assert.NoError(t, CreateSecret(gatewayCtx, *secret))
+
+	got, err := GetSecret(gatewayCtx, secret.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "vault", got.Manager)
+	assert.Equal(t, "vault", got.GetSecretManager(), "manager 应写入 config")
+	assert.Equal(t, "secret-crud", got.GetName(constant.Secret))
+	assert.True(t, ExistsSecret(gatewayCtx, secret.ID))
+
+	secrets, err := QuerySecrets(gatewayCtx, map[string]any{"manager": "vault", "id": secret.ID})
+	assert.NoError(t, err)
+	assert.Len(t, secrets, 1)
+
+	assert.False(t, IsResourceChanged(gatewayCtx, constant.Secret, secret.ID, json.RawMessage(got.Config),
+		map[string]any{"name": got.Name, "manager": got.Manager}))
+	assert.True(t, IsResourceChanged(gatewayCtx, constant.Secret, secret.ID, json.RawMessage(got.Config),
+		map[string]any{"name": got.Name, "manager": "aws"}))
+
+	got.Name = "secret-crud-updated"
+	assert.NoError(t, UpdateSecret(gatewayCtx, *got))
+	updated, err := GetSecret(gatewayCtx, secret.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret-crud-updated", updated.Name)
+
+	updated.Manager = "aws"
+	assert.ErrorIs(t, UpdateSecret(gatewayCtx, *updated), model.ErrSecretManagerChanged)
+
+	assert.NoError(t, BatchDeleteSecrets(gatewayCtx, []string{secret.ID}))
+	assert.False(t, ExistsSecret(gatewayCtx, secret.ID))
+}
+
+func TestBatchRevertSecrets(t *testing.T) {
+	secret := data.Secret1(gatewayInfo, constant.ResourceStatusUpdateDraft)
+	secret.Name = "secret-revert"
+	assert.NoError(t, CreateSecret(gatewayCtx, *secret))
+
+	syncData := &model.GatewaySyncData{
+		GatewayID: gatewayInfo.ID,
+		ID:        secret.ID,
+		Type:      constant.Secret,
+		Config: []byte(`{"id":"` + secret.ID + `","manager":"vault","uri":"http://127.0.0.1:8200",` +
+			`"prefix":"kv/synced","token":"vault-token"}`),
+	}
+	assert.Equal(t, "secrets/vault/"+secret.ID, syncData.GetEtcdKey())
+	assert.NoError(t, BatchRevertSecrets(gatewayCtx, []*model.GatewaySyncData{syncData}))
+
+	got, err := GetSecret(gatewayCtx, secret.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusSuccess, got.Status)
+	assert.Equal(t, "vault", got.Manager)
+	assert.Contains(t, string(got.Config), "kv/synced")
+}
@@ -57,13 +57,18 @@ func ListSSL(ctx context.Context) ([]*model.SSL, error) {
 // GetSSLOrderExprList 获取 ssl 排序字段列表
 func GetSSLOrderExprList(orderBy string) []field.Expr {
 	u := repo.SSL
+	// expires_in 按剩余有效期排序，与 validity_end 排序一致
 	ascFieldMap := map[string]field.Expr{
-		"name":       u.Name.Asc(),
-		"updated_at": u.UpdatedAt.Asc(),
+		"name":         u.Name.Asc(),
+		"updated_at":   u.UpdatedAt.Asc(),
+		"validity_end": u.ValidityEnd.Asc(),
+		"expires_in":   u.ValidityEnd.Asc(),
 	}
 	descFieldMap := map[string]field.Expr{
-		"name":       u.Name.Desc(),
-		"updated_at": u.UpdatedAt.Desc(),
+		"name":         u.Name.Desc(),
+		"updated_at":   u.UpdatedAt.Desc(),
+		"validity_end": u.ValidityEnd.Desc(),
+		"expires_in":   u.ValidityEnd.Desc(),
 	}
 	orderByExprList := utils.ParseOrderByExprList(ascFieldMap, descFieldMap, orderBy)
 	if len(orderByExprList) == 0 {
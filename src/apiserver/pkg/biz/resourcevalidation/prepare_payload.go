@@ -212,6 +212,9 @@ func cleanupUnsupportedFieldsForValidation(
 	if constant.ShouldRemoveFieldBeforeValidationOrPublish(resourceType, "name", apisixVersion) {
 		configRaw, _ = sjson.DeleteBytes(configRaw, "name")
 	}
+	if constant.ShouldRemoveFieldBeforeValidationOrPublish(resourceType, "consumer_id", apisixVersion) {
+		configRaw, _ = sjson.DeleteBytes(configRaw, "consumer_id")
+	}
 
 	return configRaw
 }
@@ -23,6 +23,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/tidwall/gjson"
+
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	schemax "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/schema"
 )
@@ -33,6 +35,7 @@ type DatabasePayloadValidator interface {
 }
 
 type databasePayloadValidator struct {
+	resourceType        constant.APISIXResource
 	schemaValidator     schemax.Validator
 	jsonConfigValidator schemax.Validator
 }
@@ -43,6 +46,9 @@ func NewDatabasePayloadValidator(
 	resourceType constant.APISIXResource,
 	customPluginSchemaMap map[string]any,
 ) (DatabasePayloadValidator, error) {
+	if !constant.ResourceSupportedForVersion(resourceType, version) {
+		return nil, fmt.Errorf("resource type:%s is not supported in APISIX %s", resourceType, version)
+	}
 	schemaValidator, err := schemax.NewAPISIXSchemaValidator(version, "main."+resourceType.String())
 	if err != nil {
 		return nil, fmt.Errorf("new APISIX schema validator failed, resource type:%s validate failed: %w",
@@ -60,6 +66,7 @@ func NewDatabasePayloadValidator(
 			resourceType, err)
 	}
 	return &databasePayloadValidator{
+		resourceType:        resourceType,
 		schemaValidator:     schemaValidator,
 		jsonConfigValidator: jsonConfigValidator,
 	}, nil
@@ -77,5 +84,9 @@ func (v *databasePayloadValidator) Validate(rawConfig json.RawMessage) error {
 	if err := v.jsonConfigValidator.Validate(rawConfig); err != nil {
 		return fmt.Errorf("resource config:%s validate failed, err: %w", rawConfig, err)
 	}
+	// secret 的 manager 不下发到 APISIX，schema 中为可选字段，编辑区的配置必须指定
+	if v.resourceType == constant.Secret && gjson.GetBytes(rawConfig, "manager").String() == "" {
+		return fmt.Errorf("resource config:%s validate failed, err: manager is required", rawConfig)
+	}
 	return nil
 }
@@ -292,3 +292,70 @@ func TestDatabasePayloadValidatorReturnsFormattedErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabasePayloadValidatorValidatesSecret(t *testing.T) {
+	tests := []struct {
+		name    string
+		version constant.APISIXVersion
+		config  string
+		wantErr string
+	}{
+		{
+			name:    "vault secret",
+			version: constant.APISIXVersion317,
+			config:  `{"id":"s1","manager":"vault","uri":"http://127.0.0.1:8200","prefix":"kv/apisix","token":"t"}`,
+		},
+		{
+			name:    "vault secret missing token",
+			version: constant.APISIXVersion317,
+			config:  `{"id":"s1","manager":"vault","uri":"http://127.0.0.1:8200","prefix":"kv/apisix"}`,
+			wantErr: "token",
+		},
+		{
+			name:    "vault secret with aws field",
+			version: constant.APISIXVersion317,
+			config: `{"id":"s1","manager":"vault","uri":"http://127.0.0.1:8200","prefix":"kv/apisix",` +
+				`"token":"t","access_key_id":"ak"}`,
+			wantErr: "validate failed",
+		},
+		{
+			name:    "aws secret",
+			version: constant.APISIXVersion311,
+			config:  `{"id":"s1","manager":"aws","access_key_id":"ak","secret_access_key":"sk"}`,
+		},
+		{
+			name:    "aws secret is not supported in 3.3",
+			version: constant.APISIXVersion33,
+			config:  `{"id":"s1","manager":"aws","access_key_id":"ak","secret_access_key":"sk"}`,
+			wantErr: "manager",
+		},
+		{
+			name:    "gcp secret requires auth_config or auth_file",
+			version: constant.APISIXVersion313,
+			config:  `{"id":"s1","manager":"gcp"}`,
+			wantErr: "validate failed",
+		},
+		{
+			name:    "missing manager",
+			version: constant.APISIXVersion317,
+			config:  `{"id":"s1","uri":"http://127.0.0.1:8200","prefix":"kv/apisix","token":"t"}`,
+			wantErr: "manager is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, err := NewDatabasePayloadValidator(tt.version, constant.Secret, nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+			err = validator.Validate(json.RawMessage(tt.config))
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package schedule contains scheduled publish helpers.
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"gorm.io/gen"
+	"gorm.io/gorm"
+
+	changerequestbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/changerequest"
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	maintenancebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/maintenance"
+	releasebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/release"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// ScheduledPublishErrors 定义定时发布相关的错误
+var (
+	ErrScheduledPublishNotFound   = errors.New("scheduled publish not found")
+	ErrScheduledPublishNotPending = errors.New("scheduled publish is not pending")
+	ErrScheduledAtInPast          = errors.New("scheduled time should be in the future")
+)
+
+// ScheduledPublishTaskName 定时发布执行记录的任务名称
+const ScheduledPublishTaskName = "ScheduledPublish"
+
+// now 当前时间，测试时可替换
+var now = time.Now
+
+// CreateScheduledPublish 创建定时发布
+func CreateScheduledPublish(ctx context.Context, scheduledPublish *model.GatewayScheduledPublish) error {
+	if !scheduledPublish.ScheduledAt.After(now()) {
+		return ErrScheduledAtInPast
+	}
+	scheduledPublish.Status = constant.ScheduledPublishStatusPending
+	return repo.Q.Transaction(func(tx *repo.Query) error {
+		if err := tx.GatewayScheduledPublish.WithContext(ctx).Create(scheduledPublish); err != nil {
+			return err
+		}
+		return addAuditLog(ctx, tx, constant.OperationTypeSchedulePublish, scheduledPublish)
+	})
+}
+
+// ListScheduledPublishes 分页查询网关的定时发布
+func ListScheduledPublishes(
+	ctx context.Context,
+	gatewayID int,
+	status constant.ScheduledPublishStatus,
+	page utils.PageParam,
+) ([]*model.GatewayScheduledPublish, int64, error) {
+	u := repo.GatewayScheduledPublish
+	conds := []gen.Condition{u.GatewayID.Eq(gatewayID)}
+	if status != "" {
+		conds = append(conds, u.Status.Eq(string(status)))
+	}
+	return u.WithContext(ctx).Where(conds...).Order(u.ScheduledAt.Desc()).FindByPage(page.Offset, page.Limit)
+}
+
+// GetScheduledPublish 获取网关的定时发布
+func GetScheduledPublish(ctx context.Context, gatewayID int, id int64) (*model.GatewayScheduledPublish, error) {
+	u := repo.GatewayScheduledPublish
+	scheduledPublish, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrScheduledPublishNotFound
+	}
+	return scheduledPublish, err
+}
+
+// CancelScheduledPublish 取消待执行的定时发布
+func CancelScheduledPublish(ctx context.Context, scheduledPublish *model.GatewayScheduledPublish) error {
+	operator := ginx.GetUserIDFromContext(ctx)
+	return repo.Q.Transaction(func(tx *repo.Query) error {
+		u := tx.GatewayScheduledPublish
+		info, err := u.WithContext(ctx).Where(
+			u.ID.Eq(scheduledPublish.ID),
+			u.Status.Eq(string(constant.ScheduledPublishStatusPending)),
+		).UpdateSimple(
+			u.Status.Value(string(constant.ScheduledPublishStatusCancelled)),
+			u.Updater.Value(operator),
+		)
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return ErrScheduledPublishNotPending
+		}
+		scheduledPublish.Status = constant.ScheduledPublishStatusCancelled
+		scheduledPublish.Updater = operator
+		return addAuditLog(ctx, tx, constant.OperationTypeCancelScheduledPublish, scheduledPublish)
+	})
+}
+
+// RunDueScheduledPublishes 执行所有到期的定时发布，返回执行的数量
+func RunDueScheduledPublishes(ctx context.Context) (int, error) {
+	u := repo.GatewayScheduledPublish
+	dueList, err := u.WithContext(ctx).Where(
+		u.Status.Eq(string(constant.ScheduledPublishStatusPending)),
+		u.ScheduledAt.Lte(now()),
+	).Order(u.ScheduledAt).Find()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, scheduledPublish := range dueList {
+		claimed, err := claimScheduledPublish(ctx, scheduledPublish)
+		if err != nil {
+			logging.ErrorFWithContext(ctx, "claim scheduled publish %d err: %s", scheduledPublish.ID, err.Error())
+			continue
+		}
+		// 已被其他进程执行或已取消
+		if !claimed {
+			continue
+		}
+		runScheduledPublish(ctx, scheduledPublish)
+		count++
+	}
+	return count, nil
+}
+
+// claimScheduledPublish 将定时发布标记为执行中，避免重复执行
+func claimScheduledPublish(ctx context.Context, scheduledPublish *model.GatewayScheduledPublish) (bool, error) {
+	startedAt := now()
+	u := repo.GatewayScheduledPublish
+	info, err := u.WithContext(ctx).Where(
+		u.ID.Eq(scheduledPublish.ID),
+		u.Status.Eq(string(constant.ScheduledPublishStatusPending)),
+	).UpdateSimple(
+		u.Status.Value(string(constant.ScheduledPublishStatusRunning)),
+		u.StartedAt.Value(startedAt),
+	)
+	if err != nil {
+		return false, err
+	}
+	scheduledPublish.Status = constant.ScheduledPublishStatusRunning
+	scheduledPublish.StartedAt = &startedAt
+	return info.RowsAffected > 0, nil
+}
+
+// scheduledPublishResult 定时发布执行结果，记录到 Task 中
+type scheduledPublishResult struct {
+	Status          constant.ScheduledPublishStatus `json:"status"`
+	ChangeRequestID int64                           `json:"change_request_id,omitempty"`
+	Error           string                          `json:"error,omitempty"`
+}
+
+// runScheduledPublish 执行定时发布，并通过 Task 记录执行结果
+func runScheduledPublish(ctx context.Context, scheduledPublish *model.GatewayScheduledPublish) {
+	args, _ := json.Marshal(map[string]any{
+		"gateway_id":           scheduledPublish.GatewayID,
+		"scheduled_publish_id": scheduledPublish.ID,
+	})
+	task := model.Task{
+		GatewayID: scheduledPublish.GatewayID,
+		Name:      ScheduledPublishTaskName,
+		Args:      args,
+		StartedAt: *scheduledPublish.StartedAt,
+		Status:    constant.TaskStatusRunning,
+	}
+	if err := database.Client().WithContext(ctx).Create(&task).Error; err != nil {
+		logging.ErrorFWithContext(ctx, "create task of scheduled publish %d err: %s",
+			scheduledPublish.ID, err.Error())
+	}
+
+	result := scheduledPublishResult{Status: constant.ScheduledPublishStatusSucceeded}
+	changeRequest, err := publish(ctx, scheduledPublish)
+	if err != nil {
+		result.Status = constant.ScheduledPublishStatusFailed
+		result.Error = err.Error()
+	}
+	if changeRequest != nil {
+		result.ChangeRequestID = changeRequest.ID
+	}
+
+	finishedAt := now()
+	resultData, _ := json.Marshal(result)
+	task.Result = resultData
+	task.Duration = finishedAt.Sub(task.StartedAt)
+	task.Status = constant.TaskStatusSucceeded
+	task.Progress = 100
+	if result.Status == constant.ScheduledPublishStatusFailed {
+		task.Status = constant.TaskStatusFailed
+		task.Error = result.Error
+	}
+	task.FinishedAt = &finishedAt
+	if task.ID != 0 {
+		if err := database.Client().WithContext(ctx).Save(&task).Error; err != nil {
+			logging.ErrorFWithContext(ctx, "save task of scheduled publish %d err: %s",
+				scheduledPublish.ID, err.Error())
+		}
+	}
+
+	scheduledPublish.Status = result.Status
+	scheduledPublish.ChangeRequestID = result.ChangeRequestID
+	scheduledPublish.Error = result.Error
+	scheduledPublish.TaskID = task.ID
+	scheduledPublish.FinishedAt = &finishedAt
+	u := repo.GatewayScheduledPublish
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(scheduledPublish.ID)).UpdateSimple(
+		u.Status.Value(string(result.Status)),
+		u.ChangeRequestID.Value(result.ChangeRequestID),
+		u.Error.Value(result.Error),
+		u.TaskID.Value(task.ID),
+		u.FinishedAt.Value(finishedAt),
+	)
+	if err != nil {
+		logging.ErrorFWithContext(ctx, "update scheduled publish %d err: %s", scheduledPublish.ID, err.Error())
+	}
+}
+
+// publish 以定时发布创建人的身份按网关发布策略执行发布
+func publish(
+	ctx context.Context,
+	scheduledPublish *model.GatewayScheduledPublish,
+) (*model.GatewayChangeRequest, error) {
+	gatewayInfo, err := gatewaybiz.GetGateway(ctx, scheduledPublish.GatewayID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = ginx.SetGatewayInfoToContext(ctx, gatewayInfo)
+	ctx = context.WithValue(ctx, constant.UserIDKey, scheduledPublish.Creator)
+	ctx = maintenancebiz.WithOverride(ctx, scheduledPublish.OverrideMaintenanceWindow)
+	ctx = releasebiz.WithMeta(ctx, releasebiz.Meta{
+		Trigger:   constant.ReleaseTriggerSchedule,
+		Changelog: scheduledPublish.Changelog,
+	})
+	return changerequestbiz.PublishWithPolicy(ctx, scheduledPublish.ResourceType, scheduledPublish.ResourceIDs)
+}
+
+// addAuditLog 添加定时发布的创建/取消审计
+func addAuditLog(
+	ctx context.Context,
+	tx *repo.Query,
+	operationType constant.OperationType,
+	scheduledPublish *model.GatewayScheduledPublish,
+) error {
+	config, err := json.Marshal(map[string]any{
+		"resource_type":               scheduledPublish.ResourceType,
+		"resource_ids":                scheduledPublish.ResourceIDs,
+		"changelog":                   scheduledPublish.Changelog,
+		"scheduled_at":                scheduledPublish.ScheduledAt.Unix(),
+		"override_maintenance_window": scheduledPublish.OverrideMaintenanceWindow,
+	})
+	if err != nil {
+		return err
+	}
+	data := []model.BatchOperationData{{
+		ID:     strconv.FormatInt(scheduledPublish.ID, 10),
+		Config: config,
+	}}
+	var dataBefore, dataAfter []model.BatchOperationData
+	if operationType == constant.OperationTypeCancelScheduledPublish {
+		dataBefore = data
+	} else {
+		dataAfter = data
+	}
+	dataBeforeRaw, err := json.Marshal(dataBefore)
+	if err != nil {
+		return err
+	}
+	dataAfterRaw, err := json.Marshal(dataAfter)
+	if err != nil {
+		return err
+	}
+	return tx.OperationAuditLog.WithContext(ctx).Create(&model.OperationAuditLog{
+		GatewayID:     scheduledPublish.GatewayID,
+		ResourceType:  constant.Gateway,
+		OperationType: operationType,
+		ResourceIDs:   strconv.FormatInt(scheduledPublish.ID, 10),
+		DataBefore:    dataBeforeRaw,
+		DataAfter:     dataAfterRaw,
+		Operator:      ginx.GetUserIDFromContext(ctx),
+	})
+}
@@ -0,0 +1,223 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package schedule
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	maintenancebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/maintenance"
+	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+var etcdEndpoint string
+
+func TestMain(m *testing.M) {
+	// init crypto
+	err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化embed数据库
+	util.InitEmbedDb()
+
+	_, server, endpoint, err := util.StartEmbedEtcdClientRandom(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	etcdEndpoint = endpoint
+
+	code := m.Run()
+
+	server.Close()
+	os.Exit(code)
+}
+
+// newScheduledPublish 创建网关、待发布的路由及一个 1 小时后执行的定时发布
+func newScheduledPublish(t *testing.T, override bool) (*model.Route, *model.GatewayScheduledPublish, context.Context) {
+	t.Helper()
+
+	name := strings.ToLower(strings.ReplaceAll(t.Name(), "_", "-"))
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = name
+	gateway.EtcdConfig.Endpoint = base.Endpoint(etcdEndpoint)
+	gateway.EtcdConfig.Prefix = "/" + name
+	if err := gatewaybiz.CreateGateway(context.Background(), gateway); err != nil {
+		t.Fatal(err)
+	}
+	ctx := ginx.SetGatewayInfoToContext(context.Background(), gateway)
+	ctx = context.WithValue(ctx, constant.UserIDKey, "scheduler")
+
+	route := data.Route1WithNoRelationResource(gateway, constant.ResourceStatusCreateDraft)
+	if err := resourcebiz.CreateRoute(ctx, *route); err != nil {
+		t.Fatal(err)
+	}
+
+	scheduledPublish := &model.GatewayScheduledPublish{
+		GatewayID:                 gateway.ID,
+		ResourceType:              constant.Route,
+		ResourceIDs:               []string{route.ID},
+		Changelog:                 "nightly release",
+		ScheduledAt:               time.Now().Add(time.Hour),
+		OverrideMaintenanceWindow: override,
+		BaseModel:                 model.BaseModel{Creator: "scheduler"},
+	}
+	if err := CreateScheduledPublish(ctx, scheduledPublish); err != nil {
+		t.Fatal(err)
+	}
+	return route, scheduledPublish, ctx
+}
+
+// runDueAt 以指定时间执行到期的定时发布
+func runDueAt(t *testing.T, ctx context.Context, at time.Time) int {
+	t.Helper()
+
+	defer func(original func() time.Time) { now = original }(now)
+	now = func() time.Time { return at }
+	count, err := RunDueScheduledPublishes(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return count
+}
+
+func TestCreateScheduledPublishInPast(t *testing.T) {
+	err := CreateScheduledPublish(context.Background(), &model.GatewayScheduledPublish{
+		GatewayID:   30001,
+		ScheduledAt: time.Now().Add(-time.Minute),
+	})
+	assert.ErrorIs(t, err, ErrScheduledAtInPast)
+}
+
+func TestRunDueScheduledPublishes(t *testing.T) {
+	route, scheduledPublish, ctx := newScheduledPublish(t, false)
+
+	// 未到期不执行
+	assert.Equal(t, 0, runDueAt(t, ctx, time.Now()))
+
+	assert.Equal(t, 1, runDueAt(t, ctx, time.Now().Add(2*time.Hour)))
+	scheduledPublish, err := GetScheduledPublish(ctx, scheduledPublish.GatewayID, scheduledPublish.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ScheduledPublishStatusSucceeded, scheduledPublish.Status)
+	assert.Empty(t, scheduledPublish.Error)
+	assert.NotNil(t, scheduledPublish.FinishedAt)
+
+	current, err := resourcebiz.GetRoute(ctx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusSuccess, current.Status)
+
+	var task model.Task
+	assert.NoError(t, database.Client().First(&task, scheduledPublish.TaskID).Error)
+	assert.Equal(t, ScheduledPublishTaskName, task.Name)
+	assert.JSONEq(t, `{"status":"succeeded"}`, string(task.Result))
+
+	v := repo.GatewayReleaseVersion
+	version, err := v.WithContext(ctx).Where(v.GatewayID.Eq(scheduledPublish.GatewayID)).Last()
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ReleaseTriggerSchedule, version.TriggerSource)
+	assert.Equal(t, "scheduler", version.Creator)
+
+	// 已执行的不会重复执行
+	assert.Equal(t, 0, runDueAt(t, ctx, time.Now().Add(3*time.Hour)))
+}
+
+func TestCancelScheduledPublish(t *testing.T) {
+	route, scheduledPublish, ctx := newScheduledPublish(t, false)
+
+	assert.NoError(t, CancelScheduledPublish(ctx, scheduledPublish))
+	assert.ErrorIs(t, CancelScheduledPublish(ctx, scheduledPublish), ErrScheduledPublishNotPending)
+	assert.Equal(t, 0, runDueAt(t, ctx, time.Now().Add(2*time.Hour)))
+
+	list, total, err := ListScheduledPublishes(ctx, scheduledPublish.GatewayID,
+		constant.ScheduledPublishStatusCancelled, utils.PageParam{Offset: 0, Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, scheduledPublish.ID, list[0].ID)
+
+	current, err := resourcebiz.GetRoute(ctx, route.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.ResourceStatusCreateDraft, current.Status)
+
+	u := repo.OperationAuditLog
+	count, err := u.WithContext(ctx).Where(
+		u.GatewayID.Eq(scheduledPublish.GatewayID),
+		u.OperationType.In(
+			string(constant.OperationTypeSchedulePublish),
+			string(constant.OperationTypeCancelScheduledPublish),
+		),
+	).Count()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestRunScheduledPublishOutsideMaintenanceWindow(t *testing.T) {
+	for _, override := range []bool{false, true} {
+		t.Run(strconv.FormatBool(override), func(t *testing.T) {
+			testRunScheduledPublishOutsideMaintenanceWindow(t, override)
+		})
+	}
+}
+
+func testRunScheduledPublishOutsideMaintenanceWindow(t *testing.T, override bool) {
+	route, scheduledPublish, ctx := newScheduledPublish(t, override)
+
+	// 维护窗口不包含当前时间
+	start := time.Now().UTC().Add(2 * time.Hour)
+	err := maintenancebiz.CreateMaintenanceWindow(ctx, &model.GatewayMaintenanceWindow{
+		GatewayID: scheduledPublish.GatewayID,
+		Name:      "nightly",
+		StartTime: start.Format("15:04"),
+		EndTime:   start.Add(time.Hour).Format("15:04"),
+		Timezone:  "UTC",
+		Enabled:   true,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, runDueAt(t, ctx, time.Now().Add(2*time.Hour)))
+	scheduledPublish, err = GetScheduledPublish(ctx, scheduledPublish.GatewayID, scheduledPublish.ID)
+	assert.NoError(t, err)
+	current, err := resourcebiz.GetRoute(ctx, route.ID)
+	assert.NoError(t, err)
+	if override {
+		assert.Equal(t, constant.ScheduledPublishStatusSucceeded, scheduledPublish.Status)
+		assert.Equal(t, constant.ResourceStatusSuccess, current.Status)
+	} else {
+		assert.Equal(t, constant.ScheduledPublishStatusFailed, scheduledPublish.Status)
+		assert.Equal(t, maintenancebiz.ErrOutsideMaintenanceWindow.Error(), scheduledPublish.Error)
+		assert.Equal(t, constant.ResourceStatusCreateDraft, current.Status)
+	}
+}
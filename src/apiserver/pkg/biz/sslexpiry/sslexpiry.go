@@ -0,0 +1,333 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package sslexpiry 跟踪编辑区及 etcd 中证书的有效期，达到告警阈值时告警
+package sslexpiry
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+
+	webhookbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/webhook"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+)
+
+// 证书来源
+const (
+	SourceEdit = "edit" // 编辑区
+	SourceEtcd = "etcd" // 仅存在于 etcd 中，未纳管到编辑区
+)
+
+// alertOperator 证书过期告警审计的操作人
+const alertOperator = "system"
+
+// DefaultAlertDays 默认的告警阈值（剩余天数）
+var DefaultAlertDays = []int{30, 7, 1}
+
+// Certificate 证书有效期及引用信息
+type Certificate struct {
+	ID            string
+	Name          string
+	Source        string
+	Snis          []string
+	ValidityStart int64
+	ValidityEnd   int64
+	UpstreamIDs   []string // 引用该证书作为客户端证书的上游
+}
+
+// ExpiresIn 剩余有效期，已过期为负数
+func (c *Certificate) ExpiresIn(now time.Time) time.Duration {
+	return time.Unix(c.ValidityEnd, 0).Sub(now)
+}
+
+// ExpiresInDays 剩余有效天数（向下取整），已过期为负数
+func (c *Certificate) ExpiresInDays(now time.Time) int {
+	return int(math.Floor(c.ExpiresIn(now).Hours() / 24))
+}
+
+// Expired 是否已过期
+func (c *Certificate) Expired(now time.Time) bool {
+	return c.ExpiresIn(now) <= 0
+}
+
+// InUse 是否仍在使用：配置了 SNI 或被上游引用
+func (c *Certificate) InUse() bool {
+	return len(c.Snis) > 0 || len(c.UpstreamIDs) > 0
+}
+
+// AlertDays 获取告警阈值，升序
+func AlertDays() []int {
+	days := DefaultAlertDays
+	if config.G != nil && len(config.G.Biz.SSLExpiryAlertDays) > 0 {
+		days = config.G.Biz.SSLExpiryAlertDays
+	}
+	days = slices.Clone(days)
+	slices.Sort(days)
+	return slices.Compact(days)
+}
+
+// ListCertificates 查询网关下编辑区及 etcd 中未纳管的证书，before 大于 0 时只返回在此之前过期的证书，按过期时间升序
+func ListCertificates(ctx context.Context, gatewayID int, before int64) ([]*Certificate, error) {
+	s := repo.SSL
+	sslQuery := s.WithContext(ctx).Where(s.GatewayID.Eq(gatewayID), s.ValidityEnd.Gt(0))
+	if before > 0 {
+		sslQuery = sslQuery.Where(s.ValidityEnd.Lte(before))
+	}
+	ssls, err := sslQuery.Find()
+	if err != nil {
+		return nil, err
+	}
+	g := repo.GatewaySyncData
+	syncedQuery := g.WithContext(ctx).Where(
+		g.GatewayID.Eq(gatewayID),
+		g.Type.Eq(constant.SSL.String()),
+		g.ValidityEnd.Gt(0),
+	)
+	if before > 0 {
+		syncedQuery = syncedQuery.Where(g.ValidityEnd.Lte(before))
+	}
+	syncedSSLs, err := syncedQuery.Find()
+	if err != nil {
+		return nil, err
+	}
+
+	certificates := make([]*Certificate, 0, len(ssls)+len(syncedSSLs))
+	for _, ssl := range ssls {
+		certificates = append(certificates, &Certificate{
+			ID:            ssl.ID,
+			Name:          ssl.Name,
+			Source:        SourceEdit,
+			Snis:          snis(ssl.Config),
+			ValidityStart: ssl.ValidityStart,
+			ValidityEnd:   ssl.ValidityEnd,
+		})
+	}
+	// 已纳管到编辑区的证书以编辑区为准
+	syncedIDs := lo.Map(syncedSSLs, func(item *model.GatewaySyncData, _ int) string { return item.ID })
+	var managedIDs []string
+	if len(syncedIDs) > 0 {
+		err = s.WithContext(ctx).Where(s.GatewayID.Eq(gatewayID), s.ID.In(syncedIDs...)).Pluck(s.ID, &managedIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, item := range syncedSSLs {
+		if slices.Contains(managedIDs, item.ID) {
+			continue
+		}
+		certificates = append(certificates, &Certificate{
+			ID:            item.ID,
+			Name:          item.GetName(),
+			Source:        SourceEtcd,
+			Snis:          snis(item.Config),
+			ValidityStart: item.ValidityStart,
+			ValidityEnd:   item.ValidityEnd,
+		})
+	}
+	if err := fillUpstreamReferences(ctx, gatewayID, certificates); err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(certificates, func(a, b *Certificate) int {
+		return cmp.Compare(a.ValidityEnd, b.ValidityEnd)
+	})
+	return certificates, nil
+}
+
+// CountExpiredInUse 统计网关下已过期但仍被 SNI 或上游引用的证书数量
+func CountExpiredInUse(ctx context.Context, gatewayID int) (int, error) {
+	now := time.Now()
+	certificates, err := ListCertificates(ctx, gatewayID, now.Unix())
+	if err != nil {
+		return 0, err
+	}
+	return lo.CountBy(certificates, func(c *Certificate) bool { return c.Expired(now) && c.InUse() }), nil
+}
+
+// CheckExpiry 检查所有网关的证书有效期，达到告警阈值时写入审计日志并投递 ssl.expiring 事件，返回告警数
+//
+// 同一证书有效期内每个阈值只告警一次，证书更新（过期时间变化）后重新告警
+func CheckExpiry(ctx context.Context) (int, error) {
+	days := AlertDays()
+	now := time.Now()
+	before := now.Add(time.Duration(days[len(days)-1]) * 24 * time.Hour).Unix()
+	gateways, err := repo.Gateway.WithContext(ctx).Find()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, gateway := range gateways {
+		certificates, err := ListCertificates(ctx, gateway.ID, before)
+		if err != nil {
+			return count, err
+		}
+		for _, certificate := range certificates {
+			threshold, ok := alertThreshold(certificate.ExpiresIn(now), days)
+			if !ok {
+				continue
+			}
+			alerted, err := alert(ctx, gateway.ID, certificate, threshold, now)
+			if err != nil {
+				return count, err
+			}
+			if alerted {
+				logging.Infof("ssl %s of gateway %s expires in %d days",
+					certificate.ID, gateway.Name, certificate.ExpiresInDays(now))
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+// alertThreshold 计算剩余有效期达到的最小告警阈值，已过期为 0
+func alertThreshold(expiresIn time.Duration, days []int) (int, bool) {
+	if expiresIn <= 0 {
+		return 0, true
+	}
+	for _, d := range days {
+		if expiresIn <= time.Duration(d)*24*time.Hour {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// alert 记录告警并在同一事务中写入审计日志及 webhook 事件，已告警过时返回 false
+func alert(
+	ctx context.Context,
+	gatewayID int,
+	certificate *Certificate,
+	threshold int,
+	now time.Time,
+) (bool, error) {
+	data := dto.WebhookSSLExpiringEventData{
+		ID:            certificate.ID,
+		Name:          certificate.Name,
+		Source:        certificate.Source,
+		Snis:          certificate.Snis,
+		UpstreamIDs:   certificate.UpstreamIDs,
+		ValidityEnd:   certificate.ValidityEnd,
+		ExpiresInDays: certificate.ExpiresInDays(now),
+		Threshold:     threshold,
+		Expired:       certificate.Expired(now),
+	}
+	detail, err := json.Marshal(data)
+	if err != nil {
+		return false, err
+	}
+	dataAfter, err := json.Marshal([]model.BatchOperationData{{ID: certificate.ID, Config: detail}})
+	if err != nil {
+		return false, err
+	}
+	alerted := false
+	err = repo.Q.Transaction(func(tx *repo.Query) error {
+		a := tx.SSLExpiryAlert
+		exists, err := a.WithContext(ctx).Where(
+			a.GatewayID.Eq(gatewayID),
+			a.SSLID.Eq(certificate.ID),
+			a.ValidityEnd.Eq(certificate.ValidityEnd),
+			a.Threshold.Eq(threshold),
+		).Count()
+		if err != nil || exists > 0 {
+			return err
+		}
+		err = a.WithContext(ctx).Create(&model.SSLExpiryAlert{
+			GatewayID:   gatewayID,
+			SSLID:       certificate.ID,
+			ValidityEnd: certificate.ValidityEnd,
+			Threshold:   threshold,
+		})
+		if err != nil {
+			return err
+		}
+		err = tx.OperationAuditLog.WithContext(ctx).Create(&model.OperationAuditLog{
+			GatewayID:     gatewayID,
+			ResourceType:  constant.SSL,
+			OperationType: constant.OperationTypeSSLExpiring,
+			ResourceIDs:   certificate.ID,
+			DataBefore:    []byte("[]"),
+			DataAfter:     dataAfter,
+			Operator:      alertOperator,
+		})
+		if err != nil {
+			return err
+		}
+		alerted = true
+		return webhookbiz.EmitWithTx(ctx, tx, gatewayID, constant.WebhookEventSSLExpiring, data)
+	})
+	return alerted, err
+}
+
+// fillUpstreamReferences 填充引用证书的编辑区及 etcd 中的上游
+func fillUpstreamReferences(ctx context.Context, gatewayID int, certificates []*Certificate) error {
+	if len(certificates) == 0 {
+		return nil
+	}
+	ids := lo.Map(certificates, func(c *Certificate, _ int) string { return c.ID })
+	u := repo.Upstream
+	upstreams, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.SSLID.In(ids...)).Find()
+	if err != nil {
+		return err
+	}
+	references := make(map[string][]string)
+	for _, upstream := range upstreams {
+		references[upstream.SSLID] = append(references[upstream.SSLID], upstream.ID)
+	}
+	g := repo.GatewaySyncData
+	syncedUpstreams, err := g.WithContext(ctx).Where(
+		g.GatewayID.Eq(gatewayID),
+		g.Type.Eq(constant.Upstream.String()),
+	).Find()
+	if err != nil {
+		return err
+	}
+	for _, upstream := range syncedUpstreams {
+		sslID := upstream.GetSSLID()
+		if sslID != "" && !slices.Contains(references[sslID], upstream.ID) {
+			references[sslID] = append(references[sslID], upstream.ID)
+		}
+	}
+	for _, certificate := range certificates {
+		certificate.UpstreamIDs = append([]string{}, references[certificate.ID]...)
+	}
+	return nil
+}
+
+// snis 解析证书配置中的 SNI
+func snis(config []byte) []string {
+	result := []string{}
+	for _, sni := range gjson.GetBytes(config, "snis").Array() {
+		result = append(result, sni.String())
+	}
+	if sni := gjson.GetBytes(config, "sni").String(); sni != "" && !slices.Contains(result, sni) {
+		result = append(result, sni)
+	}
+	return result
+}
@@ -0,0 +1,231 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package sslexpiry
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/sjson"
+	"gorm.io/datatypes"
+
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+func TestMain(m *testing.M) {
+	// init crypto
+	err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化embed数据库
+	util.InitEmbedDb()
+
+	os.Exit(m.Run())
+}
+
+// newCertConfig 生成指定过期时间的自签名证书配置
+func newCertConfig(t *testing.T, host string, notAfter time.Time) datatypes.JSON {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    notAfter.Add(-90 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	config, _ := sjson.SetBytes([]byte(`{}`), "cert",
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+	config, _ = sjson.SetBytes(config, "key",
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})))
+	return config
+}
+
+func newTestGateway(t *testing.T) *model.Gateway {
+	t.Helper()
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = strings.ToLower(t.Name())
+	assert.NoError(t, gatewaybiz.CreateGateway(context.Background(), gateway))
+	return gateway
+}
+
+func createSSL(t *testing.T, gatewayID int, id string, config datatypes.JSON) *model.SSL {
+	t.Helper()
+	ssl := &model.SSL{
+		Name: id,
+		ResourceCommonModel: model.ResourceCommonModel{
+			ID:        id,
+			GatewayID: gatewayID,
+			Config:    config,
+			Status:    constant.ResourceStatusCreateDraft,
+		},
+	}
+	assert.NoError(t, repo.SSL.WithContext(context.Background()).Create(ssl))
+	return ssl
+}
+
+func TestAlertThreshold(t *testing.T) {
+	days := []int{1, 7, 30}
+	tests := []struct {
+		expiresIn time.Duration
+		want      int
+		wantOK    bool
+	}{
+		{expiresIn: 60 * 24 * time.Hour},
+		{expiresIn: 20 * 24 * time.Hour, want: 30, wantOK: true},
+		{expiresIn: 7 * 24 * time.Hour, want: 7, wantOK: true},
+		{expiresIn: time.Hour, want: 1, wantOK: true},
+		{expiresIn: -time.Hour, want: 0, wantOK: true},
+	}
+	for _, tt := range tests {
+		got, ok := alertThreshold(tt.expiresIn, days)
+		assert.Equal(t, tt.wantOK, ok, tt.expiresIn)
+		assert.Equal(t, tt.want, got, tt.expiresIn)
+	}
+}
+
+func TestListCertificates(t *testing.T) {
+	ctx := context.Background()
+	gateway := newTestGateway(t)
+	now := time.Now()
+
+	soon := createSSL(t, gateway.ID, "list-soon", newCertConfig(t, "soon.example.com", now.Add(5*24*time.Hour)))
+	later := createSSL(t, gateway.ID, "list-later", newCertConfig(t, "later.example.com", now.AddDate(1, 0, 0)))
+	assert.Equal(t, now.Add(5*24*time.Hour).Unix(), soon.ValidityEnd)
+	assert.Positive(t, later.ValidityStart)
+
+	// etcd 中未纳管的已过期证书，被 etcd 中的上游引用
+	expiredConfig, _ := sjson.SetBytes(newCertConfig(t, "old.example.com", now.Add(-time.Hour)), "id", "list-expired")
+	expiredConfig, _ = sjson.SetBytes(expiredConfig, "snis", []string{"old.example.com"})
+	synced := []*model.GatewaySyncData{
+		{ID: "list-expired", GatewayID: gateway.ID, Type: constant.SSL, Config: expiredConfig},
+		// 已纳管的证书以编辑区为准
+		{ID: "list-soon", GatewayID: gateway.ID, Type: constant.SSL, Config: soon.Config},
+		{
+			ID:        "list-upstream",
+			GatewayID: gateway.ID,
+			Type:      constant.Upstream,
+			Config:    datatypes.JSON(`{"id":"list-upstream","tls":{"client_cert_id":"list-expired"}}`),
+		},
+	}
+	assert.NoError(t, repo.GatewaySyncData.WithContext(ctx).Create(synced...))
+	assert.Equal(t, now.Add(-time.Hour).Unix(), synced[0].ValidityEnd)
+	assert.Zero(t, synced[2].ValidityEnd)
+
+	certificates, err := ListCertificates(ctx, gateway.ID, now.AddDate(0, 0, 30).Unix())
+	assert.NoError(t, err)
+	if assert.Len(t, certificates, 2) {
+		assert.Equal(t, "list-expired", certificates[0].ID)
+		assert.Equal(t, SourceEtcd, certificates[0].Source)
+		assert.True(t, certificates[0].Expired(now))
+		assert.Equal(t, []string{"list-upstream"}, certificates[0].UpstreamIDs)
+		assert.Equal(t, "list-soon", certificates[1].ID)
+		assert.Equal(t, SourceEdit, certificates[1].Source)
+		assert.Equal(t, []string{"soon.example.com"}, certificates[1].Snis)
+		assert.Equal(t, 4, certificates[1].ExpiresInDays(now.Add(time.Minute)))
+	}
+
+	count, err := CountExpiredInUse(ctx, gateway.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestCheckExpiry(t *testing.T) {
+	ctx := context.Background()
+	gateway := newTestGateway(t)
+	now := time.Now()
+	webhook := &model.GatewayWebhook{
+		GatewayID: gateway.ID,
+		Name:      "ssl",
+		URL:       "https://example.com/hook",
+		Secret:    "secret",
+		Events:    []string{string(constant.WebhookEventSSLExpiring)},
+		Enabled:   true,
+	}
+	assert.NoError(t, repo.GatewayWebhook.WithContext(ctx).Create(webhook))
+
+	ssl := createSSL(t, gateway.ID, "check-soon", newCertConfig(t, "check.example.com", now.Add(5*24*time.Hour)))
+	createSSL(t, gateway.ID, "check-later", newCertConfig(t, "later.example.com", now.AddDate(1, 0, 0)))
+
+	count, err := CheckExpiry(ctx)
+	assert.NoError(t, err)
+	assert.Positive(t, count)
+	// 同一阈值只告警一次
+	count, err = CheckExpiry(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	a := repo.SSLExpiryAlert
+	alerts, err := a.WithContext(ctx).Where(a.GatewayID.Eq(gateway.ID)).Find()
+	assert.NoError(t, err)
+	if assert.Len(t, alerts, 1) {
+		assert.Equal(t, ssl.ID, alerts[0].SSLID)
+		assert.Equal(t, 7, alerts[0].Threshold)
+	}
+
+	o := repo.OperationAuditLog
+	logs, err := o.WithContext(ctx).Where(
+		o.GatewayID.Eq(gateway.ID),
+		o.OperationType.Eq(string(constant.OperationTypeSSLExpiring)),
+	).Find()
+	assert.NoError(t, err)
+	if assert.Len(t, logs, 1) {
+		assert.Equal(t, ssl.ID, logs[0].ResourceIDs)
+		assert.Contains(t, string(logs[0].DataAfter), `"threshold":7`)
+	}
+
+	d := repo.GatewayWebhookDelivery
+	deliveries, err := d.WithContext(ctx).Where(
+		d.WebhookID.Eq(webhook.ID),
+		d.Event.Eq(string(constant.WebhookEventSSLExpiring)),
+	).Find()
+	assert.NoError(t, err)
+	if assert.Len(t, deliveries, 1) {
+		var payload model.WebhookEventPayload
+		assert.NoError(t, json.Unmarshal(deliveries[0].Payload, &payload))
+		data, _ := json.Marshal(payload.Data)
+		assert.Contains(t, string(data), `"id":"check-soon"`)
+		assert.Contains(t, string(data), `"expired":false`)
+	}
+}
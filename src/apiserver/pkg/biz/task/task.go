@@ -0,0 +1,202 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package task 提供基于数据库的后台任务队列：
+// 1. 耗时操作（一键发布、资源导入、etcd 导出等）通过 Enqueue 入队，请求直接返回任务 ID
+// 2. worker 通过租约抢占任务执行，多个 webserver/scheduler 实例同时运行也不会重复执行
+// 3. 任务执行过程中可通过 ReportProgress 上报进度，并感知用户的取消请求
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"gorm.io/gen"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// 通过任务队列执行的任务名称
+const (
+	TaskNamePublishAll            = "PublishAllResource"
+	TaskNameResourceImport        = "ResourceImport"
+	TaskNameSyncedResourceManaged = "SyncedResourceManaged"
+	TaskNameEtcdExport            = "EtcdExport"
+)
+
+// PublishAllArgs 一键发布任务参数
+type PublishAllArgs struct {
+	Changelog                 string `json:"changelog"`
+	OverrideMaintenanceWindow bool   `json:"override_maintenance_window"`
+}
+
+// SyncedResourceManagedArgs 同步资源纳管任务参数
+type SyncedResourceManagedArgs struct {
+	ResourceIDList []string `json:"resource_id_list"`
+}
+
+// TaskErrors 定义后台任务相关的错误
+var (
+	ErrTaskNotFound  = errors.New("task not found")
+	ErrTaskFinished  = errors.New("task is already finished")
+	ErrTaskCancelled = errors.New("task is cancelled")
+)
+
+// now 当前时间，测试时可替换
+var now = time.Now
+
+// Enqueue 下发后台任务，任务归属于 ctx 中的网关及用户，maxAttempts 为失败时的最大执行次数
+func Enqueue(ctx context.Context, name string, args any, maxAttempts int) (*model.Task, error) {
+	argsData, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	runAt := now()
+	task := &model.Task{
+		Name:        name,
+		Args:        argsData,
+		Status:      constant.TaskStatusPending,
+		MaxAttempts: maxAttempts,
+		RunAt:       &runAt,
+		BaseModel: model.BaseModel{
+			Creator: ginx.GetUserIDFromContext(ctx),
+			Updater: ginx.GetUserIDFromContext(ctx),
+		},
+	}
+	if gatewayInfo := ginx.GetGatewayInfoFromContext(ctx); gatewayInfo != nil {
+		task.GatewayID = gatewayInfo.ID
+	}
+	if err := repo.Task.WithContext(ctx).Create(task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// ListTasks 查询网关的后台任务
+func ListTasks(
+	ctx context.Context,
+	gatewayID int,
+	name string,
+	status constant.TaskStatus,
+	page utils.PageParam,
+) ([]*model.Task, int64, error) {
+	u := repo.Task
+	conds := []gen.Condition{u.GatewayID.Eq(gatewayID)}
+	if name != "" {
+		conds = append(conds, u.Name.Eq(name))
+	}
+	if status != "" {
+		conds = append(conds, u.Status.Eq(string(status)))
+	}
+	return u.WithContext(ctx).Where(conds...).Order(u.ID.Desc()).FindByPage(page.Offset, page.Limit)
+}
+
+// GetTask 获取网关的后台任务
+func GetTask(ctx context.Context, gatewayID int, id int64) (*model.Task, error) {
+	u := repo.Task
+	task, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTaskNotFound
+	}
+	return task, err
+}
+
+// CancelTask 取消后台任务：排队中的任务直接取消，执行中的任务标记取消请求，由执行的 worker 感知后中止
+func CancelTask(ctx context.Context, task *model.Task) error {
+	operator := ginx.GetUserIDFromContext(ctx)
+	u := repo.Task
+	finishedAt := now()
+	info, err := u.WithContext(ctx).Where(
+		u.ID.Eq(task.ID),
+		u.Status.Eq(string(constant.TaskStatusPending)),
+	).UpdateSimple(
+		u.Status.Value(string(constant.TaskStatusCancelled)),
+		u.CancelRequested.Value(true),
+		u.FinishedAt.Value(finishedAt),
+		u.Updater.Value(operator),
+	)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected > 0 {
+		task.Status = constant.TaskStatusCancelled
+		task.CancelRequested = true
+		task.FinishedAt = &finishedAt
+		return nil
+	}
+	info, err = u.WithContext(ctx).Where(
+		u.ID.Eq(task.ID),
+		u.Status.Eq(string(constant.TaskStatusRunning)),
+	).UpdateSimple(
+		u.CancelRequested.Value(true),
+		u.Updater.Value(operator),
+	)
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return ErrTaskFinished
+	}
+	task.CancelRequested = true
+	return nil
+}
+
+// progressReporterKey 上报任务进度的 context key
+type progressReporterKey struct{}
+
+// progressReporter 由 worker 注入到任务执行的 context 中
+type progressReporter struct {
+	taskID int64
+	owner  string
+}
+
+// ReportProgress 上报当前任务的进度（0-100）及说明，任务被取消时返回 ErrTaskCancelled
+//
+// 不是通过任务队列执行时（如同步的 HTTP 请求）不做任何处理，因此业务代码可以直接调用
+func ReportProgress(ctx context.Context, progress int, message string) error {
+	reporter, ok := ctx.Value(progressReporterKey{}).(*progressReporter)
+	if !ok {
+		return nil
+	}
+	progress = min(max(progress, 0), 100)
+	u := repo.Task
+	if _, err := u.WithContext(ctx).Where(u.ID.Eq(reporter.taskID), u.LeaseOwner.Eq(reporter.owner)).UpdateSimple(
+		u.Progress.Value(progress),
+		u.Message.Value(message),
+	); err != nil {
+		return err
+	}
+	task, err := u.WithContext(ctx).Select(u.CancelRequested).Where(u.ID.Eq(reporter.taskID)).First()
+	if err != nil {
+		return err
+	}
+	if task.CancelRequested {
+		return ErrTaskCancelled
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/hostx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/uuidx"
+)
+
+const (
+	// 任务租约时长，执行中的任务会定期续约，租约过期说明执行的 worker 已退出，任务可被其他 worker 重新抢占
+	defaultLeaseDuration = time.Minute
+	// 队列为空时的轮询间隔
+	defaultPollInterval = 3 * time.Second
+	// 失败重试的退避时间，按执行次数指数增长
+	defaultRetryBackoff = 10 * time.Second
+	// 每次抢占任务时查询的候选任务数量
+	claimBatchSize = 10
+)
+
+// Handler 任务处理函数，返回值序列化后记录为任务结果
+type Handler func(ctx context.Context, task *model.Task) (any, error)
+
+// Worker 从任务队列抢占并执行任务
+type Worker struct {
+	owner         string
+	handlers      map[string]Handler
+	leaseDuration time.Duration
+	pollInterval  time.Duration
+	retryBackoff  time.Duration
+}
+
+// NewWorker 创建 worker，只会执行 handlers 中注册的任务
+func NewWorker(handlers map[string]Handler) *Worker {
+	return &Worker{
+		owner:         fmt.Sprintf("%s-%d-%s", hostx.GetHostname(), os.Getpid(), uuidx.New()[:8]),
+		handlers:      handlers,
+		leaseDuration: defaultLeaseDuration,
+		pollInterval:  defaultPollInterval,
+		retryBackoff:  defaultRetryBackoff,
+	}
+}
+
+// Run 持续执行队列中的任务，直到 ctx 结束
+func (w *Worker) Run(ctx context.Context) {
+	logging.Infof("task worker %s started", w.owner)
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+	for {
+		// 有可执行的任务时连续执行，队列为空时等待下一次轮询
+		for ctx.Err() == nil {
+			ok, err := w.RunOnce(ctx)
+			if err != nil {
+				logging.Errorf("task worker %s run err: %s", w.owner, err)
+			}
+			if !ok || err != nil {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			logging.Infof("task worker %s stopped", w.owner)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce 抢占并执行一个任务，没有可执行的任务时返回 false
+func (w *Worker) RunOnce(ctx context.Context) (bool, error) {
+	task, err := w.claim(ctx)
+	if err != nil || task == nil {
+		return false, err
+	}
+	w.execute(ctx, task)
+	return true, nil
+}
+
+// claim 抢占一个到期的排队任务或租约已过期的执行中任务
+//
+// 通过 status + attempts 的条件更新实现乐观锁：每次抢占都会增加 attempts，因此同一时刻只有一个 worker 能抢占成功
+func (w *Worker) claim(ctx context.Context) (*model.Task, error) {
+	if len(w.handlers) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(w.handlers))
+	for name := range w.handlers {
+		names = append(names, name)
+	}
+	current := now()
+	u := repo.Task
+	q := u.WithContext(ctx)
+	candidates, err := q.Where(
+		u.Name.In(names...),
+		q.Where(
+			u.Status.Eq(string(constant.TaskStatusPending)), u.RunAt.Lte(current),
+		).Or(
+			u.Status.Eq(string(constant.TaskStatusRunning)), u.LeaseExpiredAt.Lt(current),
+		),
+	).Order(u.ID).Limit(claimBatchSize).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range candidates {
+		conds := []gen.Condition{
+			u.ID.Eq(candidate.ID),
+			u.Status.Eq(string(candidate.Status)),
+			u.Attempts.Eq(candidate.Attempts),
+		}
+		// 执行中的任务租约过期：已达到最大执行次数或已请求取消时直接结束，不再重新执行
+		if candidate.Status == constant.TaskStatusRunning &&
+			(candidate.CancelRequested || candidate.Attempts >= candidate.MaxAttempts) {
+			status, message := constant.TaskStatusFailed, "task lease expired"
+			if candidate.CancelRequested {
+				status, message = constant.TaskStatusCancelled, ErrTaskCancelled.Error()
+			}
+			if _, err := u.WithContext(ctx).Where(conds...).UpdateSimple(
+				u.Status.Value(string(status)),
+				u.Error.Value(message),
+				u.FinishedAt.Value(current),
+			); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		leaseExpiredAt := current.Add(w.leaseDuration)
+		info, err := u.WithContext(ctx).Where(conds...).UpdateSimple(
+			u.Status.Value(string(constant.TaskStatusRunning)),
+			u.LeaseOwner.Value(w.owner),
+			u.LeaseExpiredAt.Value(leaseExpiredAt),
+			u.Attempts.Value(candidate.Attempts+1),
+			u.StartedAt.Value(current),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if info.RowsAffected == 0 {
+			// 已被其他 worker 抢占
+			continue
+		}
+		candidate.Status = constant.TaskStatusRunning
+		candidate.LeaseOwner = w.owner
+		candidate.LeaseExpiredAt = &leaseExpiredAt
+		candidate.Attempts++
+		candidate.StartedAt = current
+		return candidate, nil
+	}
+	return nil, nil
+}
+
+// execute 执行任务并记录执行结果，执行期间定期续约并检查取消请求
+func (w *Worker) execute(ctx context.Context, task *model.Task) {
+	taskCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	taskCtx = context.WithValue(taskCtx, progressReporterKey{}, &progressReporter{taskID: task.ID, owner: w.owner})
+
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		w.heartbeat(taskCtx, task, cancel)
+	}()
+	result, err := w.handle(taskCtx, task)
+	cancel()
+	<-heartbeatDone
+
+	// worker 退出时 ctx 已结束，仍需记录执行结果（中断的任务重新排队）
+	w.finish(context.WithoutCancel(ctx), task, result, err)
+}
+
+// handle 调用任务处理函数，处理函数 panic 时视为执行失败
+func (w *Worker) handle(ctx context.Context, task *model.Task) (result any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("task panic: %v", r)
+		}
+	}()
+	return w.handlers[task.Name](ctx, task)
+}
+
+// heartbeat 定期续约，租约丢失或任务被请求取消时中止任务的执行
+func (w *Worker) heartbeat(ctx context.Context, task *model.Task, cancel context.CancelFunc) {
+	ticker := time.NewTicker(w.leaseDuration / 3)
+	defer ticker.Stop()
+	u := repo.Task
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		info, err := u.WithContext(ctx).Where(
+			u.ID.Eq(task.ID),
+			u.LeaseOwner.Eq(w.owner),
+			u.Status.Eq(string(constant.TaskStatusRunning)),
+		).UpdateSimple(u.LeaseExpiredAt.Value(now().Add(w.leaseDuration)))
+		if err != nil {
+			logging.Errorf("renew lease of task %d err: %s", task.ID, err)
+			continue
+		}
+		if info.RowsAffected == 0 {
+			logging.Warnf("task %d lease lost, abort", task.ID)
+			cancel()
+			return
+		}
+		current, err := u.WithContext(ctx).Select(u.CancelRequested).Where(u.ID.Eq(task.ID)).First()
+		if err == nil && current.CancelRequested {
+			cancel()
+			return
+		}
+	}
+}
+
+// finish 记录任务执行结果，失败且未达到最大执行次数时按退避时间重新排队
+func (w *Worker) finish(ctx context.Context, task *model.Task, result any, err error) {
+	u := repo.Task
+	current, queryErr := u.WithContext(ctx).Where(u.ID.Eq(task.ID)).First()
+	if queryErr != nil {
+		logging.Errorf("query task %d err: %s", task.ID, queryErr)
+		return
+	}
+	if current.LeaseOwner != w.owner || current.Status != constant.TaskStatusRunning {
+		// 租约已被其他 worker 抢占，由其记录执行结果
+		logging.Warnf("task %d lease lost, skip saving result", task.ID)
+		return
+	}
+
+	finishedAt := now()
+	updates := []field.AssignExpr{
+		u.Duration.Value(int64(finishedAt.Sub(task.StartedAt))),
+	}
+	switch {
+	case err == nil:
+		task.Status = constant.TaskStatusSucceeded
+		task.Progress = 100
+		task.Error = ""
+		if result != nil {
+			data, marshalErr := json.Marshal(result)
+			if marshalErr != nil {
+				logging.Errorf("marshal result of task %d err: %s", task.ID, marshalErr)
+			} else {
+				task.Result = datatypes.JSON(data)
+				updates = append(updates, u.Result.Value(task.Result))
+			}
+		}
+	case current.CancelRequested || errors.Is(err, ErrTaskCancelled):
+		task.Status = constant.TaskStatusCancelled
+		task.Error = err.Error()
+	case task.Attempts < task.MaxAttempts:
+		task.Status = constant.TaskStatusPending
+		task.Error = err.Error()
+		runAt := finishedAt.Add(w.retryBackoff * time.Duration(1<<(task.Attempts-1)))
+		task.RunAt = &runAt
+		updates = append(updates, u.RunAt.Value(runAt))
+	default:
+		task.Status = constant.TaskStatusFailed
+		task.Error = err.Error()
+	}
+	if task.Status != constant.TaskStatusPending {
+		task.FinishedAt = &finishedAt
+		updates = append(updates, u.FinishedAt.Value(finishedAt))
+	}
+	updates = append(updates,
+		u.Status.Value(string(task.Status)),
+		u.Progress.Value(max(task.Progress, current.Progress)),
+		u.Error.Value(task.Error),
+	)
+	if _, err := u.WithContext(ctx).Where(
+		u.ID.Eq(task.ID),
+		u.LeaseOwner.Eq(w.owner),
+		u.Status.Eq(string(constant.TaskStatusRunning)),
+	).UpdateSimple(updates...); err != nil {
+		logging.Errorf("save result of task %d err: %s", task.ID, err)
+	}
+}
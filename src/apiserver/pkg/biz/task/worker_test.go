@@ -0,0 +1,204 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package task
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+func TestMain(m *testing.M) {
+	// 初始化embed数据库
+	util.InitEmbedDb()
+	os.Exit(m.Run())
+}
+
+var gatewayID int
+
+// newTaskContext 每个测试使用独立的网关 ID，并只注册当前测试的任务，避免互相影响
+func newTaskContext() context.Context {
+	gatewayID++
+	ctx := ginx.SetGatewayInfoToContext(context.Background(), &model.Gateway{ID: gatewayID})
+	return context.WithValue(ctx, constant.UserIDKey, "admin")
+}
+
+func getTask(t *testing.T, id int64) *model.Task {
+	t.Helper()
+	task, err := repo.Task.WithContext(context.Background()).Where(repo.Task.ID.Eq(id)).First()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return task
+}
+
+func TestWorkerRunSuccess(t *testing.T) {
+	ctx := newTaskContext()
+	task, err := Enqueue(ctx, t.Name(), map[string]int{"n": 3}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.TaskStatusPending, task.Status)
+	assert.Equal(t, "admin", task.Creator)
+
+	worker := NewWorker(map[string]Handler{
+		t.Name(): func(ctx context.Context, task *model.Task) (any, error) {
+			assert.NoError(t, ReportProgress(ctx, 50, "half"))
+			return map[string]int{"result": 6}, nil
+		},
+	})
+	ok, err := worker.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	task = getTask(t, task.ID)
+	assert.Equal(t, constant.TaskStatusSucceeded, task.Status)
+	assert.Equal(t, 100, task.Progress)
+	assert.Equal(t, "half", task.Message)
+	assert.Equal(t, 1, task.Attempts)
+	assert.JSONEq(t, `{"result": 6}`, string(task.Result))
+	assert.NotNil(t, task.FinishedAt)
+
+	// 已执行完成的任务不会被再次抢占
+	ok, err = worker.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	tasks, total, err := ListTasks(ctx, gatewayID, t.Name(), "", utils.PageParam{Limit: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, tasks, 1)
+	_, err = GetTask(ctx, gatewayID+1, task.ID)
+	assert.ErrorIs(t, err, ErrTaskNotFound)
+}
+
+func TestWorkerRetry(t *testing.T) {
+	ctx := newTaskContext()
+	task, err := Enqueue(ctx, t.Name(), nil, 2)
+	assert.NoError(t, err)
+
+	worker := NewWorker(map[string]Handler{
+		t.Name(): func(ctx context.Context, task *model.Task) (any, error) {
+			panic("boom")
+		},
+	})
+	ok, err := worker.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// 首次失败后按退避时间重新排队
+	task = getTask(t, task.ID)
+	assert.Equal(t, constant.TaskStatusPending, task.Status)
+	assert.Equal(t, 1, task.Attempts)
+	assert.Contains(t, task.Error, "boom")
+	assert.True(t, task.RunAt.After(time.Now()))
+	ok, err = worker.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// 到期后重新执行，达到最大执行次数后标记为失败
+	worker.retryBackoff = 0
+	_, err = repo.Task.WithContext(ctx).Where(repo.Task.ID.Eq(task.ID)).
+		UpdateSimple(repo.Task.RunAt.Value(time.Now().Add(-time.Second)))
+	assert.NoError(t, err)
+	ok, err = worker.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	task = getTask(t, task.ID)
+	assert.Equal(t, constant.TaskStatusFailed, task.Status)
+	assert.Equal(t, 2, task.Attempts)
+	assert.NotNil(t, task.FinishedAt)
+}
+
+func TestCancelTask(t *testing.T) {
+	ctx := newTaskContext()
+
+	// 排队中的任务直接取消
+	pending, err := Enqueue(ctx, t.Name(), nil, 1)
+	assert.NoError(t, err)
+	assert.NoError(t, CancelTask(ctx, pending))
+	assert.Equal(t, constant.TaskStatusCancelled, getTask(t, pending.ID).Status)
+	assert.ErrorIs(t, CancelTask(ctx, pending), ErrTaskFinished)
+
+	// 执行中的任务在上报进度时感知取消
+	running, err := Enqueue(ctx, t.Name(), nil, 3)
+	assert.NoError(t, err)
+	worker := NewWorker(map[string]Handler{
+		t.Name(): func(ctx context.Context, task *model.Task) (any, error) {
+			assert.NoError(t, CancelTask(ctx, task))
+			if err := ReportProgress(ctx, 10, "step 1"); err != nil {
+				return nil, err
+			}
+			return nil, errors.New("should be cancelled")
+		},
+	})
+	ok, err := worker.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	task := getTask(t, running.ID)
+	assert.Equal(t, constant.TaskStatusCancelled, task.Status)
+	assert.Equal(t, 1, task.Attempts)
+}
+
+func TestWorkerLease(t *testing.T) {
+	ctx := newTaskContext()
+	task, err := Enqueue(ctx, t.Name(), nil, 2)
+	assert.NoError(t, err)
+
+	handlers := map[string]Handler{
+		t.Name(): func(ctx context.Context, task *model.Task) (any, error) {
+			return task.LeaseOwner, nil
+		},
+	}
+	worker1 := NewWorker(handlers)
+	worker2 := NewWorker(handlers)
+
+	// worker1 抢占后退出（未续约），租约未过期时 worker2 无法抢占
+	claimed, err := worker1.claim(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, task.ID, claimed.ID)
+	ok, err := worker2.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// 租约过期后由 worker2 重新抢占执行
+	_, err = repo.Task.WithContext(ctx).Where(repo.Task.ID.Eq(task.ID)).
+		UpdateSimple(repo.Task.LeaseExpiredAt.Value(time.Now().Add(-time.Second)))
+	assert.NoError(t, err)
+	ok, err = worker2.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	task = getTask(t, task.ID)
+	assert.Equal(t, constant.TaskStatusSucceeded, task.Status)
+	assert.Equal(t, 2, task.Attempts)
+	assert.JSONEq(t, `"`+worker2.owner+`"`, string(task.Result))
+
+	// worker1 的执行结果不会覆盖 worker2 的结果
+	worker1.finish(context.Background(), claimed, nil, errors.New("stale"))
+	assert.Equal(t, constant.TaskStatusSucceeded, getTask(t, task.ID).Status)
+}
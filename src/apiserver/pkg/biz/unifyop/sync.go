@@ -23,7 +23,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math/rand"
 	"time"
 
 	"github.com/pkg/errors"
@@ -31,11 +30,13 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	driftbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/drift"
 	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	schemabiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/schema"
 	syncdatabiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/syncdata"
-	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	variablebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/variable"
+	webhookbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/webhook"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
@@ -48,6 +49,7 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/goroutinex"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 // UnifyOpInterface ...
@@ -68,6 +70,8 @@ type UnifyOp struct {
 	gatewayInfo *model.Gateway
 	elector     *election.EtcdLeaderElector
 	isLeader    bool
+	// 配置漂移检测器，同步后对比 etcd 配置与最近一次发布的配置
+	driftDetector *driftbiz.Detector
 }
 
 // SyncAll 同步所有资源
@@ -263,6 +267,18 @@ func insertSyncedResourcesModel(
 			if err != nil {
 				return err
 			}
+		case constant.Credential:
+			credentials := resourceList.([]*model.Credential) //nolint:forcetypeassert
+			err = resourcebiz.BatchCreateCredentials(ctx, credentials)
+			if err != nil {
+				return err
+			}
+		case constant.Secret:
+			secrets := resourceList.([]*model.Secret) //nolint:forcetypeassert
+			err = resourcebiz.BatchCreateSecrets(ctx, secrets)
+			if err != nil {
+				return err
+			}
 		case constant.GlobalRule:
 			globalRules := resourceList.([]*model.GlobalRule) //nolint:forcetypeassert
 			err = resourcebiz.BatchCreateGlobalRules(ctx, globalRules)
@@ -401,6 +417,7 @@ func GetSyncItemsAssociatedResources(
 		addAssociatedID(constant.Upstream, item.GetUpstreamID())
 		addAssociatedID(constant.PluginConfig, item.GetPluginConfigID())
 		addAssociatedID(constant.ConsumerGroup, item.GetGroupID())
+		addAssociatedID(constant.Consumer, item.GetConsumerID())
 		addAssociatedID(constant.SSL, item.GetSSLID())
 	}
 	if len(associatedIDsByType) > 0 {
@@ -449,8 +466,16 @@ func SyncResources(
 }
 
 // NewUnifyOp 创建 UnifyOp
+//
+// 需要选主的 UnifyOp 长期运行 watch，使用独立的连接，其余复用连接池中的连接
 func NewUnifyOp(gatewayInfo *model.Gateway, needElector bool) (*UnifyOp, error) {
-	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	var etcdStore storage.StorageInterface
+	var err error
+	if needElector {
+		etcdStore, err = storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	} else {
+		etcdStore, err = storage.NewGatewayEtcdStorage(gatewayInfo.ID, gatewayInfo.EtcdConfig.EtcdConfig)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -464,69 +489,55 @@ func NewUnifyOp(gatewayInfo *model.Gateway, needElector bool) (*UnifyOp, error)
 		isLeader = false
 	}
 	return &UnifyOp{
-		etcdStore:   etcdStore,
-		gatewayInfo: gatewayInfo,
-		elector:     elector,
-		isLeader:    isLeader,
+		etcdStore:     etcdStore,
+		gatewayInfo:   gatewayInfo,
+		elector:       elector,
+		isLeader:      isLeader,
+		driftDetector: driftbiz.NewDetector(),
 	}, nil
 }
 
-// SyncerRun 定时同步
-func (s *UnifyOp) SyncerRun(ctx context.Context) {
-	s.elector.Run(ctx)
-	s.elector.WaitForLeading()
-	s.isLeader = s.elector.IsLeader()
-	for {
-		time.Sleep(nextSyncInterval(config.G.Biz.SyncInterval))
-		// prefix 可能会更新，再查一次
-		gatewayInfo, err := gatewaybiz.GetGateway(ctx, s.gatewayInfo.ID)
-		if err != nil {
-			logging.Errorf("get gateway error: %s", err.Error())
-			continue
-		}
-		s.gatewayInfo = gatewayInfo
-		gatewayCtx := context.Background()
-		gatewayCtx = ginx.SetGatewayInfoToContext(gatewayCtx, s.gatewayInfo)
-		// 使用标准化的 prefix（带 "/" 结尾，避免前缀匹配冲突）
-		_, err = s.SyncWithPrefix(gatewayCtx, s.gatewayInfo.GetEtcdPrefixForList())
-		if err != nil {
-			logging.Errorf("sync all error: %s", err.Error())
-		}
-	}
-}
-
-func nextSyncInterval(baseInterval time.Duration) time.Duration {
-	minDelay := 1
-	maxDelay := 300
-	jitter := rand.Intn(maxDelay-minDelay+1) + minDelay //nolint:gosec // G404: non-security random for jitter
-	return baseInterval + time.Second*time.Duration(jitter)
-}
-
 // SyncWithPrefix 同步 prefix 下面的所有资源
 func (s *UnifyOp) SyncWithPrefix(ctx context.Context, prefix string) (map[constant.APISIXResource]int, error) {
 	if !s.isLeader {
 		return nil, nil
 	}
+	syncedResourceTypeStats, _, err := s.syncWithPrefix(ctx, prefix, false)
+	return syncedResourceTypeStats, err
+}
+
+// syncWithPrefix 全量同步 prefix 下面的所有资源，返回本次全量拉取对应的 etcd revision
+//
+// saveRevision 为 true 时会同时记录网关已同步的 revision，供增量同步从该 revision 继续 watch
+func (s *UnifyOp) syncWithPrefix(
+	ctx context.Context,
+	prefix string,
+	saveRevision bool,
+) (map[constant.APISIXResource]int, int64, error) {
 	logging.Infof("syncer[gateway:%s] start", s.gatewayInfo.Name)
-	kvList, err := s.etcdStore.List(ctx, prefix)
+	kvList, revision, err := s.etcdStore.ListWithRevision(ctx, prefix)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	resourceList, err := s.kvToResource(ctx, kvList)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// 获取已同步资源
 	syncedItems, err := syncdatabiz.QuerySyncedItems(ctx, map[string]any{})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	databaseResourceMap := make(map[string]*model.GatewaySyncData)
 	for _, item := range syncedItems {
 		databaseResourceMap[item.GetResourceKey()] = item
 	}
 	changeSet := buildSyncChangeSet(resourceList, syncedItems)
+	syncEvent, err := newSyncedResourcesEvent(ctx, changeSet.ToCreate)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	u := repo.GatewaySyncData
 	err = repo.Q.Transaction(func(tx *repo.Query) error {
@@ -536,7 +547,7 @@ func (s *UnifyOp) SyncWithPrefix(ctx context.Context, prefix string) (map[consta
 				Clauses(clause.OnConflict{
 					Columns: []clause.Column{{Name: "auto_id"}},
 					DoUpdates: clause.AssignmentColumns(
-						[]string{"config", "mod_revision", "updated_at"},
+						[]string{"config", "mod_revision", "validity_start", "validity_end", "updated_at"},
 					),
 				}).
 				CreateInBatches(changeSet.ToUpdate, 500)
@@ -567,19 +578,15 @@ func (s *UnifyOp) SyncWithPrefix(ctx context.Context, prefix string) (map[consta
 			}
 		}
 
+		if syncEvent != nil {
+			err = webhookbiz.EmitWithTx(ctx, tx, s.gatewayInfo.ID, constant.WebhookEventSyncNewResources, syncEvent)
+			if err != nil {
+				return err
+			}
+		}
+
 		// always update the sync time
-		g := tx.Gateway
-		s.gatewayInfo.LastSyncedAt = time.Now()
-		_, err = g.WithContext(
-			ctx,
-		).Where(
-			g.ID.Eq(s.gatewayInfo.ID),
-		).Select(
-			g.LastSyncedAt,
-		).Updates(
-			s.gatewayInfo,
-		)
-		if err != nil {
+		if err = s.updateSyncedState(ctx, tx, revision, saveRevision); err != nil {
 			return err
 		}
 
@@ -587,9 +594,12 @@ func (s *UnifyOp) SyncWithPrefix(ctx context.Context, prefix string) (map[consta
 	})
 	if err != nil {
 		logging.Errorf("sync gateway:%s resource error: %s", s.gatewayInfo.Name, err.Error())
-		return nil, err
+		return nil, 0, err
 	}
 	logging.Infof("syncer[gateway:%s] end", s.gatewayInfo.Name)
+	s.detectDrift(func(detector *driftbiz.Detector) error {
+		return detector.DetectAll(ctx, prefix, resourceList)
+	})
 
 	// 统计最新同步的资源类型及数量
 	syncedResourceTypeStats := make(map[constant.APISIXResource]int)
@@ -599,7 +609,39 @@ func (s *UnifyOp) SyncWithPrefix(ctx context.Context, prefix string) (map[consta
 			syncedResourceTypeStats[resource.Type]++
 		}
 	}
-	return syncedResourceTypeStats, nil
+	return syncedResourceTypeStats, revision, nil
+}
+
+// detectDrift 同步后检测配置漂移，检测失败不影响同步结果
+func (s *UnifyOp) detectDrift(detect func(detector *driftbiz.Detector) error) {
+	if s.driftDetector == nil {
+		return
+	}
+	if err := detect(s.driftDetector); err != nil {
+		logging.Errorf("syncer[gateway:%s] detect drift error: %s", s.gatewayInfo.Name, err.Error())
+	}
+}
+
+// updateSyncedState 更新网关同步时间，saveRevision 为 true 时同时记录已同步的 etcd revision
+func (s *UnifyOp) updateSyncedState(ctx context.Context, tx *repo.Query, revision int64, saveRevision bool) error {
+	g := tx.Gateway
+	s.gatewayInfo.LastSyncedAt = time.Now()
+	_, err := g.WithContext(
+		ctx,
+	).Where(
+		g.ID.Eq(s.gatewayInfo.ID),
+	).Select(
+		g.LastSyncedAt,
+	).Updates(
+		s.gatewayInfo,
+	)
+	if err != nil || !saveRevision {
+		return err
+	}
+	// revision 只用于增量同步续传，直接更新字段，不触发网关更新审计
+	s.gatewayInfo.SyncedRevision = revision
+	_, err = g.WithContext(ctx).Where(g.ID.Eq(s.gatewayInfo.ID)).UpdateColumnSimple(g.SyncedRevision.Value(revision))
+	return err
 }
 
 var revertConfigByIDListFunc = map[constant.APISIXResource]func(ctx context.Context,
@@ -611,6 +653,8 @@ var revertConfigByIDListFunc = map[constant.APISIXResource]func(ctx context.Cont
 	constant.PluginMetadata: resourcebiz.BatchRevertPluginMetadatas,
 	constant.Consumer:       resourcebiz.BatchRevertConsumers,
 	constant.ConsumerGroup:  resourcebiz.BatchRevertConsumerGroups,
+	constant.Credential:     resourcebiz.BatchRevertCredentials,
+	constant.Secret:         resourcebiz.BatchRevertSecrets,
 	constant.GlobalRule:     resourcebiz.BatchRevertGlobalRules,
 	constant.SSL:            resourcebiz.BatchRevertSSLs,
 	constant.Proto:          resourcebiz.BatchRevertProtos,
@@ -650,6 +694,10 @@ func (s *UnifyOp) RevertConfigByIDList(
 	}
 	var needRevertResourceList []*model.GatewaySyncData
 	for _, etcdResource := range etcdResourceList {
+		// consumers/ 前缀下同时包含 consumer 与 credential，需按类型过滤
+		if etcdResource.Type != resourceType {
+			continue
+		}
 		// 过滤掉不需要回滚的资源
 		if _, ok := resourceIDMap[etcdResource.ID]; !ok {
 			continue
@@ -674,6 +722,15 @@ func (s *UnifyOp) kvToResource(
 	return resources, nil
 }
 
+// BuildSyncedResourcesFromKVs 将 etcd 中的 key-value 转换为同步资源，供发布版本快照等场景复用
+func BuildSyncedResourcesFromKVs(
+	ctx context.Context,
+	gatewayInfo *model.Gateway,
+	kvList []storage.KeyValuePair,
+) ([]*model.GatewaySyncData, error) {
+	return buildSyncSnapshotResources(ctx, gatewayInfo, kvList)
+}
+
 // SyncedResourceToAPISIXResource 将同步的资源转换为 apisix 的资源
 func SyncedResourceToAPISIXResource(
 	resourceType constant.APISIXResource,
@@ -695,6 +752,10 @@ func SyncedResourceToAPISIXResource(
 		return syncedResourceToAPISIXConsumer(syncedResources, status)
 	case constant.ConsumerGroup:
 		return syncedResourceToAPISIXConsumerGroup(syncedResources, status)
+	case constant.Credential:
+		return syncedResourceToAPISIXCredential(syncedResources, status)
+	case constant.Secret:
+		return syncedResourceToAPISIXSecret(syncedResources, status)
 	case constant.GlobalRule:
 		return syncedResourceToAPISIXGlobalRule(syncedResources, status)
 	case constant.SSL:
@@ -854,6 +915,46 @@ func syncedResourceToAPISIXConsumerGroup(
 	return consumerGroups
 }
 
+func syncedResourceToAPISIXCredential(
+	syncedResources []*model.GatewaySyncData,
+	status constant.ResourceStatus,
+) []*model.Credential {
+	var credentials []*model.Credential
+	for _, syncedResource := range syncedResources {
+		credentials = append(credentials, &model.Credential{
+			Name:       syncedResource.GetName(),
+			ConsumerID: syncedResource.GetConsumerID(),
+			ResourceCommonModel: model.ResourceCommonModel{
+				ID:        syncedResource.ID,
+				GatewayID: syncedResource.GatewayID,
+				Config:    syncedResource.Config,
+				Status:    status,
+			},
+		})
+	}
+	return credentials
+}
+
+func syncedResourceToAPISIXSecret(
+	syncedResources []*model.GatewaySyncData,
+	status constant.ResourceStatus,
+) []*model.Secret {
+	var secrets []*model.Secret
+	for _, syncedResource := range syncedResources {
+		secrets = append(secrets, &model.Secret{
+			Name:    syncedResource.GetName(),
+			Manager: syncedResource.GetSecretManager(),
+			ResourceCommonModel: model.ResourceCommonModel{
+				ID:        syncedResource.ID,
+				GatewayID: syncedResource.GatewayID,
+				Config:    syncedResource.Config,
+				Status:    status,
+			},
+		})
+	}
+	return secrets
+}
+
 func syncedResourceToAPISIXGlobalRule(
 	syncedResources []*model.GatewaySyncData,
 	status constant.ResourceStatus,
@@ -957,9 +1058,15 @@ func GetResourceConfigDiffDetail(
 		resourceInfo.Config = []byte(jsonx.RemoveJsonKey(string(resourceInfo.Config), []string{"name"}))
 		syncedResourceConfig = []byte(jsonx.RemoveJsonKey(string(syncedResourceConfig), []string{"name"}))
 	}
+	// 编辑区配置中的变量引用按发布时的值展示，敏感变量保留引用
+	editorConfig, err := variablebiz.ResolveForDisplay(ctx, json.RawMessage(resourceInfo.Config))
+	if err != nil {
+		return nil, err
+	}
+	// 两侧配置中的敏感字段均脱敏展示
 	return &dto.ResourceDiffDetailResponse{
-		EtcdConfig:   syncedResourceConfig,
-		EditorConfig: json.RawMessage(resourceInfo.Config),
+		EtcdConfig:   sensitive.Mask(resourceType, syncedResourceConfig),
+		EditorConfig: sensitive.Mask(resourceType, editorConfig),
 	}, nil
 }
 
@@ -975,3 +1082,81 @@ func (s *UnifyOp) ExportEtcdResources(ctx context.Context) ([]*model.GatewaySync
 	logging.Infof("export [gateway:%s] end ", s.gatewayInfo.Name)
 	return s.kvToResource(ctx, kvList)
 }
+
+// ExportGatewayEtcdResources 导出 ctx 中网关在 etcd 中的所有资源
+func ExportGatewayEtcdResources(ctx context.Context) (dto.EtcdExportOutput, error) {
+	exporter, err := NewUnifyOp(ginx.GetGatewayInfoFromContext(ctx), false)
+	if err != nil {
+		logging.ErrorFWithContext(ctx, "new exporter error: %s", err.Error())
+		return nil, err
+	}
+	resources, err := exporter.ExportEtcdResources(ctx)
+	if err != nil {
+		logging.ErrorFWithContext(ctx, "export etcd resources error: %s", err.Error())
+		return nil, err
+	}
+	outputs, err := buildEtcdExportOutput(ctx, resources)
+	if err != nil {
+		logging.ErrorFWithContext(ctx, "handle export etcd resources error: %s", err.Error())
+		return nil, err
+	}
+	return outputs, nil
+}
+
+// buildEtcdExportOutput 按资源类型整理导出的 etcd 资源，并附带网关的自定义插件 schema
+func buildEtcdExportOutput(
+	ctx context.Context,
+	resources []*model.GatewaySyncData,
+) (dto.EtcdExportOutput, error) {
+	outputs := make(dto.EtcdExportOutput)
+	for _, resource := range resources {
+		if resource.ID == "" {
+			resource.ID = idx.GenResourceID(resource.Type)
+		}
+		resourceOutput := dto.ResourceInfo{
+			ResourceType: resource.Type,
+			ResourceID:   resource.ID,
+			Name:         resource.GetName(),
+			Config:       json.RawMessage(sensitive.Mask(resource.Type, resource.Config)),
+		}
+		if _, ok := outputs[resource.Type]; !ok {
+			outputs[resource.Type] = []dto.ResourceInfo{resourceOutput}
+			continue
+		}
+		outputs[resource.Type] = append(outputs[resource.Type], resourceOutput)
+	}
+	if err := AppendSchemaExportOutput(ctx, outputs); err != nil {
+		return nil, err
+	}
+	return outputs, nil
+}
+
+// AppendSchemaExportOutput 将网关的自定义插件 schema 加入导出结果
+func AppendSchemaExportOutput(ctx context.Context, outputs dto.EtcdExportOutput) error {
+	schemaMap, err := schemabiz.GetCustomizePluginSchemaInfoMap(ctx)
+	if err != nil {
+		logging.ErrorFWithContext(ctx, "get customize plugin schema info map error: %s", err.Error())
+		return err
+	}
+	var schemaInfoList []dto.ResourceInfo
+	for name, schema := range schemaMap {
+		schemaInfo := map[string]any{
+			"name":    name,
+			"schema":  schema.Schema,
+			"example": schema.Example,
+		}
+		schemaInfoBytes, err := json.Marshal(schemaInfo)
+		if err != nil {
+			return fmt.Errorf("marshal schema info failed: %w", err)
+		}
+		schemaInfoList = append(schemaInfoList, dto.ResourceInfo{
+			ResourceType: constant.Schema,
+			Name:         name,
+			Config:       schemaInfoBytes,
+		})
+	}
+	if len(schemaInfoList) > 0 {
+		outputs[constant.Schema] = schemaInfoList
+	}
+	return nil
+}
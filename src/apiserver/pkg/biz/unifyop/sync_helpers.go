@@ -28,6 +28,7 @@ import (
 
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
@@ -52,6 +53,14 @@ func buildSyncedResourceFromKV(
 ) (*model.GatewaySyncData, bool) {
 	resourceKeyWithoutPrefix := strings.TrimPrefix(kv.Key, normalizedPrefix)
 	resourceKeyList := strings.Split(resourceKeyWithoutPrefix, "/")
+	// credential 的 key 为 consumers/{consumer_id}/credentials/{id}
+	if isCredentialKey(resourceKeyList) {
+		return buildSyncedCredentialFromKV(resourceKeyList, gatewayID, kv), true
+	}
+	// secret 的 key 为 secrets/{manager}/{id}
+	if isSecretKey(resourceKeyList) {
+		return buildSyncedSecretFromKV(resourceKeyList, gatewayID, kv), true
+	}
 	// key 不合法
 	if len(resourceKeyList) != 2 {
 		return nil, false
@@ -85,22 +94,91 @@ func buildSyncedResourceFromKV(
 	return resourceInfo, true
 }
 
+// isCredentialKey 判断 key 是否为 consumers/{consumer_id}/credentials/{id} 形式
+func isCredentialKey(resourceKeyList []string) bool {
+	return len(resourceKeyList) == 4 &&
+		resourceKeyList[0] == constant.ResourceTypePrefixMap[constant.Credential] &&
+		resourceKeyList[2] == "credentials" &&
+		resourceKeyList[1] != "" && resourceKeyList[3] != ""
+}
+
+// buildSyncedCredentialFromKV 将 credential 的 etcd KV 转换为 GatewaySyncData，
+// consumer_id 取自 key 并写入 config
+func buildSyncedCredentialFromKV(
+	resourceKeyList []string,
+	gatewayID int,
+	kv storage.KeyValuePair,
+) *model.GatewaySyncData {
+	consumerID := resourceKeyList[1]
+	id := resourceKeyList[3]
+	resourceInfo := &model.GatewaySyncData{
+		ID:          id,
+		GatewayID:   gatewayID,
+		Type:        constant.Credential,
+		Config:      datatypes.JSON(kv.Value),
+		ModRevision: int(kv.ModRevision),
+	}
+	resourceInfo.Config, _ = sjson.DeleteBytes(resourceInfo.Config, "update_time")
+	resourceInfo.Config, _ = sjson.DeleteBytes(resourceInfo.Config, "create_time")
+	resourceInfo.Config, _ = sjson.SetBytes(resourceInfo.Config, "consumer_id", consumerID)
+	if resourceInfo.GetName() == "" {
+		resourceInfo.SetName(fmt.Sprintf("credentials_%s", id))
+	}
+	return resourceInfo
+}
+
+// isSecretKey 判断 key 是否为 secrets/{manager}/{id} 形式
+func isSecretKey(resourceKeyList []string) bool {
+	return len(resourceKeyList) == 3 &&
+		resourceKeyList[0] == constant.ResourceTypePrefixMap[constant.Secret] &&
+		resourceKeyList[1] != "" && resourceKeyList[2] != ""
+}
+
+// buildSyncedSecretFromKV 将 secret 的 etcd KV 转换为 GatewaySyncData，
+// manager 取自 key 并写入 config
+func buildSyncedSecretFromKV(
+	resourceKeyList []string,
+	gatewayID int,
+	kv storage.KeyValuePair,
+) *model.GatewaySyncData {
+	manager := resourceKeyList[1]
+	id := resourceKeyList[2]
+	resourceInfo := &model.GatewaySyncData{
+		ID:          id,
+		GatewayID:   gatewayID,
+		Type:        constant.Secret,
+		Config:      datatypes.JSON(kv.Value),
+		ModRevision: int(kv.ModRevision),
+	}
+	resourceInfo.Config, _ = sjson.DeleteBytes(resourceInfo.Config, "update_time")
+	resourceInfo.Config, _ = sjson.DeleteBytes(resourceInfo.Config, "create_time")
+	resourceInfo.Config, _ = sjson.SetBytes(resourceInfo.Config, "manager", manager)
+	if resourceInfo.GetName() == "" {
+		resourceInfo.SetName(fmt.Sprintf("secrets_%s", id))
+	}
+	return resourceInfo
+}
+
 // backfillStoredSnapshotFields fills snapshot Config fields (name/id/labels)
 // from the matching DB rows for global_rule / plugin_config / consumer_group /
-// proto / stream_route. Resource types not in this list are passed through.
+// credential / secret / proto / stream_route. Resource types not in this list are passed through.
 //
-// TODO(perf): these 5 QueryXxx calls are serial today (preserving the original
+// TODO(perf): these 7 QueryXxx calls are serial today (preserving the original
 // kvToResource(...) behavior). A follow-up can move them behind an errgroup.
 func backfillStoredSnapshotFields(ctx context.Context, resources []*model.GatewaySyncData) error {
 	globalRuleMap := make(map[string]*model.GatewaySyncData)
 	pluginConfigMap := make(map[string]*model.GatewaySyncData)
 	consumerGroupMap := make(map[string]*model.GatewaySyncData)
+	credentialMap := make(map[string]*model.GatewaySyncData)
+	secretMap := make(map[string]*model.GatewaySyncData)
 	protoMap := make(map[string]*model.GatewaySyncData)
 	streamRouteMap := make(map[string]*model.GatewaySyncData)
 
 	var globalRuleIDs []string
 	var pluginConfigIDs []string
 	var consumerGroupIDs []string
+	var credentialIDs []string
+	var secretIDs []string
 	var protoIDs []string
 	var streamRouteIDs []string
 
@@ -115,6 +193,12 @@ func backfillStoredSnapshotFields(ctx context.Context, resources []*model.Gatewa
 		case constant.ConsumerGroup:
 			consumerGroupMap[resource.ID] = resource
 			consumerGroupIDs = append(consumerGroupIDs, resource.ID)
+		case constant.Credential:
+			credentialMap[resource.ID] = resource
+			credentialIDs = append(credentialIDs, resource.ID)
+		case constant.Secret:
+			secretMap[resource.ID] = resource
+			secretIDs = append(secretIDs, resource.ID)
 		case constant.Proto:
 			protoMap[resource.ID] = resource
 			protoIDs = append(protoIDs, resource.ID)
@@ -177,6 +261,38 @@ func backfillStoredSnapshotFields(ctx context.Context, resources []*model.Gatewa
 		}
 	}
 
+	// Credential name 不下发到 etcd，需要从数据库补齐
+	if len(credentialIDs) > 0 {
+		credentials, err := resourcebiz.QueryCredentials(ctx, map[string]any{
+			"gateway_id": gatewayID,
+			"id":         credentialIDs,
+		})
+		if err != nil {
+			return err
+		}
+		for _, credential := range credentials {
+			if resource, ok := credentialMap[credential.ID]; ok {
+				resource.Config, _ = sjson.SetBytes(resource.Config, "name", credential.Name)
+			}
+		}
+	}
+
+	// Secret name 不下发到 etcd，需要从数据库补齐
+	if len(secretIDs) > 0 {
+		secrets, err := resourcebiz.QuerySecrets(ctx, map[string]any{
+			"gateway_id": gatewayID,
+			"id":         secretIDs,
+		})
+		if err != nil {
+			return err
+		}
+		for _, secret := range secrets {
+			if resource, ok := secretMap[secret.ID]; ok {
+				resource.Config, _ = sjson.SetBytes(resource.Config, "name", secret.Name)
+			}
+		}
+	}
+
 	// Proto name 需要特殊处理
 	if len(protoIDs) > 0 {
 		protos, err := resourcebiz.QueryProtos(ctx, map[string]any{
@@ -335,3 +451,37 @@ func buildSyncChangeSet(
 
 	return changeSet
 }
+
+// newSyncedResourcesEvent 统计同步发现的新资源：etcd 中新增且编辑区中不存在的资源，没有时返回 nil
+//
+// 编辑区发布的资源同步回来时同样是新增的同步数据，需要排除
+func newSyncedResourcesEvent(
+	ctx context.Context,
+	created []*model.GatewaySyncData,
+) (*dto.WebhookSyncEventData, error) {
+	createdIDs := make(map[constant.APISIXResource][]string)
+	for _, resource := range created {
+		createdIDs[resource.Type] = append(createdIDs[resource.Type], resource.ID)
+	}
+	event := &dto.WebhookSyncEventData{Resources: make(map[constant.APISIXResource][]string)}
+	for resourceType, ids := range createdIDs {
+		existing, err := resourcebiz.BatchGetResources(ctx, resourceType, ids)
+		if err != nil {
+			return nil, err
+		}
+		existingIDs := make(map[string]bool, len(existing))
+		for _, resource := range existing {
+			existingIDs[resource.ID] = true
+		}
+		for _, id := range ids {
+			if !existingIDs[id] {
+				event.Resources[resourceType] = append(event.Resources[resourceType], id)
+				event.Count++
+			}
+		}
+	}
+	if event.Count == 0 {
+		return nil, nil
+	}
+	return event, nil
+}
@@ -71,6 +71,35 @@ func TestBuildSyncedResourceFromKV(t *testing.T) {
 		assert.Equal(t, "clickhouse-logger", got.GetName())
 	})
 
+	t.Run("credential key carries consumer id", func(t *testing.T) {
+		got, ok := buildSyncedResourceFromKV(normalizedPrefix, 17, storage.KeyValuePair{
+			Key:         "/apisix/consumers/bk.c.consumer/credentials/cred-id",
+			Value:       `{"id":"cred-id","plugins":{"key-auth":{"key":"k"}},"create_time":1}`,
+			ModRevision: 5,
+		})
+		assert.True(t, ok)
+		assert.Equal(t, constant.Credential, got.Type)
+		assert.Equal(t, "cred-id", got.ID)
+		assert.Equal(t, "bk.c.consumer", got.GetConsumerID())
+		assert.Equal(t, "credentials_cred-id", got.GetName())
+		assert.False(t, gjson.GetBytes(got.Config, "create_time").Exists())
+		assert.Equal(t, "consumers/bk.c.consumer/credentials/cred-id", got.GetEtcdKey())
+	})
+
+	t.Run("secret key carries manager", func(t *testing.T) {
+		got, ok := buildSyncedResourceFromKV(normalizedPrefix, 17, storage.KeyValuePair{
+			Key:         "/apisix/secrets/vault/secret-id",
+			Value:       `{"id":"secret-id","uri":"http://127.0.0.1:8200","prefix":"kv/apisix","token":"t"}`,
+			ModRevision: 6,
+		})
+		assert.True(t, ok)
+		assert.Equal(t, constant.Secret, got.Type)
+		assert.Equal(t, "secret-id", got.ID)
+		assert.Equal(t, "vault", got.GetSecretManager())
+		assert.Equal(t, "secrets_secret-id", got.GetName())
+		assert.Equal(t, "secrets/vault/secret-id", got.GetEtcdKey())
+	})
+
 	t.Run("invalid key is ignored", func(t *testing.T) {
 		got, ok := buildSyncedResourceFromKV(normalizedPrefix, 17, storage.KeyValuePair{
 			Key:         "/apisix/routes/too/many/parts",
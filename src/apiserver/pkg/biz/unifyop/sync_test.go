@@ -107,6 +107,14 @@ func (m *mockEtcdStore) List(ctx context.Context, prefix string) ([]storage.KeyV
 	return kvList, nil
 }
 
+func (m *mockEtcdStore) ListWithRevision(
+	ctx context.Context,
+	prefix string,
+) ([]storage.KeyValuePair, int64, error) {
+	kvList, err := m.List(ctx, prefix)
+	return kvList, 1, err
+}
+
 func clearGatewaySyncData(t *testing.T, ctx context.Context) {
 	t.Helper()
 
@@ -2700,3 +2708,25 @@ func TestBatchRevertStreamRoutes_MultipleStreamRoutes(t *testing.T) {
 	assert.Equal(t, "stream-route-2-original", revertedStreamRoute2.Name)
 	assert.Equal(t, constant.ResourceStatusSuccess, revertedStreamRoute2.Status)
 }
+
+func TestGetResourceConfigDiffDetailMasksSensitiveFields(t *testing.T) {
+	consumer := data.Consumer1WithNoRelation(gatewayInfo, constant.ResourceStatusUpdateDraft)
+	consumer.Username = "consumer-diff-masked"
+	assert.NoError(t, resourcebiz.CreateConsumer(gatewayCtx, *consumer))
+	assert.NoError(t, repo.Q.GatewaySyncData.WithContext(gatewayCtx).Create(&model.GatewaySyncData{
+		ID:        consumer.ID,
+		GatewayID: gatewayInfo.ID,
+		Type:      constant.Consumer,
+		Config: datatypes.JSON(`{"username":"consumer-diff-masked",` +
+			`"plugins":{"key-auth":{"key":"etcd-key"}}}`),
+	}))
+
+	detail, err := GetResourceConfigDiffDetail(gatewayCtx, constant.Consumer, consumer.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, constant.SensitiveInfoFiledDisplay,
+		gjson.GetBytes(detail.EtcdConfig, "plugins.key-auth.key").String())
+	assert.Equal(t, constant.SensitiveInfoFiledDisplay,
+		gjson.GetBytes(detail.EditorConfig, "plugins.key-auth.key").String())
+	assert.NotContains(t, string(detail.EtcdConfig), "etcd-key")
+	assert.NotContains(t, string(detail.EditorConfig), "auth-one")
+}
@@ -0,0 +1,309 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package unifyop
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"gorm.io/gorm/clause"
+
+	driftbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/drift"
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	syncdatabiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/syncdata"
+	webhookbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/webhook"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/goroutinex"
+)
+
+// watchRetryInterval watch 异常断开或同步失败后的重试间隔
+const watchRetryInterval = 5 * time.Second
+
+// SyncerRun list-then-watch 增量同步
+//
+// 成为 leader 后从网关记录的 revision 续传（没有记录时先全量同步），之后通过 watch 增量更新同步数据；
+// revision 被压缩或网关 prefix 变更时回退到全量同步。失去 leader 后停止 watch，重新参与选举
+func (s *UnifyOp) SyncerRun(ctx context.Context) {
+	s.elector.Run(ctx)
+	for ctx.Err() == nil {
+		closeCh := s.elector.WaitForLeading()
+		if !s.elector.IsLeader() {
+			sleepWithContext(ctx, watchRetryInterval)
+			continue
+		}
+		s.isLeader = true
+		leaderCtx, cancel := context.WithCancel(ctx)
+		goroutinex.GoroutineWithRecovery(leaderCtx, func() {
+			select {
+			case <-closeCh:
+				cancel()
+			case <-leaderCtx.Done():
+			}
+		})
+		s.listAndWatch(leaderCtx)
+		cancel()
+		s.isLeader = false
+	}
+}
+
+// listAndWatch 全量同步后持续 watch，直到 ctx 结束
+func (s *UnifyOp) listAndWatch(ctx context.Context) {
+	revision := int64(-1) // 已同步的 revision，-1 表示尚未从网关记录中加载，0 表示需要全量同步
+	for ctx.Err() == nil {
+		// prefix 可能会更新，每轮 watch 前再查一次
+		gatewayInfo, err := gatewaybiz.GetGateway(ctx, s.gatewayInfo.ID)
+		if err != nil {
+			logging.Errorf("get gateway error: %s", err.Error())
+			sleepWithContext(ctx, watchRetryInterval)
+			continue
+		}
+		switch {
+		case revision < 0:
+			revision = gatewayInfo.SyncedRevision
+		case gatewayInfo.GetEtcdPrefixForList() != s.gatewayInfo.GetEtcdPrefixForList():
+			revision = 0
+		}
+		s.gatewayInfo = gatewayInfo
+		gatewayCtx := ginx.SetGatewayInfoToContext(ctx, s.gatewayInfo)
+		prefix := s.gatewayInfo.GetEtcdPrefixForList()
+
+		revision, err = s.checkWatchRevision(gatewayCtx, revision)
+		if err == nil && revision == 0 {
+			_, revision, err = s.syncWithPrefix(gatewayCtx, prefix, true)
+		}
+		if err != nil {
+			logging.Errorf("syncer[gateway:%s] list error: %s", s.gatewayInfo.Name, err.Error())
+			revision = 0
+			sleepWithContext(ctx, watchRetryInterval)
+			continue
+		}
+
+		revision, err = s.watchWithRevision(gatewayCtx, prefix, revision)
+		if errors.Is(err, storage.CompactedError) {
+			logging.Warnf("syncer[gateway:%s] revision %d compacted, fallback to full sync",
+				s.gatewayInfo.Name, revision)
+			revision = 0
+			continue
+		}
+		if err != nil && ctx.Err() == nil {
+			logging.Errorf("syncer[gateway:%s] watch error: %s", s.gatewayInfo.Name, err.Error())
+			sleepWithContext(ctx, watchRetryInterval)
+		}
+	}
+}
+
+// checkWatchRevision 校验续传的 revision 是否仍然有效：etcd 重建等导致当前 revision 小于记录值时需要全量同步
+func (s *UnifyOp) checkWatchRevision(ctx context.Context, revision int64) (int64, error) {
+	if revision <= 0 {
+		return 0, nil
+	}
+	currentRevision, err := s.etcdStore.Revision(ctx)
+	if err != nil {
+		return revision, err
+	}
+	if currentRevision < revision {
+		logging.Warnf("syncer[gateway:%s] etcd revision %d is less than synced revision %d, fallback to full sync",
+			s.gatewayInfo.Name, currentRevision, revision)
+		return 0, nil
+	}
+	return revision, nil
+}
+
+// watchWithRevision 从 revision 之后开始 watch 并增量应用变更，返回已同步的 revision
+//
+// 每隔一个同步间隔主动结束 watch，以便重新检查网关配置
+func (s *UnifyOp) watchWithRevision(ctx context.Context, prefix string, revision int64) (int64, error) {
+	watchCtx, cancel := context.WithTimeout(ctx, watchCheckInterval())
+	defer cancel()
+	logging.Infof("syncer[gateway:%s] watch from revision %d", s.gatewayInfo.Name, revision+1)
+	for resp := range s.etcdStore.Watch(watchCtx, prefix, revision+1) {
+		if resp.Error != nil {
+			if watchCtx.Err() != nil {
+				return revision, nil
+			}
+			return revision, resp.Error
+		}
+		if len(resp.Events) == 0 {
+			continue
+		}
+		if err := s.applyWatchEvents(ctx, resp.Events, resp.Revision); err != nil {
+			return revision, err
+		}
+		revision = resp.Revision
+	}
+	return revision, nil
+}
+
+// applyWatchEvents 将 watch 事件增量应用到同步数据，并记录已同步的 revision
+func (s *UnifyOp) applyWatchEvents(ctx context.Context, events []storage.Event, revision int64) error {
+	normalizedPrefix := model.NormalizeEtcdPrefix(s.gatewayInfo.EtcdConfig.Prefix)
+	// 同一批次中同一个 key 只保留最后一次事件
+	latestEvents := make(map[string]storage.Event, len(events))
+	for _, event := range events {
+		latestEvents[event.Key] = event
+	}
+	var putKVs []storage.KeyValuePair
+	var deleteResources []*model.GatewaySyncData
+	for _, event := range latestEvents {
+		if event.Type == storage.EventTypePut {
+			putKVs = append(putKVs, event.KeyValuePair)
+			continue
+		}
+		resource, ok := buildSyncedResourceFromKV(normalizedPrefix, s.gatewayInfo.ID, storage.KeyValuePair{
+			Key:   event.Key,
+			Value: storage.SkippedValueEtcdEmptyObject,
+		})
+		if !ok {
+			logging.Errorf("key is not validate: %s", event.Key)
+			continue
+		}
+		deleteResources = append(deleteResources, resource)
+	}
+	putResources, err := buildSyncSnapshotResources(ctx, s.gatewayInfo, putKVs)
+	if err != nil {
+		return err
+	}
+	syncedItemMap, err := querySyncedItemsByResources(ctx, append(putResources, deleteResources...))
+	if err != nil {
+		return err
+	}
+
+	changeSet := syncChangeSet{}
+	for _, resource := range putResources {
+		syncedItem, ok := syncedItemMap[resource.GetResourceKey()]
+		if !ok {
+			changeSet.ToCreate = append(changeSet.ToCreate, resource)
+			continue
+		}
+		if syncedItem.ModRevision != resource.ModRevision {
+			syncedItem.Config = resource.Config
+			syncedItem.ModRevision = resource.ModRevision
+			changeSet.ToUpdate = append(changeSet.ToUpdate, syncedItem)
+		}
+	}
+	for _, resource := range deleteResources {
+		if syncedItem, ok := syncedItemMap[resource.GetResourceKey()]; ok {
+			changeSet.ToDeleteAutoIDs = append(changeSet.ToDeleteAutoIDs, syncedItem.AutoID)
+		}
+	}
+
+	syncEvent, err := newSyncedResourcesEvent(ctx, changeSet.ToCreate)
+	if err != nil {
+		return err
+	}
+
+	err = repo.Q.Transaction(func(tx *repo.Query) error {
+		u := tx.GatewaySyncData
+		if len(changeSet.ToUpdate) > 0 {
+			err := u.WithContext(ctx).
+				Clauses(clause.OnConflict{
+					Columns: []clause.Column{{Name: "auto_id"}},
+					DoUpdates: clause.AssignmentColumns(
+						[]string{"config", "mod_revision", "validity_start", "validity_end", "updated_at"},
+					),
+				}).
+				CreateInBatches(changeSet.ToUpdate, 500)
+			if err != nil {
+				return err
+			}
+		}
+		if len(changeSet.ToCreate) > 0 {
+			if err := u.WithContext(ctx).CreateInBatches(changeSet.ToCreate, 500); err != nil {
+				return err
+			}
+		}
+		if len(changeSet.ToDeleteAutoIDs) > 0 {
+			if _, err := u.WithContext(ctx).Where(u.AutoID.In(changeSet.ToDeleteAutoIDs...)).Delete(); err != nil {
+				return err
+			}
+		}
+		if syncEvent != nil {
+			err := webhookbiz.EmitWithTx(ctx, tx, s.gatewayInfo.ID, constant.WebhookEventSyncNewResources, syncEvent)
+			if err != nil {
+				return err
+			}
+		}
+		return s.updateSyncedState(ctx, tx, revision, true)
+	})
+	if err != nil {
+		return err
+	}
+	s.detectDrift(func(detector *driftbiz.Detector) error {
+		return detector.DetectChanges(ctx, putResources, deleteResources)
+	})
+	return nil
+}
+
+// querySyncedItemsByResources 查询资源对应的已同步数据，返回 resourceKey -> 同步数据
+func querySyncedItemsByResources(
+	ctx context.Context,
+	resources []*model.GatewaySyncData,
+) (map[string]*model.GatewaySyncData, error) {
+	typeIDsMap := make(map[constant.APISIXResource][]string)
+	for _, resource := range resources {
+		typeIDsMap[resource.Type] = append(typeIDsMap[resource.Type], resource.ID)
+	}
+	syncedItemMap := make(map[string]*model.GatewaySyncData, len(resources))
+	for resourceType, ids := range typeIDsMap {
+		var queryParams []map[string]any
+		if resourceType == constant.PluginMetadata {
+			// 插件元数据的 id 与 etcd key 无关，按类型查询后通过名称匹配
+			queryParams = append(queryParams, map[string]any{"type": string(resourceType)})
+		} else {
+			for _, batchIDs := range lo.Chunk(ids, constant.DBConditionIDMaxLength) {
+				queryParams = append(queryParams, map[string]any{"type": string(resourceType), "id": batchIDs})
+			}
+		}
+		for _, queryParam := range queryParams {
+			items, err := syncdatabiz.QuerySyncedItems(ctx, queryParam)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range items {
+				syncedItemMap[item.GetResourceKey()] = item
+			}
+		}
+	}
+	return syncedItemMap, nil
+}
+
+// watchCheckInterval 单次 watch 的最长时间，到期后重新检查网关配置
+func watchCheckInterval() time.Duration {
+	if config.G == nil || config.G.Biz.SyncInterval <= 0 {
+		return time.Hour
+	}
+	return config.G.Biz.SyncInterval
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
@@ -0,0 +1,221 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package unifyop
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	syncdatabiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/syncdata"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/idx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func newWatchTestSyncer(t *testing.T, name string) (*UnifyOp, context.Context) {
+	t.Helper()
+
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = name
+	gateway.EtcdConfig.Endpoint = base.Endpoint(etcdEndpoint)
+	gateway.EtcdConfig.Prefix = "/" + name
+	if err := gatewaybiz.CreateGateway(context.Background(), gateway); err != nil {
+		t.Fatal(err)
+	}
+	syncer, err := NewUnifyOp(gateway, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = syncer.etcdStore.Close() })
+	return syncer, ginx.SetGatewayInfoToContext(context.Background(), gateway)
+}
+
+func putEtcdKey(t *testing.T, client *clientv3.Client, key, value string) int64 {
+	t.Helper()
+
+	resp, err := client.Put(context.Background(), key, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.Header.Revision
+}
+
+func TestApplyWatchEvents(t *testing.T) {
+	syncer, ctx := newWatchTestSyncer(t, "watch-apply")
+	prefix := syncer.gatewayInfo.GetEtcdPrefixForList()
+	routeID := idx.GenResourceID(constant.Route)
+
+	// put 创建
+	err := syncer.applyWatchEvents(ctx, []storage.Event{{
+		KeyValuePair: storage.KeyValuePair{
+			Key:         prefix + "routes/" + routeID,
+			Value:       `{"id":"` + routeID + `","name":"route-watch","uris":["/v1"]}`,
+			ModRevision: 10,
+		},
+		Type: storage.EventTypePut,
+	}}, 10)
+	assert.NoError(t, err)
+	created, err := syncdatabiz.GetSyncedItemByResourceTypeAndID(ctx, constant.Route, routeID)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, created.ModRevision)
+
+	// 同一批次中以最后一次 put 为准
+	err = syncer.applyWatchEvents(ctx, []storage.Event{
+		{
+			KeyValuePair: storage.KeyValuePair{
+				Key:         prefix + "routes/" + routeID,
+				Value:       `{"id":"` + routeID + `","name":"route-watch","uris":["/v2"]}`,
+				ModRevision: 11,
+			},
+			Type: storage.EventTypePut,
+		},
+		{
+			KeyValuePair: storage.KeyValuePair{
+				Key:         prefix + "routes/" + routeID,
+				Value:       `{"id":"` + routeID + `","name":"route-watch","uris":["/v3"]}`,
+				ModRevision: 12,
+			},
+			Type: storage.EventTypePut,
+		},
+	}, 12)
+	assert.NoError(t, err)
+	updated, err := syncdatabiz.GetSyncedItemByResourceTypeAndID(ctx, constant.Route, routeID)
+	assert.NoError(t, err)
+	assert.Equal(t, created.AutoID, updated.AutoID)
+	assert.Equal(t, 12, updated.ModRevision)
+	assert.Equal(t, "/v3", gjson.GetBytes(updated.Config, "uris.0").String())
+
+	// plugin metadata 通过名称匹配
+	err = syncer.applyWatchEvents(ctx, []storage.Event{{
+		KeyValuePair: storage.KeyValuePair{
+			Key:         prefix + "plugin_metadata/file-logger",
+			Value:       `{"id":"file-logger","log_format":{"host":"$host"}}`,
+			ModRevision: 13,
+		},
+		Type: storage.EventTypePut,
+	}}, 13)
+	assert.NoError(t, err)
+	metadatas, err := syncdatabiz.QuerySyncedItems(ctx, map[string]any{"type": string(constant.PluginMetadata)})
+	assert.NoError(t, err)
+	assert.Len(t, metadatas, 1)
+
+	// delete 删除
+	err = syncer.applyWatchEvents(ctx, []storage.Event{
+		{
+			KeyValuePair: storage.KeyValuePair{Key: prefix + "routes/" + routeID, ModRevision: 14},
+			Type:         storage.EventTypeDelete,
+		},
+		{
+			KeyValuePair: storage.KeyValuePair{Key: prefix + "plugin_metadata/file-logger", ModRevision: 14},
+			Type:         storage.EventTypeDelete,
+		},
+	}, 14)
+	assert.NoError(t, err)
+	_, err = syncdatabiz.GetSyncedItemByResourceTypeAndID(ctx, constant.Route, routeID)
+	assert.Error(t, err)
+	metadatas, err = syncdatabiz.QuerySyncedItems(ctx, map[string]any{"type": string(constant.PluginMetadata)})
+	assert.NoError(t, err)
+	assert.Empty(t, metadatas)
+
+	gateway, err := gatewaybiz.GetGateway(ctx, syncer.gatewayInfo.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(14), gateway.SyncedRevision)
+}
+
+func TestListAndWatch(t *testing.T) {
+	syncer, ctx := newWatchTestSyncer(t, "watch-list")
+	client := syncer.etcdStore.GetClient()
+	prefix := syncer.gatewayInfo.GetEtcdPrefixForList()
+	route1 := idx.GenResourceID(constant.Route)
+	route2 := idx.GenResourceID(constant.Route)
+	putEtcdKey(t, client, prefix+"routes/"+route1, `{"id":"`+route1+`","name":"route-1","uris":["/1"]}`)
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		syncer.listAndWatch(watchCtx)
+		close(done)
+	}()
+
+	// 全量同步
+	assert.Eventually(t, func() bool {
+		_, err := syncdatabiz.GetSyncedItemByResourceTypeAndID(ctx, constant.Route, route1)
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// 增量同步
+	revision := putEtcdKey(t, client, prefix+"routes/"+route2, `{"id":"`+route2+`","name":"route-2","uris":["/2"]}`)
+	_, err := client.Delete(context.Background(), prefix+"routes/"+route1)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		_, err1 := syncdatabiz.GetSyncedItemByResourceTypeAndID(ctx, constant.Route, route1)
+		item, err2 := syncdatabiz.GetSyncedItemByResourceTypeAndID(ctx, constant.Route, route2)
+		return err1 != nil && err2 == nil && int64(item.ModRevision) == revision
+	}, 5*time.Second, 50*time.Millisecond)
+
+	cancel()
+	<-done
+
+	gateway, err := gatewaybiz.GetGateway(ctx, syncer.gatewayInfo.ID)
+	assert.NoError(t, err)
+	assert.Greater(t, gateway.SyncedRevision, revision)
+}
+
+func TestWatchWithRevision_Compacted(t *testing.T) {
+	syncer, ctx := newWatchTestSyncer(t, "watch-compact")
+	client := syncer.etcdStore.GetClient()
+	prefix := syncer.gatewayInfo.GetEtcdPrefixForList()
+	first := putEtcdKey(t, client, prefix+"routes/r1", `{"id":"r1","uris":["/1"]}`)
+	putEtcdKey(t, client, prefix+"routes/r1", `{"id":"r1","uris":["/2"]}`)
+	last := putEtcdKey(t, client, prefix+"routes/r1", `{"id":"r1","uris":["/3"]}`)
+	_, err := client.Compact(context.Background(), last)
+	assert.NoError(t, err)
+
+	revision, err := syncer.watchWithRevision(ctx, prefix, first)
+	assert.ErrorIs(t, err, storage.CompactedError)
+	assert.Equal(t, first, revision)
+}
+
+func TestCheckWatchRevision(t *testing.T) {
+	syncer, ctx := newWatchTestSyncer(t, "watch-check")
+
+	revision, err := syncer.checkWatchRevision(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), revision)
+
+	// 记录的 revision 大于 etcd 当前 revision，需要全量同步
+	revision, err = syncer.checkWatchRevision(ctx, 1<<40)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), revision)
+
+	current, err := syncer.etcdStore.Revision(ctx)
+	assert.NoError(t, err)
+	revision, err = syncer.checkWatchRevision(ctx, current)
+	assert.NoError(t, err)
+	assert.Equal(t, current, revision)
+}
@@ -0,0 +1,154 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package variable
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// referenceRegexp 匹配配置中的变量引用 ${NAME}
+var referenceRegexp = regexp.MustCompile(`\$\{([A-Z][A-Z0-9_]{0,63})\}`)
+
+// HasReference 判断配置中是否引用了变量
+func HasReference(config json.RawMessage) bool {
+	return bytes.Contains(config, []byte("${")) && referenceRegexp.Match(config)
+}
+
+// Resolve 将配置中所有字符串里的 ${NAME} 替换为变量值，引用了未定义的变量时返回错误
+//
+// 若某个字符串仅由一个变量引用组成，且变量值为数字、布尔值、对象或数组的 json，
+// 则替换为对应的 json 值，以便数值等非字符串字段也能使用变量，如 "count": "${RATE_LIMIT}"
+func Resolve(config json.RawMessage, values map[string]string) (json.RawMessage, error) {
+	return resolve(config, values, true)
+}
+
+// ResolveDefined 与 Resolve 相同，但保留未定义的变量引用，用于编辑区的配置校验及展示
+func ResolveDefined(config json.RawMessage, values map[string]string) json.RawMessage {
+	resolved, err := resolve(config, values, false)
+	if err != nil {
+		return config
+	}
+	return resolved
+}
+
+// ResolveForGateway 使用 ctx 中网关的变量替换配置中的变量引用，未定义的变量保持不变；
+// 配置未引用变量或 ctx 中没有网关时原样返回。敏感变量会被解密，结果仅用于校验，不能返回给用户
+func ResolveForGateway(ctx context.Context, config json.RawMessage) (json.RawMessage, error) {
+	return resolveForGateway(ctx, config, GetVariableValues)
+}
+
+// ResolveForDisplay 与 ResolveForGateway 相同，但敏感变量的引用保持不变，用于展示
+func ResolveForDisplay(ctx context.Context, config json.RawMessage) (json.RawMessage, error) {
+	return resolveForGateway(ctx, config, GetDisplayVariableValues)
+}
+
+func resolveForGateway(
+	ctx context.Context,
+	config json.RawMessage,
+	getValues func(ctx context.Context, gatewayID int) (map[string]string, error),
+) (json.RawMessage, error) {
+	gateway := ginx.GetGatewayInfoFromContext(ctx)
+	if gateway == nil || !HasReference(config) {
+		return config, nil
+	}
+	values, err := getValues(ctx, gateway.ID)
+	if err != nil {
+		return nil, err
+	}
+	return ResolveDefined(config, values), nil
+}
+
+func resolve(config json.RawMessage, values map[string]string, strict bool) (json.RawMessage, error) {
+	if !HasReference(config) {
+		return config, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(config))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	undefined := make(map[string]struct{})
+	data = resolveValue(data, values, undefined)
+	if strict && len(undefined) > 0 {
+		names := make([]string, 0, len(undefined))
+		for name := range undefined {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("%w: %s", ErrVariableUndefined, strings.Join(names, ", "))
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(data); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+func resolveValue(data any, values map[string]string, undefined map[string]struct{}) any {
+	switch v := data.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = resolveValue(item, values, undefined)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = resolveValue(item, values, undefined)
+		}
+		return v
+	case string:
+		return resolveString(v, values, undefined)
+	default:
+		return v
+	}
+}
+
+func resolveString(s string, values map[string]string, undefined map[string]struct{}) any {
+	if match := referenceRegexp.FindStringSubmatchIndex(s); match != nil && match[0] == 0 && match[1] == len(s) {
+		value, ok := values[s[match[2]:match[3]]]
+		if !ok {
+			undefined[s[match[2]:match[3]]] = struct{}{}
+			return s
+		}
+		trimmed := strings.TrimSpace(value)
+		if trimmed != "" && trimmed[0] != '"' && json.Valid([]byte(trimmed)) {
+			return json.RawMessage(trimmed)
+		}
+		return value
+	}
+	return referenceRegexp.ReplaceAllStringFunc(s, func(reference string) string {
+		name := reference[2 : len(reference)-1]
+		value, ok := values[name]
+		if !ok {
+			undefined[name] = struct{}{}
+			return reference
+		}
+		return value
+	})
+}
@@ -0,0 +1,170 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+// Package variable contains gateway variable management and config resolving helpers.
+package variable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+)
+
+// VariableErrors 定义网关变量相关的错误
+var (
+	ErrVariableNotFound    = errors.New("网关变量不存在")
+	ErrVariableDuplicate   = errors.New("网关变量名已存在")
+	ErrVariableInvalidName = errors.New("网关变量名需匹配 " + namePattern)
+	ErrVariableUndefined   = errors.New("引用了未定义的网关变量")
+)
+
+// namePattern 变量名只允许大写字母、数字和下划线，避免与 nginx 变量（如 $remote_addr）混淆
+const namePattern = "^[A-Z][A-Z0-9_]{0,63}$"
+
+var nameRegexp = regexp.MustCompile(namePattern)
+
+// ListVariables 查询网关下的所有变量，敏感变量的值为密文
+func ListVariables(ctx context.Context, gatewayID int) ([]*model.GatewayVariable, error) {
+	u := repo.GatewayVariable
+	return u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).Order(u.Name).Find()
+}
+
+// GetVariable 获取网关变量
+func GetVariable(ctx context.Context, gatewayID int, id int) (*model.GatewayVariable, error) {
+	u := repo.GatewayVariable
+	variable, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrVariableNotFound
+	}
+	return variable, err
+}
+
+// CreateVariable 创建网关变量，敏感变量加密存储
+func CreateVariable(ctx context.Context, variable *model.GatewayVariable) error {
+	if err := checkVariableName(ctx, variable); err != nil {
+		return err
+	}
+	if variable.Secret {
+		variable.Value = cryptography.EncryptSecret(variable.Value)
+	}
+	return repo.GatewayVariable.WithContext(ctx).Create(variable)
+}
+
+// UpdateVariable 更新网关变量，敏感变量未传值时保留原值
+func UpdateVariable(ctx context.Context, variable *model.GatewayVariable) error {
+	current, err := GetVariable(ctx, variable.GatewayID, variable.ID)
+	if err != nil {
+		return err
+	}
+	if err := checkVariableName(ctx, variable); err != nil {
+		return err
+	}
+	if variable.Secret && variable.Value == "" {
+		variable.Value, err = plainValue(current)
+		if err != nil {
+			return err
+		}
+	}
+	if variable.Secret {
+		variable.Value = cryptography.EncryptSecret(variable.Value)
+	}
+	u := repo.GatewayVariable
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(variable.ID)).Select(
+		u.Name, u.Value, u.Secret, u.Description, u.Updater,
+	).Updates(variable)
+	return err
+}
+
+// DeleteVariable 删除网关变量
+func DeleteVariable(ctx context.Context, gatewayID int, id int) error {
+	u := repo.GatewayVariable
+	_, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).Delete()
+	return err
+}
+
+// GetVariableValues 获取网关下所有变量的明文值，key 为变量名；会解密敏感变量，仅用于发布及校验
+func GetVariableValues(ctx context.Context, gatewayID int) (map[string]string, error) {
+	variables, err := ListVariables(ctx, gatewayID)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(variables))
+	for _, variable := range variables {
+		values[variable.Name], err = plainValue(variable)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// GetDisplayVariableValues 获取网关下变量用于展示及导出的值，key 为变量名；
+// 敏感变量不解密，值为其引用 ${NAME}，替换后引用保持不变且不会被视为未定义
+func GetDisplayVariableValues(ctx context.Context, gatewayID int) (map[string]string, error) {
+	variables, err := ListVariables(ctx, gatewayID)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(variables))
+	for _, variable := range variables {
+		if variable.Secret {
+			values[variable.Name] = "${" + variable.Name + "}"
+			continue
+		}
+		values[variable.Name] = variable.Value
+	}
+	return values, nil
+}
+
+// checkVariableName 校验变量名格式及在网关下唯一
+func checkVariableName(ctx context.Context, variable *model.GatewayVariable) error {
+	if !nameRegexp.MatchString(variable.Name) {
+		return ErrVariableInvalidName
+	}
+	u := repo.GatewayVariable
+	count, err := u.WithContext(ctx).Where(
+		u.GatewayID.Eq(variable.GatewayID),
+		u.Name.Eq(variable.Name),
+		u.ID.Neq(variable.ID),
+	).Count()
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %s", ErrVariableDuplicate, variable.Name)
+	}
+	return nil
+}
+
+// plainValue 获取变量的明文值
+func plainValue(variable *model.GatewayVariable) (string, error) {
+	if !variable.Secret {
+		return variable.Value, nil
+	}
+	value, err := cryptography.DecryptSecret(variable.Value)
+	if err != nil {
+		return "", fmt.Errorf("解密网关变量 %s 失败: %w", variable.Name, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,139 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package variable
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+func TestMain(m *testing.M) {
+	// init crypto
+	err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化embed数据库
+	util.InitEmbedDb()
+
+	os.Exit(m.Run())
+}
+
+func TestResolve(t *testing.T) {
+	values := map[string]string{
+		"UPSTREAM_HOST": "httpbin.org",
+		"RATE_LIMIT":    "100",
+		"API_KEY":       `a"b`,
+	}
+	tests := []struct {
+		name    string
+		config  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "no reference",
+			config: `{"uri":"/get","remote_addr":"$remote_addr"}`,
+			want:   `{"uri":"/get","remote_addr":"$remote_addr"}`,
+		},
+		{
+			name: "string and json value",
+			config: `{"nodes":[{"host":"${UPSTREAM_HOST}","port":80}],` +
+				`"plugins":{"limit-count":{"count":"${RATE_LIMIT}"}}}`,
+			want: `{"nodes":[{"host":"httpbin.org","port":80}],"plugins":{"limit-count":{"count":100}}}`,
+		},
+		{
+			name:   "embedded reference and escaping",
+			config: `{"uri":"/${UPSTREAM_HOST}/${RATE_LIMIT}","key":"${API_KEY}"}`,
+			want:   `{"uri":"/httpbin.org/100","key":"a\"b"}`,
+		},
+		{
+			name:   "lower case is not a reference",
+			config: `{"uri":"${upstream_host}"}`,
+			want:   `{"uri":"${upstream_host}"}`,
+		},
+		{
+			name:    "undefined",
+			config:  `{"uri":"${UNKNOWN}"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Resolve(json.RawMessage(tt.config), values)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrVariableUndefined)
+				assert.JSONEq(t, tt.config, string(ResolveDefined(json.RawMessage(tt.config), values)))
+				return
+			}
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestVariableCRUD(t *testing.T) {
+	ctx := context.Background()
+	gateway := &model.Gateway{ID: 1001}
+
+	secret := &model.GatewayVariable{GatewayID: gateway.ID, Name: "API_KEY", Value: "s3cret", Secret: true}
+	assert.NoError(t, CreateVariable(ctx, secret))
+	assert.NotEqual(t, "s3cret", secret.Value)
+	host := &model.GatewayVariable{GatewayID: gateway.ID, Name: "UPSTREAM_HOST", Value: "httpbin.org"}
+	assert.NoError(t, CreateVariable(ctx, host))
+
+	assert.ErrorIs(t, CreateVariable(ctx, &model.GatewayVariable{GatewayID: gateway.ID, Name: "API_KEY"}),
+		ErrVariableDuplicate)
+	assert.ErrorIs(t, CreateVariable(ctx, &model.GatewayVariable{GatewayID: gateway.ID, Name: "api_key"}),
+		ErrVariableInvalidName)
+
+	// 敏感变量未传值时保留原值
+	assert.NoError(t, UpdateVariable(ctx, &model.GatewayVariable{
+		ID: secret.ID, GatewayID: gateway.ID, Name: "API_KEY", Secret: true, Description: "key",
+	}))
+	values, err := GetVariableValues(ctx, gateway.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"API_KEY": "s3cret", "UPSTREAM_HOST": "httpbin.org"}, values)
+
+	gatewayCtx := ginx.SetGatewayInfoToContext(ctx, gateway)
+	resolved, err := ResolveForGateway(gatewayCtx, json.RawMessage(`{"key":"${API_KEY}","host":"${OTHER}"}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"key":"s3cret","host":"${OTHER}"}`, string(resolved))
+
+	// 展示时敏感变量不解密，保留引用
+	values, err = GetDisplayVariableValues(ctx, gateway.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"API_KEY": "${API_KEY}", "UPSTREAM_HOST": "httpbin.org"}, values)
+	resolved, err = ResolveForDisplay(gatewayCtx, json.RawMessage(`{"key":"${API_KEY}","host":"${UPSTREAM_HOST}"}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"key":"${API_KEY}","host":"httpbin.org"}`, string(resolved))
+
+	assert.NoError(t, DeleteVariable(ctx, gateway.ID, host.ID))
+	_, err = GetVariable(ctx, gateway.ID, host.ID)
+	assert.ErrorIs(t, err, ErrVariableNotFound)
+}
@@ -0,0 +1,254 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	resty "github.com/go-resty/resty/v2"
+	"github.com/samber/lo"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+)
+
+// 推送请求头
+const (
+	HeaderEvent     = "X-BK-Webhook-Event"     // 事件类型
+	HeaderDelivery  = "X-BK-Webhook-Delivery"  // 投递记录 ID，重试时不变
+	HeaderTimestamp = "X-BK-Webhook-Timestamp" // 签名时间戳，接收方可据此拒绝过旧的请求防止重放
+	HeaderSignature = "X-BK-Webhook-Signature" // sha256=<签名>
+)
+
+const (
+	// MaxAttempts 最大投递次数，用尽后投递记录置为失败
+	MaxAttempts = 8
+	// 每轮最多投递的记录数
+	deliveryBatchSize = 100
+	// 单次投递的超时时间
+	deliveryTimeout = 10 * time.Second
+	// 重试间隔从 30s 开始指数增长，最长 1h
+	retryBaseInterval = 30 * time.Second
+	retryMaxInterval  = time.Hour
+	// 记录的响应体最大长度
+	maxResponseBodyLength = 1024
+	// 已结束的投递记录保留时间
+	deliveryRetention = 30 * 24 * time.Hour
+)
+
+// ErrWebhookDisabled 投递时 webhook 已删除或停用
+var ErrWebhookDisabled = errors.New("webhook is deleted or disabled")
+
+// ErrWebhookDeniedAddress 投递地址解析为内网、回环或链路本地地址
+var ErrWebhookDeniedAddress = errors.New("webhook address should not be private, loopback or link-local")
+
+// isDeniedIP 判断是否为不允许投递的地址，防止通过 webhook 探测或访问控制面所在的内网；单测中可替换
+var isDeniedIP = func(ip net.IP) bool {
+	return ip.IsPrivate() || ip.IsLoopback() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast()
+}
+
+// newDeliveryClient 创建投递使用的 http 客户端：在 DNS 解析后、建立连接前校验目标地址，
+// 重定向及 DNS 重绑定同样会被拦截；不使用环境变量中的代理，避免校验的是代理地址
+func newDeliveryClient() *resty.Client {
+	dialer := &net.Dialer{
+		Timeout: deliveryTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || isDeniedIP(ip) {
+				return fmt.Errorf("%w: %s", ErrWebhookDeniedAddress, host)
+			}
+			return nil
+		},
+	}
+	transport := &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: deliveryTimeout}
+	return resty.New().SetTransport(transport).SetLogger(logging.New()).SetTimeout(deliveryTimeout)
+}
+
+// Sign 计算推送签名：hex(HMAC-SHA256(secret, "<timestamp>.<body>"))
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// DeliverDueDeliveries 投递到期的待投递记录，返回本次投递的记录数
+func DeliverDueDeliveries(ctx context.Context) (int, error) {
+	now := time.Now()
+	u := repo.GatewayWebhookDelivery
+	deliveries, err := u.WithContext(ctx).Where(
+		u.Status.Eq(string(constant.WebhookDeliveryStatusPending)),
+		u.NextAttemptAt.Lte(now),
+	).Order(u.ID).Limit(deliveryBatchSize).Find()
+	if err != nil {
+		return 0, err
+	}
+	if len(deliveries) == 0 {
+		return 0, purgeDeliveries(ctx, now)
+	}
+	webhookIDs := lo.Uniq(lo.Map(deliveries, func(delivery *model.GatewayWebhookDelivery, _ int) int {
+		return delivery.WebhookID
+	}))
+	w := repo.GatewayWebhook
+	webhooks, err := w.WithContext(ctx).Where(w.ID.In(webhookIDs...)).Find()
+	if err != nil {
+		return 0, err
+	}
+	webhookMap := lo.KeyBy(webhooks, func(webhook *model.GatewayWebhook) int { return webhook.ID })
+	count := 0
+	for _, delivery := range deliveries {
+		claimed, err := claimDelivery(ctx, delivery)
+		if err != nil {
+			return count, err
+		}
+		if !claimed {
+			continue
+		}
+		if err := deliver(ctx, webhookMap[delivery.WebhookID], delivery); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, purgeDeliveries(ctx, now)
+}
+
+// claimDelivery 抢占投递记录：投递次数加一并推迟下次投递时间，避免并发投递；投递中进程退出时超时后会被重新投递
+func claimDelivery(ctx context.Context, delivery *model.GatewayWebhookDelivery) (bool, error) {
+	u := repo.GatewayWebhookDelivery
+	result, err := u.WithContext(ctx).Where(
+		u.ID.Eq(delivery.ID),
+		u.Status.Eq(string(constant.WebhookDeliveryStatusPending)),
+		u.Attempts.Eq(delivery.Attempts),
+	).UpdateSimple(
+		u.Attempts.Add(1),
+		u.NextAttemptAt.Value(time.Now().Add(2*deliveryTimeout)),
+	)
+	if err != nil {
+		return false, err
+	}
+	delivery.Attempts++
+	return result.RowsAffected == 1, nil
+}
+
+// deliver 推送一次并记录结果，失败时按指数退避安排重试
+func deliver(ctx context.Context, webhook *model.GatewayWebhook, delivery *model.GatewayWebhookDelivery) error {
+	now := time.Now()
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatus = 0
+	delivery.ResponseBody = ""
+	delivery.Error = ""
+
+	err := send(ctx, webhook, delivery)
+	switch {
+	case err == nil:
+		delivery.Status = constant.WebhookDeliveryStatusSucceeded
+		delivery.DeliveredAt = &now
+	case errors.Is(err, ErrWebhookDisabled) || delivery.Attempts >= MaxAttempts:
+		delivery.Status = constant.WebhookDeliveryStatusFailed
+		delivery.Error = err.Error()
+	default:
+		delivery.Status = constant.WebhookDeliveryStatusPending
+		delivery.NextAttemptAt = now.Add(retryInterval(delivery.Attempts))
+		delivery.Error = err.Error()
+	}
+	if err != nil {
+		logging.WarnFWithCtx(ctx, "deliver webhook event %s [delivery:%d] attempt %d failed: %s",
+			delivery.Event, delivery.ID, delivery.Attempts, err.Error())
+	}
+
+	u := repo.GatewayWebhookDelivery
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(delivery.ID)).Select(
+		u.Status, u.NextAttemptAt, u.LastAttemptAt, u.ResponseStatus, u.ResponseBody, u.Error, u.DeliveredAt,
+	).Updates(delivery)
+	return err
+}
+
+// send 发送签名的推送请求，非 2xx 响应视为失败
+func send(ctx context.Context, webhook *model.GatewayWebhook, delivery *model.GatewayWebhookDelivery) error {
+	if webhook == nil || !webhook.Enabled {
+		return ErrWebhookDisabled
+	}
+	secret, err := cryptography.DecryptSecret(webhook.Secret)
+	if err != nil {
+		return fmt.Errorf("decrypt webhook secret failed: %w", err)
+	}
+	timestamp := time.Now().Unix()
+	resp, err := newDeliveryClient().R().
+		SetContext(ctx).
+		SetHeaders(map[string]string{
+			"Content-Type":  "application/json",
+			"User-Agent":    "bk-micro-apigateway-webhook",
+			HeaderEvent:     string(delivery.Event),
+			HeaderDelivery:  strconv.FormatInt(delivery.ID, 10),
+			HeaderTimestamp: strconv.FormatInt(timestamp, 10),
+			HeaderSignature: "sha256=" + Sign(secret, timestamp, delivery.Payload),
+		}).
+		SetBody([]byte(delivery.Payload)).
+		Post(webhook.URL)
+	if err != nil {
+		return err
+	}
+	delivery.ResponseStatus = resp.StatusCode()
+	body := resp.String()
+	if len(body) > maxResponseBodyLength {
+		body = body[:maxResponseBodyLength]
+	}
+	delivery.ResponseBody = body
+	if !resp.IsSuccess() {
+		return fmt.Errorf("webhook return status %d", resp.StatusCode())
+	}
+	return nil
+}
+
+// retryInterval 第 attempts 次投递失败后的重试间隔
+func retryInterval(attempts int) time.Duration {
+	interval := retryBaseInterval
+	for i := 1; i < attempts && interval < retryMaxInterval; i++ {
+		interval *= 2
+	}
+	return min(interval, retryMaxInterval)
+}
+
+// purgeDeliveries 清理超过保留时间的已结束投递记录
+func purgeDeliveries(ctx context.Context, now time.Time) error {
+	u := repo.GatewayWebhookDelivery
+	_, err := u.WithContext(ctx).Where(
+		u.Status.Neq(string(constant.WebhookDeliveryStatusPending)),
+		u.CreatedAt.Lt(now.Add(-deliveryRetention)),
+	).Delete()
+	return err
+}
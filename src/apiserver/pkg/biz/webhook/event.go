@@ -0,0 +1,50 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package webhook
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// Emit 写入网关事件的待投递记录，ctx 中存在事务时随事务提交
+func Emit(ctx context.Context, gatewayID int, event constant.WebhookEvent, data any) error {
+	return EmitWithTx(ctx, ginx.GetTx(ctx), gatewayID, event, data)
+}
+
+// EmitWithTx 在事务中写入网关事件的待投递记录，tx 为空时直接写入
+func EmitWithTx(ctx context.Context, tx *repo.Query, gatewayID int, event constant.WebhookEvent, data any) error {
+	q := repo.Q
+	if tx != nil {
+		q = tx
+	}
+	return model.EnqueueWebhookEvent(q.GatewayWebhookDelivery.WithContext(ctx).UnderlyingDB(), gatewayID, event, data)
+}
+
+// Notify 直接写入网关事件的待投递记录（不随 ctx 中的事务回滚），失败时只记录日志，用于不影响主流程的通知
+func Notify(ctx context.Context, gatewayID int, event constant.WebhookEvent, data any) {
+	if err := EmitWithTx(ctx, nil, gatewayID, event, data); err != nil {
+		logging.ErrorFWithContext(ctx, "emit webhook event %s of gateway %d err: %s", event, gatewayID, err.Error())
+	}
+}
@@ -0,0 +1,203 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package webhook 实现网关 webhook 订阅管理及事件投递
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"gorm.io/gen"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+)
+
+// WebhookErrors 定义 webhook 相关的错误
+var (
+	ErrWebhookNotFound     = errors.New("webhook not found")
+	ErrWebhookDuplicate    = errors.New("webhook name already exists")
+	ErrWebhookInvalidURL   = errors.New("webhook url should be an absolute http/https url")
+	ErrWebhookInvalidEvent = errors.New("webhook event not supported")
+	ErrDeliveryNotFound    = errors.New("webhook delivery not found")
+)
+
+// secretBytes 自动生成的签名密钥长度
+const secretBytes = 32
+
+// ListWebhooks 查询网关下的所有 webhook，签名密钥为密文
+func ListWebhooks(ctx context.Context, gatewayID int) ([]*model.GatewayWebhook, error) {
+	u := repo.GatewayWebhook
+	return u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID)).Order(u.ID).Find()
+}
+
+// GetWebhook 获取网关 webhook
+func GetWebhook(ctx context.Context, gatewayID int, id int) (*model.GatewayWebhook, error) {
+	u := repo.GatewayWebhook
+	webhook, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrWebhookNotFound
+	}
+	return webhook, err
+}
+
+// CreateWebhook 创建网关 webhook，未指定签名密钥时自动生成，返回签名密钥明文
+func CreateWebhook(ctx context.Context, webhook *model.GatewayWebhook) (string, error) {
+	if err := checkWebhook(ctx, webhook); err != nil {
+		return "", err
+	}
+	secret := webhook.Secret
+	if secret == "" {
+		var err error
+		if secret, err = generateSecret(); err != nil {
+			return "", err
+		}
+	}
+	webhook.Secret = cryptography.EncryptSecret(secret)
+	if err := repo.GatewayWebhook.WithContext(ctx).Create(webhook); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// UpdateWebhook 更新网关 webhook，未传签名密钥时保留原值
+func UpdateWebhook(ctx context.Context, webhook *model.GatewayWebhook) error {
+	current, err := GetWebhook(ctx, webhook.GatewayID, webhook.ID)
+	if err != nil {
+		return err
+	}
+	if err := checkWebhook(ctx, webhook); err != nil {
+		return err
+	}
+	if webhook.Secret == "" {
+		webhook.Secret = current.Secret
+	} else {
+		webhook.Secret = cryptography.EncryptSecret(webhook.Secret)
+	}
+	u := repo.GatewayWebhook
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(webhook.ID)).Select(
+		u.Name, u.URL, u.Secret, u.Events, u.Enabled, u.Description, u.Updater,
+	).Updates(webhook)
+	return err
+}
+
+// DeleteWebhook 删除网关 webhook 及其投递记录
+func DeleteWebhook(ctx context.Context, gatewayID int, id int) error {
+	return repo.Q.Transaction(func(tx *repo.Query) error {
+		u := tx.GatewayWebhook
+		if _, err := u.WithContext(ctx).Where(u.GatewayID.Eq(gatewayID), u.ID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+		d := tx.GatewayWebhookDelivery
+		_, err := d.WithContext(ctx).Where(d.GatewayID.Eq(gatewayID), d.WebhookID.Eq(id)).Delete()
+		return err
+	})
+}
+
+// ListDeliveries 分页查询 webhook 的投递记录
+func ListDeliveries(
+	ctx context.Context,
+	gatewayID int,
+	webhookID int,
+	queryParam map[string]any,
+	page utils.PageParam,
+) ([]*model.GatewayWebhookDelivery, int64, error) {
+	u := repo.GatewayWebhookDelivery
+	conds := []gen.Condition{u.GatewayID.Eq(gatewayID), u.WebhookID.Eq(webhookID)}
+	if status, ok := queryParam["status"].(string); ok && status != "" {
+		conds = append(conds, u.Status.Eq(status))
+	}
+	if event, ok := queryParam["event"].(string); ok && event != "" {
+		conds = append(conds, u.Event.Eq(event))
+	}
+	return u.WithContext(ctx).
+		Where(conds...).
+		Order(u.ID.Desc()).
+		FindByPage(page.Offset, page.Limit)
+}
+
+// Redeliver 重新投递：重置投递次数，由 scheduler 在下一轮投递
+func Redeliver(ctx context.Context, gatewayID int, webhookID int, id int64) error {
+	u := repo.GatewayWebhookDelivery
+	result, err := u.WithContext(ctx).Where(
+		u.GatewayID.Eq(gatewayID),
+		u.WebhookID.Eq(webhookID),
+		u.ID.Eq(id),
+	).UpdateSimple(
+		u.Status.Value(string(constant.WebhookDeliveryStatusPending)),
+		u.Attempts.Value(0),
+		u.NextAttemptAt.Value(time.Now()),
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return ErrDeliveryNotFound
+	}
+	return nil
+}
+
+// checkWebhook 校验 webhook 地址、事件及名称在网关下唯一
+func checkWebhook(ctx context.Context, webhook *model.GatewayWebhook) error {
+	u, err := url.Parse(webhook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrWebhookInvalidURL
+	}
+	// 域名在投递时解析后校验，这里只提前拒绝直接填写的 IP
+	if ip := net.ParseIP(u.Hostname()); ip != nil && isDeniedIP(ip) {
+		return fmt.Errorf("%w: %s", ErrWebhookDeniedAddress, u.Hostname())
+	}
+	for _, event := range webhook.Events {
+		if _, ok := constant.WebhookEventMap[constant.WebhookEvent(event)]; !ok {
+			return fmt.Errorf("%w: %s", ErrWebhookInvalidEvent, event)
+		}
+	}
+	w := repo.GatewayWebhook
+	count, err := w.WithContext(ctx).Where(
+		w.GatewayID.Eq(webhook.GatewayID),
+		w.Name.Eq(webhook.Name),
+		w.ID.Neq(webhook.ID),
+	).Count()
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %s", ErrWebhookDuplicate, webhook.Name)
+	}
+	return nil
+}
+
+// generateSecret 生成随机签名密钥
+func generateSecret() (string, error) {
+	b := make([]byte, secretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,302 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+func TestMain(m *testing.M) {
+	// init crypto
+	err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化embed数据库
+	util.InitEmbedDb()
+
+	// 投递单测使用本地 httptest 服务，放开回环地址
+	defaultIsDeniedIP = isDeniedIP
+	isDeniedIP = func(ip net.IP) bool {
+		return !ip.IsLoopback() && defaultIsDeniedIP(ip)
+	}
+
+	os.Exit(m.Run())
+}
+
+// defaultIsDeniedIP 未放开回环地址的校验
+var defaultIsDeniedIP func(ip net.IP) bool
+
+func listDeliveries(t *testing.T, gatewayID int, webhookID int) []*model.GatewayWebhookDelivery {
+	deliveries, _, err := ListDeliveries(context.Background(), gatewayID, webhookID, nil, utils.PageParam{Limit: 100})
+	assert.NoError(t, err)
+	return deliveries
+}
+
+func TestWebhookCRUD(t *testing.T) {
+	ctx := context.Background()
+	gatewayID := 3001
+
+	webhook := &model.GatewayWebhook{
+		GatewayID: gatewayID,
+		Name:      "notify",
+		URL:       "https://example.com/hook",
+		Events:    pq.StringArray{string(constant.WebhookEventPublishSucceeded)},
+		Enabled:   true,
+	}
+	secret, err := CreateWebhook(ctx, webhook)
+	assert.NoError(t, err)
+	assert.Len(t, secret, 2*secretBytes)
+	plain, err := cryptography.DecryptSecret(webhook.Secret)
+	assert.NoError(t, err)
+	assert.Equal(t, secret, plain)
+
+	_, err = CreateWebhook(ctx, &model.GatewayWebhook{GatewayID: gatewayID, Name: "notify", URL: "http://a.com"})
+	assert.ErrorIs(t, err, ErrWebhookDuplicate)
+	_, err = CreateWebhook(ctx, &model.GatewayWebhook{GatewayID: gatewayID, Name: "ftp", URL: "ftp://a.com"})
+	assert.ErrorIs(t, err, ErrWebhookInvalidURL)
+	_, err = CreateWebhook(ctx, &model.GatewayWebhook{
+		GatewayID: gatewayID, Name: "unknown", URL: "http://a.com", Events: pq.StringArray{"unknown"},
+	})
+	assert.ErrorIs(t, err, ErrWebhookInvalidEvent)
+	for _, address := range []string{"http://10.0.0.1/hook", "http://169.254.169.254/latest", "http://[fd00::1]"} {
+		_, err = CreateWebhook(ctx, &model.GatewayWebhook{GatewayID: gatewayID, Name: "internal", URL: address})
+		assert.ErrorIs(t, err, ErrWebhookDeniedAddress, address)
+	}
+
+	// 未传签名密钥时保留原值
+	assert.NoError(t, UpdateWebhook(ctx, &model.GatewayWebhook{
+		ID:        webhook.ID,
+		GatewayID: gatewayID,
+		Name:      "notify",
+		URL:       "https://example.com/hook2",
+		Events:    pq.StringArray{string(constant.WebhookEventPublishFailed)},
+	}))
+	updated, err := GetWebhook(ctx, gatewayID, webhook.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/hook2", updated.URL)
+	assert.False(t, updated.Enabled)
+	plain, err = cryptography.DecryptSecret(updated.Secret)
+	assert.NoError(t, err)
+	assert.Equal(t, secret, plain)
+
+	webhooks, err := ListWebhooks(ctx, gatewayID)
+	assert.NoError(t, err)
+	assert.Len(t, webhooks, 1)
+
+	assert.NoError(t, DeleteWebhook(ctx, gatewayID, webhook.ID))
+	_, err = GetWebhook(ctx, gatewayID, webhook.ID)
+	assert.ErrorIs(t, err, ErrWebhookNotFound)
+}
+
+func TestAuditLogEmitsResourceEvent(t *testing.T) {
+	ctx := context.Background()
+	gatewayID := 3002
+	webhook := &model.GatewayWebhook{
+		GatewayID: gatewayID,
+		Name:      "resource",
+		URL:       "https://example.com/hook",
+		Events:    pq.StringArray{string(constant.WebhookEventResourceCreated)},
+		Enabled:   true,
+	}
+	_, err := CreateWebhook(ctx, webhook)
+	assert.NoError(t, err)
+
+	for _, operationType := range []constant.OperationType{
+		constant.OperationTypeCreate, constant.OperationTypeUpdate,
+	} {
+		assert.NoError(t, repo.OperationAuditLog.WithContext(ctx).Create(&model.OperationAuditLog{
+			GatewayID:     gatewayID,
+			OperationType: operationType,
+			Operator:      "admin",
+			ResourceIDs:   "r1,r2",
+			ResourceType:  constant.Route,
+			DataBefore:    []byte(`[]`),
+			DataAfter:     []byte(`[]`),
+		}))
+	}
+
+	// 只订阅了 resource.created
+	deliveries := listDeliveries(t, gatewayID, webhook.ID)
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, constant.WebhookEventResourceCreated, deliveries[0].Event)
+	assert.Equal(t, constant.WebhookDeliveryStatusPending, deliveries[0].Status)
+	var payload model.WebhookEventPayload
+	assert.NoError(t, json.Unmarshal(deliveries[0].Payload, &payload))
+	assert.Equal(t, deliveries[0].EventID, payload.ID)
+	data, _ := json.Marshal(payload.Data)
+	assert.Contains(t, string(data), `"resource_ids":["r1","r2"]`)
+}
+
+func TestDeliverDueDeliveries(t *testing.T) {
+	ctx := context.Background()
+	gatewayID := 3003
+
+	var received *http.Request
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	webhook := &model.GatewayWebhook{
+		GatewayID: gatewayID,
+		Name:      "deliver",
+		URL:       server.URL,
+		Secret:    "s3cret",
+		Events:    pq.StringArray{string(constant.WebhookEventPublishSucceeded)},
+		Enabled:   true,
+	}
+	_, err := CreateWebhook(ctx, webhook)
+	assert.NoError(t, err)
+	assert.NoError(t, Emit(ctx, gatewayID, constant.WebhookEventPublishSucceeded, map[string]any{"all": true}))
+	// 未订阅的事件不投递
+	assert.NoError(t, Emit(ctx, gatewayID, constant.WebhookEventPublishFailed, nil))
+
+	count, err := DeliverDueDeliveries(ctx)
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, count, 1)
+
+	deliveries := listDeliveries(t, gatewayID, webhook.ID)
+	assert.Len(t, deliveries, 1)
+	delivery := deliveries[0]
+	assert.Equal(t, constant.WebhookDeliveryStatusSucceeded, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusOK, delivery.ResponseStatus)
+	assert.NotNil(t, delivery.DeliveredAt)
+
+	if assert.NotNil(t, received) {
+		assert.Equal(t, string(constant.WebhookEventPublishSucceeded), received.Header.Get(HeaderEvent))
+		assert.Equal(t, strconv.FormatInt(delivery.ID, 10), received.Header.Get(HeaderDelivery))
+		timestamp, err := strconv.ParseInt(received.Header.Get(HeaderTimestamp), 10, 64)
+		assert.NoError(t, err)
+		assert.Equal(t, "sha256="+Sign("s3cret", timestamp, receivedBody), received.Header.Get(HeaderSignature))
+		assert.JSONEq(t, string(delivery.Payload), string(receivedBody))
+	}
+}
+
+func TestDeliverRetryAndRedeliver(t *testing.T) {
+	ctx := context.Background()
+	gatewayID := 3004
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("oops"))
+	}))
+	defer server.Close()
+
+	webhook := &model.GatewayWebhook{
+		GatewayID: gatewayID,
+		Name:      "retry",
+		URL:       server.URL,
+		Events:    pq.StringArray{string(constant.WebhookEventConflictDetected)},
+		Enabled:   true,
+	}
+	_, err := CreateWebhook(ctx, webhook)
+	assert.NoError(t, err)
+	assert.NoError(t, Emit(ctx, gatewayID, constant.WebhookEventConflictDetected, nil))
+
+	// 失败后按退避间隔重试
+	_, err = DeliverDueDeliveries(ctx)
+	assert.NoError(t, err)
+	delivery := listDeliveries(t, gatewayID, webhook.ID)[0]
+	assert.Equal(t, constant.WebhookDeliveryStatusPending, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusInternalServerError, delivery.ResponseStatus)
+	assert.Equal(t, "oops", delivery.ResponseBody)
+	assert.WithinDuration(t, time.Now().Add(retryBaseInterval), delivery.NextAttemptAt, 5*time.Second)
+
+	// 达到最大投递次数后标记为失败
+	u := repo.GatewayWebhookDelivery
+	_, err = u.WithContext(ctx).Where(u.ID.Eq(delivery.ID)).UpdateSimple(
+		u.Attempts.Value(MaxAttempts-1),
+		u.NextAttemptAt.Value(time.Now()),
+	)
+	assert.NoError(t, err)
+	_, err = DeliverDueDeliveries(ctx)
+	assert.NoError(t, err)
+	delivery = listDeliveries(t, gatewayID, webhook.ID)[0]
+	assert.Equal(t, constant.WebhookDeliveryStatusFailed, delivery.Status)
+	assert.Equal(t, MaxAttempts, delivery.Attempts)
+
+	assert.NoError(t, Redeliver(ctx, gatewayID, webhook.ID, delivery.ID))
+	delivery = listDeliveries(t, gatewayID, webhook.ID)[0]
+	assert.Equal(t, constant.WebhookDeliveryStatusPending, delivery.Status)
+	assert.Equal(t, 0, delivery.Attempts)
+	assert.ErrorIs(t, Redeliver(ctx, gatewayID, webhook.ID, delivery.ID+1000), ErrDeliveryNotFound)
+}
+
+func TestRetryInterval(t *testing.T) {
+	assert.Equal(t, retryBaseInterval, retryInterval(1))
+	assert.Equal(t, 2*retryBaseInterval, retryInterval(2))
+	assert.Equal(t, 8*retryBaseInterval, retryInterval(4))
+	assert.Equal(t, retryMaxInterval, retryInterval(MaxAttempts*2))
+}
+
+func TestSendRejectsDeniedAddress(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		_, _ = w.Write([]byte("internal"))
+	}))
+	defer server.Close()
+
+	secret, err := generateSecret()
+	assert.NoError(t, err)
+	webhook := &model.GatewayWebhook{URL: server.URL, Secret: cryptography.EncryptSecret(secret), Enabled: true}
+	delivery := &model.GatewayWebhookDelivery{
+		ID: 1, Event: constant.WebhookEventPublishSucceeded, Payload: datatypes.JSON(`{}`),
+	}
+
+	// 域名解析或重定向到回环地址时在建立连接前拒绝
+	allowLoopback := isDeniedIP
+	isDeniedIP = defaultIsDeniedIP
+	defer func() { isDeniedIP = allowLoopback }()
+	for _, address := range []string{server.URL, strings.Replace(server.URL, "127.0.0.1", "localhost", 1)} {
+		webhook.URL = address
+		err = send(context.Background(), webhook, delivery)
+		assert.ErrorIs(t, err, ErrWebhookDeniedAddress, address)
+	}
+	assert.False(t, called)
+	assert.Empty(t, delivery.ResponseBody)
+}
@@ -271,6 +271,18 @@ func loadBizConfigFromEnv() (BizConfig, error) {
 		}
 		demoProtectResourceMap[r] = true
 	}
+	sslExpiryAlertDays := envx.Get("SSL_EXPIRY_ALERT_DAYS", "30,7,1")
+	var sslExpiryAlertDayList []int
+	for _, d := range strings.Split(sslExpiryAlertDays, ",") {
+		if strings.TrimSpace(d) == "" {
+			continue
+		}
+		days, err := cast.ToIntE(strings.TrimSpace(d))
+		if err != nil || days <= 0 {
+			return BizConfig{}, errors.Errorf("invalid SSL_EXPIRY_ALERT_DAYS: %s", sslExpiryAlertDays)
+		}
+		sslExpiryAlertDayList = append(sslExpiryAlertDayList, days)
+	}
 	return BizConfig{
 		SyncInterval:          envx.GetDuration("SYNC_INTERVAL", "1h"),
 		TAPISIXPluginDocURLs:  tapisixPluginMap,
@@ -282,13 +294,36 @@ func loadBizConfigFromEnv() (BizConfig, error) {
 			BKGuideLink:      envx.Get("BK_GUIDE_LINK", ""),
 			BKApigatewayLink: envx.Get("BK_APIGATEWAY_LINK", ""),
 		},
+		DriftWebhook: WebhookConfig{
+			URL:         envx.Get("DRIFT_WEBHOOK_URL", ""),
+			Token:       envx.Get("DRIFT_WEBHOOK_TOKEN", ""),
+			Timeout:     envx.GetDuration("DRIFT_WEBHOOK_TIMEOUT", "10s"),
+			NotifyDelay: envx.GetDuration("DRIFT_WEBHOOK_NOTIFY_DELAY", "30s"),
+		},
+		SSLExpiryAlertDays:    sslExpiryAlertDayList,
+		DataPlaneStaleTimeout: envx.GetDuration("DATA_PLANE_STALE_TIMEOUT", "3m"),
 	}, nil
 }
 
 // 加载 co
 func loadCryptoFromEnv() (Crypto, error) {
+	// 其他可用密钥在环境变量中格式如 "key2026:xxx,key2027:yyy"
+	var keys []CryptoKey
+	for i, item := range strings.Split(envx.Get("CRYPTO_KEYS", ""), ",") {
+		if item == "" {
+			continue
+		}
+		id, key, ok := strings.Cut(item, ":")
+		if !ok {
+			// 不输出配置项内容，避免密钥泄露到日志
+			return Crypto{}, errors.Errorf("invalid CRYPTO_KEYS item at index %d, should be {id}:{key}", i)
+		}
+		keys = append(keys, CryptoKey{ID: id, Key: key})
+	}
 	return Crypto{
 		Nonce: envx.Get("CRYPTO_NONCE", ""),
 		Key:   envx.Get("CRYPTO_KEY", ""),
+		KeyID: envx.Get("CRYPTO_KEY_ID", ""),
+		Keys:  keys,
 	}, nil
 }
@@ -182,12 +182,23 @@ type BkPlatUrlConfig struct {
 
 // BizConfig 业务相关配置
 type BizConfig struct {
-	SyncInterval          time.Duration     // 定时同步间隔
+	SyncInterval          time.Duration     // 增量同步重新检查网关配置的间隔
 	TAPISIXPluginDocURLs  map[string]string // TAPISIX 插件文档地址列表
 	BKPluginDocURLs       map[string]string // 蓝鲸插件文档地址列表
 	OpenApiTokenWhitelist map[string]bool   // OpenAPI 接口 token 白名单
 	DemoProtectResources  map[string]bool   // demo 模式保护资源列表
 	Links                 LinkConfig        // 前端需要的链接相关配置
+	DriftWebhook          WebhookConfig     // 配置漂移告警 webhook
+	SSLExpiryAlertDays    []int             // 证书过期告警阈值（剩余天数）
+	DataPlaneStaleTimeout time.Duration     // 数据面实例心跳超时时间，超时后标记为失联
+}
+
+// WebhookConfig webhook 通知配置
+type WebhookConfig struct {
+	URL         string        // webhook 地址，为空时不发送通知
+	Token       string        // 请求头 Authorization: Bearer <Token>，为空时不设置
+	Timeout     time.Duration // 请求超时时间
+	NotifyDelay time.Duration // 延迟发送时间，延迟期间已恢复的记录不再通知
 }
 
 type LinkConfig struct {
@@ -198,6 +209,16 @@ type LinkConfig struct {
 
 // Crypto  配置
 type Crypto struct {
-	Nonce string
-	Key   string
+	Nonce string // 旧版本密文使用的固定 nonce，仅用于解密存量数据，为空时不支持旧版本密文
+	Key   string // ID 为 default 的密钥
+	// 当前用于加密的密钥 ID，为空时使用 Key
+	KeyID string
+	// 其他可用密钥，轮换期间保留旧密钥用于解密
+	Keys []CryptoKey
+}
+
+// CryptoKey 加密密钥
+type CryptoKey struct {
+	ID  string
+	Key string
 }
@@ -30,6 +30,8 @@ const (
 	PluginConfig   APISIXResource = "plugin_config"
 	PluginMetadata APISIXResource = "plugin_metadata"
 	Consumer       APISIXResource = "consumer"
+	Credential     APISIXResource = "credential"
+	Secret         APISIXResource = "secret"
 	ConsumerGroup  APISIXResource = "consumer_group"
 	GlobalRule     APISIXResource = "global_rule"
 	Proto          APISIXResource = "proto"
@@ -41,6 +43,12 @@ const (
 
 const ResourceKeyFormat = "%s-%s" // type-resource-id
 
+// CredentialKeyFormat credential 嵌套在所属 consumer 下，etcd key 为 consumers/{consumer_id}/credentials/{id}
+const CredentialKeyFormat = "%s/credentials/%s"
+
+// SecretKeyFormat secret 按密钥管理器分类，etcd key 为 secrets/{manager}/{id}
+const SecretKeyFormat = "%s/%s"
+
 // RelationIDFiledMap ...
 var RelationIDFiledMap = map[APISIXResource]string{
 	Service:       "service_id",
@@ -48,6 +56,40 @@ var RelationIDFiledMap = map[APISIXResource]string{
 	PluginConfig:  "plugin_config_id",
 	ConsumerGroup: "group_id",
 	SSL:           "ssl_id",
+	Consumer:      "consumer_id",
+}
+
+// ResourceDependencyField 资源配置中引用其他资源的字段
+type ResourceDependencyField struct {
+	Path string         // 字段的 json 路径
+	Type APISIXResource // 引用的资源类型
+}
+
+// ResourceDependencyFields 资源配置中引用其他资源的字段
+var ResourceDependencyFields = []ResourceDependencyField{
+	{Path: "service_id", Type: Service},
+	{Path: "upstream_id", Type: Upstream},
+	{Path: "plugin_config_id", Type: PluginConfig},
+	{Path: "group_id", Type: ConsumerGroup},
+	{Path: "tls.client_cert_id", Type: SSL},
+	{Path: "consumer_id", Type: Consumer},
+}
+
+// StandaloneResourceKeyMap APISIX standalone 模式下 apisix.yaml 中各资源类型对应的配置项
+var StandaloneResourceKeyMap = map[APISIXResource]string{
+	Route:          "routes",
+	Service:        "services",
+	Upstream:       "upstreams",
+	PluginConfig:   "plugin_configs",
+	PluginMetadata: "plugin_metadata",
+	Consumer:       "consumers",
+	Credential:     "consumers", // credential 作为 consumers 中的一项，id 为 {username}/credentials/{id}
+	Secret:         "secrets",   // id 为 {manager}/{id}
+	ConsumerGroup:  "consumer_groups",
+	GlobalRule:     "global_rules",
+	Proto:          "protos",
+	SSL:            "ssls",
+	StreamRoute:    "stream_routes",
 }
 
 // String ...
@@ -78,6 +120,8 @@ var ResourceTypeMap = map[APISIXResource]string{
 	Proto:          "proto",
 	SSL:            "证书",
 	Consumer:       "消费者",
+	Credential:     "消费者凭证",
+	Secret:         "密钥",
 	ConsumerGroup:  "消费者组",
 	PluginMetadata: "插件元数据",
 	GlobalRule:     "全局规则",
@@ -95,21 +139,25 @@ var ResourceTypeOrder = []APISIXResource{
 	Proto,
 	SSL,
 	Consumer,
+	Credential,
 	ConsumerGroup,
 	PluginMetadata,
 	GlobalRule,
 	PluginConfig,
+	Secret,
 	Schema,
 	Gateway,
 }
 
 // ResourceTypeList ...
 var ResourceTypeList = []APISIXResource{
+	Secret, // secret 需先于引用它的资源发布
 	Route,
 	Service,
 	Upstream,
 	PluginConfig,
 	PluginMetadata,
+	Credential, // credential 需先于 consumer 处理，保证删除 consumer 前其下的 credential 已删除
 	Consumer,
 	ConsumerGroup,
 	GlobalRule,
@@ -148,6 +196,7 @@ var ResourceRelationMap = map[APISIXResource][]APISIXResource{
 	Upstream:      {Route, Service, StreamRoute},
 	SSL:           {Upstream},
 	ConsumerGroup: {Consumer},
+	Consumer:      {Credential},
 }
 
 // PluginsMustResourceMap 必须要配置插件的资源
@@ -156,4 +205,77 @@ var PluginsMustResourceMap = map[APISIXResource]bool{
 	PluginMetadata: true,
 	ConsumerGroup:  true,
 	GlobalRule:     true,
+	Credential:     true,
+}
+
+// SecretManager APISIX secret 资源支持的密钥管理器
+type SecretManager string
+
+// SecretManagerVault ...
+const (
+	SecretManagerVault SecretManager = "vault"
+	SecretManagerAWS   SecretManager = "aws"
+	SecretManagerGCP   SecretManager = "gcp"
+)
+
+// SecretManagerList ...
+var SecretManagerList = []SecretManager{
+	SecretManagerVault,
+	SecretManagerAWS,
+	SecretManagerGCP,
+}
+
+// String ...
+func (m SecretManager) String() string {
+	return string(m)
+}
+
+// 插件配置中可被 APISIX 解析的引用前缀，引用的值不是敏感信息本身
+const (
+	SecretReferencePrefix = "$secret://"
+	EnvReferencePrefix    = "$env://"
+)
+
+// PluginSensitiveFieldMap 插件配置中的敏感字段，key 为插件名，value 为字段在插件配置中的路径，# 表示数组的每个元素
+//
+// 敏感字段在数据库中加密存储，仅在发布时解密，接口返回、导出及审计中脱敏
+var PluginSensitiveFieldMap = map[string][]string{
+	"ai-aws-content-moderation": {"comprehend.secret_access_key"},
+	"ai-rag": {
+		"embeddings_provider.azure_openai.api_key",
+		"vector_search_provider.azure_ai_search.api_key",
+	},
+	"authz-casdoor":        {"client_secret"},
+	"authz-keycloak":       {"client_secret"},
+	"aws-lambda":           {"authorization.apikey", "authorization.iam.secretkey"},
+	"azure-functions":      {"authorization.apikey"},
+	"basic-auth":           {"password"},
+	"clickhouse-logger":    {"password"},
+	"csrf":                 {"key"},
+	"elasticsearch-logger": {"auth.password"},
+	"google-cloud-logging": {"auth_config.private_key"},
+	"hmac-auth":            {"secret_key"},
+	"http-logger":          {"auth_header"},
+	"jwe-decrypt":          {"secret"},
+	"jwt-auth":             {"secret", "private_key"},
+	"kafka-logger":         {"brokers.#.sasl_config.password"},
+	"kafka-proxy":          {"sasl.password"},
+	"key-auth":             {"key"},
+	"lago":                 {"token"},
+	"limit-conn":           {"redis_password"},
+	"limit-count":          {"redis_password"},
+	"limit-req":            {"redis_password"},
+	"loggly":               {"customer_token"},
+	"openfunction":         {"authorization.service_token"},
+	"openid-connect":       {"client_secret", "client_rsa_private_key", "session.secret"},
+	"openwhisk":            {"service_token"},
+	"rocketmq-logger":      {"secret_key"},
+	"sls-logger":           {"access_key_secret"},
+	"splunk-hec-logging":   {"endpoint.token"},
+	"tencent-cloud-cls":    {"secret_key"},
+}
+
+// ResourceSensitiveFieldMap 资源配置自身（插件以外）的敏感字段
+var ResourceSensitiveFieldMap = map[APISIXResource][]string{
+	Secret: {"token", "secret_access_key", "session_token", "auth_config.private_key"},
 }
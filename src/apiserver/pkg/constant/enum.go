@@ -92,6 +92,8 @@ const (
 	Upstreams       ResourcePath = "upstreams"
 	Services        ResourcePath = "services"
 	Consumers       ResourcePath = "consumers"
+	Credentials     ResourcePath = "credentials"
+	Secrets         ResourcePath = "secrets"
 	GlobalRules     ResourcePath = "global_rules"
 	ConsumerGroups  ResourcePath = "consumer_groups"
 	PluginConfigs   ResourcePath = "plugin_configs"
@@ -107,6 +109,8 @@ var ResourcePathToTypeMap = map[ResourcePath]APISIXResource{
 	Upstreams:       Upstream,
 	Services:        Service,
 	Consumers:       Consumer,
+	Credentials:     Credential,
+	Secrets:         Secret,
 	GlobalRules:     GlobalRule,
 	ConsumerGroups:  ConsumerGroup,
 	PluginConfigs:   PluginConfig,
@@ -122,6 +126,8 @@ var ResourceTypePrefixMap = map[APISIXResource]string{
 	Upstream:       "upstreams",
 	Service:        "services",
 	Consumer:       "consumers",
+	Credential:     "consumers", // credential 的 key 嵌套在 consumer 下：consumers/{consumer_id}/credentials/{id}
+	Secret:         "secrets",   // secret 的 key 带有密钥管理器：secrets/{manager}/{id}
 	GlobalRule:     "global_rules",
 	ConsumerGroup:  "consumer_groups",
 	PluginConfig:   "plugin_configs",
@@ -153,14 +159,35 @@ type SyncStatus string
 const (
 	SyncedResourceStatusSuccess SyncStatus = "success" // 同步成功
 	SyncedResourceStatusMiss    SyncStatus = "miss"    // 编辑区无此资源
+	SyncedResourceStatusDrifted SyncStatus = "drifted" // etcd 中的配置与最近一次发布的配置不一致
 )
 
 // SyncedResourceStatusMap ...
 var SyncedResourceStatusMap = map[SyncStatus]SyncStatus{
 	SyncedResourceStatusSuccess: SyncedResourceStatusSuccess,
 	SyncedResourceStatusMiss:    SyncedResourceStatusMiss,
+	SyncedResourceStatusDrifted: SyncedResourceStatusDrifted,
 }
 
+// DriftType 配置漂移类型
+type DriftType string
+
+// DriftTypeModified ...
+const (
+	DriftTypeModified DriftType = "modified" // etcd 中的配置被修改
+	DriftTypeAdded    DriftType = "added"    // etcd 中新增了未经控制面发布的资源
+	DriftTypeDeleted  DriftType = "deleted"  // etcd 中已发布的资源被删除
+)
+
+// DriftStatus 配置漂移记录状态
+type DriftStatus string
+
+// DriftStatusDrifted ...
+const (
+	DriftStatusDrifted  DriftStatus = "drifted"  // 漂移中
+	DriftStatusResolved DriftStatus = "resolved" // 已恢复：etcd 配置与基线一致或发布了新版本
+)
+
 // UploadStatus 资源上传状态
 type UploadStatus string
 
@@ -181,6 +208,24 @@ const (
 	PublishByOthers               = "others"
 )
 
+// ReleaseTrigger 发布版本触发来源
+type ReleaseTrigger string
+
+// String ...
+func (r ReleaseTrigger) String() string {
+	return string(r)
+}
+
+// ReleaseTriggerWeb ...
+const (
+	ReleaseTriggerWeb      ReleaseTrigger = "web"      // 页面发布
+	ReleaseTriggerOpen     ReleaseTrigger = "open"     // openapi 发布
+	ReleaseTriggerMCP      ReleaseTrigger = "mcp"      // MCP 发布
+	ReleaseTriggerSchedule ReleaseTrigger = "schedule" // 定时发布
+	// 网关组分批发布
+	ReleaseTriggerGroupRollout ReleaseTrigger = "group_rollout"
+)
+
 // OperationType 资源操作类型
 type OperationType string
 
@@ -199,6 +244,17 @@ const (
 	OperationTypeFixConflict OperationType = "fix_conflict"      // 解决冲突
 	OperationOneClickManaged OperationType = "one_click_managed" // 一键同步（数据量太大，不添加审计）
 	OperationImport          OperationType = "import"            // 导入
+	OperationTypeRollback    OperationType = "rollback"          // 版本回滚
+	// 创建定时发布
+	OperationTypeSchedulePublish OperationType = "schedule_publish"
+	// 取消定时发布
+	OperationTypeCancelScheduledPublish OperationType = "cancel_scheduled_publish"
+	// 维护窗口外强制发布
+	OperationTypeMaintenanceOverride OperationType = "maintenance_override"
+	// 网关组分批发布
+	OperationTypeGroupRollout OperationType = "group_rollout"
+	// 证书即将过期/已过期告警
+	OperationTypeSSLExpiring OperationType = "ssl_expiring"
 )
 
 // OperationTypeMap ...
@@ -209,6 +265,13 @@ var OperationTypeMap = map[OperationType]string{
 	OperationTypePublish:     "发布",
 	OperationTypeRevert:      "撤销",
 	OperationTypeFixConflict: "解决冲突",
+	OperationTypeRollback:    "版本回滚",
+
+	OperationTypeSchedulePublish:        "定时发布",
+	OperationTypeCancelScheduledPublish: "取消定时发布",
+	OperationTypeMaintenanceOverride:    "维护窗口外强制发布",
+	OperationTypeGroupRollout:           "网关组分批发布",
+	OperationTypeSSLExpiring:            "证书过期告警",
 }
 
 // HTTP ...
@@ -245,3 +308,386 @@ const (
 	DATABASE DataType = "db"
 	ETCD     DataType = "etcd"
 )
+
+// GatewayRole 网关成员角色
+type GatewayRole string
+
+// String ...
+func (r GatewayRole) String() string {
+	return string(r)
+}
+
+// GatewayRoleViewer ...
+const (
+	GatewayRoleViewer    GatewayRole = "viewer"    // 只读
+	GatewayRoleEditor    GatewayRole = "editor"    // 可编辑草稿
+	GatewayRolePublisher GatewayRole = "publisher" // 可发布、同步
+	GatewayRoleAdmin     GatewayRole = "admin"     // 可管理网关及成员
+)
+
+// GatewayRoleMap ...
+var GatewayRoleMap = map[GatewayRole]string{
+	GatewayRoleViewer:    "查看者",
+	GatewayRoleEditor:    "编辑者",
+	GatewayRolePublisher: "发布者",
+	GatewayRoleAdmin:     "管理员",
+}
+
+// GatewayPermission 网关操作权限
+type GatewayPermission string
+
+// GatewayPermissionView ...
+const (
+	GatewayPermissionView    GatewayPermission = "view"    // 查看网关及资源
+	GatewayPermissionEdit    GatewayPermission = "edit"    // 编辑资源草稿
+	GatewayPermissionPublish GatewayPermission = "publish" // 发布、同步、回滚
+	GatewayPermissionManage  GatewayPermission = "manage"  // 管理网关及成员
+)
+
+// gatewayRoleLevel 角色等级，高等级角色拥有低等级角色的所有权限
+var gatewayRoleLevel = map[GatewayRole]int{
+	GatewayRoleViewer:    1,
+	GatewayRoleEditor:    2,
+	GatewayRolePublisher: 3,
+	GatewayRoleAdmin:     4,
+}
+
+// gatewayPermissionRole 权限所需的最低角色
+var gatewayPermissionRole = map[GatewayPermission]GatewayRole{
+	GatewayPermissionView:    GatewayRoleViewer,
+	GatewayPermissionEdit:    GatewayRoleEditor,
+	GatewayPermissionPublish: GatewayRolePublisher,
+	GatewayPermissionManage:  GatewayRoleAdmin,
+}
+
+// HasPermission 角色是否拥有权限
+func (r GatewayRole) HasPermission(permission GatewayPermission) bool {
+	level, ok := gatewayRoleLevel[r]
+	if !ok {
+		return false
+	}
+	requiredRole, ok := gatewayPermissionRole[permission]
+	if !ok {
+		return false
+	}
+	return level >= gatewayRoleLevel[requiredRole]
+}
+
+// ChangeRequestStatus 发布变更请求状态
+type ChangeRequestStatus string
+
+// ChangeRequestStatusPending ...
+const (
+	ChangeRequestStatusPending  ChangeRequestStatus = "pending"  // 待审批
+	ChangeRequestStatusApproved ChangeRequestStatus = "approved" // 已通过，待执行发布
+	ChangeRequestStatusApplying ChangeRequestStatus = "applying" // 发布中
+	ChangeRequestStatusRejected ChangeRequestStatus = "rejected" // 已驳回
+	ChangeRequestStatusApplied  ChangeRequestStatus = "applied"  // 已发布
+	ChangeRequestStatusExpired  ChangeRequestStatus = "expired"  // 已过期
+)
+
+// ChangeRequestStatusMap ...
+var ChangeRequestStatusMap = map[ChangeRequestStatus]string{
+	ChangeRequestStatusPending:  "待审批",
+	ChangeRequestStatusApproved: "已通过",
+	ChangeRequestStatusApplying: "发布中",
+	ChangeRequestStatusRejected: "已驳回",
+	ChangeRequestStatusApplied:  "已发布",
+	ChangeRequestStatusExpired:  "已过期",
+}
+
+// ChangeRequestAction 发布变更请求操作记录类型
+type ChangeRequestAction string
+
+// ChangeRequestActionCreate ...
+const (
+	ChangeRequestActionCreate      ChangeRequestAction = "create"       // 创建
+	ChangeRequestActionApprove     ChangeRequestAction = "approve"      // 审批通过
+	ChangeRequestActionReject      ChangeRequestAction = "reject"       // 驳回
+	ChangeRequestActionComment     ChangeRequestAction = "comment"      // 评论
+	ChangeRequestActionApply       ChangeRequestAction = "apply"        // 执行发布
+	ChangeRequestActionApplyFailed ChangeRequestAction = "apply_failed" // 执行发布失败
+	ChangeRequestActionExpire      ChangeRequestAction = "expire"       // 过期
+)
+
+// ScheduledPublishStatus 定时发布状态
+type ScheduledPublishStatus string
+
+// ScheduledPublishStatusPending ...
+const (
+	ScheduledPublishStatusPending   ScheduledPublishStatus = "pending"   // 待执行
+	ScheduledPublishStatusRunning   ScheduledPublishStatus = "running"   // 执行中
+	ScheduledPublishStatusSucceeded ScheduledPublishStatus = "succeeded" // 执行成功
+	ScheduledPublishStatusFailed    ScheduledPublishStatus = "failed"    // 执行失败
+	ScheduledPublishStatusCancelled ScheduledPublishStatus = "cancelled" // 已取消
+)
+
+// ScheduledPublishStatusMap ...
+var ScheduledPublishStatusMap = map[ScheduledPublishStatus]string{
+	ScheduledPublishStatusPending:   "待执行",
+	ScheduledPublishStatusRunning:   "执行中",
+	ScheduledPublishStatusSucceeded: "执行成功",
+	ScheduledPublishStatusFailed:    "执行失败",
+	ScheduledPublishStatusCancelled: "已取消",
+}
+
+// TaskStatus 后台任务状态
+type TaskStatus string
+
+// TaskStatusPending ...
+const (
+	TaskStatusPending   TaskStatus = "pending"   // 排队中
+	TaskStatusRunning   TaskStatus = "running"   // 执行中
+	TaskStatusSucceeded TaskStatus = "succeeded" // 执行成功
+	TaskStatusFailed    TaskStatus = "failed"    // 执行失败
+	TaskStatusCancelled TaskStatus = "cancelled" // 已取消
+)
+
+// TaskStatusMap ...
+var TaskStatusMap = map[TaskStatus]string{
+	TaskStatusPending:   "排队中",
+	TaskStatusRunning:   "执行中",
+	TaskStatusSucceeded: "执行成功",
+	TaskStatusFailed:    "执行失败",
+	TaskStatusCancelled: "已取消",
+}
+
+// GroupRolloutStatus 网关组分批发布状态
+type GroupRolloutStatus string
+
+// GroupRolloutStatusPending ...
+const (
+	GroupRolloutStatusPending     GroupRolloutStatus = "pending"      // 待执行
+	GroupRolloutStatusRunning     GroupRolloutStatus = "running"      // 发布中
+	GroupRolloutStatusSoaking     GroupRolloutStatus = "soaking"      // 金丝雀观察中
+	GroupRolloutStatusSucceeded   GroupRolloutStatus = "succeeded"    // 发布成功
+	GroupRolloutStatusRollingBack GroupRolloutStatus = "rolling_back" // 回滚中
+	GroupRolloutStatusRolledBack  GroupRolloutStatus = "rolled_back"  // 已回滚
+	GroupRolloutStatusFailed      GroupRolloutStatus = "failed"       // 失败（回滚未完成）
+	GroupRolloutStatusCancelled   GroupRolloutStatus = "cancelled"    // 已取消
+)
+
+// GroupRolloutStatusMap ...
+var GroupRolloutStatusMap = map[GroupRolloutStatus]string{
+	GroupRolloutStatusPending:     "待执行",
+	GroupRolloutStatusRunning:     "发布中",
+	GroupRolloutStatusSoaking:     "金丝雀观察中",
+	GroupRolloutStatusSucceeded:   "发布成功",
+	GroupRolloutStatusRollingBack: "回滚中",
+	GroupRolloutStatusRolledBack:  "已回滚",
+	GroupRolloutStatusFailed:      "失败",
+	GroupRolloutStatusCancelled:   "已取消",
+}
+
+// GroupRolloutStageStatus 网关组分批发布中单个网关的发布状态
+type GroupRolloutStageStatus string
+
+// GroupRolloutStageStatusPending ...
+const (
+	GroupRolloutStageStatusPending        GroupRolloutStageStatus = "pending"         // 待发布
+	GroupRolloutStageStatusRunning        GroupRolloutStageStatus = "running"         // 发布中
+	GroupRolloutStageStatusSucceeded      GroupRolloutStageStatus = "succeeded"       // 发布成功
+	GroupRolloutStageStatusFailed         GroupRolloutStageStatus = "failed"          // 发布或健康检查失败
+	GroupRolloutStageStatusRolledBack     GroupRolloutStageStatus = "rolled_back"     // 已回滚
+	GroupRolloutStageStatusRollbackFailed GroupRolloutStageStatus = "rollback_failed" // 回滚失败
+	GroupRolloutStageStatusSkipped        GroupRolloutStageStatus = "skipped"         // 未执行
+)
+
+// GroupRolloutStageStatusMap ...
+var GroupRolloutStageStatusMap = map[GroupRolloutStageStatus]string{
+	GroupRolloutStageStatusPending:        "待发布",
+	GroupRolloutStageStatusRunning:        "发布中",
+	GroupRolloutStageStatusSucceeded:      "发布成功",
+	GroupRolloutStageStatusFailed:         "发布失败",
+	GroupRolloutStageStatusRolledBack:     "已回滚",
+	GroupRolloutStageStatusRollbackFailed: "回滚失败",
+	GroupRolloutStageStatusSkipped:        "未执行",
+}
+
+// PromotionAction 跨网关资源晋级时目标网关中资源的变更类型
+type PromotionAction string
+
+// PromotionActionCreate ...
+const (
+	PromotionActionCreate    PromotionAction = "create"    // 新增
+	PromotionActionUpdate    PromotionAction = "update"    // 更新
+	PromotionActionUnchanged PromotionAction = "unchanged" // 无变化
+	PromotionActionConflict  PromotionAction = "conflict"  // 与目标网关中的其他资源重名
+)
+
+// PromotionActionMap ...
+var PromotionActionMap = map[PromotionAction]string{
+	PromotionActionCreate:    "新增",
+	PromotionActionUpdate:    "更新",
+	PromotionActionUnchanged: "无变化",
+	PromotionActionConflict:  "重名冲突",
+}
+
+// ExportState 导出编辑区资源时的资源状态
+type ExportState string
+
+// ExportStateDraft ...
+const (
+	ExportStateDraft     ExportState = "draft"     // 编辑区当前的配置，包含未发布的变更
+	ExportStatePublished ExportState = "published" // 已发布的配置
+)
+
+// ExportStateMap ...
+var ExportStateMap = map[ExportState]string{
+	ExportStateDraft:     "草稿",
+	ExportStatePublished: "已发布",
+}
+
+// ExportFormat 导出文件格式
+type ExportFormat string
+
+// ExportFormatJSON ...
+const (
+	ExportFormatJSON       ExportFormat = "json"       // 与 etcd 资源导出相同的 json 格式，可再次导入
+	ExportFormatStandalone ExportFormat = "standalone" // APISIX standalone 模式的 apisix.yaml
+)
+
+// ExportFormatMap ...
+var ExportFormatMap = map[ExportFormat]string{
+	ExportFormatJSON:       "JSON",
+	ExportFormatStandalone: "APISIX standalone (apisix.yaml)",
+}
+
+// ImportFormat 上传导入文件的格式
+type ImportFormat string
+
+// ImportFormatJSON ...
+const (
+	ImportFormatJSON    ImportFormat = "json"    // 资源导出的 json 格式
+	ImportFormatOpenAPI ImportFormat = "openapi" // OpenAPI 3.x / Swagger 2.0 文档 (json/yaml)
+)
+
+// ImportFormatMap ...
+var ImportFormatMap = map[ImportFormat]string{
+	ImportFormatJSON:    "JSON",
+	ImportFormatOpenAPI: "OpenAPI / Swagger",
+}
+
+// OpenAPIFormat 生成的 OpenAPI 文档格式
+type OpenAPIFormat string
+
+// OpenAPIFormatJSON ...
+const (
+	OpenAPIFormatJSON OpenAPIFormat = "json"
+	OpenAPIFormatYAML OpenAPIFormat = "yaml"
+)
+
+// OpenAPIFormatMap ...
+var OpenAPIFormatMap = map[OpenAPIFormat]string{
+	OpenAPIFormatJSON: "JSON",
+	OpenAPIFormatYAML: "YAML",
+}
+
+// ApplyAction 声明式 apply 时编辑区中资源的变更类型
+type ApplyAction string
+
+// ApplyActionCreate ...
+const (
+	ApplyActionCreate    ApplyAction = "create"    // 新增
+	ApplyActionUpdate    ApplyAction = "update"    // 更新
+	ApplyActionUnchanged ApplyAction = "unchanged" // 无变化
+	ApplyActionDelete    ApplyAction = "delete"    // 删除 (prune)
+	ApplyActionConflict  ApplyAction = "conflict"  // 与编辑区中的其他资源冲突
+)
+
+// ApplyActionMap ...
+var ApplyActionMap = map[ApplyAction]string{
+	ApplyActionCreate:    "新增",
+	ApplyActionUpdate:    "更新",
+	ApplyActionUnchanged: "无变化",
+	ApplyActionDelete:    "删除",
+	ApplyActionConflict:  "冲突",
+}
+
+// ApplyOwnerLabelKey 声明式 apply 写入资源的归属 label，用于区分 Git 管理与页面管理的资源
+const ApplyOwnerLabelKey = "managed-by"
+
+// ApplyDefaultOwner 未指定归属时使用的 label 值
+const ApplyDefaultOwner = "gitops"
+
+// WebhookEvent 网关 webhook 订阅的事件类型
+type WebhookEvent string
+
+// WebhookEventResourceCreated ...
+const (
+	WebhookEventResourceCreated  WebhookEvent = "resource.created"   // 编辑区资源新增
+	WebhookEventResourceUpdated  WebhookEvent = "resource.updated"   // 编辑区资源更新
+	WebhookEventResourceDeleted  WebhookEvent = "resource.deleted"   // 编辑区资源删除
+	WebhookEventPublishSucceeded WebhookEvent = "publish.succeeded"  // 发布成功
+	WebhookEventPublishFailed    WebhookEvent = "publish.failed"     // 发布失败
+	WebhookEventSyncNewResources WebhookEvent = "sync.new_resources" // 同步发现 etcd 中新增的资源
+	WebhookEventConflictDetected WebhookEvent = "conflict.detected"  // 检测到 etcd 中的配置漂移
+	WebhookEventTokenCreated     WebhookEvent = "token.created"      // 创建 MCP 访问令牌
+	WebhookEventSSLExpiring      WebhookEvent = "ssl.expiring"       // 证书即将过期或已过期
+)
+
+// WebhookEventMap ...
+var WebhookEventMap = map[WebhookEvent]string{
+	WebhookEventResourceCreated:  "资源新增",
+	WebhookEventResourceUpdated:  "资源更新",
+	WebhookEventResourceDeleted:  "资源删除",
+	WebhookEventPublishSucceeded: "发布成功",
+	WebhookEventPublishFailed:    "发布失败",
+	WebhookEventSyncNewResources: "同步发现新资源",
+	WebhookEventConflictDetected: "检测到配置冲突",
+	WebhookEventTokenCreated:     "创建访问令牌",
+	WebhookEventSSLExpiring:      "证书即将过期",
+}
+
+// WebhookDeliveryStatus webhook 投递状态
+type WebhookDeliveryStatus string
+
+// WebhookDeliveryStatusPending ...
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"   // 待投递（含等待重试）
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded" // 投递成功
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"    // 重试次数用尽
+)
+
+// WebhookDeliveryStatusMap ...
+var WebhookDeliveryStatusMap = map[WebhookDeliveryStatus]string{
+	WebhookDeliveryStatusPending:   "待投递",
+	WebhookDeliveryStatusSucceeded: "投递成功",
+	WebhookDeliveryStatusFailed:    "投递失败",
+}
+
+// DataPlaneWarning 数据面实例告警类型
+type DataPlaneWarning string
+
+// DataPlaneWarningStale ...
+const (
+	DataPlaneWarningStale           DataPlaneWarning = "stale"            // 心跳超时或已下线
+	DataPlaneWarningVersionMismatch DataPlaneWarning = "version_mismatch" // 实例版本与网关配置的 APISIX 版本不一致
+	DataPlaneWarningRevisionLag     DataPlaneWarning = "revision_lag"     // 最近一次上报早于最新发布，无法确认已加载最新配置（仅定期上报的 APISIX 2.x）
+)
+
+// DataPlaneWarningMap ...
+var DataPlaneWarningMap = map[DataPlaneWarning]string{
+	DataPlaneWarningStale:           "实例失联",
+	DataPlaneWarningVersionMismatch: "版本不一致",
+	DataPlaneWarningRevisionLag:     "配置版本落后",
+}
+
+// PublishJournalStatus 分批发布日志状态
+type PublishJournalStatus string
+
+// PublishJournalStatusRunning ...
+const (
+	PublishJournalStatusRunning    PublishJournalStatus = "running"     // 写入中，进程退出时停留在此状态
+	PublishJournalStatusSucceeded  PublishJournalStatus = "succeeded"   // 全部写入成功
+	PublishJournalStatusRolledBack PublishJournalStatus = "rolled_back" // 写入失败，已回滚
+	PublishJournalStatusFailed     PublishJournalStatus = "failed"      // 写入失败，回滚未完成
+)
+
+// PublishJournalStatusMap ...
+var PublishJournalStatusMap = map[PublishJournalStatus]string{
+	PublishJournalStatusRunning:    "写入中",
+	PublishJournalStatusSucceeded:  "成功",
+	PublishJournalStatusRolledBack: "已回滚",
+	PublishJournalStatusFailed:     "回滚失败",
+}
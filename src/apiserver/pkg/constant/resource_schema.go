@@ -61,12 +61,12 @@ func ResourceSupportsNameField(resourceType APISIXResource) bool {
 // APISIX 3.11:
 //   - route, service, upstream, plugin_config: have "name"
 //   - consumer_group, stream_route, proto: NO "name"
-//   - global_rule, ssl: NO "name"
+//   - global_rule, ssl, credential, secret: NO "name"
 //
 // APISIX 3.13/3.17:
 //   - route, service, upstream, plugin_config: have "name"
 //   - consumer_group, stream_route, proto: have "name" (ADDED in 3.13)
-//   - global_rule, ssl: NO "name"
+//   - global_rule, ssl, credential, secret: NO "name"
 func ResourceSupportsNameFieldForVersion(resourceType APISIXResource, version APISIXVersion) bool {
 	// Resources that support name in all versions
 	switch resourceType {
@@ -75,7 +75,7 @@ func ResourceSupportsNameFieldForVersion(resourceType APISIXResource, version AP
 	case ConsumerGroup, StreamRoute, Proto:
 		// Added in 3.13; older schemas do not expose name.
 		return version == APISIXVersion313 || version == APISIXVersion317
-	case GlobalRule, SSL:
+	case GlobalRule, SSL, Credential, Secret:
 		// Never supported
 		return false
 	default:
@@ -83,6 +83,16 @@ func ResourceSupportsNameFieldForVersion(resourceType APISIXResource, version AP
 	}
 }
 
+// ResourceSupportedForVersion checks if a resource type exists in the specified APISIX version.
+//
+// credential was introduced in APISIX 3.11, older versions (3.2/3.3) have no such resource.
+func ResourceSupportedForVersion(resourceType APISIXResource, version APISIXVersion) bool {
+	if resourceType == Credential {
+		return version != APISIXVersion32 && version != APISIXVersion33
+	}
+	return true
+}
+
 // ResourceSupportsIDInConfig checks if a resource type should have "id" in its APISIX config.
 // Note: All resources use ID internally for database and etcd key, but not all include it in the JSON config.
 //
@@ -100,6 +110,7 @@ func ResourceSupportsIDInConfig(resourceType APISIXResource) bool {
 // Rules:
 // - "id" should be removed for consumer (uses username as key)
 // - "name" should be removed if not supported in the target APISIX version
+// - "consumer_id" should be removed for credential (the consumer is part of the etcd key)
 func ShouldRemoveFieldBeforeValidationOrPublish(
 	resourceType APISIXResource,
 	fieldName string,
@@ -113,6 +124,8 @@ func ShouldRemoveFieldBeforeValidationOrPublish(
 	case "name":
 		// Remove name only if the schema doesn't support it for this version
 		return !ResourceSupportsNameFieldForVersion(resourceType, version)
+	case "consumer_id":
+		return resourceType == Credential
 	default:
 		return false
 	}
@@ -248,6 +248,23 @@ func TestShouldRemoveFieldBeforePublish(t *testing.T) {
 		expected     bool
 		reason       string
 	}{
+		// consumer_id field removal
+		{
+			name:         "credential consumer_id should be removed",
+			resourceType: constant.Credential,
+			fieldName:    "consumer_id",
+			version:      constant.APISIXVersion313,
+			expected:     true,
+			reason:       "consumer_id is part of the credential etcd key",
+		},
+		{
+			name:         "consumer consumer_id should NOT be removed",
+			resourceType: constant.Consumer,
+			fieldName:    "consumer_id",
+			version:      constant.APISIXVersion313,
+			expected:     false,
+			reason:       "only credential carries consumer_id",
+		},
 		// ID field removal
 		{
 			name:         "consumer id should be removed",
@@ -299,6 +316,14 @@ func TestShouldRemoveFieldBeforePublish(t *testing.T) {
 			expected:     true,
 			reason:       "name never supported for global_rule",
 		},
+		{
+			name:         "secret name should be removed in all versions",
+			resourceType: constant.Secret,
+			fieldName:    "name",
+			version:      constant.APISIXVersion317,
+			expected:     true,
+			reason:       "name never supported for secret",
+		},
 		{
 			name:         "route name should NOT be removed",
 			resourceType: constant.Route,
@@ -682,3 +707,11 @@ func TestResourceSchemaCapabilityConsistency(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceSupportedForVersion(t *testing.T) {
+	assert.True(t, constant.ResourceSupportedForVersion(constant.Route, constant.APISIXVersion32))
+	assert.False(t, constant.ResourceSupportedForVersion(constant.Credential, constant.APISIXVersion32))
+	assert.False(t, constant.ResourceSupportedForVersion(constant.Credential, constant.APISIXVersion33))
+	assert.True(t, constant.ResourceSupportedForVersion(constant.Credential, constant.APISIXVersion311))
+	assert.True(t, constant.ResourceSupportedForVersion(constant.Credential, constant.APISIXVersion317))
+}
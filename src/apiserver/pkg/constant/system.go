@@ -61,6 +61,9 @@ const ResourceTypeKey CtxKey = "resource_type"
 // DbTxKey transaction 在 context 中的 key
 const DbTxKey CtxKey = "db_tx"
 
+// GatewayRoleKey 当前用户在网关中的角色在 context 中的 key
+const GatewayRoleKey CtxKey = "gateway_role"
+
 // SystemConfigUserWhitest system config key
 const (
 	// SystemConfigUserWhitest user whitelist
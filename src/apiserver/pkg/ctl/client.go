@@ -0,0 +1,209 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package ctl 实现 `apiserver ctl` 客户端子命令，通过 openapi 访问远程控制面
+package ctl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	resty "github.com/go-resty/resty/v2"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/common"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/open/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+)
+
+// openAPIPrefix openapi 的路由前缀
+const openAPIPrefix = "/api/v1/open/gateways/"
+
+// APIError openapi 返回的错误
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Data       json.RawMessage
+}
+
+// Error ...
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("request failed with status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, e.Message)
+}
+
+// IsConflict 是否为 apply 冲突
+func (e *APIError) IsConflict() bool {
+	return e.StatusCode == http.StatusConflict
+}
+
+// Resource openapi 返回的资源配置
+//
+// 不直接使用 serializer.ResourceGetResponse：其内嵌的 json.RawMessage 会接管整个对象的反序列化
+type Resource struct {
+	ID     string          `json:"id"`
+	Config json.RawMessage `json:"config"`
+}
+
+// Config 客户端配置
+type Config struct {
+	Server  string        // 控制面地址，如 http://127.0.0.1:8080
+	Token   string        // 网关 token
+	Gateway string        // 网关名称
+	Timeout time.Duration // 请求超时时间
+}
+
+// Client openapi 客户端
+type Client struct {
+	client  *resty.Client
+	gateway string
+}
+
+// NewClient 创建 openapi 客户端
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.Server == "" {
+		return nil, errors.New("server is required")
+	}
+	if cfg.Gateway == "" {
+		return nil, errors.New("gateway is required")
+	}
+	client := resty.New().
+		SetLogger(logging.New()).
+		SetBaseURL(strings.TrimSuffix(cfg.Server, "/")).
+		SetHeader(constant.OpenAPITokenHeaderKey, cfg.Token).
+		SetHeader("Content-Type", "application/json")
+	if cfg.Timeout > 0 {
+		client.SetTimeout(cfg.Timeout)
+	}
+	return &Client{client: client, gateway: cfg.Gateway}, nil
+}
+
+// path 拼接网关下的 openapi 路径
+func (c *Client) path(elems ...string) string {
+	escaped := make([]string, 0, len(elems)+1)
+	escaped = append(escaped, url.PathEscape(c.gateway))
+	for _, elem := range elems {
+		escaped = append(escaped, url.PathEscape(elem))
+	}
+	return openAPIPrefix + strings.Join(escaped, "/") + "/"
+}
+
+// do 发送请求并解析 {"data": ...} 响应
+func (c *Client) do(ctx context.Context, method, path string, body any, result any) error {
+	request := c.client.R().SetContext(ctx)
+	if body != nil {
+		request.SetBody(body)
+	}
+	resp, err := request.Execute(method, path)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		apiErr := &APIError{StatusCode: resp.StatusCode()}
+		var errResp struct {
+			Error struct {
+				Code    string          `json:"code"`
+				Message string          `json:"message"`
+				Data    json.RawMessage `json:"data"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(resp.Body(), &errResp) == nil {
+			apiErr.Code = errResp.Error.Code
+			apiErr.Message = errResp.Error.Message
+			apiErr.Data = errResp.Error.Data
+		}
+		return apiErr
+	}
+	if result == nil || len(resp.Body()) == 0 {
+		return nil
+	}
+	data := struct {
+		Data any `json:"data"`
+	}{Data: result}
+	if err := json.Unmarshal(resp.Body(), &data); err != nil {
+		return fmt.Errorf("decode response of %s %s failed: %w", method, path, err)
+	}
+	return nil
+}
+
+// ListResources 获取网关下某类资源的全部配置
+func (c *Client) ListResources(
+	ctx context.Context,
+	resourcePath constant.ResourcePath,
+) ([]*Resource, error) {
+	var resources []*Resource
+	if err := c.do(ctx, http.MethodGet, c.path("resources", resourcePath.String()), nil, &resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// GetResource 获取单个资源配置
+func (c *Client) GetResource(
+	ctx context.Context,
+	resourcePath constant.ResourcePath,
+	id string,
+) (*Resource, error) {
+	var resource Resource
+	if err := c.do(ctx, http.MethodGet, c.path("resources", resourcePath.String(), id), nil, &resource); err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Apply 提交声明式 apply，dry run 时只返回计划；冲突时返回计划及 *APIError
+func (c *Client) Apply(
+	ctx context.Context,
+	req *serializer.ResourceApplyRequest,
+) (*serializer.ResourceApplyResponse, error) {
+	var resp serializer.ResourceApplyResponse
+	err := c.do(ctx, http.MethodPost, c.path("resources", "-", "apply"), req, &resp)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.IsConflict() && len(apiErr.Data) > 0 {
+		var plan dto.ApplyPlan
+		if json.Unmarshal(apiErr.Data, &plan) == nil {
+			return &serializer.ResourceApplyResponse{Plan: &plan}, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Publish 一键发布网关，开启发布审批时返回发布变更请求
+func (c *Client) Publish(
+	ctx context.Context,
+	req *serializer.GatewayPublishRequest,
+) (*common.ChangeRequestOutputInfo, error) {
+	var changeRequest *common.ChangeRequestOutputInfo
+	if err := c.do(ctx, http.MethodPost, c.path("publish"), req, &changeRequest); err != nil {
+		return nil, err
+	}
+	return changeRequest, nil
+}
@@ -0,0 +1,152 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package ctl
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/apis/open/serializer"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client, err := NewClient(Config{Server: server.URL + "/", Token: "token", Gateway: "gw"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestNewClient(t *testing.T) {
+	_, err := NewClient(Config{Gateway: "gw"})
+	assert.Error(t, err)
+	_, err = NewClient(Config{Server: "http://127.0.0.1"})
+	assert.Error(t, err)
+}
+
+func TestClientListResources(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/open/gateways/gw/resources/routes/", r.URL.Path)
+		assert.Equal(t, "token", r.Header.Get(constant.OpenAPITokenHeaderKey))
+		_, _ = w.Write([]byte(`{"data":[{"id":"r1","config":{"uris":["/a"]}}]}`))
+	})
+
+	resources, err := client.ListResources(context.Background(), constant.Routes)
+	assert.NoError(t, err)
+	if assert.Len(t, resources, 1) {
+		assert.Equal(t, "r1", resources[0].ID)
+		assert.JSONEq(t, `{"uris":["/a"]}`, string(resources[0].Config))
+	}
+}
+
+func TestClientApply(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		wantConflict bool
+		wantErr      bool
+	}{
+		{
+			name:   "dry run",
+			status: http.StatusOK,
+			body:   `{"data":{"plan":{"created_count":1,"items":[{"resource_id":"r1","action":"create"}]}}}`,
+		},
+		{
+			name:         "conflict",
+			status:       http.StatusConflict,
+			body:         `{"error":{"code":"Conflict","message":"conflict","data":{"conflict_count":1}}}`,
+			wantConflict: true,
+			wantErr:      true,
+		},
+		{
+			name:    "bad request",
+			status:  http.StatusBadRequest,
+			body:    `{"error":{"code":"BadRequest","message":"invalid document"}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/api/v1/open/gateways/gw/resources/-/apply/", r.URL.Path)
+				var req serializer.ResourceApplyRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.True(t, req.DryRun)
+				assert.Equal(t, "ci", req.Owner)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+
+			resp, err := client.Apply(context.Background(), &serializer.ResourceApplyRequest{
+				ApplyRequest: dto.ApplyRequest{Owner: "ci"},
+				DryRun:       true,
+			})
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, resp.Plan.CreatedCount)
+				return
+			}
+			apiErr, ok := err.(*APIError)
+			if !assert.True(t, ok) {
+				return
+			}
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, tt.wantConflict, apiErr.IsConflict())
+			if tt.wantConflict {
+				assert.Equal(t, 1, resp.Plan.ConflictCount)
+			} else {
+				assert.Nil(t, resp)
+				assert.Contains(t, err.Error(), "invalid document")
+			}
+		})
+	}
+}
+
+func TestClientPublish(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/open/gateways/gw/publish/", r.URL.Path)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`null`))
+	})
+	changeRequest, err := client.Publish(context.Background(), &serializer.GatewayPublishRequest{})
+	assert.NoError(t, err)
+	assert.Nil(t, changeRequest)
+
+	client = newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":7,"status":"pending"}}`))
+	})
+	changeRequest, err = client.Publish(context.Background(), &serializer.GatewayPublishRequest{})
+	assert.NoError(t, err)
+	if assert.NotNil(t, changeRequest) {
+		assert.Equal(t, int64(7), changeRequest.ID)
+	}
+}
@@ -0,0 +1,153 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package ctl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+)
+
+// 退出码，便于 CI 根据结果判断
+const (
+	ExitOK       = 0 // 成功，或 diff 时没有变更
+	ExitError    = 1 // 请求或参数错误
+	ExitChanges  = 2 // diff 时存在变更
+	ExitConflict = 3 // 存在冲突，未写入编辑区
+	// 网关开启了发布审批，发布只创建了发布变更请求，审批通过后才会执行
+	ExitPendingApproval = 4
+)
+
+var planActionSymbols = map[constant.ApplyAction]string{
+	constant.ApplyActionCreate:    "+",
+	constant.ApplyActionUpdate:    "~",
+	constant.ApplyActionDelete:    "-",
+	constant.ApplyActionConflict:  "!",
+	constant.ApplyActionUnchanged: "=",
+}
+
+// PlanExitCode 根据计划返回退出码，detailed 时有变更返回 ExitChanges
+func PlanExitCode(plan *dto.ApplyPlan, detailed bool) int {
+	switch {
+	case plan.ConflictCount > 0:
+		return ExitConflict
+	case detailed && plan.HasChanges():
+		return ExitChanges
+	default:
+		return ExitOK
+	}
+}
+
+// WritePlan 输出可读的 apply 计划：每个变更资源的配置 diff 及汇总
+func WritePlan(w io.Writer, plan *dto.ApplyPlan, verbose bool) error {
+	for _, item := range plan.Items {
+		if item.Action == constant.ApplyActionUnchanged && !verbose {
+			continue
+		}
+		title := fmt.Sprintf("%s %s %s", planActionSymbols[item.Action], item.ResourceType, item.ResourceID)
+		if item.Name != "" && item.Name != item.ResourceID {
+			title += fmt.Sprintf(" (%s)", item.Name)
+		}
+		if _, err := fmt.Fprintln(w, title); err != nil {
+			return err
+		}
+		if item.Reason != "" {
+			if _, err := fmt.Fprintf(w, "    %s\n", item.Reason); err != nil {
+				return err
+			}
+		}
+		if item.Action == constant.ApplyActionUnchanged || item.Action == constant.ApplyActionConflict {
+			continue
+		}
+		diff, err := configDiff(item)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, diff); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "Plan: %d to create, %d to update, %d to delete, %d unchanged, %d conflicts.\n",
+		plan.CreatedCount, plan.UpdatedCount, plan.DeletedCount, plan.UnchangedCount, plan.ConflictCount)
+	return err
+}
+
+// configDiff 生成资源配置的 unified diff
+func configDiff(item dto.ApplyItem) (string, error) {
+	before, err := prettyConfig(item.Before)
+	if err != nil {
+		return "", err
+	}
+	after, err := prettyConfig(item.After)
+	if err != nil {
+		return "", err
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(before),
+		B:        splitLines(after),
+		FromFile: "current",
+		ToFile:   "desired",
+		Context:  3,
+	})
+	if err != nil {
+		return "", err
+	}
+	lines := strings.SplitAfter(diff, "\n")
+	var buf strings.Builder
+	for _, line := range lines {
+		if line != "" {
+			buf.WriteString("    " + line)
+		}
+	}
+	return buf.String(), nil
+}
+
+// splitLines 按行切分并保留换行符，空字符串返回空切片
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// prettyConfig 按 key 排序格式化配置，避免字段顺序不同产生的 diff；空配置返回空字符串
+func prettyConfig(config json.RawMessage) (string, error) {
+	if len(config) == 0 || string(config) == "null" {
+		return "", nil
+	}
+	var obj any
+	if err := json.Unmarshal(config, &obj); err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
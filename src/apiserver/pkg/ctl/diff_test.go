@@ -0,0 +1,87 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package ctl
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+)
+
+func TestWritePlan(t *testing.T) {
+	plan := &dto.ApplyPlan{
+		CreatedCount:   1,
+		UpdatedCount:   1,
+		UnchangedCount: 1,
+		ConflictCount:  1,
+		Items: []dto.ApplyItem{
+			{
+				ResourceType: constant.Route,
+				ResourceID:   "r1",
+				Name:         "route-1",
+				Action:       constant.ApplyActionCreate,
+				After:        json.RawMessage(`{"uris":["/a"]}`),
+			},
+			{
+				ResourceType: constant.Upstream,
+				ResourceID:   "u1",
+				Action:       constant.ApplyActionUpdate,
+				Before:       json.RawMessage(`{"type":"roundrobin","nodes":[]}`),
+				After:        json.RawMessage(`{"nodes":[],"type":"chash"}`),
+			},
+			{ResourceType: constant.Service, ResourceID: "s1", Action: constant.ApplyActionUnchanged},
+			{
+				ResourceType: constant.Route,
+				ResourceID:   "r2",
+				Action:       constant.ApplyActionConflict,
+				Reason:       "owned by others",
+			},
+		},
+	}
+
+	var buf strings.Builder
+	assert.NoError(t, WritePlan(&buf, plan, false))
+	out := buf.String()
+	assert.Contains(t, out, "+ route r1 (route-1)\n")
+	assert.Contains(t, out, "    @@ -0,0 +1,5 @@\n    +{\n    +  \"uris\": [\n")
+	assert.Contains(t, out, "    +}\n~ upstream u1\n")
+	assert.Contains(t, out, "-  \"type\": \"roundrobin\"")
+	assert.Contains(t, out, "+  \"type\": \"chash\"")
+	// 字段顺序不同不产生 diff
+	assert.NotContains(t, out, "-  \"nodes\"")
+	assert.NotContains(t, out, "service s1")
+	assert.Contains(t, out, "! route r2\n    owned by others\n")
+	assert.Contains(t, out, "Plan: 1 to create, 1 to update, 0 to delete, 1 unchanged, 1 conflicts.")
+
+	buf.Reset()
+	assert.NoError(t, WritePlan(&buf, plan, true))
+	assert.Contains(t, buf.String(), "= service s1\n")
+}
+
+func TestPlanExitCode(t *testing.T) {
+	assert.Equal(t, ExitOK, PlanExitCode(&dto.ApplyPlan{UnchangedCount: 1}, true))
+	assert.Equal(t, ExitChanges, PlanExitCode(&dto.ApplyPlan{DeletedCount: 1}, true))
+	assert.Equal(t, ExitOK, PlanExitCode(&dto.ApplyPlan{DeletedCount: 1}, false))
+	assert.Equal(t, ExitConflict, PlanExitCode(&dto.ApplyPlan{CreatedCount: 1, ConflictCount: 1}, true))
+}
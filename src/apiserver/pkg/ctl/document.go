@@ -0,0 +1,148 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package ctl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/dto"
+)
+
+// 输出格式
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// Document 期望状态文件，格式与 apply 请求的 resources 相同
+type Document struct {
+	Resources map[constant.APISIXResource][]*dto.ImportResourceInfo `json:"resources"`
+}
+
+// FormatOfFile 根据文件后缀推断格式，默认为 yaml
+func FormatOfFile(path string) string {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return FormatJSON
+	}
+	return FormatYAML
+}
+
+// ReadDocument 读取 yaml/json 格式的期望状态文件，path 为 - 时从标准输入读取
+func ReadDocument(path string, stdin io.Reader) (*Document, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// yaml 是 json 的超集，统一转换为 json 解析
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s failed: %w", path, err)
+	}
+	var doc Document
+	if err := json.Unmarshal(jsonData, &doc); err != nil {
+		return nil, fmt.Errorf("parse %s failed: %w", path, err)
+	}
+	if doc.Resources == nil {
+		return nil, fmt.Errorf("parse %s failed: resources is required", path)
+	}
+	return &doc, nil
+}
+
+// Marshal 按格式序列化对象
+func Marshal(obj any, format string) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		data, err := json.MarshalIndent(obj, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	case FormatYAML:
+		return yaml.Marshal(obj)
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// Export 拉取网关编辑区的资源，生成可直接用于 apply 的期望状态文件
+func Export(ctx context.Context, client *Client, resourcePaths []constant.ResourcePath) (*Document, error) {
+	doc := &Document{Resources: map[constant.APISIXResource][]*dto.ImportResourceInfo{}}
+	for _, resourcePath := range resourcePaths {
+		resourceType, ok := constant.ResourcePathToTypeMap[resourcePath]
+		if !ok {
+			return nil, fmt.Errorf("invalid resource type: %s", resourcePath)
+		}
+		resources, err := client.ListResources(ctx, resourcePath)
+		if err != nil {
+			return nil, fmt.Errorf("list %s failed: %w", resourcePath, err)
+		}
+		if len(resources) == 0 {
+			continue
+		}
+		sort.Slice(resources, func(i, j int) bool { return resources[i].ID < resources[j].ID })
+		infos := make([]*dto.ImportResourceInfo, 0, len(resources))
+		for _, resource := range resources {
+			infos = append(infos, &dto.ImportResourceInfo{
+				ResourceID: resource.ID,
+				Config:     resource.Config,
+			})
+		}
+		doc.Resources[resourceType] = infos
+	}
+	return doc, nil
+}
+
+// ParseResourcePaths 解析逗号分隔的资源类型，为空时返回全部资源类型
+func ParseResourcePaths(value string) ([]constant.ResourcePath, error) {
+	if value == "" {
+		resourcePaths := make([]constant.ResourcePath, 0, len(constant.ResourcePathToTypeMap))
+		for resourcePath := range constant.ResourcePathToTypeMap {
+			resourcePaths = append(resourcePaths, resourcePath)
+		}
+		sort.Slice(resourcePaths, func(i, j int) bool { return resourcePaths[i] < resourcePaths[j] })
+		return resourcePaths, nil
+	}
+	var resourcePaths []constant.ResourcePath
+	for _, item := range strings.Split(value, ",") {
+		resourcePath := constant.ResourcePath(strings.TrimSpace(item))
+		if _, ok := constant.ResourcePathToTypeMap[resourcePath]; !ok {
+			return nil, fmt.Errorf("invalid resource type: %s", item)
+		}
+		resourcePaths = append(resourcePaths, resourcePath)
+	}
+	return resourcePaths, nil
+}
@@ -0,0 +1,104 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package ctl
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+func TestReadDocument(t *testing.T) {
+	yamlDoc := `
+resources:
+  route:
+    - resource_id: r1
+      config:
+        uris: ["/a"]
+        upstream_id: u1
+`
+	doc, err := ReadDocument("-", strings.NewReader(yamlDoc))
+	assert.NoError(t, err)
+	if assert.Len(t, doc.Resources[constant.Route], 1) {
+		assert.Equal(t, "r1", doc.Resources[constant.Route][0].ResourceID)
+		assert.JSONEq(t, `{"uris":["/a"],"upstream_id":"u1"}`, string(doc.Resources[constant.Route][0].Config))
+	}
+
+	file := filepath.Join(t.TempDir(), "gateway.json")
+	assert.NoError(t, os.WriteFile(file, []byte(`{"resources":{"upstream":[]}}`), 0o644))
+	doc, err = ReadDocument(file, nil)
+	assert.NoError(t, err)
+	assert.Contains(t, doc.Resources, constant.Upstream)
+
+	_, err = ReadDocument("-", strings.NewReader(`routes: []`))
+	assert.ErrorContains(t, err, "resources is required")
+	_, err = ReadDocument("-", strings.NewReader(`resources: [`))
+	assert.Error(t, err)
+}
+
+func TestFormatOfFile(t *testing.T) {
+	assert.Equal(t, FormatJSON, FormatOfFile("a.JSON"))
+	assert.Equal(t, FormatYAML, FormatOfFile("a.yml"))
+	assert.Equal(t, FormatYAML, FormatOfFile(""))
+}
+
+func TestParseResourcePaths(t *testing.T) {
+	resourcePaths, err := ParseResourcePaths("")
+	assert.NoError(t, err)
+	assert.Len(t, resourcePaths, len(constant.ResourcePathToTypeMap))
+
+	resourcePaths, err = ParseResourcePaths("routes, upstreams")
+	assert.NoError(t, err)
+	assert.Equal(t, []constant.ResourcePath{constant.Routes, constant.Upstreams}, resourcePaths)
+
+	_, err = ParseResourcePaths("routes,unknown")
+	assert.Error(t, err)
+}
+
+func TestExport(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/open/gateways/gw/resources/routes/":
+			_, _ = w.Write([]byte(`{"data":[{"id":"r2","config":{"uris":["/b"]}},` +
+				`{"id":"r1","config":{"uris":["/a"]}}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":null}`))
+		}
+	})
+
+	doc, err := Export(context.Background(), client, []constant.ResourcePath{constant.Routes, constant.Upstreams})
+	assert.NoError(t, err)
+	assert.NotContains(t, doc.Resources, constant.Upstream)
+	if assert.Len(t, doc.Resources[constant.Route], 2) {
+		assert.Equal(t, "r1", doc.Resources[constant.Route][0].ResourceID)
+	}
+
+	data, err := Marshal(doc, FormatYAML)
+	assert.NoError(t, err)
+	exported, err := ReadDocument("-", strings.NewReader(string(data)))
+	assert.NoError(t, err)
+	assert.Equal(t, doc, exported)
+}
@@ -181,6 +181,16 @@ type ConsumerGroup struct {
 	UpdateTime int64             `json:"update_time,omitempty"`
 }
 
+// Credential ...
+type Credential struct {
+	ID         any               `json:"id"`
+	Desc       string            `json:"desc,omitempty"`
+	Plugins    map[string]any    `json:"plugins,omitempty"`
+	Labels     map[string]string `json:"labels,omitempty"`
+	CreateTime int64             `json:"create_time,omitempty"`
+	UpdateTime int64             `json:"update_time,omitempty"`
+}
+
 // Service ...
 type Service struct {
 	BaseInfo
@@ -0,0 +1,87 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
+)
+
+// ApplyRequest 声明式 apply 请求：Resources 为网关编辑区的完整期望状态，格式与资源导出相同
+//
+// 写入的资源带有归属 label (managed-by: Owner)，已存在但不属于 Owner 的资源需指定 Adopt 才能接管；
+// Prune 时删除属于 Owner 但未在 Resources 中声明的资源
+type ApplyRequest struct {
+	Resources map[constant.APISIXResource][]*ImportResourceInfo `json:"resources" binding:"required"`
+	Owner     string                                            `json:"owner"` // 归属 label 的值，默认为 gitops
+	Prune     bool                                              `json:"prune"` // 是否删除未声明的归属资源
+	Adopt     bool                                              `json:"adopt"` // 是否接管不属于 Owner 的已有资源
+}
+
+// ApplyItem apply 计划中的资源及其与编辑区的差异
+type ApplyItem struct {
+	ResourceType constant.APISIXResource `json:"resource_type"`
+	ResourceID   string                  `json:"resource_id"`
+	Name         string                  `json:"name"`
+	Action       constant.ApplyAction    `json:"action"`
+	Reason       string                  `json:"reason,omitempty"`
+	Before       json.RawMessage         `json:"before" swaggertype:"object"` // 编辑区中的配置
+	After        json.RawMessage         `json:"after" swaggertype:"object"`  // apply 后的配置
+}
+
+// ApplyPlan 声明式 apply 计划
+type ApplyPlan struct {
+	Owner          string      `json:"owner"`
+	CreatedCount   int         `json:"created_count"`
+	UpdatedCount   int         `json:"updated_count"`
+	UnchangedCount int         `json:"unchanged_count"`
+	DeletedCount   int         `json:"deleted_count"`
+	ConflictCount  int         `json:"conflict_count"`
+	Items          []ApplyItem `json:"items"`
+}
+
+// HasChanges 计划中是否有需要写入编辑区的变更
+func (p *ApplyPlan) HasChanges() bool {
+	return p.CreatedCount+p.UpdatedCount+p.DeletedCount > 0
+}
+
+// ChangedResources 计划中写入编辑区的资源（新增、更新、删除），按资源类型分组
+func (p *ApplyPlan) ChangedResources() map[constant.APISIXResource][]string {
+	resources := make(map[constant.APISIXResource][]string)
+	for _, item := range p.Items {
+		switch item.Action {
+		case constant.ApplyActionCreate, constant.ApplyActionUpdate, constant.ApplyActionDelete:
+			resources[item.ResourceType] = append(resources[item.ResourceType], item.ResourceID)
+		}
+	}
+	return resources
+}
+
+// MaskSensitive 脱敏计划中配置的敏感字段，用于接口返回
+func (p *ApplyPlan) MaskSensitive() {
+	if p == nil {
+		return
+	}
+	for i := range p.Items {
+		p.Items[i].Before = sensitive.Mask(p.Items[i].ResourceType, p.Items[i].Before)
+		p.Items[i].After = sensitive.Mask(p.Items[i].ResourceType, p.Items[i].After)
+	}
+}
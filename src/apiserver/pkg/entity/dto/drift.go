@@ -0,0 +1,49 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// DriftNotificationEventDetected 检测到配置漂移的通知事件
+const DriftNotificationEventDetected = "drift_detected"
+
+// DriftNotification 配置漂移 webhook 通知内容
+type DriftNotification struct {
+	Event       string          `json:"event"`
+	GatewayID   int             `json:"gateway_id"`
+	GatewayName string          `json:"gateway_name"`
+	Drifts      []DriftResource `json:"drifts"`
+}
+
+// DriftResource 单个资源的配置漂移
+type DriftResource struct {
+	ID             int64                   `json:"id"` // 漂移记录 ID
+	ResourceType   constant.APISIXResource `json:"resource_type"`
+	ResourceID     string                  `json:"resource_id"`
+	Name           string                  `json:"name"`
+	Key            string                  `json:"key"` // etcd key（不包含网关前缀）
+	DriftType      constant.DriftType      `json:"drift_type"`
+	ExpectedConfig json.RawMessage         `json:"expected_config" swaggertype:"object"` // 最近一次发布的配置
+	ActualConfig   json.RawMessage         `json:"actual_config" swaggertype:"object"`   // etcd 中的配置
+	DetectedAt     int64                   `json:"detected_at"`
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 // ImportResourceInfo ...
@@ -48,7 +49,42 @@ type ImportUploadInfo struct {
 	Update map[constant.APISIXResource][]*ImportResourceInfo `json:"update,omitempty"`
 }
 
+// EncryptSensitive 加密资源配置中的敏感字段，用于作为后台任务参数持久化
+func (i *ImportUploadInfo) EncryptSensitive() error {
+	return i.convertConfigs(func(resource *ImportResourceInfo) ([]byte, error) {
+		return sensitive.Encrypt(resource.ResourceType, resource.Config)
+	})
+}
+
+// DecryptSensitive 解密资源配置中的敏感字段
+func (i *ImportUploadInfo) DecryptSensitive() error {
+	return i.convertConfigs(func(resource *ImportResourceInfo) ([]byte, error) {
+		return sensitive.Decrypt(resource.Config)
+	})
+}
+
+func (i *ImportUploadInfo) convertConfigs(convert func(resource *ImportResourceInfo) ([]byte, error)) error {
+	for _, resources := range []map[constant.APISIXResource][]*ImportResourceInfo{i.Add, i.Update} {
+		for _, items := range resources {
+			for _, resource := range items {
+				config, err := convert(resource)
+				if err != nil {
+					return err
+				}
+				resource.Config = config
+			}
+		}
+	}
+	return nil
+}
+
 // ImportMetadata ...
 type ImportMetadata struct {
 	IgnoreFields map[constant.APISIXResource][]string `json:"ignore_fields"`
 }
+
+// OpenAPIImportOptions OpenAPI 文档转换为路由时的选项
+type OpenAPIImportOptions struct {
+	ServiceID  string `json:"service_id,omitempty"`  // 生成的路由绑定的服务 ID
+	UpstreamID string `json:"upstream_id,omitempty"` // 生成的路由绑定的上游 ID，接口未声明 x-apisix-upstream 时生效
+}
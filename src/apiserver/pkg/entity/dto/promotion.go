@@ -0,0 +1,81 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
+)
+
+// PromotionRequest 跨网关资源晋级请求：从源网关编辑区选择资源，连同依赖资源以草稿写入目标网关
+//
+// ResourceTypes、ResourceIDs、Labels 至少指定一项，同时指定时需全部满足
+type PromotionRequest struct {
+	SourceGatewayID int                       `json:"source_gateway_id" binding:"required"`
+	ResourceTypes   []constant.APISIXResource `json:"resource_types"` // 资源类型，为空表示所有类型
+	ResourceIDs     []string                  `json:"resource_ids"`   // 资源 ID
+	Labels          map[string]string         `json:"labels"`         // 资源需包含所有指定的 label
+	// 变量替换：将配置中的 ${NAME} 替换为对应的值，未指定的变量保持不变
+	Variables map[string]string `json:"variables"`
+	// 按 json 路径覆盖配置，如为目标环境指定不同的上游节点
+	Overrides []PromotionOverride `json:"overrides" binding:"dive"`
+}
+
+// PromotionOverride 按 json 路径覆盖资源配置
+type PromotionOverride struct {
+	ResourceType constant.APISIXResource `json:"resource_type" binding:"required"`
+	ResourceID   string                  `json:"resource_id"`             // 为空表示该类型的所有资源
+	Path         string                  `json:"path" binding:"required"` // json 路径，如 nodes
+	Value        json.RawMessage         `json:"value" binding:"required" swaggertype:"object"`
+}
+
+// PromotionItem 晋级资源及其与目标网关的差异
+type PromotionItem struct {
+	ResourceType constant.APISIXResource  `json:"resource_type"`
+	ResourceID   string                   `json:"resource_id"`
+	Name         string                   `json:"name"`
+	Action       constant.PromotionAction `json:"action"`
+	Dependency   bool                     `json:"dependency"` // 是否作为依赖资源被选中
+	Reason       string                   `json:"reason,omitempty"`
+	Before       json.RawMessage          `json:"before" swaggertype:"object"` // 目标网关中的配置
+	After        json.RawMessage          `json:"after" swaggertype:"object"`  // 晋级后的配置
+}
+
+// PromotionPlan 跨网关资源晋级计划
+type PromotionPlan struct {
+	SourceGatewayID int             `json:"source_gateway_id"`
+	TargetGatewayID int             `json:"target_gateway_id"`
+	CreatedCount    int             `json:"created_count"`
+	UpdatedCount    int             `json:"updated_count"`
+	UnchangedCount  int             `json:"unchanged_count"`
+	ConflictCount   int             `json:"conflict_count"`
+	Items           []PromotionItem `json:"items"`
+}
+
+// MaskSensitive 脱敏计划中配置的敏感字段，用于接口返回
+func (p *PromotionPlan) MaskSensitive() {
+	if p == nil {
+		return
+	}
+	for i := range p.Items {
+		p.Items[i].Before = sensitive.Mask(p.Items[i].ResourceType, p.Items[i].Before)
+		p.Items[i].After = sensitive.Mask(p.Items[i].ResourceType, p.Items[i].After)
+	}
+}
@@ -0,0 +1,46 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// ReleaseResourceDiff 发布版本之间单个资源的差异
+type ReleaseResourceDiff struct {
+	ResourceType constant.APISIXResource `json:"resource_type"`
+	ResourceID   string                  `json:"resource_id"`
+	Name         string                  `json:"name"`
+	Key          string                  `json:"key"`                         // etcd key（不包含网关前缀）
+	Action       constant.OperationType  `json:"action"`                      // 变更类型：create/update/delete
+	Before       json.RawMessage         `json:"before" swaggertype:"object"` // 变更前配置
+	After        json.RawMessage         `json:"after" swaggertype:"object"`  // 变更后配置
+}
+
+// ReleaseVersionDiff 发布版本差异
+type ReleaseVersionDiff struct {
+	BaseVersionID   int64                 `json:"base_version_id"` // 对比基准版本 ID，0 表示当前生效的配置
+	TargetVersionID int64                 `json:"target_version_id"`
+	AddedCount      int                   `json:"added_count"`
+	UpdatedCount    int                   `json:"updated_count"`
+	DeletedCount    int                   `json:"deleted_count"`
+	Changes         []ReleaseResourceDiff `json:"changes"`
+}
@@ -44,6 +44,44 @@ type ResourceChangeDetail struct {
 	UpdatedAt    int64                   `json:"updated_at"`
 }
 
+// EtcdExportOutput etcd 资源导出结果
+type EtcdExportOutput map[constant.APISIXResource][]ResourceInfo
+
+// EditorExportOptions 编辑区资源导出选项
+type EditorExportOptions struct {
+	State         constant.ExportState      `json:"state"`          // 导出草稿或已发布的配置
+	ResourceTypes []constant.APISIXResource `json:"resource_types"` // 资源类型，为空表示所有类型
+	Labels        map[string][]string       `json:"labels"`         // 资源需包含所有指定的 label
+	Plaintext     bool                      `json:"plaintext"`      // 导出明文配置，不脱敏且解密敏感变量
+}
+
+// OpenAPIGenerateOptions 由路由生成 OpenAPI 文档的选项
+type OpenAPIGenerateOptions struct {
+	State     constant.ExportState `json:"state"`      // 使用草稿或已发布的配置
+	ServiceID string               `json:"service_id"` // 仅包含绑定该服务的路由，为空表示所有路由
+	Labels    map[string][]string  `json:"labels"`     // 路由需包含所有指定的 label
+}
+
+// RouteMatchOptions 路由匹配模拟的请求
+type RouteMatchOptions struct {
+	State      constant.ExportState `json:"state"`       // 使用草稿或已发布的配置
+	Method     string               `json:"method"`      // 请求方法
+	Host       string               `json:"host"`        // 请求 host，可包含端口
+	Path       string               `json:"path"`        // 请求路径，可包含 query
+	Headers    map[string]string    `json:"headers"`     // 请求头
+	Query      map[string]string    `json:"query"`       // query 参数，与 path 中的 query 合并
+	RemoteAddr string               `json:"remote_addr"` // 客户端地址
+}
+
+// ResourceInfo ...
+type ResourceInfo struct {
+	ResourceType constant.APISIXResource `json:"resource_type,omitempty"`               // 资源类型
+	ResourceID   string                  `json:"resource_id,omitempty"`                 // 资源ID
+	Name         string                  `json:"name,omitempty"`                        // 资源名称
+	Config       json.RawMessage         `json:"config,omitempty" swaggertype:"object"` // 资源配置
+	Status       constant.UploadStatus   `json:"status,omitempty"`                      // 资源导入状态(add/update)
+}
+
 // ResourceDiffDetailResponse ...
 type ResourceDiffDetailResponse struct {
 	EditorConfig json.RawMessage `json:"editor_config" swaggertype:"object"` // 编辑区配置
@@ -56,6 +94,7 @@ type ResourceAssociateID struct {
 	UpstreamID     string `json:"upstream_id" validate:"upstreamID"`          // 上游服务地址 ID
 	PluginConfigID string `json:"plugin_config_id" validate:"pluginConfigID"` // 插件配置 groupID
 	GroupID        string `json:"group_id" validate:"groupID"`
+	ConsumerID     string `json:"consumer_id" validate:"consumerID"` // 凭证所属消费者 ID
 }
 
 // GetResourceKey 获取资源 key
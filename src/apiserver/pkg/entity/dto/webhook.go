@@ -0,0 +1,65 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dto
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// WebhookPublishEventData 发布成功/失败事件的数据
+type WebhookPublishEventData struct {
+	All       bool                                 `json:"all"`                 // 是否为一键发布
+	Resources map[constant.APISIXResource][]string `json:"resources,omitempty"` // 发布的资源 ID
+	Operator  string                               `json:"operator"`
+	Error     string                               `json:"error,omitempty"` // 发布失败的原因
+}
+
+// WebhookSyncEventData 同步发现新资源事件的数据
+type WebhookSyncEventData struct {
+	Count     int                                  `json:"count"`
+	Resources map[constant.APISIXResource][]string `json:"resources"` // etcd 中新增的资源 ID
+}
+
+// WebhookConflictEventData 检测到配置冲突事件的数据
+type WebhookConflictEventData struct {
+	Drifts []DriftResource `json:"drifts"`
+}
+
+// WebhookTokenEventData 创建访问令牌事件的数据，不包含令牌明文
+type WebhookTokenEventData struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	MaskedToken string `json:"masked_token"`
+	AccessScope string `json:"access_scope"`
+	ExpiredAt   int64  `json:"expired_at"` // Unix timestamp
+	Creator     string `json:"creator"`
+}
+
+// WebhookSSLExpiringEventData 证书即将过期事件的数据，同时作为证书过期告警审计的内容
+type WebhookSSLExpiringEventData struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Source        string   `json:"source"` // edit: 编辑区；etcd: 仅存在于 etcd 中的未纳管证书
+	Snis          []string `json:"snis"`
+	UpstreamIDs   []string `json:"upstream_ids"` // 引用该证书的上游
+	ValidityEnd   int64    `json:"validity_end"` // Unix timestamp
+	ExpiresInDays int      `json:"expires_in_days"`
+	Threshold     int      `json:"threshold"` // 触发的告警阈值（天），已过期为 0
+	Expired       bool     `json:"expired"`
+}
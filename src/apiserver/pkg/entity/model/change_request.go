@@ -0,0 +1,92 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// GatewayPublishPolicy 网关发布审批策略，开启后发布操作将转为发布变更请求
+type GatewayPublishPolicy struct {
+	ID        int  `gorm:"column:id;primaryKey;autoIncrement"`
+	GatewayID int  `gorm:"column:gateway_id;type:int;uniqueIndex"`
+	Enabled   bool `gorm:"column:enabled"` // 是否开启发布审批
+	// 需要的审批通过人数
+	RequiredApprovals int            `gorm:"column:required_approvals"`
+	Reviewers         pq.StringArray `gorm:"column:reviewers;type:text"` // 审批人
+	// 变更请求有效期（小时），超时未审批通过则过期
+	ExpireHours int `gorm:"column:expire_hours"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (GatewayPublishPolicy) TableName() string {
+	return "gateway_publish_policy"
+}
+
+// GatewayChangeRequest 发布变更请求
+type GatewayChangeRequest struct {
+	ID            int64                   `gorm:"column:id;primaryKey;autoIncrement"`
+	GatewayID     int                     `gorm:"column:gateway_id;type:int;index:idx_gateway_status"`
+	TriggerSource constant.ReleaseTrigger `gorm:"column:trigger_source;type:varchar(16)"` // 触发来源：web/open
+	Changelog     string                  `gorm:"column:changelog;type:text"`             // 变更说明
+	// 发布的资源类型，为空表示一键发布
+	ResourceType constant.APISIXResource `gorm:"column:resource_type;type:varchar(32)"`
+	ResourceIDs  pq.StringArray          `gorm:"column:resource_ids;type:text"` // 发布的资源 ID，一键发布时为空
+	// 创建时编辑区待发布资源的变更 (JSON 格式)，结构为 []dto.ResourceChangeInfo
+	Diff              datatypes.JSON               `gorm:"column:diff"`
+	RequiredApprovals int                          `gorm:"column:required_approvals"`
+	Reviewers         pq.StringArray               `gorm:"column:reviewers;type:text"`
+	Status            constant.ChangeRequestStatus `gorm:"column:status;type:varchar(16);index:idx_gateway_status"`
+	ApplyError        string                       `gorm:"column:apply_error;type:text"` // 最近一次执行发布的错误
+	ExpiredAt         time.Time                    `gorm:"column:expired_at"`
+	AppliedAt         *time.Time                   `gorm:"column:applied_at"`
+	BaseModel                                      // Creator 即发起人
+}
+
+// TableName 设置表名
+func (GatewayChangeRequest) TableName() string {
+	return "gateway_change_request"
+}
+
+// IsExpired 待审批的请求是否已过期
+func (r GatewayChangeRequest) IsExpired() bool {
+	return r.Status == constant.ChangeRequestStatusPending && time.Now().After(r.ExpiredAt)
+}
+
+// GatewayChangeRequestEvent 发布变更请求的操作记录：审批、评论及状态变更
+type GatewayChangeRequestEvent struct {
+	ID              int64                        `gorm:"column:id;primaryKey;autoIncrement"`
+	GatewayID       int                          `gorm:"column:gateway_id;type:int"`
+	ChangeRequestID int64                        `gorm:"column:change_request_id;index"`
+	Action          constant.ChangeRequestAction `gorm:"column:action;type:varchar(16)"`
+	Operator        string                       `gorm:"column:operator;type:varchar(32)"`
+	Comment         string                       `gorm:"column:comment;type:text"`
+	CreatedAt       time.Time                    `gorm:"column:created_at"`
+}
+
+// TableName 设置表名
+func (GatewayChangeRequestEvent) TableName() string {
+	return "gateway_change_request_event"
+}
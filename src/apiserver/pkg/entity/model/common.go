@@ -20,13 +20,16 @@
 package model
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/tidwall/gjson"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 // BaseModel 基础模型
@@ -85,6 +88,16 @@ func (r ResourceCommonModel) GetGroupID() string {
 	return gjson.GetBytes(r.Config, "group_id").String()
 }
 
+// GetConsumerID 获取 consumer id
+func (r ResourceCommonModel) GetConsumerID() string {
+	return gjson.GetBytes(r.Config, "consumer_id").String()
+}
+
+// GetSecretManager 获取 secret 的密钥管理器
+func (r ResourceCommonModel) GetSecretManager() string {
+	return gjson.GetBytes(r.Config, "manager").String()
+}
+
 // GetSSLID 获取 ssl id
 func (r ResourceCommonModel) GetSSLID() string {
 	return gjson.GetBytes(r.Config, "tls.client_cert_id").String()
@@ -128,6 +141,18 @@ func (r ResourceCommonModel) ToResourceModel(resourceType constant.APISIXResourc
 			ResourceCommonModel: r,
 			Name:                r.GetName(resourceType),
 		}
+	case constant.Credential:
+		return &Credential{
+			ResourceCommonModel: r,
+			Name:                r.GetName(resourceType),
+			ConsumerID:          r.GetConsumerID(),
+		}
+	case constant.Secret:
+		return &Secret{
+			ResourceCommonModel: r,
+			Name:                r.GetName(resourceType),
+			Manager:             r.GetSecretManager(),
+		}
 	case constant.PluginConfig:
 		return &PluginConfig{
 			ResourceCommonModel: r,
@@ -163,3 +188,32 @@ func (r ResourceCommonModel) ToResourceModel(resourceType constant.APISIXResourc
 	}
 	return nil
 }
+
+// prepareSensitiveConfig 加密配置中的敏感字段；编辑时回传的脱敏占位值还原为当前网关下数据库中的原值，
+// 无法还原的占位值（如导入脱敏后的导出数据）拒绝写入
+func prepareSensitiveConfig(
+	tx *gorm.DB,
+	resourceType constant.APISIXResource,
+	gatewayID int,
+	id string,
+	config datatypes.JSON,
+) (datatypes.JSON, error) {
+	if !sensitive.HasMasked(resourceType, config) {
+		return sensitive.Encrypt(resourceType, config)
+	}
+	if id != "" {
+		var origin ResourceCommonModel
+		err := tx.Table(resourceType.String()).Select("config").
+			Where("gateway_id = ? AND id = ?", gatewayID, id).Take(&origin).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if config, err = sensitive.RestoreMasked(resourceType, config, origin.Config); err != nil {
+			return nil, err
+		}
+	}
+	if sensitive.HasMasked(resourceType, config) {
+		return nil, sensitive.ErrMaskedValue
+	}
+	return sensitive.Encrypt(resourceType, config)
+}
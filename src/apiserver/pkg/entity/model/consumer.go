@@ -46,6 +46,10 @@ func (c *Consumer) BeforeCreate(tx *gorm.DB) (err error) {
 	if err := c.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if c.Config, err = prepareSensitiveConfig(tx, constant.Consumer, c.GatewayID, c.ID, c.Config); err != nil {
+		return err
+	}
 	// 关联自定义插件
 	err = ResourceSchemaCallback(tx, c.GatewayID, c.ID, constant.Consumer, c.Config)
 	if err != nil {
@@ -60,6 +64,10 @@ func (c *Consumer) BeforeUpdate(tx *gorm.DB) (err error) {
 	if err := c.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if c.Config, err = prepareSensitiveConfig(tx, constant.Consumer, c.GatewayID, c.ID, c.Config); err != nil {
+		return err
+	}
 	// 关联自定义插件
 	err = ResourceSchemaCallback(tx, c.GatewayID, c.ID, constant.Consumer, c.Config)
 	if err != nil {
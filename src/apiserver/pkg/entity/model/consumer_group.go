@@ -45,6 +45,10 @@ func (c *ConsumerGroup) BeforeCreate(tx *gorm.DB) (err error) {
 	if err := c.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if c.Config, err = prepareSensitiveConfig(tx, constant.ConsumerGroup, c.GatewayID, c.ID, c.Config); err != nil {
+		return err
+	}
 	// 关联自定义插件
 	err = ResourceSchemaCallback(tx, c.GatewayID, c.ID, constant.ConsumerGroup, c.Config)
 	if err != nil {
@@ -59,6 +63,10 @@ func (c *ConsumerGroup) BeforeUpdate(tx *gorm.DB) (err error) {
 	if err := c.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if c.Config, err = prepareSensitiveConfig(tx, constant.ConsumerGroup, c.GatewayID, c.ID, c.Config); err != nil {
+		return err
+	}
 	// 关联自定义插件
 	err = ResourceSchemaCallback(tx, c.GatewayID, c.ID, constant.ConsumerGroup, c.Config)
 	if err != nil {
@@ -0,0 +1,138 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package model
+
+import (
+	"github.com/tidwall/sjson"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+)
+
+// Credential 表示数据库中的 credential 表
+type Credential struct {
+	// 凭证名称
+	Name string `gorm:"column:name;type:varchar(255);not null;uniqueIndex:idx_name"`
+	// 所属 consumer_id
+	ConsumerID          string                 `gorm:"column:consumer_id;type:varchar(255);not null;index"`
+	ResourceCommonModel                        // 资源通用 model: 创建时间、更新时间、创建人、更新人、config、status 等
+	OperationType       constant.OperationType `gorm:"-"` // 用于标识操作类型，不持久化到数据库
+}
+
+// TableName 设置表名
+func (Credential) TableName() string {
+	return "credential"
+}
+
+// BeforeCreate 创建前钩子
+func (c *Credential) BeforeCreate(tx *gorm.DB) (err error) {
+	if err := c.HandleConfig(); err != nil {
+		return err
+	}
+	// 加密敏感字段
+	if c.Config, err = prepareSensitiveConfig(tx, constant.Credential, c.GatewayID, c.ID, c.Config); err != nil {
+		return err
+	}
+	// 关联自定义插件
+	err = ResourceSchemaCallback(tx, c.GatewayID, c.ID, constant.Credential, c.Config)
+	if err != nil {
+		return err
+	}
+	// 添加审计
+	return c.AddAuditLog(tx, constant.OperationTypeCreate)
+}
+
+// BeforeUpdate 更新前钩子
+func (c *Credential) BeforeUpdate(tx *gorm.DB) (err error) {
+	if err := c.HandleConfig(); err != nil {
+		return err
+	}
+	// 加密敏感字段
+	if c.Config, err = prepareSensitiveConfig(tx, constant.Credential, c.GatewayID, c.ID, c.Config); err != nil {
+		return err
+	}
+	// 关联自定义插件
+	err = ResourceSchemaCallback(tx, c.GatewayID, c.ID, constant.Credential, c.Config)
+	if err != nil {
+		return err
+	}
+	// 如果更新的操作类型为撤销，则不触发审计
+	if c.OperationType == constant.OperationTypeRevert {
+		return nil
+	}
+	// 添加审计
+	return c.AddAuditLog(tx, constant.OperationTypeUpdate)
+}
+
+// BeforeDelete 删除前钩子
+func (c *Credential) BeforeDelete(tx *gorm.DB) (err error) {
+	if err := c.HandleConfig(); err != nil {
+		return err
+	}
+	// 添加审计
+	return c.AddAuditLog(tx, constant.OperationTypeDelete)
+}
+
+// AddAuditLog 添加审计
+func (c *Credential) AddAuditLog(tx *gorm.DB, operation constant.OperationType) (err error) {
+	// 排除批量删除，更新的情况
+	if c.ID == "" {
+		return nil
+	}
+	originConfig := datatypes.JSON{}
+	if operation != constant.OperationTypeCreate {
+		// 获取原始数据
+		var origin Credential
+		if err := tx.First(&origin, "id = ?", c.ID).Error; err != nil {
+			return err
+		}
+		originConfig = origin.Config
+	}
+	return auditCallback(tx,
+		c.GatewayID, c.ID, c.Updater, c.Status, operation, constant.Credential, originConfig, c.Config)
+}
+
+// HandleConfig 处理 config
+func (c *Credential) HandleConfig() (err error) {
+	c.Config, err = sjson.SetBytes(c.Config, "id", c.ID)
+	if err != nil {
+		return err
+	}
+
+	if c.ConsumerID != "" {
+		c.Config, err = sjson.SetBytes(c.Config, "consumer_id", c.ConsumerID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.Name != "" {
+		c.Config, err = sjson.SetBytes(c.Config, "name", c.Name)
+		if err != nil {
+			return err
+		}
+	}
+	// 去除空字段
+	config, err := jsonx.RemoveEmptyObjectsAndArrays(string(c.Config))
+	if err == nil {
+		c.Config = []byte(config)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// GatewayDataPlaneInstance 数据面 APISIX 实例，由实例上报到 etcd /data_plane/server_info 下的信息刷新
+type GatewayDataPlaneInstance struct {
+	ID         int    `gorm:"column:id;primaryKey;autoIncrement"`
+	GatewayID  int    `gorm:"column:gateway_id;type:int;uniqueIndex:idx_data_plane_instance_unique"`
+	InstanceID string `gorm:"column:instance_id;type:varchar(64);uniqueIndex:idx_data_plane_instance_unique"`
+	Hostname   string `gorm:"column:hostname;type:varchar(255)"`
+	Version    string `gorm:"column:version;type:varchar(32)"` // APISIX 版本
+	EtcdKey    string `gorm:"column:etcd_key;type:varchar(512)"`
+	BootTime   int64  `gorm:"column:boot_time"` // 实例启动时间（unix 时间戳）
+	// 实例最近一次写入 server_info 时的 etcd revision
+	Revision int64 `gorm:"column:revision"`
+	// 最近一次心跳时间：实例上报了 last_report_time 时以其为准，否则为最近一次在 etcd 中看到 server_info 的时间
+	LastHeartbeatAt time.Time `gorm:"column:last_heartbeat_at"`
+	// 最近一次刷新时 server_info 是否仍在 etcd 中（实例下线后其租约过期，server_info 被删除）
+	Online     bool           `gorm:"column:online"`
+	ServerInfo datatypes.JSON `gorm:"column:server_info"` // 实例上报的原始信息
+	CreatedAt  time.Time      `json:"createdAt"`
+	UpdatedAt  time.Time      `json:"updatedAt"`
+}
+
+// TableName 设置表名
+func (GatewayDataPlaneInstance) TableName() string {
+	return "gateway_data_plane_instance"
+}
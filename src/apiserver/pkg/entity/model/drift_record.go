@@ -0,0 +1,57 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// GatewayDriftRecord 表示数据库中的 gateway_drift_record 表，记录 etcd 中被控制面以外修改的资源
+type GatewayDriftRecord struct {
+	ID        int64 `gorm:"column:id;primaryKey;autoIncrement"`                  // 自增 ID
+	GatewayID int   `gorm:"column:gateway_id;type:int;index:idx_gateway_status"` // 对应网关 ID
+	// apisix 资源类型：route/service/upstream
+	Type       constant.APISIXResource `gorm:"column:type;type:varchar(32)"`
+	ResourceID string                  `gorm:"column:resource_id;type:varchar(255)"` // 资源 ID
+	Name       string                  `gorm:"column:name;type:varchar(255)"`        // 资源名称
+	// etcd key（不包含网关前缀），如 routes/xxx
+	EtcdKey   string             `gorm:"column:etcd_key;type:varchar(512)"`
+	DriftType constant.DriftType `gorm:"column:drift_type;type:varchar(16)"` // 漂移类型：modified/added/deleted
+	// 记录状态：drifted/resolved
+	Status constant.DriftStatus `gorm:"column:status;type:varchar(16);index:idx_gateway_status"`
+	// 最近一次发布的配置，etcd 中新增的资源为空
+	ExpectedConfig datatypes.JSON `gorm:"column:expected_config"`
+	// etcd 中的配置，被删除的资源为空
+	ActualConfig     datatypes.JSON `gorm:"column:actual_config"`
+	ReleaseVersionID int64          `gorm:"column:release_version_id"` // 作为对比基线的发布版本 ID
+	ModRevision      int            `gorm:"column:mod_revision"`       // etcd 中最近一次变更的 revision
+	DetectedAt       time.Time      `gorm:"column:detected_at"`        // 首次检测到漂移的时间
+	ResolvedAt       *time.Time     `gorm:"column:resolved_at"`        // 恢复时间
+	CreatedAt        time.Time      `json:"createdAt"`                 // 创建时间
+	UpdatedAt        time.Time      `json:"updatedAt"`                 // 更新时间
+}
+
+// TableName 设置表名
+func (GatewayDriftRecord) TableName() string {
+	return "gateway_drift_record"
+}
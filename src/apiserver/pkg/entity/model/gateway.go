@@ -52,7 +52,9 @@ type Gateway struct {
 	Token         string         `gorm:"column:token;type:varchar(255)"`                   // 网关 token
 	ReadOnly      bool           `gorm:"column:read_only;type:tinyint"`                    // 是否只读
 	LastSyncedAt  time.Time      `json:"last_synced_at" gorm:"type:datetime;default:null"` // 上次同步时间
-	auditSnapshot datatypes.JSON `gorm:"-"`                                                // 用于审计日志传递网关信息，不持久化到数据库
+	// 增量同步已处理到的 etcd revision，重启后从该 revision 继续 watch
+	SyncedRevision int64          `json:"synced_revision" gorm:"column:synced_revision;type:bigint;default:0"`
+	auditSnapshot  datatypes.JSON `gorm:"-"` // 用于审计日志传递网关信息，不持久化到数据库
 	BaseModel
 }
 
@@ -0,0 +1,116 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// GatewayGroup 网关组，组内网关运行同一套 API，发布时先发布金丝雀网关，观察通过后再发布其余网关
+type GatewayGroup struct {
+	ID          int    `gorm:"column:id;primaryKey;autoIncrement"`
+	Name        string `gorm:"column:name;type:varchar(64);uniqueIndex:idx_gateway_group_name"`
+	Description string `gorm:"column:description;type:varchar(512)"`
+	// 金丝雀网关发布后的观察时长（秒），观察结束后检查健康状态
+	SoakSeconds int `gorm:"column:soak_seconds"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (GatewayGroup) TableName() string {
+	return "gateway_group"
+}
+
+// GatewayGroupMember 网关组中的网关，按 Priority 从小到大依次发布，Priority 最小的为金丝雀网关
+type GatewayGroupMember struct {
+	ID        int `gorm:"column:id;primaryKey;autoIncrement"`
+	GroupID   int `gorm:"column:group_id;type:int;uniqueIndex:idx_group_gateway"`
+	GatewayID int `gorm:"column:gateway_id;type:int;uniqueIndex:idx_group_gateway;index"`
+	Priority  int `gorm:"column:priority"`
+	// 数据面健康检查地址（可选），发布后 GET 请求返回 2xx 视为健康
+	HealthCheckURL string `gorm:"column:health_check_url;type:varchar(512)"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (GatewayGroupMember) TableName() string {
+	return "gateway_group_member"
+}
+
+// GatewayGroupRollout 网关组分批发布
+//
+// 第 0 批为金丝雀网关：发布其草稿资源并记录发布版本，观察 SoakSeconds 后检查健康状态；
+// 其余网关依次应用金丝雀网关的发布版本。任意一批失败时，自动将已发布的网关回滚到发布前的版本
+type GatewayGroupRollout struct {
+	ID      int64 `gorm:"column:id;primaryKey;autoIncrement"`
+	GroupID int   `gorm:"column:group_id;type:int;index"`
+	// 金丝雀网关发布产生的版本，其余网关应用该版本
+	SourceReleaseVersionID int64                       `gorm:"column:source_release_version_id"`
+	Changelog              string                      `gorm:"column:changelog;type:text"`
+	SoakSeconds            int                         `gorm:"column:soak_seconds"`
+	Status                 constant.GroupRolloutStatus `gorm:"column:status;type:varchar(32);index"`
+	CurrentStage           int                         `gorm:"column:current_stage"`
+	SoakUntil              *time.Time                  `gorm:"column:soak_until"`
+	Error                  string                      `gorm:"column:error;type:text"`
+	StartedAt              *time.Time                  `gorm:"column:started_at"`
+	FinishedAt             *time.Time                  `gorm:"column:finished_at"`
+	// 推进分批发布的进程需先抢占租约，避免多个进程同时推进
+	LeaseOwner     string     `gorm:"column:lease_owner;type:varchar(128)"`
+	LeaseExpiredAt *time.Time `gorm:"column:lease_expired_at"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (GatewayGroupRollout) TableName() string {
+	return "gateway_group_rollout"
+}
+
+// IsFinished 分批发布是否已结束
+func (r GatewayGroupRollout) IsFinished() bool {
+	switch r.Status {
+	case constant.GroupRolloutStatusSucceeded, constant.GroupRolloutStatusRolledBack,
+		constant.GroupRolloutStatusFailed, constant.GroupRolloutStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// GatewayGroupRolloutStage 分批发布中单个网关的发布记录
+type GatewayGroupRolloutStage struct {
+	ID             int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	RolloutID      int64  `gorm:"column:rollout_id;index"`
+	Stage          int    `gorm:"column:stage"`
+	GatewayID      int    `gorm:"column:gateway_id;type:int"`
+	HealthCheckURL string `gorm:"column:health_check_url;type:varchar(512)"`
+	// 发布前网关的最新版本，回滚时使用；为 0 表示发布前没有版本，无法自动回滚
+	PreviousReleaseVersionID int64                            `gorm:"column:previous_release_version_id"`
+	ReleaseVersionID         int64                            `gorm:"column:release_version_id"`
+	Status                   constant.GroupRolloutStageStatus `gorm:"column:status;type:varchar(32)"`
+	Error                    string                           `gorm:"column:error;type:text"`
+	StartedAt                *time.Time                       `gorm:"column:started_at"`
+	FinishedAt               *time.Time                       `gorm:"column:finished_at"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (GatewayGroupRolloutStage) TableName() string {
+	return "gateway_group_rollout_stage"
+}
@@ -0,0 +1,37 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// GatewayMember 网关成员角色绑定
+type GatewayMember struct {
+	ID        int                  `gorm:"column:id;primaryKey;autoIncrement"`
+	GatewayID int                  `gorm:"column:gateway_id;type:int;uniqueIndex:idx_gateway_username"`
+	Username  string               `gorm:"column:username;type:varchar(64);uniqueIndex:idx_gateway_username"`
+	Role      constant.GatewayRole `gorm:"column:role;type:varchar(16)"` // 角色：viewer/editor/publisher/admin
+	BaseModel
+}
+
+// TableName 设置表名
+func (GatewayMember) TableName() string {
+	return "gateway_member"
+}
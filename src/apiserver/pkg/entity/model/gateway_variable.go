@@ -0,0 +1,35 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package model
+
+// GatewayVariable 网关变量，资源配置中可通过 ${NAME} 引用，发布时替换为变量值
+type GatewayVariable struct {
+	ID        int    `gorm:"column:id;primaryKey;autoIncrement"`
+	GatewayID int    `gorm:"column:gateway_id;type:int;uniqueIndex:idx_gateway_variable_name"`
+	Name      string `gorm:"column:name;type:varchar(64);uniqueIndex:idx_gateway_variable_name"`
+	// 变量值，敏感变量使用 AES-GCM 加密存储
+	Value       string `gorm:"column:value;type:text"`
+	Secret      bool   `gorm:"column:secret"` // 是否为敏感变量
+	Description string `gorm:"column:description;type:varchar(512)"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (GatewayVariable) TableName() string {
+	return "gateway_variable"
+}
@@ -44,6 +44,10 @@ func (g *GlobalRule) BeforeCreate(tx *gorm.DB) (err error) {
 	if err := g.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if g.Config, err = prepareSensitiveConfig(tx, constant.GlobalRule, g.GatewayID, g.ID, g.Config); err != nil {
+		return err
+	}
 	// 关联自定义插件
 	err = ResourceSchemaCallback(tx, g.GatewayID, g.ID, constant.GlobalRule, g.Config)
 	if err != nil {
@@ -58,6 +62,10 @@ func (g *GlobalRule) BeforeUpdate(tx *gorm.DB) (err error) {
 	if err := g.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if g.Config, err = prepareSensitiveConfig(tx, constant.GlobalRule, g.GatewayID, g.ID, g.Config); err != nil {
+		return err
+	}
 	// 关联自定义插件
 	err = ResourceSchemaCallback(tx, g.GatewayID, g.ID, constant.GlobalRule, g.Config)
 	if err != nil {
@@ -20,6 +20,7 @@ package model
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -27,6 +28,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 // OperationAuditLog 操作审计表
@@ -56,6 +58,35 @@ func (OperationAuditLog) TableName() string {
 	return "operation_audit_log"
 }
 
+// auditWebhookEvents 操作审计对应的资源变更 webhook 事件
+var auditWebhookEvents = map[constant.OperationType]constant.WebhookEvent{
+	constant.OperationTypeCreate: constant.WebhookEventResourceCreated,
+	constant.OperationImport:     constant.WebhookEventResourceCreated,
+	constant.OperationTypeUpdate: constant.WebhookEventResourceUpdated,
+	constant.OperationTypeRevert: constant.WebhookEventResourceUpdated,
+	constant.OperationTypeDelete: constant.WebhookEventResourceDeleted,
+}
+
+// AfterCreate 写入审计日志后，在同一事务中投递编辑区资源变更的 webhook 事件
+func (o *OperationAuditLog) AfterCreate(tx *gorm.DB) error {
+	event, ok := auditWebhookEvents[o.OperationType]
+	if !ok {
+		return nil
+	}
+	// 网关及 MCP 令牌的审计不投递资源变更事件
+	if o.ResourceType == "" || o.ResourceType == constant.Gateway {
+		return nil
+	}
+	return EnqueueWebhookEvent(tx, o.GatewayID, event, WebhookResourceEventData{
+		ResourceType:  o.ResourceType,
+		ResourceIDs:   strings.Split(o.ResourceIDs, ","),
+		OperationType: o.OperationType,
+		Operator:      o.Operator,
+		DataBefore:    json.RawMessage(o.DataBefore),
+		DataAfter:     json.RawMessage(o.DataAfter),
+	})
+}
+
 // 定义一个通用的回调
 func auditCallback(
 	db *gorm.DB,
@@ -68,6 +99,9 @@ func auditCallback(
 ) error {
 	var dataBeforeList []BatchOperationData
 	var dataAfterList []BatchOperationData
+	// 审计中不记录敏感字段的值
+	dataBefore = sensitive.Mask(resourceType, dataBefore)
+	dataAfter = sensitive.Mask(resourceType, dataAfter)
 
 	if operationType != constant.OperationTypeCreate {
 		dataBeforeList = append(dataBeforeList, BatchOperationData{
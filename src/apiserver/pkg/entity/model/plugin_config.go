@@ -44,6 +44,10 @@ func (p *PluginConfig) BeforeCreate(tx *gorm.DB) (err error) {
 	if err := p.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if p.Config, err = prepareSensitiveConfig(tx, constant.PluginConfig, p.GatewayID, p.ID, p.Config); err != nil {
+		return err
+	}
 	// 关联自定义插件
 	err = ResourceSchemaCallback(tx, p.GatewayID, p.ID, constant.PluginConfig, p.Config)
 	if err != nil {
@@ -58,6 +62,10 @@ func (p *PluginConfig) BeforeUpdate(tx *gorm.DB) (err error) {
 	if err := p.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if p.Config, err = prepareSensitiveConfig(tx, constant.PluginConfig, p.GatewayID, p.ID, p.Config); err != nil {
+		return err
+	}
 	// 关联自定义插件
 	err = ResourceSchemaCallback(tx, p.GatewayID, p.ID, constant.PluginConfig, p.Config)
 	if err != nil {
@@ -0,0 +1,103 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
+)
+
+// GatewayPublishJournal 分批发布日志：超过单个 etcd 事务上限的发布分批写入，
+// 写入前记录全部操作及写入前的值，进程在写入过程中退出后可据此继续写入或回滚
+type GatewayPublishJournal struct {
+	ID        int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	GatewayID int    `gorm:"column:gateway_id;type:int;index:idx_publish_journal_gateway"`
+	Operator  string `gorm:"column:operator;type:varchar(32)"`
+	// 状态：running/succeeded/rolled_back/failed
+	Status constant.PublishJournalStatus `gorm:"column:status;type:varchar(16);index:idx_publish_journal_status"`
+	// 全部操作及各 key 写入前的值：[]storage.BatchOperation，写入的值及写入前的值加密存储
+	Operations     datatypes.JSON `gorm:"column:operations"`
+	OperationCount int            `gorm:"column:operation_count"`
+	ChunkCount     int            `gorm:"column:chunk_count"`    // 总批次数
+	AppliedChunks  int            `gorm:"column:applied_chunks"` // 已写入的批次数
+	Error          string         `gorm:"column:error;type:text"`
+	CreatedAt      time.Time      `json:"createdAt"`
+	UpdatedAt      time.Time      `json:"updatedAt"`
+}
+
+// TableName 设置表名
+func (GatewayPublishJournal) TableName() string {
+	return "gateway_publish_journal"
+}
+
+// journalValueFields 发布日志操作中加密存储的字段：写入的值及写入前的值
+var journalValueFields = []string{"value", "prev_value"}
+
+// SetOperations 记录全部操作（[]storage.BatchOperation），写入的值及写入前的值整体加密，
+// 避免资源配置中的敏感信息明文落库
+func (j *GatewayPublishJournal) SetOperations(ops any) error {
+	operations, err := json.Marshal(ops)
+	if err != nil {
+		return err
+	}
+	j.Operations, err = convertJournalValues(operations, func(value string) (string, error) {
+		return sensitive.EncryptValue(value), nil
+	})
+	return err
+}
+
+// GetOperations 将解密后的全部操作解析到 ops
+func (j *GatewayPublishJournal) GetOperations(ops any) error {
+	operations, err := convertJournalValues(j.Operations, sensitive.DecryptValue)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(operations, ops)
+}
+
+// convertJournalValues 使用 convert 处理每个操作中的 journalValueFields
+func convertJournalValues(operations []byte, convert func(string) (string, error)) ([]byte, error) {
+	// 使用 json.Number 解析，避免 mod revision 等整数丢失精度
+	var items []map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(operations))
+	decoder.UseNumber()
+	if err := decoder.Decode(&items); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		for _, field := range journalValueFields {
+			value, ok := item[field].(string)
+			if !ok || value == "" {
+				continue
+			}
+			converted, err := convert(value)
+			if err != nil {
+				return nil, err
+			}
+			item[field] = converted
+		}
+	}
+	return json.Marshal(items)
+}
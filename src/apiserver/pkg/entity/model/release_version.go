@@ -19,19 +19,101 @@
 package model
 
 import (
+	"encoding/json"
+
 	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 // GatewayReleaseVersion 表示数据库中的 gateway_release_version 表
 type GatewayReleaseVersion struct {
-	ID          int64          `gorm:"column:id;primaryKey;autoIncrement"` // 自增 ID
-	GatewayID   string         `gorm:"column:gateway_id;type:varchar(32)"` // 对应网关 ID
-	ReleaseData datatypes.JSON `gorm:"column:release_data"`                // 全量生效的资源数据 (JSON 格式)
-	Version     string         `gorm:"column:version;type:varchar(32)"`    // 对应的版本号
-	BaseModel
+	ID        int64 `gorm:"column:id;primaryKey;autoIncrement"`                                // 自增 ID
+	GatewayID int   `gorm:"column:gateway_id;type:int;uniqueIndex:idx_release_version_unique"` // 对应网关 ID
+	// 全量生效的资源数据 (JSON 格式)，结构为 []ReleaseResource
+	ReleaseData datatypes.JSON `gorm:"column:release_data"`
+	// 对应的版本号，同一网关内唯一
+	Version string `gorm:"column:version;type:varchar(32);uniqueIndex:idx_release_version_unique"`
+	// 触发来源：web/open/mcp
+	TriggerSource constant.ReleaseTrigger `gorm:"column:trigger_source;type:varchar(16)"`
+	Changelog     string                  `gorm:"column:changelog;type:text"` // 变更说明
+	ResourceCount int                     `gorm:"column:resource_count"`      // 快照中的资源数量
+	// 回滚来源版本 ID，非回滚产生的版本为 0
+	RollbackFromID int64 `gorm:"column:rollback_from_id"`
+	BaseModel            // Creator 即发布人
 }
 
 // TableName 设置表名
 func (GatewayReleaseVersion) TableName() string {
 	return "gateway_release_version"
 }
+
+// ReleaseResource 发布版本快照中的单个资源
+type ReleaseResource struct {
+	Type constant.APISIXResource `json:"type"`
+	// 编辑区资源 ID
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// etcd key（不包含网关前缀），如 routes/xxx
+	Key string `json:"key"`
+	// 编辑区格式的配置，由 etcd 中的配置解析得到，变量已替换，用于 diff
+	Config json.RawMessage `json:"config"`
+	// etcd 中的原始配置，用于回滚数据面
+	Value json.RawMessage `json:"value"`
+	// 发布时编辑区中的配置，保留变量引用，用于回滚编辑区及在其他网关按其变量重新替换；旧版本的快照中为空
+	EditorConfig json.RawMessage `json:"editor_config,omitempty"`
+}
+
+// SetReleaseResources 加密资源中的敏感字段后写入快照
+func (v *GatewayReleaseVersion) SetReleaseResources(resources []ReleaseResource) error {
+	encrypted := make([]ReleaseResource, 0, len(resources))
+	for _, resource := range resources {
+		var err error
+		if resource.Config, err = sensitive.Encrypt(resource.Type, resource.Config); err != nil {
+			return err
+		}
+		if resource.Value, err = sensitive.Encrypt(resource.Type, resource.Value); err != nil {
+			return err
+		}
+		if len(resource.EditorConfig) > 0 {
+			if resource.EditorConfig, err = sensitive.Encrypt(resource.Type, resource.EditorConfig); err != nil {
+				return err
+			}
+		}
+		encrypted = append(encrypted, resource)
+	}
+	releaseData, err := json.Marshal(encrypted)
+	if err != nil {
+		return err
+	}
+	v.ReleaseData = releaseData
+	v.ResourceCount = len(resources)
+	return nil
+}
+
+// GetReleaseResources 解析快照中的资源列表，并解密其中的敏感字段
+func (v GatewayReleaseVersion) GetReleaseResources() ([]ReleaseResource, error) {
+	var resources []ReleaseResource
+	if len(v.ReleaseData) == 0 {
+		return resources, nil
+	}
+	if err := json.Unmarshal(v.ReleaseData, &resources); err != nil {
+		return nil, err
+	}
+	for i := range resources {
+		var err error
+		if resources[i].Config, err = sensitive.Decrypt(resources[i].Config); err != nil {
+			return nil, err
+		}
+		if resources[i].Value, err = sensitive.Decrypt(resources[i].Value); err != nil {
+			return nil, err
+		}
+		if len(resources[i].EditorConfig) > 0 {
+			if resources[i].EditorConfig, err = sensitive.Decrypt(resources[i].EditorConfig); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return resources, nil
+}
@@ -50,6 +50,10 @@ func (r *Route) BeforeCreate(tx *gorm.DB) (err error) {
 	if err := r.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if r.Config, err = prepareSensitiveConfig(tx, constant.Route, r.GatewayID, r.ID, r.Config); err != nil {
+		return err
+	}
 	// 关联自定义插件
 	err = ResourceSchemaCallback(tx, r.GatewayID, r.ID, constant.Route, r.Config)
 	if err != nil {
@@ -69,6 +73,10 @@ func (r *Route) BeforeUpdate(tx *gorm.DB) (err error) {
 	if err := r.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if r.Config, err = prepareSensitiveConfig(tx, constant.Route, r.GatewayID, r.ID, r.Config); err != nil {
+		return err
+	}
 	// 关联自定义插件
 	err = ResourceSchemaCallback(tx, r.GatewayID, r.ID, constant.Route, r.Config)
 	if err != nil {
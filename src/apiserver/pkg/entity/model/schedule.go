@@ -0,0 +1,124 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+)
+
+// GatewayMaintenanceWindow 网关维护窗口，配置了维护窗口的网关只允许在窗口内发布
+type GatewayMaintenanceWindow struct {
+	ID        int    `gorm:"column:id;primaryKey;autoIncrement"`
+	GatewayID int    `gorm:"column:gateway_id;type:int;index"`
+	Name      string `gorm:"column:name;type:varchar(64)"`
+	// 生效的星期（0 为周日），为空表示每天；跨天的窗口以开始时间所在的日期为准
+	Weekdays  pq.Int64Array `gorm:"column:weekdays;type:text"`
+	StartTime string        `gorm:"column:start_time;type:varchar(5)"` // 开始时间 HH:MM
+	EndTime   string        `gorm:"column:end_time;type:varchar(5)"`   // 结束时间 HH:MM，早于开始时间表示跨天
+	Timezone  string        `gorm:"column:timezone;type:varchar(64)"`  // 时区，如 Asia/Shanghai，为空时使用服务时区
+	Enabled   bool          `gorm:"column:enabled"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (GatewayMaintenanceWindow) TableName() string {
+	return "gateway_maintenance_window"
+}
+
+// Location 获取维护窗口的时区
+func (w GatewayMaintenanceWindow) Location() (*time.Location, error) {
+	if w.Timezone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(w.Timezone)
+}
+
+// Contains 判断时间是否在维护窗口内
+func (w GatewayMaintenanceWindow) Contains(t time.Time) (bool, error) {
+	loc, err := w.Location()
+	if err != nil {
+		return false, err
+	}
+	start, err := parseClock(w.StartTime)
+	if err != nil {
+		return false, err
+	}
+	end, err := parseClock(w.EndTime)
+	if err != nil {
+		return false, err
+	}
+	t = t.In(loc)
+	clock := t.Hour()*60 + t.Minute()
+	if start < end {
+		return w.matchWeekday(t.Weekday()) && clock >= start && clock < end, nil
+	}
+	// 跨天的窗口：开始当天的 [start, 24:00) 及次日的 [00:00, end)
+	if clock >= start {
+		return w.matchWeekday(t.Weekday()), nil
+	}
+	if clock < end {
+		return w.matchWeekday(t.AddDate(0, 0, -1).Weekday()), nil
+	}
+	return false, nil
+}
+
+func (w GatewayMaintenanceWindow) matchWeekday(weekday time.Weekday) bool {
+	return len(w.Weekdays) == 0 || slices.Contains(w.Weekdays, int64(weekday))
+}
+
+// parseClock 解析 HH:MM 格式的时间，返回当天的分钟数
+func parseClock(clock string) (int, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %s, should be HH:MM", clock)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// GatewayScheduledPublish 网关定时发布任务
+type GatewayScheduledPublish struct {
+	ID        int64 `gorm:"column:id;primaryKey;autoIncrement"`
+	GatewayID int   `gorm:"column:gateway_id;type:int;index"`
+	// 发布的资源类型，为空表示一键发布
+	ResourceType constant.APISIXResource `gorm:"column:resource_type;type:varchar(32)"`
+	ResourceIDs  pq.StringArray          `gorm:"column:resource_ids;type:text"` // 发布的资源 ID，一键发布时为空
+	Changelog    string                  `gorm:"column:changelog;type:text"`
+	ScheduledAt  time.Time               `gorm:"column:scheduled_at;index:idx_status_scheduled_at"` // 计划执行时间
+	// 是否允许在维护窗口外执行
+	OverrideMaintenanceWindow bool                            `gorm:"column:override_maintenance_window"`
+	Status                    constant.ScheduledPublishStatus `gorm:"column:status;type:varchar(16);index:idx_status_scheduled_at"`
+	TaskID                    int64                           `gorm:"column:task_id"` // 执行记录 Task ID
+	// 网关开启发布审批时，执行后创建的发布变更请求 ID
+	ChangeRequestID int64      `gorm:"column:change_request_id"`
+	Error           string     `gorm:"column:error;type:text"` // 执行失败的原因
+	StartedAt       *time.Time `gorm:"column:started_at"`
+	FinishedAt      *time.Time `gorm:"column:finished_at"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (GatewayScheduledPublish) TableName() string {
+	return "gateway_scheduled_publish"
+}
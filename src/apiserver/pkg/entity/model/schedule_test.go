@@ -0,0 +1,88 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatewayMaintenanceWindow_Contains(t *testing.T) {
+	t.Parallel()
+
+	// 2026-10-16 为周五
+	friday := func(hour, minute int) time.Time {
+		return time.Date(2026, 10, 16, hour, minute, 0, 0, time.UTC)
+	}
+	nightly := GatewayMaintenanceWindow{
+		Weekdays:  pq.Int64Array{5},
+		StartTime: "22:00",
+		EndTime:   "06:00",
+		Timezone:  "UTC",
+	}
+	daytime := GatewayMaintenanceWindow{
+		StartTime: "10:00",
+		EndTime:   "12:30",
+		Timezone:  "UTC",
+	}
+
+	tests := []struct {
+		name     string
+		window   GatewayMaintenanceWindow
+		t        time.Time
+		expected bool
+	}{
+		{name: "daytime before start", window: daytime, t: friday(9, 59), expected: false},
+		{name: "daytime at start", window: daytime, t: friday(10, 0), expected: true},
+		{name: "daytime before end", window: daytime, t: friday(12, 29), expected: true},
+		{name: "daytime at end", window: daytime, t: friday(12, 30), expected: false},
+		{name: "nightly on start day", window: nightly, t: friday(23, 0), expected: true},
+		{name: "nightly next morning", window: nightly, t: friday(23, 0).Add(6 * time.Hour), expected: true},
+		{name: "nightly after end", window: nightly, t: friday(23, 0).Add(8 * time.Hour), expected: false},
+		{name: "nightly before start", window: nightly, t: friday(21, 59), expected: false},
+		{name: "nightly morning of start day", window: nightly, t: friday(1, 0), expected: false},
+		{
+			name:     "timezone",
+			window:   GatewayMaintenanceWindow{StartTime: "10:00", EndTime: "11:00", Timezone: "Asia/Shanghai"},
+			t:        friday(2, 30),
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			contains, err := tt.window.Contains(tt.t)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, contains)
+		})
+	}
+}
+
+func TestGatewayMaintenanceWindow_ContainsInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := GatewayMaintenanceWindow{StartTime: "25:00", EndTime: "06:00"}.Contains(time.Now())
+	assert.Error(t, err)
+	_, err = GatewayMaintenanceWindow{StartTime: "22:00", EndTime: "06:00", Timezone: "Mars/Base"}.
+		Contains(time.Now())
+	assert.Error(t, err)
+}
@@ -0,0 +1,152 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tidwall/sjson"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+)
+
+// ErrSecretManagerChanged secret 的密钥管理器不可修改
+var ErrSecretManagerChanged = errors.New("secret manager can not be changed")
+
+// Secret 表示数据库中的 secret 表
+type Secret struct {
+	// 密钥名称
+	Name string `gorm:"column:name;type:varchar(255);not null;uniqueIndex:idx_name"`
+	// 密钥管理器: vault、aws、gcp
+	Manager             string                 `gorm:"column:manager;type:varchar(32);not null;index"`
+	ResourceCommonModel                        // 资源通用 model: 创建时间、更新时间、创建人、更新人、config、status 等
+	OperationType       constant.OperationType `gorm:"-"` // 用于标识操作类型，不持久化到数据库
+}
+
+// TableName 设置表名
+func (Secret) TableName() string {
+	return "secret"
+}
+
+// BeforeCreate 创建前钩子
+func (s *Secret) BeforeCreate(tx *gorm.DB) (err error) {
+	if err := s.HandleConfig(); err != nil {
+		return err
+	}
+	// 加密敏感字段
+	if s.Config, err = prepareSensitiveConfig(tx, constant.Secret, s.GatewayID, s.ID, s.Config); err != nil {
+		return err
+	}
+	// 添加审计
+	return s.AddAuditLog(tx, constant.OperationTypeCreate)
+}
+
+// BeforeUpdate 更新前钩子
+func (s *Secret) BeforeUpdate(tx *gorm.DB) (err error) {
+	if err := s.checkManagerUnchanged(tx); err != nil {
+		return err
+	}
+	if err := s.HandleConfig(); err != nil {
+		return err
+	}
+	// 加密敏感字段
+	if s.Config, err = prepareSensitiveConfig(tx, constant.Secret, s.GatewayID, s.ID, s.Config); err != nil {
+		return err
+	}
+	// 如果更新的操作类型为撤销，则不触发审计
+	if s.OperationType == constant.OperationTypeRevert {
+		return nil
+	}
+	// 添加审计
+	return s.AddAuditLog(tx, constant.OperationTypeUpdate)
+}
+
+// BeforeDelete 删除前钩子
+func (s *Secret) BeforeDelete(tx *gorm.DB) (err error) {
+	if err := s.HandleConfig(); err != nil {
+		return err
+	}
+	// 添加审计
+	return s.AddAuditLog(tx, constant.OperationTypeDelete)
+}
+
+// AddAuditLog 添加审计
+func (s *Secret) AddAuditLog(tx *gorm.DB, operation constant.OperationType) (err error) {
+	// 排除批量删除，更新的情况
+	if s.ID == "" {
+		return nil
+	}
+	originConfig := datatypes.JSON{}
+	if operation != constant.OperationTypeCreate {
+		// 获取原始数据
+		var origin Secret
+		if err := tx.First(&origin, "id = ?", s.ID).Error; err != nil {
+			return err
+		}
+		originConfig = origin.Config
+	}
+	return auditCallback(tx,
+		s.GatewayID, s.ID, s.Updater, s.Status, operation, constant.Secret, originConfig, s.Config)
+}
+
+// checkManagerUnchanged 密钥管理器为 etcd key 的一部分，创建后不可修改
+func (s *Secret) checkManagerUnchanged(tx *gorm.DB) error {
+	if s.ID == "" || s.Manager == "" {
+		return nil
+	}
+	var origin Secret
+	if err := tx.First(&origin, "id = ?", s.ID).Error; err != nil {
+		return err
+	}
+	if origin.Manager != s.Manager {
+		return fmt.Errorf("%w: %s -> %s", ErrSecretManagerChanged, origin.Manager, s.Manager)
+	}
+	return nil
+}
+
+// HandleConfig 处理 config
+func (s *Secret) HandleConfig() (err error) {
+	s.Config, err = sjson.SetBytes(s.Config, "id", s.ID)
+	if err != nil {
+		return err
+	}
+
+	if s.Manager != "" {
+		s.Config, err = sjson.SetBytes(s.Config, "manager", s.Manager)
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.Name != "" {
+		s.Config, err = sjson.SetBytes(s.Config, "name", s.Name)
+		if err != nil {
+			return err
+		}
+	}
+	// 去除空字段
+	config, err := jsonx.RemoveEmptyObjectsAndArrays(string(s.Config))
+	if err == nil {
+		s.Config = []byte(config)
+	}
+	return nil
+}
@@ -45,6 +45,10 @@ func (s *Service) BeforeCreate(tx *gorm.DB) (err error) {
 	if err := s.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if s.Config, err = prepareSensitiveConfig(tx, constant.Service, s.GatewayID, s.ID, s.Config); err != nil {
+		return err
+	}
 	// 关联自定义插件
 	err = ResourceSchemaCallback(tx, s.GatewayID, s.ID, constant.Service, s.Config)
 	if err != nil {
@@ -59,6 +63,10 @@ func (s *Service) BeforeUpdate(tx *gorm.DB) (err error) {
 	if err := s.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if s.Config, err = prepareSensitiveConfig(tx, constant.Service, s.GatewayID, s.ID, s.Config); err != nil {
+		return err
+	}
 	// 关联自定义插件
 	err = ResourceSchemaCallback(tx, s.GatewayID, s.ID, constant.Service, s.Config)
 	if err != nil {
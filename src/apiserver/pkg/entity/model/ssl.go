@@ -35,6 +35,9 @@ import (
 // SSL ...
 type SSL struct {
 	Name string `gorm:"column:name;type:varchar(255);uniqueIndex:idx_name" json:"name"` // 证书名称
+	// 证书有效期（unix 时间戳），由 config.cert 解析，证书无法解析时为 0
+	ValidityStart int64 `gorm:"column:validity_start" json:"validity_start"`
+	ValidityEnd   int64 `gorm:"column:validity_end;index:idx_ssl_validity_end" json:"validity_end"`
 	// 资源通用 model: 创建时间、更新时间、创建人、更新人、config、status 等
 	ResourceCommonModel
 	OperationType constant.OperationType `gorm:"-"` // 用于标识操作类型，不持久化到数据库
@@ -123,6 +126,7 @@ func (s *SSL) HandleConfig() (err error) {
 	if err == nil {
 		s.Config = []byte(config)
 	}
+	s.ValidityStart, s.ValidityEnd = SSLValidity(s.Config)
 	crt := gjson.GetBytes(s.Config, "cert").String()
 	key := gjson.GetBytes(s.Config, "key").String()
 	sins := gjson.GetBytes(s.Config, "snis").String()
@@ -135,3 +139,12 @@ func (s *SSL) HandleConfig() (err error) {
 	}
 	return nil
 }
+
+// SSLValidity 解析 ssl 配置中证书的有效期，证书无法解析时返回 0
+func SSLValidity(config datatypes.JSON) (start, end int64) {
+	validity, err := sslx.X509CertValidity(gjson.GetBytes(config, "cert").String())
+	if err != nil {
+		return 0, 0
+	}
+	return validity.NotBefore, validity.NotAfter
+}
@@ -0,0 +1,38 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import "time"
+
+// SSLExpiryAlert 证书过期告警记录，同一证书有效期内每个告警阈值只告警一次，证书更新后重新告警
+type SSLExpiryAlert struct {
+	ID        int    `gorm:"column:id;primaryKey;autoIncrement"`
+	GatewayID int    `gorm:"column:gateway_id;type:int;uniqueIndex:idx_ssl_expiry_alert_unique"`
+	SSLID     string `gorm:"column:ssl_id;type:varchar(255);uniqueIndex:idx_ssl_expiry_alert_unique"`
+	// 告警时证书的过期时间（unix 时间戳）
+	ValidityEnd int64 `gorm:"column:validity_end;uniqueIndex:idx_ssl_expiry_alert_unique"`
+	// 触发的告警阈值（剩余天数），已过期为 0
+	Threshold int       `gorm:"column:threshold;uniqueIndex:idx_ssl_expiry_alert_unique"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// TableName 设置表名
+func (SSLExpiryAlert) TableName() string {
+	return "ssl_expiry_alert"
+}
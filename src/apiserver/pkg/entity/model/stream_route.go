@@ -61,6 +61,10 @@ func (s *StreamRoute) BeforeCreate(tx *gorm.DB) (err error) {
 	if err := s.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if s.Config, err = prepareSensitiveConfig(tx, constant.StreamRoute, s.GatewayID, s.ID, s.Config); err != nil {
+		return err
+	}
 	// 添加审计
 	return s.AddAuditLog(tx, constant.OperationTypeCreate)
 }
@@ -70,6 +74,10 @@ func (s *StreamRoute) BeforeUpdate(tx *gorm.DB) (err error) {
 	if err := s.HandleConfig(); err != nil {
 		return err
 	}
+	// 加密敏感字段
+	if s.Config, err = prepareSensitiveConfig(tx, constant.StreamRoute, s.GatewayID, s.ID, s.Config); err != nil {
+		return err
+	}
 	// 如果更新的操作类型为撤销，则不触发审计
 	if s.OperationType == constant.OperationTypeRevert {
 		return nil
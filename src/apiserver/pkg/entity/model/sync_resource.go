@@ -25,8 +25,10 @@ import (
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/sensitive"
 )
 
 // GatewaySyncData  gateway_sync_data 表
@@ -38,8 +40,11 @@ type GatewaySyncData struct {
 	Type        constant.APISIXResource `gorm:"column:type;type:varchar(32);uniqueIndex:idx_resource_unique"`
 	Config      datatypes.JSON          `gorm:"column:config;type:json"` // etcd raw config
 	ModRevision int                     `gorm:"column:mod_revision"`     // 更新版本
-	CreatedAt   time.Time               `json:"createdAt"`               // 创建时间
-	UpdatedAt   time.Time               `json:"updatedAt"`               // 更新时间
+	// ssl 证书有效期（unix 时间戳），其他资源类型为 0
+	ValidityStart int64     `gorm:"column:validity_start"`
+	ValidityEnd   int64     `gorm:"column:validity_end;index:idx_sync_data_validity_end"`
+	CreatedAt     time.Time `json:"createdAt"` // 创建时间
+	UpdatedAt     time.Time `json:"updatedAt"` // 更新时间
 }
 
 // GetResourceKey 获取资源 key
@@ -51,6 +56,25 @@ func (g GatewaySyncData) GetResourceKey() string {
 	return fmt.Sprintf(constant.ResourceKeyFormat, g.Type, g.ID)
 }
 
+// GetEtcdKey 获取资源在 etcd 中不包含网关前缀的 key，如 routes/xxx
+func (g GatewaySyncData) GetEtcdKey() string {
+	// 插件元数据的 etcd key 为插件名称
+	if g.Type == constant.PluginMetadata {
+		return constant.ResourceTypePrefixMap[g.Type] + "/" + g.GetName()
+	}
+	// credential 嵌套在所属 consumer 下
+	if g.Type == constant.Credential {
+		return constant.ResourceTypePrefixMap[g.Type] + "/" +
+			fmt.Sprintf(constant.CredentialKeyFormat, g.GetConsumerID(), g.ID)
+	}
+	// secret 按密钥管理器分类
+	if g.Type == constant.Secret {
+		return constant.ResourceTypePrefixMap[g.Type] + "/" +
+			fmt.Sprintf(constant.SecretKeyFormat, g.GetSecretManager(), g.ID)
+	}
+	return constant.ResourceTypePrefixMap[g.Type] + "/" + g.ID
+}
+
 // GetServiceID 获取 service id
 func (g GatewaySyncData) GetServiceID() string {
 	return gjson.GetBytes(g.Config, "service_id").String()
@@ -71,6 +95,16 @@ func (g GatewaySyncData) GetGroupID() string {
 	return gjson.GetBytes(g.Config, "group_id").String()
 }
 
+// GetConsumerID 获取 consumer id
+func (g GatewaySyncData) GetConsumerID() string {
+	return gjson.GetBytes(g.Config, "consumer_id").String()
+}
+
+// GetSecretManager 获取 secret 的密钥管理器
+func (g GatewaySyncData) GetSecretManager() string {
+	return gjson.GetBytes(g.Config, "manager").String()
+}
+
 // GetName 获取 name
 func (g GatewaySyncData) GetName() string {
 	return gjson.GetBytes(g.Config, GetResourceNameKey(g.Type)).String()
@@ -96,6 +130,27 @@ func (GatewaySyncData) TableName() string {
 	return "gateway_sync_data"
 }
 
+// BeforeSave 保存前解析 ssl 证书有效期，并加密配置中的敏感字段
+func (g *GatewaySyncData) BeforeSave(tx *gorm.DB) (err error) {
+	if g.Type == constant.SSL {
+		g.ValidityStart, g.ValidityEnd = SSLValidity(g.Config)
+	}
+	g.Config, err = sensitive.Encrypt(g.Type, g.Config)
+	return err
+}
+
+// AfterSave 保存后解密配置，调用方继续使用明文配置
+func (g *GatewaySyncData) AfterSave(tx *gorm.DB) (err error) {
+	g.Config, err = sensitive.Decrypt(g.Config)
+	return err
+}
+
+// AfterFind 查询后解密配置中的敏感字段
+func (g *GatewaySyncData) AfterFind(tx *gorm.DB) (err error) {
+	g.Config, err = sensitive.Decrypt(g.Config)
+	return err
+}
+
 // GetConfigCreatedAt 获取更新时间
 func (g GatewaySyncData) GetConfigCreatedAt() int64 {
 	return gjson.ParseBytes(g.Config).Get("update_time").Int()
@@ -22,17 +22,46 @@ import (
 	"time"
 
 	"gorm.io/datatypes"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 )
 
 // Task 后台任务
+//
+// 通过任务队列下发的任务由 worker 抢占租约（LeaseOwner/LeaseExpiredAt）后执行，
+// 租约过期未续约的任务会被其他 worker 重新抢占，因此进程重启/崩溃不会导致任务丢失
 type Task struct {
 	BaseModel
 	ID        int64          `json:"id" gorm:"primaryKey"`
+	GatewayID int            `json:"gatewayID" gorm:"type:int;index;default:0"`
 	Name      string         `json:"name" gorm:"type:varchar(128);not null"`
 	Args      datatypes.JSON `json:"args" gorm:"type:json"`
 	Result    datatypes.JSON `json:"result" gorm:"type:json"`
 	StartedAt time.Time      `json:"startedAt" gorm:"type:datetime;default:null"`
 	Duration  time.Duration  `json:"duration" gorm:"type:bigint;default:null"`
+
+	Status   constant.TaskStatus `json:"status" gorm:"type:varchar(32);index"`
+	Progress int                 `json:"progress" gorm:"type:int;default:0"` // 进度百分比 0-100
+	Message  string              `json:"message" gorm:"type:varchar(255)"`   // 当前进度说明
+	Error    string              `json:"error" gorm:"type:text"`             // 最近一次执行的错误信息
+	// 已执行次数与最大执行次数，失败后未达到最大执行次数时按退避时间重新排队
+	Attempts    int `json:"attempts" gorm:"type:int;default:0"`
+	MaxAttempts int `json:"maxAttempts" gorm:"type:int;default:1"`
+	// 最早可执行时间
+	RunAt           *time.Time `json:"runAt" gorm:"type:datetime;index;default:null"`
+	LeaseOwner      string     `json:"leaseOwner" gorm:"type:varchar(128)"`
+	LeaseExpiredAt  *time.Time `json:"leaseExpiredAt" gorm:"type:datetime;default:null"`
+	CancelRequested bool       `json:"cancelRequested" gorm:"default:false"`
+	FinishedAt      *time.Time `json:"finishedAt" gorm:"type:datetime;default:null"`
+}
+
+// IsFinished 任务是否已结束
+func (t Task) IsFinished() bool {
+	switch t.Status {
+	case constant.TaskStatusSucceeded, constant.TaskStatusFailed, constant.TaskStatusCancelled:
+		return true
+	}
+	return false
 }
 
 // PeriodicTask 周期任务
@@ -0,0 +1,155 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/uuidx"
+)
+
+// GatewayWebhook 网关 webhook 订阅，订阅的事件发生时向 URL 推送 HMAC 签名的 JSON
+type GatewayWebhook struct {
+	ID        int    `gorm:"column:id;primaryKey;autoIncrement"`
+	GatewayID int    `gorm:"column:gateway_id;type:int;uniqueIndex:idx_gateway_webhook_name"`
+	Name      string `gorm:"column:name;type:varchar(64);uniqueIndex:idx_gateway_webhook_name"`
+	URL       string `gorm:"column:url;type:varchar(1024)"`
+	// 签名密钥，使用 AES-GCM 加密存储
+	Secret      string         `gorm:"column:secret;type:text"`
+	Events      pq.StringArray `gorm:"column:events;type:text"` // 订阅的事件
+	Enabled     bool           `gorm:"column:enabled"`
+	Description string         `gorm:"column:description;type:varchar(512)"`
+	BaseModel
+}
+
+// TableName 设置表名
+func (GatewayWebhook) TableName() string {
+	return "gateway_webhook"
+}
+
+// Subscribed 是否订阅了事件
+func (w *GatewayWebhook) Subscribed(event constant.WebhookEvent) bool {
+	return w.Enabled && slices.Contains(w.Events, string(event))
+}
+
+// GatewayWebhookDelivery webhook 投递记录，作为 outbox 与产生事件的变更在同一事务中写入，由 scheduler 异步投递
+type GatewayWebhookDelivery struct {
+	ID        int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	GatewayID int    `gorm:"column:gateway_id;type:int;index:idx_webhook_delivery_gateway"`
+	WebhookID int    `gorm:"column:webhook_id;type:int;index:idx_webhook_delivery_webhook"`
+	EventID   string `gorm:"column:event_id;type:varchar(64)"` // 同一事件投递到多个订阅时相同
+	// 事件类型
+	Event   constant.WebhookEvent `gorm:"column:event;type:varchar(32)"`
+	Payload datatypes.JSON        `gorm:"column:payload"` // 推送的请求体
+	// 投递状态：pending/succeeded/failed
+	Status   constant.WebhookDeliveryStatus `gorm:"column:status;type:varchar(16);index:idx_webhook_delivery_due"`
+	Attempts int                            `gorm:"column:attempts"` // 已投递次数
+	// 下次投递时间
+	NextAttemptAt time.Time `gorm:"column:next_attempt_at;index:idx_webhook_delivery_due"`
+	// 最近一次投递的结果
+	LastAttemptAt  *time.Time `gorm:"column:last_attempt_at"`
+	ResponseStatus int        `gorm:"column:response_status"`
+	ResponseBody   string     `gorm:"column:response_body;type:text"` // 截断后的响应体
+	Error          string     `gorm:"column:error;type:text"`
+	DeliveredAt    *time.Time `gorm:"column:delivered_at"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+// TableName 设置表名
+func (GatewayWebhookDelivery) TableName() string {
+	return "gateway_webhook_delivery"
+}
+
+// WebhookEventPayload webhook 推送的请求体
+type WebhookEventPayload struct {
+	ID          string                `json:"id"` // 事件 ID，可用于接收方去重
+	Event       constant.WebhookEvent `json:"event"`
+	GatewayID   int                   `json:"gateway_id"`
+	GatewayName string                `json:"gateway_name"`
+	OccurredAt  int64                 `json:"occurred_at"` // Unix timestamp
+	Data        any                   `json:"data"`
+}
+
+// WebhookResourceEventData 资源变更事件的数据，来自操作审计
+type WebhookResourceEventData struct {
+	ResourceType  constant.APISIXResource `json:"resource_type"`
+	ResourceIDs   []string                `json:"resource_ids"`
+	OperationType constant.OperationType  `json:"operation_type"`
+	Operator      string                  `json:"operator"`
+	DataBefore    json.RawMessage         `json:"data_before"`
+	DataAfter     json.RawMessage         `json:"data_after"`
+}
+
+// EnqueueWebhookEvent 为订阅了事件的网关 webhook 写入待投递记录
+//
+// db 传入产生事件的事务，事务回滚时不会投递
+func EnqueueWebhookEvent(db *gorm.DB, gatewayID int, event constant.WebhookEvent, data any) error {
+	db = db.Session(&gorm.Session{NewDB: true})
+	var webhooks []*GatewayWebhook
+	if err := db.Where("gateway_id = ? AND enabled = ?", gatewayID, true).Find(&webhooks).Error; err != nil {
+		return err
+	}
+	webhooks = slices.DeleteFunc(webhooks, func(webhook *GatewayWebhook) bool {
+		return !webhook.Subscribed(event)
+	})
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	var gatewayNames []string
+	if err := db.Model(&Gateway{}).Where("id = ?", gatewayID).Pluck("name", &gatewayNames).Error; err != nil {
+		return err
+	}
+	now := time.Now()
+	payload := WebhookEventPayload{
+		ID:         uuidx.New(),
+		Event:      event,
+		GatewayID:  gatewayID,
+		OccurredAt: now.Unix(),
+		Data:       data,
+	}
+	if len(gatewayNames) > 0 {
+		payload.GatewayName = gatewayNames[0]
+	}
+	payloadRaw, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	deliveries := make([]*GatewayWebhookDelivery, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		deliveries = append(deliveries, &GatewayWebhookDelivery{
+			GatewayID:     gatewayID,
+			WebhookID:     webhook.ID,
+			EventID:       payload.ID,
+			Event:         event,
+			Payload:       payloadRaw,
+			Status:        constant.WebhookDeliveryStatusPending,
+			NextAttemptAt: now,
+		})
+	}
+	return db.Create(&deliveries).Error
+}
@@ -20,8 +20,11 @@
 package database
 
 import (
+	"gorm.io/datatypes"
 	"gorm.io/gen"
+	"gorm.io/gorm"
 
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
 )
 
@@ -33,7 +36,7 @@ import (
 // 4. 如果需要更精细地对数据库进行迁移，或版本管理，可使用：https://github.com/golang-migrate/migrate
 // 5. Gorm migrate 更多参考：https://gorm.io/docs/migration.html
 func RunMigrate() error {
-	return Client().AutoMigrate(
+	err := Client().AutoMigrate(
 		model.Gateway{},
 		model.Route{},
 		model.Service{},
@@ -45,6 +48,25 @@ func RunMigrate() error {
 		model.GlobalRule{},
 		model.GatewaySyncData{},
 		model.GatewayReleaseVersion{},
+		model.GatewayDriftRecord{},
+		model.GatewayMember{},
+		model.GatewayPublishPolicy{},
+		model.GatewayChangeRequest{},
+		model.GatewayChangeRequestEvent{},
+		model.GatewayMaintenanceWindow{},
+		model.GatewayScheduledPublish{},
+		model.GatewayGroup{},
+		model.GatewayGroupMember{},
+		model.GatewayGroupRollout{},
+		model.GatewayGroupRolloutStage{},
+		model.GatewayVariable{},
+		model.GatewayWebhook{},
+		model.GatewayWebhookDelivery{},
+		model.SSLExpiryAlert{},
+		model.GatewayDataPlaneInstance{},
+		model.GatewayPublishJournal{},
+		model.Credential{},
+		model.Secret{},
 		model.OperationAuditLog{},
 		model.Proto{},
 		model.SSL{},
@@ -53,7 +75,51 @@ func RunMigrate() error {
 		model.GatewayResourceSchemaAssociation{},
 		model.StreamRoute{},
 		model.MCPAccessToken{},
+		model.Task{},
+		model.PeriodicTask{},
 	)
+	if err != nil {
+		return err
+	}
+	return backfillSSLValidity()
+}
+
+// backfillSSLValidity 为证书有效期字段加入前保存的 ssl 计算有效期，证书无法解析的保持为 0
+func backfillSSLValidity() error {
+	var ssls []*model.SSL
+	err := Client().Where("validity_end = ?", 0).FindInBatches(&ssls, 100, func(*gorm.DB, int) error {
+		for _, ssl := range ssls {
+			if err := updateSSLValidity(ssl, ssl.Config); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error
+	if err != nil {
+		return err
+	}
+	var syncedSSLs []*model.GatewaySyncData
+	return Client().Where("type = ? AND validity_end = ?", constant.SSL.String(), 0).
+		FindInBatches(&syncedSSLs, 100, func(*gorm.DB, int) error {
+			for _, synced := range syncedSSLs {
+				if err := updateSSLValidity(synced, synced.Config); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+}
+
+// updateSSLValidity 解析证书有效期并更新到记录中，不触发模型的钩子
+func updateSSLValidity(record any, config datatypes.JSON) error {
+	start, end := model.SSLValidity(config)
+	if end == 0 {
+		return nil
+	}
+	return Client().Model(record).UpdateColumns(map[string]any{
+		"validity_start": start,
+		"validity_end":   end,
+	}).Error
 }
 
 // RunGenDao 生成 dao 文件
@@ -75,6 +141,25 @@ func RunGenDao() {
 		model.GlobalRule{},
 		model.GatewaySyncData{},
 		model.GatewayReleaseVersion{},
+		model.GatewayDriftRecord{},
+		model.GatewayMember{},
+		model.GatewayPublishPolicy{},
+		model.GatewayChangeRequest{},
+		model.GatewayChangeRequestEvent{},
+		model.GatewayMaintenanceWindow{},
+		model.GatewayScheduledPublish{},
+		model.GatewayGroup{},
+		model.GatewayGroupMember{},
+		model.GatewayGroupRollout{},
+		model.GatewayGroupRolloutStage{},
+		model.GatewayVariable{},
+		model.GatewayWebhook{},
+		model.GatewayWebhookDelivery{},
+		model.SSLExpiryAlert{},
+		model.GatewayDataPlaneInstance{},
+		model.GatewayPublishJournal{},
+		model.Credential{},
+		model.Secret{},
 		model.OperationAuditLog{},
 		model.Proto{},
 		model.SSL{},
@@ -82,6 +167,7 @@ func RunGenDao() {
 		model.GatewayCustomPluginSchema{},
 		model.GatewayResourceSchemaAssociation{},
 		model.StreamRoute{},
+		model.Task{},
 	)
 	g.Execute()
 }
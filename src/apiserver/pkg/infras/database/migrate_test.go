@@ -0,0 +1,62 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+)
+
+func TestBackfillSSLValidity(t *testing.T) {
+	client, err := gorm.Open(sqlite.Open("file:backfill?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetClient(client)
+	defer SetClient(nil)
+	if err := client.AutoMigrate(model.SSL{}, model.GatewaySyncData{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// 模拟有效期字段加入前保存的记录
+	legacy := client.Session(&gorm.Session{SkipHooks: true})
+	ssl := data.SSL1(&model.Gateway{ID: 1}, constant.ResourceStatusSuccess)
+	assert.NoError(t, legacy.Create(ssl).Error)
+	synced := &model.GatewaySyncData{ID: ssl.ID, GatewayID: 1, Type: constant.SSL, Config: ssl.Config}
+	assert.NoError(t, legacy.Create(synced).Error)
+
+	assert.NoError(t, backfillSSLValidity())
+
+	_, expectedEnd := model.SSLValidity(ssl.Config)
+	assert.Positive(t, expectedEnd)
+	var got model.SSL
+	assert.NoError(t, client.Where("auto_id = ?", ssl.AutoID).Take(&got).Error)
+	assert.Equal(t, expectedEnd, got.ValidityEnd)
+	assert.Positive(t, got.ValidityStart)
+	var gotSynced model.GatewaySyncData
+	assert.NoError(t, client.Where("auto_id = ?", synced.AutoID).Take(&gotSynced).Error)
+	assert.Equal(t, expectedEnd, gotSynced.ValidityEnd)
+}
@@ -0,0 +1,267 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	log "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+)
+
+// BatchConflictError 分批写入过程中 key 被其他写入方修改
+var BatchConflictError = errors.New("etcd 中的数据在发布过程中被修改")
+
+// batchRollbackTimeout 分批写入失败后回滚及记录日志的超时时间
+const batchRollbackTimeout = 30 * time.Second
+
+// BatchOperation 分批写入 etcd 的单个操作，同时记录写入前 key 的值，用于失败后回滚
+type BatchOperation struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Delete bool   `json:"delete,omitempty"`
+	// 写入前 key 的值及 mod revision，key 不存在时 PrevExists 为 false
+	PrevValue       string `json:"prev_value,omitempty"`
+	PrevExists      bool   `json:"prev_exists"`
+	PrevModRevision int64  `json:"prev_mod_revision"`
+}
+
+// BatchJournal 分批写入日志：写入超过单个事务上限的操作时，记录写入前的值及写入进度，
+// 进程在写入过程中退出时，可以据此继续写入（ResumeBatchOperations）或回滚（RevertBatchOperations）
+type BatchJournal interface {
+	// Begin 写入第一批之前记录全部操作，返回错误时不会写入
+	Begin(ctx context.Context, ops []BatchOperation, chunks int) error
+	// ChunkApplied 记录已写入的批次数
+	ChunkApplied(ctx context.Context, applied int) error
+	// Succeeded 全部批次写入成功
+	Succeeded(ctx context.Context) error
+	// RolledBack 写入失败并回滚，回滚失败时 rollbackErr 不为空
+	RolledBack(ctx context.Context, cause error, rollbackErr error) error
+}
+
+type batchJournalKey struct{}
+
+// WithBatchJournal 设置本次批量写入使用的日志
+func WithBatchJournal(ctx context.Context, journal BatchJournal) context.Context {
+	return context.WithValue(ctx, batchJournalKey{}, journal)
+}
+
+func batchJournalFromContext(ctx context.Context) BatchJournal {
+	journal, _ := ctx.Value(batchJournalKey{}).(BatchJournal)
+	return journal
+}
+
+func (op *BatchOperation) clientOp() clientv3.Op {
+	if op.Delete {
+		return clientv3.OpDelete(op.Key)
+	}
+	return clientv3.OpPut(op.Key, op.Value)
+}
+
+// appliedCmp 判断 key 当前是否为本次操作写入后的状态
+func (op *BatchOperation) appliedCmp() clientv3.Cmp {
+	if op.Delete {
+		return clientv3.Compare(clientv3.CreateRevision(op.Key), "=", 0)
+	}
+	return clientv3.Compare(clientv3.Value(op.Key), "=", op.Value)
+}
+
+// revertOp 恢复 key 写入前的值
+func (op *BatchOperation) revertOp() clientv3.Op {
+	if op.PrevExists {
+		return clientv3.OpPut(op.Key, op.PrevValue)
+	}
+	return clientv3.OpDelete(op.Key)
+}
+
+func chunkBatchOperations(ops []BatchOperation) [][]BatchOperation {
+	var chunks [][]BatchOperation
+	for start := 0; start < len(ops); start += bulkOperateSize {
+		chunks = append(chunks, ops[start:min(start+bulkOperateSize, len(ops))])
+	}
+	return chunks
+}
+
+func txnWithTimeout(ctx context.Context, client *clientv3.Client, cmps []clientv3.Cmp,
+	ops []clientv3.Op,
+) (*clientv3.TxnResponse, error) {
+	timeoutCtx, cancelFunc := context.WithTimeout(ctx, time.Second*2)
+	defer cancelFunc()
+	return client.Txn(timeoutCtx).If(cmps...).Then(ops...).Commit()
+}
+
+// loadPrevValues 预检：读取各 key 写入前的值及 mod revision，每批在一个事务中读取
+func loadPrevValues(ctx context.Context, client *clientv3.Client, ops []BatchOperation) error {
+	for _, chunk := range chunkBatchOperations(ops) {
+		getOps := make([]clientv3.Op, 0, len(chunk))
+		for i := range chunk {
+			getOps = append(getOps, clientv3.OpGet(chunk[i].Key))
+		}
+		resp, err := txnWithTimeout(ctx, client, nil, getOps)
+		if err != nil {
+			return fmt.Errorf("etcd get failed: %w", err)
+		}
+		for i := range chunk {
+			kvs := resp.Responses[i].GetResponseRange().GetKvs()
+			if len(kvs) == 0 {
+				continue
+			}
+			chunk[i].PrevExists = true
+			chunk[i].PrevValue = string(kvs[0].Value)
+			chunk[i].PrevModRevision = kvs[0].ModRevision
+		}
+	}
+	return nil
+}
+
+// applyChunk 写入一批操作，key 的 mod revision 与预检时不一致时不写入并返回 BatchConflictError
+func applyChunk(ctx context.Context, client *clientv3.Client, chunk []BatchOperation) error {
+	cmps := make([]clientv3.Cmp, 0, len(chunk))
+	clientOps := make([]clientv3.Op, 0, len(chunk))
+	for i := range chunk {
+		cmps = append(cmps, clientv3.Compare(clientv3.ModRevision(chunk[i].Key), "=", chunk[i].PrevModRevision))
+		clientOps = append(clientOps, chunk[i].clientOp())
+	}
+	resp, err := txnWithTimeout(ctx, client, cmps, clientOps)
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		return BatchConflictError
+	}
+	return nil
+}
+
+// atomicMultiOperate 分批写入，对调用方表现为全部成功或全部不生效：
+// 写入前读取各 key 的当前值，每批写入时校验 key 未被修改，某一批失败时回滚已写入的批次
+func atomicMultiOperate(ctx context.Context, client *clientv3.Client, ops []BatchOperation) error {
+	if err := loadPrevValues(ctx, client, ops); err != nil {
+		return err
+	}
+	chunks := chunkBatchOperations(ops)
+	journal := batchJournalFromContext(ctx)
+	if journal != nil {
+		if err := journal.Begin(ctx, ops, len(chunks)); err != nil {
+			return fmt.Errorf("record batch journal failed: %w", err)
+		}
+	}
+	for i, chunk := range chunks {
+		if err := applyChunk(ctx, client, chunk); err != nil {
+			log.Errorf("etcd batch operate failed at chunk %d/%d: %s", i+1, len(chunks), err)
+			// 超时等错误时该批次可能已经写入，一并回滚
+			rollbackErr := rollbackBatchOperations(ctx, client, ops[:min((i+1)*bulkOperateSize, len(ops))],
+				journal, err)
+			if rollbackErr != nil {
+				return fmt.Errorf("etcd 分批写入失败: %w，回滚失败: %s", err, rollbackErr.Error())
+			}
+			return fmt.Errorf("etcd 分批写入失败，已回滚: %w", err)
+		}
+		if journal != nil {
+			if err := journal.ChunkApplied(ctx, i+1); err != nil {
+				log.Errorf("record batch journal failed: %s", err)
+			}
+		}
+	}
+	if journal != nil {
+		if err := journal.Succeeded(ctx); err != nil {
+			log.Errorf("record batch journal failed: %s", err)
+		}
+	}
+	return nil
+}
+
+// rollbackBatchOperations 回滚已写入的操作并记录日志；ctx 可能已被取消（如请求断开），
+// 回滚及记录日志使用不随 ctx 取消的独立超时
+func rollbackBatchOperations(
+	ctx context.Context,
+	client *clientv3.Client,
+	ops []BatchOperation,
+	journal BatchJournal,
+	cause error,
+) error {
+	rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), batchRollbackTimeout)
+	defer cancel()
+	rollbackErr := RevertBatchOperations(rollbackCtx, client, ops)
+	if journal != nil {
+		if journalErr := journal.RolledBack(rollbackCtx, cause, rollbackErr); journalErr != nil {
+			log.Errorf("record batch journal failed: %s", journalErr)
+		}
+	}
+	return rollbackErr
+}
+
+// AtomicBatchOperate 不论操作数量均按分批写入的方式写入 ops，对调用方表现为全部成功或全部不生效，
+// ctx 中设置了日志时记录写入进度
+//
+// 写入成功后 ops 中记录了各 key 写入前的值，后续步骤失败时调用方可以通过 RevertBatchOperations 回滚
+func AtomicBatchOperate(ctx context.Context, client *clientv3.Client, ops []BatchOperation) error {
+	return atomicMultiOperate(ctx, client, ops)
+}
+
+// RevertBatchOperations 回滚已写入的操作：仅恢复当前仍为本次写入结果的 key，已被其他写入方修改的 key 保持不变
+//
+// 不依赖写入进度，进程在写入过程中退出后，可以对全部操作调用
+func RevertBatchOperations(ctx context.Context, client *clientv3.Client, ops []BatchOperation) error {
+	chunks := chunkBatchOperations(ops)
+	for i := len(chunks) - 1; i >= 0; i-- {
+		for j := range chunks[i] {
+			op := &chunks[i][j]
+			if _, err := txnWithTimeout(ctx, client, []clientv3.Cmp{op.appliedCmp()},
+				[]clientv3.Op{op.revertOp()}); err != nil {
+				return fmt.Errorf("revert key %s failed: %w", op.Key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ResumeBatchOperations 继续写入未完成的操作：跳过已是写入结果的 key，其余 key 校验未被修改后分批写入
+func ResumeBatchOperations(ctx context.Context, client *clientv3.Client, ops []BatchOperation) error {
+	for _, chunk := range chunkBatchOperations(ops) {
+		cmps := make([]clientv3.Cmp, 0, len(chunk))
+		clientOps := make([]clientv3.Op, 0, len(chunk))
+		for i := range chunk {
+			op := &chunk[i]
+			resp, err := txnWithTimeout(ctx, client, []clientv3.Cmp{op.appliedCmp()}, nil)
+			if err != nil {
+				return err
+			}
+			if resp.Succeeded {
+				continue
+			}
+			cmps = append(cmps, clientv3.Compare(clientv3.ModRevision(op.Key), "=", op.PrevModRevision))
+			clientOps = append(clientOps, op.clientOp())
+		}
+		if len(clientOps) == 0 {
+			continue
+		}
+		resp, err := txnWithTimeout(ctx, client, cmps, clientOps)
+		if err != nil {
+			return err
+		}
+		if !resp.Succeeded {
+			return BatchConflictError
+		}
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+// fakeBatchJournal 记录调用，ChunkApplied 时执行 onChunkApplied 模拟并发写入
+type fakeBatchJournal struct {
+	chunks         int
+	applied        []int
+	succeeded      bool
+	cause          error
+	rollbackErr    error
+	onChunkApplied func(applied int)
+}
+
+func (j *fakeBatchJournal) Begin(_ context.Context, _ []BatchOperation, chunks int) error {
+	j.chunks = chunks
+	return nil
+}
+
+func (j *fakeBatchJournal) ChunkApplied(_ context.Context, applied int) error {
+	j.applied = append(j.applied, applied)
+	if j.onChunkApplied != nil {
+		j.onChunkApplied(applied)
+	}
+	return nil
+}
+
+func (j *fakeBatchJournal) Succeeded(context.Context) error {
+	j.succeeded = true
+	return nil
+}
+
+func (j *fakeBatchJournal) RolledBack(_ context.Context, cause error, rollbackErr error) error {
+	j.cause = cause
+	j.rollbackErr = rollbackErr
+	return nil
+}
+
+func startBatchTestEtcd(t *testing.T) *clientv3.Client {
+	t.Helper()
+
+	client, server, _, err := util.StartEmbedEtcdClientRandom(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+		server.Close()
+	})
+	return client
+}
+
+// newBatchOperations 生成 count 个写入操作，第一个 key 为删除操作
+func newBatchOperations(count int) []BatchOperation {
+	ops := make([]BatchOperation, 0, count)
+	for i := range count {
+		ops = append(ops, BatchOperation{Key: fmt.Sprintf("/batch/routes/%03d", i), Value: "new"})
+	}
+	ops[0].Value = ""
+	ops[0].Delete = true
+	return ops
+}
+
+func getValue(t *testing.T, client *clientv3.Client, key string) (string, bool) {
+	t.Helper()
+
+	resp, err := client.Get(context.Background(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Kvs) == 0 {
+		return "", false
+	}
+	return string(resp.Kvs[0].Value), true
+}
+
+func TestAtomicMultiOperate(t *testing.T) {
+	client := startBatchTestEtcd(t)
+	ctx := context.Background()
+	ops := newBatchOperations(250)
+	_, err := client.Put(ctx, ops[0].Key, "old")
+	assert.NoError(t, err)
+	_, err = client.Put(ctx, ops[1].Key, "old")
+	assert.NoError(t, err)
+
+	journal := &fakeBatchJournal{}
+	err = atomicMultiOperate(WithBatchJournal(ctx, journal), client, ops)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, journal.chunks)
+	assert.Equal(t, []int{1, 2, 3}, journal.applied)
+	assert.True(t, journal.succeeded)
+	_, exists := getValue(t, client, ops[0].Key)
+	assert.False(t, exists)
+	for _, op := range ops[1:] {
+		value, _ := getValue(t, client, op.Key)
+		assert.Equal(t, "new", value)
+	}
+}
+
+func TestAtomicMultiOperateRollback(t *testing.T) {
+	client := startBatchTestEtcd(t)
+	ctx := context.Background()
+	ops := newBatchOperations(250)
+	_, err := client.Put(ctx, ops[0].Key, "old")
+	assert.NoError(t, err)
+	_, err = client.Put(ctx, ops[1].Key, "old")
+	assert.NoError(t, err)
+
+	// 第一批写入后，其他写入方修改了第三批中的 key
+	journal := &fakeBatchJournal{onChunkApplied: func(applied int) {
+		if applied == 1 {
+			_, err := client.Put(ctx, ops[220].Key, "concurrent")
+			assert.NoError(t, err)
+		}
+	}}
+	err = atomicMultiOperate(WithBatchJournal(ctx, journal), client, ops)
+	assert.ErrorIs(t, err, BatchConflictError)
+	assert.Equal(t, []int{1, 2}, journal.applied)
+	assert.False(t, journal.succeeded)
+	assert.ErrorIs(t, journal.cause, BatchConflictError)
+	assert.NoError(t, journal.rollbackErr)
+
+	// 已写入的批次被回滚，并发写入的 key 保持不变
+	value, _ := getValue(t, client, ops[0].Key)
+	assert.Equal(t, "old", value)
+	value, _ = getValue(t, client, ops[1].Key)
+	assert.Equal(t, "old", value)
+	for _, op := range ops[2:200] {
+		_, exists := getValue(t, client, op.Key)
+		assert.False(t, exists, op.Key)
+	}
+	value, _ = getValue(t, client, ops[220].Key)
+	assert.Equal(t, "concurrent", value)
+}
+
+func TestAtomicMultiOperateRollbackAfterCancel(t *testing.T) {
+	client := startBatchTestEtcd(t)
+	ops := newBatchOperations(250)
+	_, err := client.Put(context.Background(), ops[1].Key, "old")
+	assert.NoError(t, err)
+
+	// 第一批写入后 ctx 被取消（如请求断开），回滚不受影响
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	journal := &fakeBatchJournal{onChunkApplied: func(applied int) {
+		if applied == 1 {
+			cancel()
+		}
+	}}
+	err = atomicMultiOperate(WithBatchJournal(ctx, journal), client, ops)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, journal.cause, context.Canceled)
+	assert.NoError(t, journal.rollbackErr)
+
+	value, _ := getValue(t, client, ops[1].Key)
+	assert.Equal(t, "old", value)
+	for _, op := range ops[2:100] {
+		_, exists := getValue(t, client, op.Key)
+		assert.False(t, exists, op.Key)
+	}
+}
+
+func TestResumeAndRevertBatchOperations(t *testing.T) {
+	client := startBatchTestEtcd(t)
+	ctx := context.Background()
+	ops := newBatchOperations(250)
+	_, err := client.Put(ctx, ops[0].Key, "old")
+	assert.NoError(t, err)
+	assert.NoError(t, loadPrevValues(ctx, client, ops))
+
+	// 模拟只写入了第一批后进程退出
+	assert.NoError(t, applyChunk(ctx, client, ops[:bulkOperateSize]))
+	assert.NoError(t, ResumeBatchOperations(ctx, client, ops))
+	for _, op := range ops[1:] {
+		value, _ := getValue(t, client, op.Key)
+		assert.Equal(t, "new", value)
+	}
+
+	_, err = client.Put(ctx, ops[100].Key, "concurrent")
+	assert.NoError(t, err)
+	assert.NoError(t, RevertBatchOperations(ctx, client, ops))
+	value, _ := getValue(t, client, ops[0].Key)
+	assert.Equal(t, "old", value)
+	_, exists := getValue(t, client, ops[1].Key)
+	assert.False(t, exists)
+	value, _ = getValue(t, client, ops[100].Key)
+	assert.Equal(t, "concurrent", value)
+
+	// 未写入的 key 被修改后不能继续写入
+	assert.ErrorIs(t, ResumeBatchOperations(ctx, client, ops), BatchConflictError)
+}
@@ -39,6 +39,7 @@ var (
 	KeyNotFoundError      = errors.New("key not found")
 	ConnectionFailedError = errors.New("连接失败，请检查 etcd 地址是否正确")
 	AuthFailedError       = errors.New("用户名或密码错误，或者证书错误，请重新检查后再试")
+	CompactedError        = errors.New("required revision has been compacted")
 )
 
 // SkippedValueEtcdInitDir ...
@@ -63,6 +64,8 @@ const (
 type EtcdV3Storage struct {
 	client *clientv3.Client
 	prefix string
+	// 是否为连接池中的共享连接，共享连接由连接池关闭
+	pooled bool
 }
 
 var _ StorageInterface = &EtcdV3Storage{}
@@ -137,34 +140,30 @@ func (e *EtcdV3Storage) txnOperate(ctx context.Context, ops []clientv3.Op) error
 	return nil
 }
 
-func (e *EtcdV3Storage) txnMultiOperate(ctx context.Context, ops []clientv3.Op) error {
-	length := len(ops)
-	if length <= bulkOperateSize {
-		return e.txnOperate(ctx, ops)
-	}
-
-	count := length / bulkOperateSize
-	if length%bulkOperateSize > 0 {
-		count += 1
-	}
-	for i := range count {
-		start := i * bulkOperateSize
-		end := min(start+bulkOperateSize, length)
-		err := e.txnOperate(ctx, ops[start:end])
-		if err != nil {
-			return err
+// txnMultiOperate 批量写入，超过单个事务上限时分批写入，任一批失败时回滚已写入的批次
+func (e *EtcdV3Storage) txnMultiOperate(ctx context.Context, ops []BatchOperation) error {
+	if len(ops) <= bulkOperateSize {
+		clientOps := make([]clientv3.Op, 0, len(ops))
+		for i := range ops {
+			clientOps = append(clientOps, ops[i].clientOp())
 		}
+		return e.txnOperate(ctx, clientOps)
 	}
-	_ = e.client.Close()
-	return nil
+	return atomicMultiOperate(ctx, e.client, ops)
 }
 
 // List ...
 func (e *EtcdV3Storage) List(ctx context.Context, key string) ([]KeyValuePair, error) {
+	ret, _, err := e.ListWithRevision(ctx, key)
+	return ret, err
+}
+
+// ListWithRevision 列出 key 前缀下的数据，同时返回本次读取对应的 etcd revision
+func (e *EtcdV3Storage) ListWithRevision(ctx context.Context, key string) ([]KeyValuePair, int64, error) {
 	resp, err := e.client.Get(ctx, key, clientv3.WithPrefix())
 	if err != nil {
 		log.Errorf("etcd get failed: %s", err)
-		return nil, fmt.Errorf("etcd get failed: %w", err)
+		return nil, 0, fmt.Errorf("etcd get failed: %w", err)
 	}
 	var ret []KeyValuePair
 	for i := range resp.Kvs {
@@ -188,7 +187,21 @@ func (e *EtcdV3Storage) List(ctx context.Context, key string) ([]KeyValuePair, e
 		ret = append(ret, data)
 	}
 
-	return ret, nil
+	var revision int64
+	if resp.Header != nil {
+		revision = resp.Header.Revision
+	}
+	return ret, revision, nil
+}
+
+// Revision 获取 etcd 当前的 revision
+func (e *EtcdV3Storage) Revision(ctx context.Context) (int64, error) {
+	resp, err := e.client.Get(ctx, "/", clientv3.WithCountOnly())
+	if err != nil {
+		log.Errorf("etcd get revision failed: %s", err)
+		return 0, fmt.Errorf("etcd get revision failed: %w", err)
+	}
+	return resp.Header.Revision, nil
 }
 
 // Create ...
@@ -213,38 +226,55 @@ func (e *EtcdV3Storage) Update(ctx context.Context, key, val string) error {
 
 // BatchCreate ...
 func (e *EtcdV3Storage) BatchCreate(ctx context.Context, resource map[string]string) error {
-	var ops []clientv3.Op
+	ops := make([]BatchOperation, 0, len(resource))
 	for k, v := range resource {
-		ops = append(ops, clientv3.OpPut(fmt.Sprintf("%s/%s", e.prefix, k), v))
+		ops = append(ops, BatchOperation{Key: fmt.Sprintf("%s/%s", e.prefix, k), Value: v})
 	}
 	return e.txnMultiOperate(ctx, ops)
 }
 
 // BatchDelete ...
 func (e *EtcdV3Storage) BatchDelete(ctx context.Context, keys []string) error {
-	var ops []clientv3.Op
+	ops := make([]BatchOperation, 0, len(keys))
 	for _, key := range keys {
-		ops = append(ops, clientv3.OpDelete(fmt.Sprintf("%s/%s", e.prefix, key)))
+		ops = append(ops, BatchOperation{Key: fmt.Sprintf("%s/%s", e.prefix, key), Delete: true})
 	}
 	return e.txnMultiOperate(ctx, ops)
 }
 
-// Watch ...
-func (e *EtcdV3Storage) Watch(ctx context.Context, key string) <-chan WatchResponse {
+// Watch 监听 key 前缀下的变更，revision 大于 0 时从该 revision 开始监听
+//
+// 当 revision 已被压缩时，返回的 WatchResponse.Error 为 CompactedError，之后通道关闭，调用方需要重新全量拉取
+func (e *EtcdV3Storage) Watch(ctx context.Context, key string, revision int64) <-chan WatchResponse {
 	// NOTE: should use e.prefix here?
-	eventChan := e.client.Watch(ctx, key, clientv3.WithPrefix())
+	opts := []clientv3.OpOption{clientv3.WithPrefix()}
+	if revision > 0 {
+		opts = append(opts, clientv3.WithRev(revision))
+	}
+	eventChan := e.client.Watch(clientv3.WithRequireLeader(ctx), key, opts...)
 	ch := make(chan WatchResponse, 1)
 	go func() {
 		defer runtime.HandlePanic()
+		defer close(ch)
 		for event := range eventChan {
+			if event.CompactRevision != 0 {
+				log.Warnf("etcd watch compacted: key: %s compact revision: %d", key, event.CompactRevision)
+				sendWatchResponse(ctx, ch, WatchResponse{
+					Error:           CompactedError,
+					Canceled:        true,
+					CompactRevision: event.CompactRevision,
+				})
+				return
+			}
 			if event.Err() != nil {
 				log.Errorf("etcd watch error: key: %s err: %v", key, event.Err())
-				close(ch)
+				sendWatchResponse(ctx, ch, WatchResponse{Error: event.Err(), Canceled: true})
 				return
 			}
 
 			output := WatchResponse{
 				Canceled: event.Canceled,
+				Revision: event.Header.Revision,
 			}
 
 			for i := range event.Events {
@@ -262,8 +292,9 @@ func (e *EtcdV3Storage) Watch(ctx context.Context, key string) <-chan WatchRespo
 
 				e := Event{
 					KeyValuePair: KeyValuePair{
-						Key:   key,
-						Value: value,
+						Key:         key,
+						Value:       value,
+						ModRevision: event.Events[i].Kv.ModRevision,
 					},
 				}
 				switch event.Events[i].Type {
@@ -278,17 +309,30 @@ func (e *EtcdV3Storage) Watch(ctx context.Context, key string) <-chan WatchRespo
 				log.Error("channel canceled")
 				output.Error = fmt.Errorf("channel canceled")
 			}
-			ch <- output
+			if !sendWatchResponse(ctx, ch, output) {
+				return
+			}
 		}
-
-		close(ch)
 	}()
 
 	return ch
 }
 
+// sendWatchResponse 发送 watch 响应，调用方已退出（ctx 结束）时放弃发送，避免协程泄漏
+func sendWatchResponse(ctx context.Context, ch chan<- WatchResponse, resp WatchResponse) bool {
+	select {
+	case ch <- resp:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 // Close ...
 func (e *EtcdV3Storage) Close() error {
+	if e.pooled {
+		return nil
+	}
 	return e.client.Close()
 }
 
@@ -136,6 +136,37 @@ func (mr *MockStorageInterfaceMockRecorder) List(ctx, key interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockStorageInterface)(nil).List), ctx, key)
 }
 
+// ListWithRevision mocks base method.
+func (m *MockStorageInterface) ListWithRevision(ctx context.Context, key string) ([]storage.KeyValuePair, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithRevision", ctx, key)
+	ret0, _ := ret[0].([]storage.KeyValuePair)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListWithRevision indicates an expected call of ListWithRevision.
+func (mr *MockStorageInterfaceMockRecorder) ListWithRevision(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithRevision", reflect.TypeOf((*MockStorageInterface)(nil).ListWithRevision), ctx, key)
+}
+
+// Revision mocks base method.
+func (m *MockStorageInterface) Revision(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revision", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revision indicates an expected call of Revision.
+func (mr *MockStorageInterfaceMockRecorder) Revision(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revision", reflect.TypeOf((*MockStorageInterface)(nil).Revision), ctx)
+}
+
 // Update mocks base method.
 func (m *MockStorageInterface) Update(ctx context.Context, key, val string) error {
 	m.ctrl.T.Helper()
@@ -151,15 +182,15 @@ func (mr *MockStorageInterfaceMockRecorder) Update(ctx, key, val interface{}) *g
 }
 
 // Watch mocks base method.
-func (m *MockStorageInterface) Watch(ctx context.Context, key string) <-chan storage.WatchResponse {
+func (m *MockStorageInterface) Watch(ctx context.Context, key string, revision int64) <-chan storage.WatchResponse {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Watch", ctx, key)
+	ret := m.ctrl.Call(m, "Watch", ctx, key, revision)
 	ret0, _ := ret[0].(<-chan storage.WatchResponse)
 	return ret0
 }
 
 // Watch indicates an expected call of Watch.
-func (mr *MockStorageInterfaceMockRecorder) Watch(ctx, key interface{}) *gomock.Call {
+func (mr *MockStorageInterfaceMockRecorder) Watch(ctx, key, revision interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockStorageInterface)(nil).Watch), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockStorageInterface)(nil).Watch), ctx, key, revision)
 }
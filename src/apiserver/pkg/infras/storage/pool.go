@@ -0,0 +1,288 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
+	log "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/runtime"
+)
+
+// PoolClosedError 连接池已关闭
+var PoolClosedError = errors.New("etcd client pool is closed")
+
+const (
+	// poolHealthCheckInterval 健康检查间隔
+	poolHealthCheckInterval = 30 * time.Second
+	// poolHealthCheckTimeout 单个连接健康检查的超时时间
+	poolHealthCheckTimeout = 3 * time.Second
+	// poolIdleTimeout 连接超过该时间未使用时关闭
+	poolIdleTimeout = 10 * time.Minute
+	// poolCloseGracePeriod 被替换的连接延迟关闭，避免中断仍在使用该连接的请求
+	poolCloseGracePeriod = 30 * time.Second
+)
+
+// 连接被移出连接池的原因
+const (
+	evictReasonConfigChanged = "config_changed"
+	evictReasonInvalidated   = "invalidated"
+	evictReasonUnhealthy     = "unhealthy"
+	evictReasonIdle          = "idle"
+	evictReasonShutdown      = "shutdown"
+)
+
+var (
+	poolConnections = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "apigateway_etcd_client_pool_connections",
+		Help: "Number of etcd clients cached in the pool.",
+	})
+	poolRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "apigateway_etcd_client_pool_requests_total",
+		Help: "Number of etcd client requests to the pool, by result (hit/miss).",
+	}, []string{"result"})
+	poolDials = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "apigateway_etcd_client_pool_dials_total",
+		Help: "Number of etcd clients dialed by the pool, by result (success/failure).",
+	}, []string{"result"})
+	poolEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "apigateway_etcd_client_pool_evictions_total",
+		Help: "Number of etcd clients removed from the pool, by reason.",
+	}, []string{"reason"})
+)
+
+// pooledClient 连接池中的 etcd client
+type pooledClient struct {
+	client      *clientv3.Client
+	fingerprint string // etcd 连接配置的摘要，配置变更时重建连接
+	lastUsedAt  time.Time
+}
+
+// ClientPool 按网关缓存 etcd client：同一网关的请求复用连接，网关的 etcd 连接配置变更时重建，
+// 后台定期检查连接健康状态并关闭长时间未使用的连接
+type ClientPool struct {
+	mu        sync.Mutex
+	clients   map[int]*pooledClient
+	closed    bool
+	startOnce sync.Once
+	stop      chan struct{}
+	dial      func(base.EtcdConfig) (*clientv3.Client, error)
+}
+
+// NewClientPool 创建连接池
+func NewClientPool() *ClientPool {
+	return &ClientPool{
+		clients: make(map[int]*pooledClient),
+		stop:    make(chan struct{}),
+		dial:    initEtcdClient,
+	}
+}
+
+var defaultClientPool = NewClientPool()
+
+// DefaultClientPool 进程内共享的连接池
+func DefaultClientPool() *ClientPool {
+	return defaultClientPool
+}
+
+// NewGatewayEtcdStorage 从连接池获取网关的 etcd 存储，调用 Close 不会关闭共享的连接
+//
+// 未保存的网关（gatewayID 为 0）不使用连接池
+func NewGatewayEtcdStorage(gatewayID int, etcdConf base.EtcdConfig) (StorageInterface, error) {
+	if gatewayID == 0 {
+		return NewEtcdStorage(etcdConf)
+	}
+	cli, err := defaultClientPool.Get(gatewayID, etcdConf)
+	if err != nil {
+		return nil, err
+	}
+	return &EtcdV3Storage{
+		client: cli,
+		prefix: etcdConf.Prefix,
+		pooled: true,
+	}, nil
+}
+
+// etcdConfigFingerprint 计算 etcd 连接配置的摘要，prefix 不影响连接，不参与计算
+func etcdConfigFingerprint(etcdConf base.EtcdConfig) string {
+	h := sha256.New()
+	for _, item := range []string{
+		string(etcdConf.Endpoint), etcdConf.Username, etcdConf.Password,
+		etcdConf.CACert, etcdConf.CertCert, etcdConf.CertKey,
+	} {
+		h.Write([]byte(item))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Get 获取网关的 etcd client，不存在或连接配置已变更时重新建立连接
+func (p *ClientPool) Get(gatewayID int, etcdConf base.EtcdConfig) (*clientv3.Client, error) {
+	p.startOnce.Do(func() {
+		go p.runHealthCheck()
+	})
+	fingerprint := etcdConfigFingerprint(etcdConf)
+	if cli, ok := p.lookup(gatewayID, fingerprint); ok {
+		poolRequests.WithLabelValues("hit").Inc()
+		return cli, nil
+	}
+	poolRequests.WithLabelValues("miss").Inc()
+
+	cli, err := p.dial(etcdConf)
+	if err != nil {
+		poolDials.WithLabelValues("failure").Inc()
+		return nil, err
+	}
+	poolDials.WithLabelValues("success").Inc()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		_ = cli.Close()
+		return nil, PoolClosedError
+	}
+	if entry, ok := p.clients[gatewayID]; ok {
+		// 并发请求已经建立了相同配置的连接，使用已有的连接
+		if entry.fingerprint == fingerprint {
+			_ = cli.Close()
+			entry.lastUsedAt = time.Now()
+			return entry.client, nil
+		}
+		p.evictLocked(gatewayID, evictReasonConfigChanged, poolCloseGracePeriod)
+	}
+	p.clients[gatewayID] = &pooledClient{client: cli, fingerprint: fingerprint, lastUsedAt: time.Now()}
+	poolConnections.Set(float64(len(p.clients)))
+	return cli, nil
+}
+
+func (p *ClientPool) lookup(gatewayID int, fingerprint string) (*clientv3.Client, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	entry, ok := p.clients[gatewayID]
+	if !ok || entry.fingerprint != fingerprint {
+		return nil, false
+	}
+	entry.lastUsedAt = time.Now()
+	return entry.client, true
+}
+
+// Invalidate 移除网关的连接，网关的 etcd 配置变更或网关删除时调用
+func (p *ClientPool) Invalidate(gatewayID int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.evictLocked(gatewayID, evictReasonInvalidated, poolCloseGracePeriod)
+}
+
+// evictLocked 移除连接，grace 大于 0 时延迟关闭，调用方需持有锁
+func (p *ClientPool) evictLocked(gatewayID int, reason string, grace time.Duration) {
+	entry, ok := p.clients[gatewayID]
+	if !ok {
+		return
+	}
+	delete(p.clients, gatewayID)
+	poolConnections.Set(float64(len(p.clients)))
+	poolEvictions.WithLabelValues(reason).Inc()
+	if grace <= 0 {
+		_ = entry.client.Close()
+		return
+	}
+	time.AfterFunc(grace, func() {
+		_ = entry.client.Close()
+	})
+}
+
+// Len 当前缓存的连接数
+func (p *ClientPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.clients)
+}
+
+// Close 关闭连接池中的全部连接，之后不能再获取连接，服务退出时调用
+func (p *ClientPool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return
+	}
+	p.closed = true
+	close(p.stop)
+	for gatewayID := range p.clients {
+		p.evictLocked(gatewayID, evictReasonShutdown, 0)
+	}
+}
+
+func (p *ClientPool) runHealthCheck() {
+	defer runtime.HandlePanic()
+	ticker := time.NewTicker(poolHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.HealthCheck(context.Background())
+		}
+	}
+}
+
+// HealthCheck 关闭长时间未使用或不可用的连接，下次获取时重新建立连接
+func (p *ClientPool) HealthCheck(ctx context.Context) {
+	p.mu.Lock()
+	entries := make(map[int]*pooledClient, len(p.clients))
+	for gatewayID, entry := range p.clients {
+		if time.Since(entry.lastUsedAt) > poolIdleTimeout {
+			p.evictLocked(gatewayID, evictReasonIdle, 0)
+			continue
+		}
+		entries[gatewayID] = entry
+	}
+	p.mu.Unlock()
+
+	for gatewayID, entry := range entries {
+		err := checkClient(ctx, entry.client)
+		if err == nil {
+			continue
+		}
+		log.Warnf("etcd client of gateway %d is unhealthy: %s", gatewayID, err)
+		p.mu.Lock()
+		// 检查期间连接可能已被替换
+		if current, ok := p.clients[gatewayID]; ok && current == entry {
+			p.evictLocked(gatewayID, evictReasonUnhealthy, poolCloseGracePeriod)
+		}
+		p.mu.Unlock()
+	}
+}
+
+func checkClient(ctx context.Context, cli *clientv3.Client) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, poolHealthCheckTimeout)
+	defer cancel()
+	_, err := cli.Get(timeoutCtx, "health", clientv3.WithCountOnly())
+	return err
+}
@@ -0,0 +1,100 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/base"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+func TestClientPool(t *testing.T) {
+	_, server, endpoint, err := util.StartEmbedEtcdClientRandom(context.Background())
+	assert.NoError(t, err)
+	defer server.Close()
+
+	pool := NewClientPool()
+	defer pool.Close()
+	conf := base.EtcdConfig{Endpoint: base.Endpoint(endpoint), Prefix: "/a"}
+	cli, err := pool.Get(1, conf)
+	assert.NoError(t, err)
+
+	// prefix 不影响连接
+	conf.Prefix = "/b"
+	same, err := pool.Get(1, conf)
+	assert.NoError(t, err)
+	assert.Same(t, cli, same)
+	other, err := pool.Get(2, conf)
+	assert.NoError(t, err)
+	assert.NotSame(t, cli, other)
+	assert.Equal(t, 2, pool.Len())
+
+	// 连接配置变更后重建连接
+	conf.Username = "admin"
+	changed, err := pool.Get(1, conf)
+	assert.NoError(t, err)
+	assert.NotSame(t, cli, changed)
+	assert.Equal(t, 2, pool.Len())
+
+	pool.Invalidate(2)
+	assert.Equal(t, 1, pool.Len())
+
+	pool.HealthCheck(context.Background())
+	assert.Equal(t, 1, pool.Len())
+
+	pool.Close()
+	assert.Equal(t, 0, pool.Len())
+	_, err = pool.Get(1, conf)
+	assert.ErrorIs(t, err, PoolClosedError)
+}
+
+func TestClientPoolEvictUnhealthy(t *testing.T) {
+	_, server, endpoint, err := util.StartEmbedEtcdClientRandom(context.Background())
+	assert.NoError(t, err)
+
+	pool := NewClientPool()
+	defer pool.Close()
+	_, err = pool.Get(1, base.EtcdConfig{Endpoint: base.Endpoint(endpoint)})
+	assert.NoError(t, err)
+	server.Close()
+
+	pool.HealthCheck(context.Background())
+	assert.Equal(t, 0, pool.Len())
+}
+
+func TestEtcdStorageClosePooled(t *testing.T) {
+	_, server, endpoint, err := util.StartEmbedEtcdClientRandom(context.Background())
+	assert.NoError(t, err)
+	defer server.Close()
+
+	conf := base.EtcdConfig{Endpoint: base.Endpoint(endpoint), Prefix: "/pooled"}
+	store, err := NewGatewayEtcdStorage(10001, conf)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Close())
+	// 共享连接未被关闭
+	assert.NoError(t, store.Create(context.Background(), "routes/1", `{"id":"1"}`))
+	value, err := store.Get(context.Background(), "routes/1")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"1"}`, value)
+	DefaultClientPool().Invalidate(10001)
+}
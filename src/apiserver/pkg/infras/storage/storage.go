@@ -30,11 +30,13 @@ import (
 type StorageInterface interface {
 	Get(ctx context.Context, key string) (string, error)
 	List(ctx context.Context, key string) ([]KeyValuePair, error)
+	ListWithRevision(ctx context.Context, key string) ([]KeyValuePair, int64, error)
+	Revision(ctx context.Context) (int64, error)
 	Create(ctx context.Context, key, val string) error
 	Update(ctx context.Context, key, val string) error
 	BatchDelete(ctx context.Context, keys []string) error
 	BatchCreate(ctx context.Context, resource map[string]string) error
-	Watch(ctx context.Context, key string) <-chan WatchResponse
+	Watch(ctx context.Context, key string, revision int64) <-chan WatchResponse
 	Close() error
 
 	// NOTE: this is a temporary method to get the etcd client
@@ -46,6 +48,10 @@ type WatchResponse struct {
 	Events   []Event
 	Error    error
 	Canceled bool
+	// Revision 本次响应对应的 etcd revision
+	Revision int64
+	// CompactRevision 请求的 revision 已被压缩时，返回当前的压缩 revision
+	CompactRevision int64
 }
 
 // KeyValuePair ...
@@ -21,16 +21,30 @@ package middleware
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/spf13/cast"
 
 	gatewaybiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/gateway"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
 )
 
-// GatewayAccess  网关权限校验
-func GatewayAccess() gin.HandlerFunc {
+// webGatewayPathPrefix web 网关路由前缀
+const webGatewayPathPrefix = "/api/v1/web/gateways/:gateway_id"
+
+// GatewayRoutePermissions 网关路由所需的权限，key 为 GatewayRoutePermissionKey 生成的 "METHOD 路由"
+type GatewayRoutePermissions map[string]constant.GatewayPermission
+
+// GatewayRoutePermissionKey 生成路由权限 key，path 为去掉网关前缀的路由，如 /routes/:id/
+func GatewayRoutePermissionKey(method, path string) string {
+	return method + " " + path
+}
+
+// GatewayAccess  网关权限校验：根据用户在网关中的角色校验路由所需的权限，未配置权限的路由拒绝访问
+func GatewayAccess(routePermissions GatewayRoutePermissions) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		gatewayID := c.Param("gateway_id")
 		gatewayInfo, err := gatewaybiz.GetGateway(c.Request.Context(), cast.ToInt(gatewayID))
@@ -40,11 +54,30 @@ func GatewayAccess() gin.HandlerFunc {
 			return
 		}
 		// 校验权限
-		if !gatewayInfo.HasPermission(ginx.GetUserID(c)) {
+		role, err := gatewaybiz.GetGatewayRole(c.Request.Context(), gatewayInfo, ginx.GetUserID(c))
+		if err != nil {
+			ginx.SystemErrorJSONResponse(c, err)
+			c.Abort()
+			return
+		}
+		if role == "" {
 			ginx.ForbiddenJSONResponse(c, errors.New("没有权限访问该网关"))
 			c.Abort()
 			return
 		}
+		routePath := strings.TrimPrefix(c.FullPath(), webGatewayPathPrefix)
+		permission, ok := routePermissions[GatewayRoutePermissionKey(c.Request.Method, routePath)]
+		if !ok {
+			ginx.ForbiddenJSONResponse(c, fmt.Errorf("路由 %s %s 未配置权限", c.Request.Method, routePath))
+			c.Abort()
+			return
+		}
+		if !role.HasPermission(permission) {
+			ginx.ForbiddenJSONResponse(c, fmt.Errorf("当前角色 %s 没有该网关的 %s 权限", role, permission))
+			c.Abort()
+			return
+		}
+		ginx.SetGatewayRole(c, role)
 		ginx.SetGatewayInfo(c, gatewayInfo)
 		ginx.SetValidateErrorInfo(c)
 		c.Next()
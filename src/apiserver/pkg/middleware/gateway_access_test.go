@@ -0,0 +1,120 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/config"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/data"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/tests/util"
+)
+
+func TestGatewayAccess(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	if err := cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW"); err != nil {
+		t.Fatal(err)
+	}
+	util.InitEmbedDb()
+	config.G = &config.Config{}
+
+	gateway := data.Gateway1WithBkAPISIX()
+	gateway.Name = "gateway-access"
+	gateway.Maintainers = []string{"maintainer", "downgraded"}
+	assert.NoError(t, repo.Gateway.WithContext(context.Background()).Create(gateway))
+	for username, role := range map[string]constant.GatewayRole{
+		"viewer":     constant.GatewayRoleViewer,
+		"editor":     constant.GatewayRoleEditor,
+		"publisher":  constant.GatewayRolePublisher,
+		"downgraded": constant.GatewayRoleViewer,
+	} {
+		err := repo.GatewayMember.WithContext(context.Background()).Create(&model.GatewayMember{
+			GatewayID: gateway.ID,
+			Username:  username,
+			Role:      role,
+		})
+		assert.NoError(t, err)
+	}
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		ginx.SetUserID(c, c.GetHeader("X-User"))
+	})
+	group := router.Group(webGatewayPathPrefix)
+	group.Use(GatewayAccess(GatewayRoutePermissions{
+		"GET /routes/":       constant.GatewayPermissionView,
+		"PUT /routes/:id/":   constant.GatewayPermissionView,
+		"POST /routes/":      constant.GatewayPermissionEdit,
+		"POST /publish/":     constant.GatewayPermissionPublish,
+		"DELETE /members/1/": constant.GatewayPermissionManage,
+	}))
+	group.Use(ResourceOperationCheck())
+	handler := func(c *gin.Context) {
+		assert.NotEmpty(t, ginx.GetGatewayRole(c))
+		c.Status(http.StatusOK)
+	}
+	group.GET("/routes/", handler)
+	group.PUT("/routes/:id/", handler)
+	group.POST("/routes/", handler)
+	group.POST("/publish/", handler)
+	group.DELETE("/members/1/", handler)
+	group.GET("/unmapped/", handler)
+
+	tests := []struct {
+		user       string
+		method     string
+		path       string
+		statusCode int
+	}{
+		{"viewer", http.MethodGet, "/routes/", http.StatusOK},
+		{"viewer", http.MethodPost, "/routes/", http.StatusForbidden},
+		{"editor", http.MethodPost, "/routes/", http.StatusOK},
+		{"editor", http.MethodPost, "/publish/", http.StatusForbidden},
+		{"publisher", http.MethodPost, "/publish/", http.StatusOK},
+		{"publisher", http.MethodDelete, "/members/1/", http.StatusForbidden},
+		{"maintainer", http.MethodDelete, "/members/1/", http.StatusOK},
+		{"downgraded", http.MethodPost, "/routes/", http.StatusForbidden},
+		{"stranger", http.MethodGet, "/routes/", http.StatusForbidden},
+		{"maintainer", http.MethodGet, "/unmapped/", http.StatusForbidden},
+		// 资源变更需要编辑权限
+		{"viewer", http.MethodPut, "/routes/route-id/", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %s %s", tt.user, tt.method, tt.path), func(t *testing.T) {
+			url := fmt.Sprintf("/api/v1/web/gateways/%d%s", gateway.ID, tt.path)
+			req, _ := http.NewRequest(tt.method, url, nil)
+			req.Header.Set("X-User", tt.user)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.statusCode, w.Code, w.Body.String())
+		})
+	}
+}
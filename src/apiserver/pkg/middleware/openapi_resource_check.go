@@ -35,6 +35,7 @@ import (
 	resourcebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resource"
 	resourcevalidationbiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/resourcevalidation"
 	schemabiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/schema"
+	variablebiz "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/biz/variable"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/status"
@@ -163,12 +164,24 @@ func OpenAPIResourceCheck() gin.HandlerFunc {
 				resolvedID,
 			))
 
+			configRawForValidation, err = variablebiz.ResolveForGateway(c.Request.Context(), configRawForValidation)
+			if err != nil {
+				ginx.SystemErrorJSONResponse(c, err)
+				c.Abort()
+				return
+			}
 			if err = databaseValidator.Validate(configRawForValidation); err != nil {
 				logging.Errorf("database payload validate failed, err: %v", err)
 				ginx.BadRequestErrorJSONResponse(c, err)
 				c.Abort()
 				return
 			}
+			// 校验插件中引用的 secret 是否存在
+			if err = resourcebiz.CheckSecretReferences(c.Request.Context(), configRawForValidation); err != nil {
+				ginx.BadRequestErrorJSONResponse(c, errors.Wrapf(err, "config validate failed"))
+				c.Abort()
+				return
+			}
 
 			// 校验关联数据是否存在
 			var resourceAssociateIDInfo serializer.ResourceAssociateID
@@ -20,6 +20,7 @@
 package middleware
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -40,7 +41,7 @@ func ResourceOperationCheck() gin.HandlerFunc {
 			c.Next()
 			return
 		}
-		pathPrefix := "/api/v1/web/gateways/:gateway_id/"
+		pathPrefix := webGatewayPathPrefix + "/"
 		resourcePath, ok := strings.CutPrefix(c.FullPath(), pathPrefix)
 		if !ok {
 			c.Next()
@@ -56,6 +57,12 @@ func ResourceOperationCheck() gin.HandlerFunc {
 			c.Next()
 			return
 		}
+		// 变更资源需要编辑权限
+		if !ginx.GetGatewayRole(c).HasPermission(constant.GatewayPermissionEdit) {
+			ginx.ForbiddenJSONResponse(c, errors.New("没有编辑该网关资源的权限"))
+			c.Abort()
+			return
+		}
 		resourceId := c.Param("id")
 		resourceInfo, err := resourcebiz.GetResourceByID(c.Request.Context(), resourceType, resourceId)
 		if err != nil {
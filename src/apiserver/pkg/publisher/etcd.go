@@ -23,9 +23,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
+
+	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/database"
 	log "github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/logging"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
@@ -33,6 +37,9 @@ import (
 	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/version"
 )
 
+// batchApplyFinishTimeout BatchApply 中回滚 etcd 及更新发布日志的超时时间
+const batchApplyFinishTimeout = 30 * time.Second
+
 // EtcdPublisher ...
 type EtcdPublisher struct {
 	ctx       context.Context
@@ -49,7 +56,7 @@ var _ PInterface = &EtcdPublisher{}
 
 // NewEtcdPublisher 创建 etcd publisher
 func NewEtcdPublisher(ctx context.Context, gatewayInfo *model.Gateway) (*EtcdPublisher, error) {
-	etcdStore, err := storage.NewEtcdStorage(gatewayInfo.EtcdConfig.EtcdConfig)
+	etcdStore, err := storage.NewGatewayEtcdStorage(gatewayInfo.ID, gatewayInfo.EtcdConfig.EtcdConfig)
 	if err != nil {
 		log.ErrorFWithContext(ctx, "init etcd failed: %s", err)
 		return nil, fmt.Errorf("init etcd failed: %w", err)
@@ -77,8 +84,17 @@ func (s *EtcdPublisher) List(ctx context.Context, prefix string) (any, error) {
 }
 
 func (s *EtcdPublisher) buildETCDValidator(resourceType constant.APISIXResource) (schema.Validator, error) {
-	apisixVersion, _ := version.ToXVersion(s.gatewayInfo.APISIXVersion)
-	customizePluginSchemaMap := GetCustomizePluginSchemaMap(s.ctx, s.gatewayInfo.ID)
+	return NewETCDValidator(s.ctx, s.gatewayInfo, resourceType)
+}
+
+// NewETCDValidator 创建发布时校验写入 etcd 的资源配置所使用的校验器
+func NewETCDValidator(
+	ctx context.Context,
+	gatewayInfo *model.Gateway,
+	resourceType constant.APISIXResource,
+) (schema.Validator, error) {
+	apisixVersion, _ := version.ToXVersion(gatewayInfo.APISIXVersion)
+	customizePluginSchemaMap := GetCustomizePluginSchemaMap(ctx, gatewayInfo.ID)
 	return schema.NewAPISIXJsonSchemaValidator(
 		apisixVersion,
 		resourceType,
@@ -115,7 +131,7 @@ func (s *EtcdPublisher) Create(ctx context.Context, resource ResourceOperation)
 	if err := s.etcdStore.Create(ctx, resource.GetKey(), string(resource.Config)); err != nil {
 		return err
 	}
-
+	recordOperations(ctx, []ResourceOperation{resource}, false)
 	return nil
 }
 
@@ -135,7 +151,7 @@ func (s *EtcdPublisher) Update(ctx context.Context, resource ResourceOperation,
 	if err := s.etcdStore.Update(ctx, resource.GetKey(), string(resource.Config)); err != nil {
 		return err
 	}
-
+	recordOperations(ctx, []ResourceOperation{resource}, false)
 	return nil
 }
 
@@ -148,9 +164,10 @@ func (s *EtcdPublisher) BatchCreate(ctx context.Context, resources []ResourceOpe
 	for _, resource := range resources {
 		resourcesMap[resource.GetKey()] = string(resource.Config)
 	}
-	if err := s.etcdStore.BatchCreate(ctx, resourcesMap); err != nil {
+	if err := s.etcdStore.BatchCreate(s.withPublishJournal(ctx), resourcesMap); err != nil {
 		return err
 	}
+	recordOperations(ctx, resources, false)
 	return nil
 }
 
@@ -163,9 +180,10 @@ func (s *EtcdPublisher) BatchUpdate(ctx context.Context, resources []ResourceOpe
 	for _, resource := range resources {
 		resourcesMap[resource.GetKey()] = string(resource.Config)
 	}
-	if err := s.etcdStore.BatchCreate(ctx, resourcesMap); err != nil {
+	if err := s.etcdStore.BatchCreate(s.withPublishJournal(ctx), resourcesMap); err != nil {
 		return err
 	}
+	recordOperations(ctx, resources, false)
 	return nil
 }
 
@@ -175,57 +193,71 @@ func (s *EtcdPublisher) BatchDelete(ctx context.Context, resources []ResourceOpe
 	for _, resource := range resources {
 		keys = append(keys, resource.GetKey())
 	}
-	return s.etcdStore.BatchDelete(ctx, keys)
+	if err := s.etcdStore.BatchDelete(s.withPublishJournal(ctx), keys); err != nil {
+		return err
+	}
+	recordOperations(ctx, resources, true)
+	return nil
 }
 
-// nolint:unused
-// func (s *EtcdPublisher) listAndWatch() error {
-// 	lc, lcancel := context.WithTimeout(context.TODO(), 5*time.Second)
-// 	defer lcancel()
-// 	ret, err := s.etcdStore.List(lc, s.Prefix)
-// 	if err != nil {
-// 		return err
-// 	}
-// 	for i := range ret {
-// 		key := ret[i].Key[len(s.Prefix)+1:]
-// 		print(key)
-// 		// todo: 同步逻辑
-// 	}
-
-// 	// start watch
-// 	s.cancel = s.watch()
-
-// 	return nil
-// }
+// BatchApply 在一次分批写入中写入 puts 并删除 deletes，全部写入后在数据库事务中执行 commit（如更新编辑区）
+//
+// 写入记录在发布日志中，日志在 commit 的同一事务中标记为写入成功：commit 失败时回滚本次写入的 etcd 数据，
+// 进程在 commit 提交前退出时，由中断发布的自动回滚恢复 etcd 中的数据；commit 已提交的发布不会被自动回滚
+func (s *EtcdPublisher) BatchApply(
+	ctx context.Context,
+	puts, deletes []ResourceOperation,
+	commit func(tx *gorm.DB) error,
+) error {
+	if err := s.validatePublishOperations(puts); err != nil {
+		return err
+	}
+	ops := make([]storage.BatchOperation, 0, len(puts)+len(deletes))
+	for _, resource := range puts {
+		ops = append(ops, storage.BatchOperation{
+			Key:   fmt.Sprintf("%s/%s", s.Prefix, resource.GetKey()),
+			Value: string(resource.Config),
+		})
+	}
+	for _, resource := range deletes {
+		ops = append(ops, storage.BatchOperation{Key: fmt.Sprintf("%s/%s", s.Prefix, resource.GetKey()), Delete: true})
+	}
+	journal := &publishJournal{gatewayID: s.gatewayInfo.ID, pending: true}
+	client := s.etcdStore.GetClient()
+	if len(ops) > 0 {
+		if err := storage.AtomicBatchOperate(storage.WithBatchJournal(ctx, journal), client, ops); err != nil {
+			return err
+		}
+	}
+	commitErr := database.Client().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := commit(tx); err != nil {
+			return err
+		}
+		if journal.journal == nil {
+			return nil
+		}
+		return journal.markSucceeded(ctx, repo.Use(tx))
+	})
+	if commitErr == nil {
+		recordOperations(ctx, puts, false)
+		recordOperations(ctx, deletes, true)
+		return nil
+	}
 
-// nolint:unused
-// func (s *EtcdPublisher) watch() context.CancelFunc {
-// 	c, cancel := context.WithCancel(context.TODO())
-// 	ch := s.etcdStore.Watch(c, s.Prefix)
-// 	go func() {
-// 		defer func() {
-// 			if !s.closing {
-// 				log.Errorf("etcd watch exception closed, restarting: prefix: %s", s.Prefix)
-// 			}
-// 		}()
-// 		defer runtime.HandlePanic()
-// 		for event := range ch {
-// 			if event.Canceled {
-// 				log.Warnf("etcd watch failed: %s", event.Error)
-// 				return
-// 			}
-// 			for i := range event.Events {
-// 				switch event.Events[i].Type {
-// 				case storage.EventTypePut:
-// 					// todo: 同步逻辑
-// 				case storage.EventTypeDelete:
-// 					// todo: 同步逻辑
-// 				}
-// 			}
-// 		}
-// 	}()
-// 	return cancel
-// }
+	// etcd 已写入，后续的回滚及日志更新不受请求取消的影响
+	finishCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), batchApplyFinishTimeout)
+	defer cancel()
+	revertErr := storage.RevertBatchOperations(finishCtx, client, ops)
+	if journal.journal != nil {
+		if err := journal.RolledBack(finishCtx, commitErr, revertErr); err != nil {
+			log.ErrorFWithContext(ctx, "record publish journal failed: %s", err)
+		}
+	}
+	if revertErr != nil {
+		return fmt.Errorf("%w，回滚 etcd 失败: %s", commitErr, revertErr.Error())
+	}
+	return commitErr
+}
 
 // Close 关闭
 func (s *EtcdPublisher) Close() error {
@@ -0,0 +1,99 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package publisher
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/infras/storage"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/repo"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/ginx"
+)
+
+// publishJournal 将分批写入 etcd 的日志记录到 gateway_publish_journal
+type publishJournal struct {
+	gatewayID int
+	journal   *model.GatewayPublishJournal
+	// pending 为 true 时全部批次写入后日志仍保持写入中，在调用方的提交事务中标记成功
+	pending bool
+}
+
+var _ storage.BatchJournal = &publishJournal{}
+
+// withPublishJournal 为批量写入设置发布日志，仅在需要分批写入时记录
+func (s *EtcdPublisher) withPublishJournal(ctx context.Context) context.Context {
+	if s.gatewayInfo == nil {
+		return ctx
+	}
+	return storage.WithBatchJournal(ctx, &publishJournal{gatewayID: s.gatewayInfo.ID})
+}
+
+// Begin ...
+func (j *publishJournal) Begin(ctx context.Context, ops []storage.BatchOperation, chunks int) error {
+	j.journal = &model.GatewayPublishJournal{
+		GatewayID:      j.gatewayID,
+		Operator:       ginx.GetUserIDFromContext(ctx),
+		Status:         constant.PublishJournalStatusRunning,
+		OperationCount: len(ops),
+		ChunkCount:     chunks,
+	}
+	if err := j.journal.SetOperations(ops); err != nil {
+		return err
+	}
+	return repo.GatewayPublishJournal.WithContext(ctx).Create(j.journal)
+}
+
+// ChunkApplied ...
+func (j *publishJournal) ChunkApplied(ctx context.Context, applied int) error {
+	u := repo.GatewayPublishJournal
+	_, err := u.WithContext(ctx).Where(u.ID.Eq(j.journal.ID)).UpdateSimple(u.AppliedChunks.Value(applied))
+	return err
+}
+
+// Succeeded ...
+func (j *publishJournal) Succeeded(ctx context.Context) error {
+	if j.pending {
+		return nil
+	}
+	return j.markSucceeded(ctx, repo.Q)
+}
+
+// markSucceeded 通过 q（可为事务）将日志标记为写入成功
+func (j *publishJournal) markSucceeded(ctx context.Context, q *repo.Query) error {
+	u := q.GatewayPublishJournal
+	_, err := u.WithContext(ctx).Where(u.ID.Eq(j.journal.ID)).
+		UpdateSimple(u.Status.Value(string(constant.PublishJournalStatusSucceeded)))
+	return err
+}
+
+// RolledBack ...
+func (j *publishJournal) RolledBack(ctx context.Context, cause error, rollbackErr error) error {
+	u := repo.GatewayPublishJournal
+	status := constant.PublishJournalStatusRolledBack
+	message := cause.Error()
+	if rollbackErr != nil {
+		status = constant.PublishJournalStatusFailed
+		message += "; rollback: " + rollbackErr.Error()
+	}
+	_, err := u.WithContext(ctx).Where(u.ID.Eq(j.journal.ID)).
+		UpdateSimple(u.Status.Value(string(status)), u.Error.Value(message))
+	return err
+}
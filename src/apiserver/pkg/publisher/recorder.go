@@ -0,0 +1,75 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package publisher
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// RecordedOperation 一次发布中写入 etcd 的操作，Delete 为 true 时表示删除
+type RecordedOperation struct {
+	ResourceOperation
+	Delete bool
+}
+
+// OperationRecorder 记录一次发布中写入 etcd 的操作，用于在上一个发布版本的快照上生成新的快照
+type OperationRecorder struct {
+	mu  sync.Mutex
+	ops map[string]RecordedOperation // etcd key（不包含网关前缀）-> 最后一次写入
+}
+
+type operationRecorderKey struct{}
+
+// WithOperationRecorder 为本次发布设置写入记录，ctx 中已有记录时复用
+func WithOperationRecorder(ctx context.Context) (context.Context, *OperationRecorder) {
+	if recorder, ok := ctx.Value(operationRecorderKey{}).(*OperationRecorder); ok {
+		return ctx, recorder
+	}
+	recorder := &OperationRecorder{ops: make(map[string]RecordedOperation)}
+	return context.WithValue(ctx, operationRecorderKey{}, recorder), recorder
+}
+
+// Operations 按 key 排序返回记录的操作，同一个 key 只保留最后一次写入
+func (r *OperationRecorder) Operations() []RecordedOperation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ops := make([]RecordedOperation, 0, len(r.ops))
+	for _, op := range r.ops {
+		ops = append(ops, op)
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].GetKey() < ops[j].GetKey()
+	})
+	return ops
+}
+
+// recordOperations 记录写入成功的操作，ctx 中没有设置写入记录时忽略
+func recordOperations(ctx context.Context, resources []ResourceOperation, isDelete bool) {
+	recorder, ok := ctx.Value(operationRecorderKey{}).(*OperationRecorder)
+	if !ok {
+		return
+	}
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	for _, resource := range resources {
+		recorder.ops[resource.GetKey()] = RecordedOperation{ResourceOperation: resource, Delete: isDelete}
+	}
+}
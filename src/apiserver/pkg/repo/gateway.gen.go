@@ -56,6 +56,7 @@ func newGateway(db *gorm.DB, opts ...gen.DOOption) gateway {
 	_gateway.Token = field.NewString(tableName, "token")
 	_gateway.ReadOnly = field.NewBool(tableName, "read_only")
 	_gateway.LastSyncedAt = field.NewTime(tableName, "last_synced_at")
+	_gateway.SyncedRevision = field.NewInt64(tableName, "synced_revision")
 	_gateway.Creator = field.NewString(tableName, "creator")
 	_gateway.Updater = field.NewString(tableName, "updater")
 	_gateway.CreatedAt = field.NewTime(tableName, "created_at")
@@ -69,22 +70,23 @@ func newGateway(db *gorm.DB, opts ...gen.DOOption) gateway {
 type gateway struct {
 	gatewayDo gatewayDo
 
-	ALL           field.Asterisk
-	ID            field.Int
-	Name          field.String
-	Mode          field.Uint8
-	Maintainers   field.Field
-	Desc          field.String
-	APISIXType    field.String
-	APISIXVersion field.String
-	EtcdConfig    field.Field
-	Token         field.String
-	ReadOnly      field.Bool
-	LastSyncedAt  field.Time
-	Creator       field.String
-	Updater       field.String
-	CreatedAt     field.Time
-	UpdatedAt     field.Time
+	ALL            field.Asterisk
+	ID             field.Int
+	Name           field.String
+	Mode           field.Uint8
+	Maintainers    field.Field
+	Desc           field.String
+	APISIXType     field.String
+	APISIXVersion  field.String
+	EtcdConfig     field.Field
+	Token          field.String
+	ReadOnly       field.Bool
+	LastSyncedAt   field.Time
+	SyncedRevision field.Int64
+	Creator        field.String
+	Updater        field.String
+	CreatedAt      field.Time
+	UpdatedAt      field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -114,6 +116,7 @@ func (g *gateway) updateTableName(table string) *gateway {
 	g.Token = field.NewString(table, "token")
 	g.ReadOnly = field.NewBool(table, "read_only")
 	g.LastSyncedAt = field.NewTime(table, "last_synced_at")
+	g.SyncedRevision = field.NewInt64(table, "synced_revision")
 	g.Creator = field.NewString(table, "creator")
 	g.Updater = field.NewString(table, "updater")
 	g.CreatedAt = field.NewTime(table, "created_at")
@@ -147,7 +150,7 @@ func (g *gateway) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (g *gateway) fillFieldMap() {
-	g.fieldMap = make(map[string]field.Expr, 15)
+	g.fieldMap = make(map[string]field.Expr, 16)
 	g.fieldMap["id"] = g.ID
 	g.fieldMap["name"] = g.Name
 	g.fieldMap["mode"] = g.Mode
@@ -159,6 +162,7 @@ func (g *gateway) fillFieldMap() {
 	g.fieldMap["token"] = g.Token
 	g.fieldMap["read_only"] = g.ReadOnly
 	g.fieldMap["last_synced_at"] = g.LastSyncedAt
+	g.fieldMap["synced_revision"] = g.SyncedRevision
 	g.fieldMap["creator"] = g.Creator
 	g.fieldMap["updater"] = g.Updater
 	g.fieldMap["created_at"] = g.CreatedAt
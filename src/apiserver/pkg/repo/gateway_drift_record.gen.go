@@ -0,0 +1,529 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+func newGatewayDriftRecord(db *gorm.DB, opts ...gen.DOOption) gatewayDriftRecord {
+	_gatewayDriftRecord := gatewayDriftRecord{}
+
+	_gatewayDriftRecord.gatewayDriftRecordDo.UseDB(db, opts...)
+	_gatewayDriftRecord.gatewayDriftRecordDo.UseModel(&model.GatewayDriftRecord{})
+
+	tableName := _gatewayDriftRecord.gatewayDriftRecordDo.TableName()
+	_gatewayDriftRecord.ALL = field.NewAsterisk(tableName)
+	_gatewayDriftRecord.ID = field.NewInt64(tableName, "id")
+	_gatewayDriftRecord.GatewayID = field.NewInt(tableName, "gateway_id")
+	_gatewayDriftRecord.Type = field.NewString(tableName, "type")
+	_gatewayDriftRecord.ResourceID = field.NewString(tableName, "resource_id")
+	_gatewayDriftRecord.Name = field.NewString(tableName, "name")
+	_gatewayDriftRecord.EtcdKey = field.NewString(tableName, "etcd_key")
+	_gatewayDriftRecord.DriftType = field.NewString(tableName, "drift_type")
+	_gatewayDriftRecord.Status = field.NewString(tableName, "status")
+	_gatewayDriftRecord.ExpectedConfig = field.NewField(tableName, "expected_config")
+	_gatewayDriftRecord.ActualConfig = field.NewField(tableName, "actual_config")
+	_gatewayDriftRecord.ReleaseVersionID = field.NewInt64(tableName, "release_version_id")
+	_gatewayDriftRecord.ModRevision = field.NewInt(tableName, "mod_revision")
+	_gatewayDriftRecord.DetectedAt = field.NewTime(tableName, "detected_at")
+	_gatewayDriftRecord.ResolvedAt = field.NewTime(tableName, "resolved_at")
+	_gatewayDriftRecord.CreatedAt = field.NewTime(tableName, "created_at")
+	_gatewayDriftRecord.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_gatewayDriftRecord.fillFieldMap()
+
+	return _gatewayDriftRecord
+}
+
+type gatewayDriftRecord struct {
+	gatewayDriftRecordDo gatewayDriftRecordDo
+
+	ALL              field.Asterisk
+	ID               field.Int64
+	GatewayID        field.Int
+	Type             field.String
+	ResourceID       field.String
+	Name             field.String
+	EtcdKey          field.String
+	DriftType        field.String
+	Status           field.String
+	ExpectedConfig   field.Field
+	ActualConfig     field.Field
+	ReleaseVersionID field.Int64
+	ModRevision      field.Int
+	DetectedAt       field.Time
+	ResolvedAt       field.Time
+	CreatedAt        field.Time
+	UpdatedAt        field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+// Table ...
+func (g gatewayDriftRecord) Table(newTableName string) *gatewayDriftRecord {
+	g.gatewayDriftRecordDo.UseTable(newTableName)
+	return g.updateTableName(newTableName)
+}
+
+// As ...
+func (g gatewayDriftRecord) As(alias string) *gatewayDriftRecord {
+	g.gatewayDriftRecordDo.DO = *(g.gatewayDriftRecordDo.As(alias).(*gen.DO))
+	return g.updateTableName(alias)
+}
+
+func (g *gatewayDriftRecord) updateTableName(table string) *gatewayDriftRecord {
+	g.ALL = field.NewAsterisk(table)
+	g.ID = field.NewInt64(table, "id")
+	g.GatewayID = field.NewInt(table, "gateway_id")
+	g.Type = field.NewString(table, "type")
+	g.ResourceID = field.NewString(table, "resource_id")
+	g.Name = field.NewString(table, "name")
+	g.EtcdKey = field.NewString(table, "etcd_key")
+	g.DriftType = field.NewString(table, "drift_type")
+	g.Status = field.NewString(table, "status")
+	g.ExpectedConfig = field.NewField(table, "expected_config")
+	g.ActualConfig = field.NewField(table, "actual_config")
+	g.ReleaseVersionID = field.NewInt64(table, "release_version_id")
+	g.ModRevision = field.NewInt(table, "mod_revision")
+	g.DetectedAt = field.NewTime(table, "detected_at")
+	g.ResolvedAt = field.NewTime(table, "resolved_at")
+	g.CreatedAt = field.NewTime(table, "created_at")
+	g.UpdatedAt = field.NewTime(table, "updated_at")
+
+	g.fillFieldMap()
+
+	return g
+}
+
+// WithContext ...
+func (g *gatewayDriftRecord) WithContext(ctx context.Context) IGatewayDriftRecordDo {
+	return g.gatewayDriftRecordDo.WithContext(ctx)
+}
+
+// TableName ...
+func (g gatewayDriftRecord) TableName() string { return g.gatewayDriftRecordDo.TableName() }
+
+// Alias ...
+func (g gatewayDriftRecord) Alias() string { return g.gatewayDriftRecordDo.Alias() }
+
+// Columns ...
+func (g gatewayDriftRecord) Columns(cols ...field.Expr) gen.Columns {
+	return g.gatewayDriftRecordDo.Columns(cols...)
+}
+
+// GetFieldByName ...
+func (g *gatewayDriftRecord) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := g.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (g *gatewayDriftRecord) fillFieldMap() {
+	g.fieldMap = make(map[string]field.Expr, 16)
+	g.fieldMap["id"] = g.ID
+	g.fieldMap["gateway_id"] = g.GatewayID
+	g.fieldMap["type"] = g.Type
+	g.fieldMap["resource_id"] = g.ResourceID
+	g.fieldMap["name"] = g.Name
+	g.fieldMap["etcd_key"] = g.EtcdKey
+	g.fieldMap["drift_type"] = g.DriftType
+	g.fieldMap["status"] = g.Status
+	g.fieldMap["expected_config"] = g.ExpectedConfig
+	g.fieldMap["actual_config"] = g.ActualConfig
+	g.fieldMap["release_version_id"] = g.ReleaseVersionID
+	g.fieldMap["mod_revision"] = g.ModRevision
+	g.fieldMap["detected_at"] = g.DetectedAt
+	g.fieldMap["resolved_at"] = g.ResolvedAt
+	g.fieldMap["created_at"] = g.CreatedAt
+	g.fieldMap["updated_at"] = g.UpdatedAt
+}
+
+func (g gatewayDriftRecord) clone(db *gorm.DB) gatewayDriftRecord {
+	g.gatewayDriftRecordDo.ReplaceConnPool(db.Statement.ConnPool)
+	return g
+}
+
+func (g gatewayDriftRecord) replaceDB(db *gorm.DB) gatewayDriftRecord {
+	g.gatewayDriftRecordDo.ReplaceDB(db)
+	return g
+}
+
+type gatewayDriftRecordDo struct{ gen.DO }
+
+// IGatewayDriftRecordDo ...
+type IGatewayDriftRecordDo interface {
+	gen.SubQuery
+	Debug() IGatewayDriftRecordDo
+	WithContext(ctx context.Context) IGatewayDriftRecordDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IGatewayDriftRecordDo
+	WriteDB() IGatewayDriftRecordDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IGatewayDriftRecordDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IGatewayDriftRecordDo
+	Not(conds ...gen.Condition) IGatewayDriftRecordDo
+	Or(conds ...gen.Condition) IGatewayDriftRecordDo
+	Select(conds ...field.Expr) IGatewayDriftRecordDo
+	Where(conds ...gen.Condition) IGatewayDriftRecordDo
+	Order(conds ...field.Expr) IGatewayDriftRecordDo
+	Distinct(cols ...field.Expr) IGatewayDriftRecordDo
+	Omit(cols ...field.Expr) IGatewayDriftRecordDo
+	Join(table schema.Tabler, on ...field.Expr) IGatewayDriftRecordDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IGatewayDriftRecordDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IGatewayDriftRecordDo
+	Group(cols ...field.Expr) IGatewayDriftRecordDo
+	Having(conds ...gen.Condition) IGatewayDriftRecordDo
+	Limit(limit int) IGatewayDriftRecordDo
+	Offset(offset int) IGatewayDriftRecordDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IGatewayDriftRecordDo
+	Unscoped() IGatewayDriftRecordDo
+	Create(values ...*model.GatewayDriftRecord) error
+	CreateInBatches(values []*model.GatewayDriftRecord, batchSize int) error
+	Save(values ...*model.GatewayDriftRecord) error
+	First() (*model.GatewayDriftRecord, error)
+	Take() (*model.GatewayDriftRecord, error)
+	Last() (*model.GatewayDriftRecord, error)
+	Find() ([]*model.GatewayDriftRecord, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.GatewayDriftRecord, err error)
+	FindInBatches(result *[]*model.GatewayDriftRecord, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*model.GatewayDriftRecord) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IGatewayDriftRecordDo
+	Assign(attrs ...field.AssignExpr) IGatewayDriftRecordDo
+	Joins(fields ...field.RelationField) IGatewayDriftRecordDo
+	Preload(fields ...field.RelationField) IGatewayDriftRecordDo
+	FirstOrInit() (*model.GatewayDriftRecord, error)
+	FirstOrCreate() (*model.GatewayDriftRecord, error)
+	FindByPage(offset int, limit int) (result []*model.GatewayDriftRecord, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IGatewayDriftRecordDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+// Debug ...
+func (g gatewayDriftRecordDo) Debug() IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Debug())
+}
+
+// WithContext ...
+func (g gatewayDriftRecordDo) WithContext(ctx context.Context) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.WithContext(ctx))
+}
+
+// ReadDB ...
+func (g gatewayDriftRecordDo) ReadDB() IGatewayDriftRecordDo {
+	return g.Clauses(dbresolver.Read)
+}
+
+// WriteDB ...
+func (g gatewayDriftRecordDo) WriteDB() IGatewayDriftRecordDo {
+	return g.Clauses(dbresolver.Write)
+}
+
+// Session ...
+func (g gatewayDriftRecordDo) Session(config *gorm.Session) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Session(config))
+}
+
+// Clauses ...
+func (g gatewayDriftRecordDo) Clauses(conds ...clause.Expression) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Clauses(conds...))
+}
+
+// Returning ...
+func (g gatewayDriftRecordDo) Returning(value interface{}, columns ...string) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Returning(value, columns...))
+}
+
+// Not ...
+func (g gatewayDriftRecordDo) Not(conds ...gen.Condition) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Not(conds...))
+}
+
+// Or ...
+func (g gatewayDriftRecordDo) Or(conds ...gen.Condition) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Or(conds...))
+}
+
+// Select ...
+func (g gatewayDriftRecordDo) Select(conds ...field.Expr) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Select(conds...))
+}
+
+// Where ...
+func (g gatewayDriftRecordDo) Where(conds ...gen.Condition) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Where(conds...))
+}
+
+// Order ...
+func (g gatewayDriftRecordDo) Order(conds ...field.Expr) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Order(conds...))
+}
+
+// Distinct ...
+func (g gatewayDriftRecordDo) Distinct(cols ...field.Expr) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Distinct(cols...))
+}
+
+// Omit ...
+func (g gatewayDriftRecordDo) Omit(cols ...field.Expr) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Omit(cols...))
+}
+
+// Join ...
+func (g gatewayDriftRecordDo) Join(table schema.Tabler, on ...field.Expr) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Join(table, on...))
+}
+
+// LeftJoin ...
+func (g gatewayDriftRecordDo) LeftJoin(table schema.Tabler, on ...field.Expr) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.LeftJoin(table, on...))
+}
+
+// RightJoin ...
+func (g gatewayDriftRecordDo) RightJoin(table schema.Tabler, on ...field.Expr) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.RightJoin(table, on...))
+}
+
+// Group ...
+func (g gatewayDriftRecordDo) Group(cols ...field.Expr) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Group(cols...))
+}
+
+// Having ...
+func (g gatewayDriftRecordDo) Having(conds ...gen.Condition) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Having(conds...))
+}
+
+// Limit ...
+func (g gatewayDriftRecordDo) Limit(limit int) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Limit(limit))
+}
+
+// Offset ...
+func (g gatewayDriftRecordDo) Offset(offset int) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Offset(offset))
+}
+
+// Scopes ...
+func (g gatewayDriftRecordDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Scopes(funcs...))
+}
+
+// Unscoped ...
+func (g gatewayDriftRecordDo) Unscoped() IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Unscoped())
+}
+
+// Create ...
+func (g gatewayDriftRecordDo) Create(values ...*model.GatewayDriftRecord) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return g.DO.Create(values)
+}
+
+// CreateInBatches ...
+func (g gatewayDriftRecordDo) CreateInBatches(values []*model.GatewayDriftRecord, batchSize int) error {
+	return g.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (g gatewayDriftRecordDo) Save(values ...*model.GatewayDriftRecord) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return g.DO.Save(values)
+}
+
+// First ...
+func (g gatewayDriftRecordDo) First() (*model.GatewayDriftRecord, error) {
+	if result, err := g.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayDriftRecord), nil
+	}
+}
+
+// Take ...
+func (g gatewayDriftRecordDo) Take() (*model.GatewayDriftRecord, error) {
+	if result, err := g.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayDriftRecord), nil
+	}
+}
+
+// Last ...
+func (g gatewayDriftRecordDo) Last() (*model.GatewayDriftRecord, error) {
+	if result, err := g.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayDriftRecord), nil
+	}
+}
+
+// Find ...
+func (g gatewayDriftRecordDo) Find() ([]*model.GatewayDriftRecord, error) {
+	result, err := g.DO.Find()
+	return result.([]*model.GatewayDriftRecord), err
+}
+
+// FindInBatch ...
+func (g gatewayDriftRecordDo) FindInBatch(
+	batchSize int,
+	fc func(tx gen.Dao, batch int) error,
+) (results []*model.GatewayDriftRecord, err error) {
+	buf := make([]*model.GatewayDriftRecord, 0, batchSize)
+	err = g.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+// FindInBatches ...
+func (g gatewayDriftRecordDo) FindInBatches(
+	result *[]*model.GatewayDriftRecord,
+	batchSize int,
+	fc func(tx gen.Dao, batch int) error,
+) error {
+	return g.DO.FindInBatches(result, batchSize, fc)
+}
+
+// Attrs ...
+func (g gatewayDriftRecordDo) Attrs(attrs ...field.AssignExpr) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Attrs(attrs...))
+}
+
+// Assign ...
+func (g gatewayDriftRecordDo) Assign(attrs ...field.AssignExpr) IGatewayDriftRecordDo {
+	return g.withDO(g.DO.Assign(attrs...))
+}
+
+// Joins ...
+func (g gatewayDriftRecordDo) Joins(fields ...field.RelationField) IGatewayDriftRecordDo {
+	for _, _f := range fields {
+		g = *g.withDO(g.DO.Joins(_f))
+	}
+	return &g
+}
+
+// Preload ...
+func (g gatewayDriftRecordDo) Preload(fields ...field.RelationField) IGatewayDriftRecordDo {
+	for _, _f := range fields {
+		g = *g.withDO(g.DO.Preload(_f))
+	}
+	return &g
+}
+
+// FirstOrInit ...
+func (g gatewayDriftRecordDo) FirstOrInit() (*model.GatewayDriftRecord, error) {
+	if result, err := g.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayDriftRecord), nil
+	}
+}
+
+// FirstOrCreate ...
+func (g gatewayDriftRecordDo) FirstOrCreate() (*model.GatewayDriftRecord, error) {
+	if result, err := g.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayDriftRecord), nil
+	}
+}
+
+// FindByPage ...
+func (g gatewayDriftRecordDo) FindByPage(
+	offset int,
+	limit int,
+) (result []*model.GatewayDriftRecord, count int64, err error) {
+	result, err = g.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = g.Offset(-1).Limit(-1).Count()
+	return
+}
+
+// ScanByPage ...
+func (g gatewayDriftRecordDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = g.Count()
+	if err != nil {
+		return
+	}
+
+	err = g.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+// Scan ...
+func (g gatewayDriftRecordDo) Scan(result interface{}) (err error) {
+	return g.DO.Scan(result)
+}
+
+// Delete ...
+func (g gatewayDriftRecordDo) Delete(models ...*model.GatewayDriftRecord) (result gen.ResultInfo, err error) {
+	return g.DO.Delete(models)
+}
+
+func (g *gatewayDriftRecordDo) withDO(do gen.Dao) *gatewayDriftRecordDo {
+	g.DO = *do.(*gen.DO)
+	return g
+}
@@ -0,0 +1,494 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+func newGatewayGroup(db *gorm.DB, opts ...gen.DOOption) gatewayGroup {
+	_gatewayGroup := gatewayGroup{}
+
+	_gatewayGroup.gatewayGroupDo.UseDB(db, opts...)
+	_gatewayGroup.gatewayGroupDo.UseModel(&model.GatewayGroup{})
+
+	tableName := _gatewayGroup.gatewayGroupDo.TableName()
+	_gatewayGroup.ALL = field.NewAsterisk(tableName)
+	_gatewayGroup.ID = field.NewInt(tableName, "id")
+	_gatewayGroup.Name = field.NewString(tableName, "name")
+	_gatewayGroup.Description = field.NewString(tableName, "description")
+	_gatewayGroup.SoakSeconds = field.NewInt(tableName, "soak_seconds")
+	_gatewayGroup.Creator = field.NewString(tableName, "creator")
+	_gatewayGroup.Updater = field.NewString(tableName, "updater")
+	_gatewayGroup.CreatedAt = field.NewTime(tableName, "created_at")
+	_gatewayGroup.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_gatewayGroup.fillFieldMap()
+
+	return _gatewayGroup
+}
+
+type gatewayGroup struct {
+	gatewayGroupDo gatewayGroupDo
+
+	ALL         field.Asterisk
+	ID          field.Int
+	Name        field.String
+	Description field.String
+	SoakSeconds field.Int
+	Creator     field.String
+	Updater     field.String
+	CreatedAt   field.Time
+	UpdatedAt   field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+// Table ...
+func (g gatewayGroup) Table(newTableName string) *gatewayGroup {
+	g.gatewayGroupDo.UseTable(newTableName)
+	return g.updateTableName(newTableName)
+}
+
+// As ...
+func (g gatewayGroup) As(alias string) *gatewayGroup {
+	g.gatewayGroupDo.DO = *(g.gatewayGroupDo.As(alias).(*gen.DO))
+	return g.updateTableName(alias)
+}
+
+func (g *gatewayGroup) updateTableName(table string) *gatewayGroup {
+	g.ALL = field.NewAsterisk(table)
+	g.ID = field.NewInt(table, "id")
+	g.Name = field.NewString(table, "name")
+	g.Description = field.NewString(table, "description")
+	g.SoakSeconds = field.NewInt(table, "soak_seconds")
+	g.Creator = field.NewString(table, "creator")
+	g.Updater = field.NewString(table, "updater")
+	g.CreatedAt = field.NewTime(table, "created_at")
+	g.UpdatedAt = field.NewTime(table, "updated_at")
+
+	g.fillFieldMap()
+
+	return g
+}
+
+// WithContext ...
+func (g *gatewayGroup) WithContext(ctx context.Context) IGatewayGroupDo {
+	return g.gatewayGroupDo.WithContext(ctx)
+}
+
+// TableName ...
+func (g gatewayGroup) TableName() string { return g.gatewayGroupDo.TableName() }
+
+// Alias ...
+func (g gatewayGroup) Alias() string { return g.gatewayGroupDo.Alias() }
+
+// Columns ...
+func (g gatewayGroup) Columns(cols ...field.Expr) gen.Columns {
+	return g.gatewayGroupDo.Columns(cols...)
+}
+
+// GetFieldByName ...
+func (g *gatewayGroup) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := g.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (g *gatewayGroup) fillFieldMap() {
+	g.fieldMap = make(map[string]field.Expr, 8)
+	g.fieldMap["id"] = g.ID
+	g.fieldMap["name"] = g.Name
+	g.fieldMap["description"] = g.Description
+	g.fieldMap["soak_seconds"] = g.SoakSeconds
+	g.fieldMap["creator"] = g.Creator
+	g.fieldMap["updater"] = g.Updater
+	g.fieldMap["created_at"] = g.CreatedAt
+	g.fieldMap["updated_at"] = g.UpdatedAt
+}
+
+func (g gatewayGroup) clone(db *gorm.DB) gatewayGroup {
+	g.gatewayGroupDo.ReplaceConnPool(db.Statement.ConnPool)
+	return g
+}
+
+func (g gatewayGroup) replaceDB(db *gorm.DB) gatewayGroup {
+	g.gatewayGroupDo.ReplaceDB(db)
+	return g
+}
+
+type gatewayGroupDo struct{ gen.DO }
+
+// IGatewayGroupDo ...
+type IGatewayGroupDo interface {
+	gen.SubQuery
+	Debug() IGatewayGroupDo
+	WithContext(ctx context.Context) IGatewayGroupDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IGatewayGroupDo
+	WriteDB() IGatewayGroupDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IGatewayGroupDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IGatewayGroupDo
+	Not(conds ...gen.Condition) IGatewayGroupDo
+	Or(conds ...gen.Condition) IGatewayGroupDo
+	Select(conds ...field.Expr) IGatewayGroupDo
+	Where(conds ...gen.Condition) IGatewayGroupDo
+	Order(conds ...field.Expr) IGatewayGroupDo
+	Distinct(cols ...field.Expr) IGatewayGroupDo
+	Omit(cols ...field.Expr) IGatewayGroupDo
+	Join(table schema.Tabler, on ...field.Expr) IGatewayGroupDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IGatewayGroupDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IGatewayGroupDo
+	Group(cols ...field.Expr) IGatewayGroupDo
+	Having(conds ...gen.Condition) IGatewayGroupDo
+	Limit(limit int) IGatewayGroupDo
+	Offset(offset int) IGatewayGroupDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IGatewayGroupDo
+	Unscoped() IGatewayGroupDo
+	Create(values ...*model.GatewayGroup) error
+	CreateInBatches(values []*model.GatewayGroup, batchSize int) error
+	Save(values ...*model.GatewayGroup) error
+	First() (*model.GatewayGroup, error)
+	Take() (*model.GatewayGroup, error)
+	Last() (*model.GatewayGroup, error)
+	Find() ([]*model.GatewayGroup, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*model.GatewayGroup, err error)
+	FindInBatches(result *[]*model.GatewayGroup, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*model.GatewayGroup) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IGatewayGroupDo
+	Assign(attrs ...field.AssignExpr) IGatewayGroupDo
+	Joins(fields ...field.RelationField) IGatewayGroupDo
+	Preload(fields ...field.RelationField) IGatewayGroupDo
+	FirstOrInit() (*model.GatewayGroup, error)
+	FirstOrCreate() (*model.GatewayGroup, error)
+	FindByPage(offset int, limit int) (result []*model.GatewayGroup, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IGatewayGroupDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+// Debug ...
+func (g gatewayGroupDo) Debug() IGatewayGroupDo {
+	return g.withDO(g.DO.Debug())
+}
+
+// WithContext ...
+func (g gatewayGroupDo) WithContext(ctx context.Context) IGatewayGroupDo {
+	return g.withDO(g.DO.WithContext(ctx))
+}
+
+// ReadDB ...
+func (g gatewayGroupDo) ReadDB() IGatewayGroupDo {
+	return g.Clauses(dbresolver.Read)
+}
+
+// WriteDB ...
+func (g gatewayGroupDo) WriteDB() IGatewayGroupDo {
+	return g.Clauses(dbresolver.Write)
+}
+
+// Session ...
+func (g gatewayGroupDo) Session(config *gorm.Session) IGatewayGroupDo {
+	return g.withDO(g.DO.Session(config))
+}
+
+// Clauses ...
+func (g gatewayGroupDo) Clauses(conds ...clause.Expression) IGatewayGroupDo {
+	return g.withDO(g.DO.Clauses(conds...))
+}
+
+// Returning ...
+func (g gatewayGroupDo) Returning(value interface{}, columns ...string) IGatewayGroupDo {
+	return g.withDO(g.DO.Returning(value, columns...))
+}
+
+// Not ...
+func (g gatewayGroupDo) Not(conds ...gen.Condition) IGatewayGroupDo {
+	return g.withDO(g.DO.Not(conds...))
+}
+
+// Or ...
+func (g gatewayGroupDo) Or(conds ...gen.Condition) IGatewayGroupDo {
+	return g.withDO(g.DO.Or(conds...))
+}
+
+// Select ...
+func (g gatewayGroupDo) Select(conds ...field.Expr) IGatewayGroupDo {
+	return g.withDO(g.DO.Select(conds...))
+}
+
+// Where ...
+func (g gatewayGroupDo) Where(conds ...gen.Condition) IGatewayGroupDo {
+	return g.withDO(g.DO.Where(conds...))
+}
+
+// Order ...
+func (g gatewayGroupDo) Order(conds ...field.Expr) IGatewayGroupDo {
+	return g.withDO(g.DO.Order(conds...))
+}
+
+// Distinct ...
+func (g gatewayGroupDo) Distinct(cols ...field.Expr) IGatewayGroupDo {
+	return g.withDO(g.DO.Distinct(cols...))
+}
+
+// Omit ...
+func (g gatewayGroupDo) Omit(cols ...field.Expr) IGatewayGroupDo {
+	return g.withDO(g.DO.Omit(cols...))
+}
+
+// Join ...
+func (g gatewayGroupDo) Join(table schema.Tabler, on ...field.Expr) IGatewayGroupDo {
+	return g.withDO(g.DO.Join(table, on...))
+}
+
+// LeftJoin ...
+func (g gatewayGroupDo) LeftJoin(table schema.Tabler, on ...field.Expr) IGatewayGroupDo {
+	return g.withDO(g.DO.LeftJoin(table, on...))
+}
+
+// RightJoin ...
+func (g gatewayGroupDo) RightJoin(table schema.Tabler, on ...field.Expr) IGatewayGroupDo {
+	return g.withDO(g.DO.RightJoin(table, on...))
+}
+
+// Group ...
+func (g gatewayGroupDo) Group(cols ...field.Expr) IGatewayGroupDo {
+	return g.withDO(g.DO.Group(cols...))
+}
+
+// Having ...
+func (g gatewayGroupDo) Having(conds ...gen.Condition) IGatewayGroupDo {
+	return g.withDO(g.DO.Having(conds...))
+}
+
+// Limit ...
+func (g gatewayGroupDo) Limit(limit int) IGatewayGroupDo {
+	return g.withDO(g.DO.Limit(limit))
+}
+
+// Offset ...
+func (g gatewayGroupDo) Offset(offset int) IGatewayGroupDo {
+	return g.withDO(g.DO.Offset(offset))
+}
+
+// Scopes ...
+func (g gatewayGroupDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IGatewayGroupDo {
+	return g.withDO(g.DO.Scopes(funcs...))
+}
+
+// Unscoped ...
+func (g gatewayGroupDo) Unscoped() IGatewayGroupDo {
+	return g.withDO(g.DO.Unscoped())
+}
+
+// Create ...
+func (g gatewayGroupDo) Create(values ...*model.GatewayGroup) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return g.DO.Create(values)
+}
+
+// CreateInBatches ...
+func (g gatewayGroupDo) CreateInBatches(values []*model.GatewayGroup, batchSize int) error {
+	return g.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (g gatewayGroupDo) Save(values ...*model.GatewayGroup) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return g.DO.Save(values)
+}
+
+// First ...
+func (g gatewayGroupDo) First() (*model.GatewayGroup, error) {
+	if result, err := g.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayGroup), nil
+	}
+}
+
+// Take ...
+func (g gatewayGroupDo) Take() (*model.GatewayGroup, error) {
+	if result, err := g.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayGroup), nil
+	}
+}
+
+// Last ...
+func (g gatewayGroupDo) Last() (*model.GatewayGroup, error) {
+	if result, err := g.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayGroup), nil
+	}
+}
+
+// Find ...
+func (g gatewayGroupDo) Find() ([]*model.GatewayGroup, error) {
+	result, err := g.DO.Find()
+	return result.([]*model.GatewayGroup), err
+}
+
+// FindInBatch ...
+func (g gatewayGroupDo) FindInBatch(
+	batchSize int,
+	fc func(tx gen.Dao, batch int) error,
+) (results []*model.GatewayGroup, err error) {
+	buf := make([]*model.GatewayGroup, 0, batchSize)
+	err = g.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+// FindInBatches ...
+func (g gatewayGroupDo) FindInBatches(
+	result *[]*model.GatewayGroup,
+	batchSize int,
+	fc func(tx gen.Dao, batch int) error,
+) error {
+	return g.DO.FindInBatches(result, batchSize, fc)
+}
+
+// Attrs ...
+func (g gatewayGroupDo) Attrs(attrs ...field.AssignExpr) IGatewayGroupDo {
+	return g.withDO(g.DO.Attrs(attrs...))
+}
+
+// Assign ...
+func (g gatewayGroupDo) Assign(attrs ...field.AssignExpr) IGatewayGroupDo {
+	return g.withDO(g.DO.Assign(attrs...))
+}
+
+// Joins ...
+func (g gatewayGroupDo) Joins(fields ...field.RelationField) IGatewayGroupDo {
+	for _, _f := range fields {
+		g = *g.withDO(g.DO.Joins(_f))
+	}
+	return &g
+}
+
+// Preload ...
+func (g gatewayGroupDo) Preload(fields ...field.RelationField) IGatewayGroupDo {
+	for _, _f := range fields {
+		g = *g.withDO(g.DO.Preload(_f))
+	}
+	return &g
+}
+
+// FirstOrInit ...
+func (g gatewayGroupDo) FirstOrInit() (*model.GatewayGroup, error) {
+	if result, err := g.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayGroup), nil
+	}
+}
+
+// FirstOrCreate ...
+func (g gatewayGroupDo) FirstOrCreate() (*model.GatewayGroup, error) {
+	if result, err := g.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayGroup), nil
+	}
+}
+
+// FindByPage ...
+func (g gatewayGroupDo) FindByPage(offset int, limit int) (result []*model.GatewayGroup, count int64, err error) {
+	result, err = g.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = g.Offset(-1).Limit(-1).Count()
+	return
+}
+
+// ScanByPage ...
+func (g gatewayGroupDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = g.Count()
+	if err != nil {
+		return
+	}
+
+	err = g.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+// Scan ...
+func (g gatewayGroupDo) Scan(result interface{}) (err error) {
+	return g.DO.Scan(result)
+}
+
+// Delete ...
+func (g gatewayGroupDo) Delete(models ...*model.GatewayGroup) (result gen.ResultInfo, err error) {
+	return g.DO.Delete(models)
+}
+
+func (g *gatewayGroupDo) withDO(do gen.Dao) *gatewayGroupDo {
+	g.DO = *do.(*gen.DO)
+	return g
+}
@@ -0,0 +1,530 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关(BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package repo
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/entity/model"
+)
+
+func newGatewayGroupRolloutStage(db *gorm.DB, opts ...gen.DOOption) gatewayGroupRolloutStage {
+	_gatewayGroupRolloutStage := gatewayGroupRolloutStage{}
+
+	_gatewayGroupRolloutStage.gatewayGroupRolloutStageDo.UseDB(db, opts...)
+	_gatewayGroupRolloutStage.gatewayGroupRolloutStageDo.UseModel(&model.GatewayGroupRolloutStage{})
+
+	tableName := _gatewayGroupRolloutStage.gatewayGroupRolloutStageDo.TableName()
+	_gatewayGroupRolloutStage.ALL = field.NewAsterisk(tableName)
+	_gatewayGroupRolloutStage.ID = field.NewInt64(tableName, "id")
+	_gatewayGroupRolloutStage.RolloutID = field.NewInt64(tableName, "rollout_id")
+	_gatewayGroupRolloutStage.Stage = field.NewInt(tableName, "stage")
+	_gatewayGroupRolloutStage.GatewayID = field.NewInt(tableName, "gateway_id")
+	_gatewayGroupRolloutStage.HealthCheckURL = field.NewString(tableName, "health_check_url")
+	_gatewayGroupRolloutStage.PreviousReleaseVersionID = field.NewInt64(tableName, "previous_release_version_id")
+	_gatewayGroupRolloutStage.ReleaseVersionID = field.NewInt64(tableName, "release_version_id")
+	_gatewayGroupRolloutStage.Status = field.NewString(tableName, "status")
+	_gatewayGroupRolloutStage.Error = field.NewString(tableName, "error")
+	_gatewayGroupRolloutStage.StartedAt = field.NewTime(tableName, "started_at")
+	_gatewayGroupRolloutStage.FinishedAt = field.NewTime(tableName, "finished_at")
+	_gatewayGroupRolloutStage.Creator = field.NewString(tableName, "creator")
+	_gatewayGroupRolloutStage.Updater = field.NewString(tableName, "updater")
+	_gatewayGroupRolloutStage.CreatedAt = field.NewTime(tableName, "created_at")
+	_gatewayGroupRolloutStage.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_gatewayGroupRolloutStage.fillFieldMap()
+
+	return _gatewayGroupRolloutStage
+}
+
+type gatewayGroupRolloutStage struct {
+	gatewayGroupRolloutStageDo gatewayGroupRolloutStageDo
+
+	ALL                      field.Asterisk
+	ID                       field.Int64
+	RolloutID                field.Int64
+	Stage                    field.Int
+	GatewayID                field.Int
+	HealthCheckURL           field.String
+	PreviousReleaseVersionID field.Int64
+	ReleaseVersionID         field.Int64
+	Status                   field.String
+	Error                    field.String
+	StartedAt                field.Time
+	FinishedAt               field.Time
+	Creator                  field.String
+	Updater                  field.String
+	CreatedAt                field.Time
+	UpdatedAt                field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+// Table ...
+func (g gatewayGroupRolloutStage) Table(newTableName string) *gatewayGroupRolloutStage {
+	g.gatewayGroupRolloutStageDo.UseTable(newTableName)
+	return g.updateTableName(newTableName)
+}
+
+// As ...
+func (g gatewayGroupRolloutStage) As(alias string) *gatewayGroupRolloutStage {
+	g.gatewayGroupRolloutStageDo.DO = *(g.gatewayGroupRolloutStageDo.As(alias).(*gen.DO))
+	return g.updateTableName(alias)
+}
+
+func (g *gatewayGroupRolloutStage) updateTableName(table string) *gatewayGroupRolloutStage {
+	g.ALL = field.NewAsterisk(table)
+	g.ID = field.NewInt64(table, "id")
+	g.RolloutID = field.NewInt64(table, "rollout_id")
+	g.Stage = field.NewInt(table, "stage")
+	g.GatewayID = field.NewInt(table, "gateway_id")
+	g.HealthCheckURL = field.NewString(table, "health_check_url")
+	g.PreviousReleaseVersionID = field.NewInt64(table, "previous_release_version_id")
+	g.ReleaseVersionID = field.NewInt64(table, "release_version_id")
+	g.Status = field.NewString(table, "status")
+	g.Error = field.NewString(table, "error")
+	g.StartedAt = field.NewTime(table, "started_at")
+	g.FinishedAt = field.NewTime(table, "finished_at")
+	g.Creator = field.NewString(table, "creator")
+	g.Updater = field.NewString(table, "updater")
+	g.CreatedAt = field.NewTime(table, "created_at")
+	g.UpdatedAt = field.NewTime(table, "updated_at")
+
+	g.fillFieldMap()
+
+	return g
+}
+
+// WithContext ...
+func (g *gatewayGroupRolloutStage) WithContext(ctx context.Context) IGatewayGroupRolloutStageDo {
+	return g.gatewayGroupRolloutStageDo.WithContext(ctx)
+}
+
+// TableName ...
+func (g gatewayGroupRolloutStage) TableName() string { return g.gatewayGroupRolloutStageDo.TableName() }
+
+// Alias ...
+func (g gatewayGroupRolloutStage) Alias() string { return g.gatewayGroupRolloutStageDo.Alias() }
+
+// Columns ...
+func (g gatewayGroupRolloutStage) Columns(cols ...field.Expr) gen.Columns {
+	return g.gatewayGroupRolloutStageDo.Columns(cols...)
+}
+
+// GetFieldByName ...
+func (g *gatewayGroupRolloutStage) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := g.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (g *gatewayGroupRolloutStage) fillFieldMap() {
+	g.fieldMap = make(map[string]field.Expr, 15)
+	g.fieldMap["id"] = g.ID
+	g.fieldMap["rollout_id"] = g.RolloutID
+	g.fieldMap["stage"] = g.Stage
+	g.fieldMap["gateway_id"] = g.GatewayID
+	g.fieldMap["health_check_url"] = g.HealthCheckURL
+	g.fieldMap["previous_release_version_id"] = g.PreviousReleaseVersionID
+	g.fieldMap["release_version_id"] = g.ReleaseVersionID
+	g.fieldMap["status"] = g.Status
+	g.fieldMap["error"] = g.Error
+	g.fieldMap["started_at"] = g.StartedAt
+	g.fieldMap["finished_at"] = g.FinishedAt
+	g.fieldMap["creator"] = g.Creator
+	g.fieldMap["updater"] = g.Updater
+	g.fieldMap["created_at"] = g.CreatedAt
+	g.fieldMap["updated_at"] = g.UpdatedAt
+}
+
+func (g gatewayGroupRolloutStage) clone(db *gorm.DB) gatewayGroupRolloutStage {
+	g.gatewayGroupRolloutStageDo.ReplaceConnPool(db.Statement.ConnPool)
+	return g
+}
+
+func (g gatewayGroupRolloutStage) replaceDB(db *gorm.DB) gatewayGroupRolloutStage {
+	g.gatewayGroupRolloutStageDo.ReplaceDB(db)
+	return g
+}
+
+type gatewayGroupRolloutStageDo struct{ gen.DO }
+
+// IGatewayGroupRolloutStageDo ...
+type IGatewayGroupRolloutStageDo interface {
+	gen.SubQuery
+	Debug() IGatewayGroupRolloutStageDo
+	WithContext(ctx context.Context) IGatewayGroupRolloutStageDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IGatewayGroupRolloutStageDo
+	WriteDB() IGatewayGroupRolloutStageDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IGatewayGroupRolloutStageDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IGatewayGroupRolloutStageDo
+	Not(conds ...gen.Condition) IGatewayGroupRolloutStageDo
+	Or(conds ...gen.Condition) IGatewayGroupRolloutStageDo
+	Select(conds ...field.Expr) IGatewayGroupRolloutStageDo
+	Where(conds ...gen.Condition) IGatewayGroupRolloutStageDo
+	Order(conds ...field.Expr) IGatewayGroupRolloutStageDo
+	Distinct(cols ...field.Expr) IGatewayGroupRolloutStageDo
+	Omit(cols ...field.Expr) IGatewayGroupRolloutStageDo
+	Join(table schema.Tabler, on ...field.Expr) IGatewayGroupRolloutStageDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IGatewayGroupRolloutStageDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IGatewayGroupRolloutStageDo
+	Group(cols ...field.Expr) IGatewayGroupRolloutStageDo
+	Having(conds ...gen.Condition) IGatewayGroupRolloutStageDo
+	Limit(limit int) IGatewayGroupRolloutStageDo
+	Offset(offset int) IGatewayGroupRolloutStageDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IGatewayGroupRolloutStageDo
+	Unscoped() IGatewayGroupRolloutStageDo
+	Create(values ...*model.GatewayGroupRolloutStage) error
+	CreateInBatches(values []*model.GatewayGroupRolloutStage, batchSize int) error
+	Save(values ...*model.GatewayGroupRolloutStage) error
+	First() (*model.GatewayGroupRolloutStage, error)
+	Take() (*model.GatewayGroupRolloutStage, error)
+	Last() (*model.GatewayGroupRolloutStage, error)
+	Find() ([]*model.GatewayGroupRolloutStage, error)
+	FindInBatch(
+		batchSize int,
+		fc func(tx gen.Dao, batch int) error,
+	) (results []*model.GatewayGroupRolloutStage, err error)
+	FindInBatches(result *[]*model.GatewayGroupRolloutStage, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*model.GatewayGroupRolloutStage) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IGatewayGroupRolloutStageDo
+	Assign(attrs ...field.AssignExpr) IGatewayGroupRolloutStageDo
+	Joins(fields ...field.RelationField) IGatewayGroupRolloutStageDo
+	Preload(fields ...field.RelationField) IGatewayGroupRolloutStageDo
+	FirstOrInit() (*model.GatewayGroupRolloutStage, error)
+	FirstOrCreate() (*model.GatewayGroupRolloutStage, error)
+	FindByPage(offset int, limit int) (result []*model.GatewayGroupRolloutStage, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IGatewayGroupRolloutStageDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+// Debug ...
+func (g gatewayGroupRolloutStageDo) Debug() IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Debug())
+}
+
+// WithContext ...
+func (g gatewayGroupRolloutStageDo) WithContext(ctx context.Context) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.WithContext(ctx))
+}
+
+// ReadDB ...
+func (g gatewayGroupRolloutStageDo) ReadDB() IGatewayGroupRolloutStageDo {
+	return g.Clauses(dbresolver.Read)
+}
+
+// WriteDB ...
+func (g gatewayGroupRolloutStageDo) WriteDB() IGatewayGroupRolloutStageDo {
+	return g.Clauses(dbresolver.Write)
+}
+
+// Session ...
+func (g gatewayGroupRolloutStageDo) Session(config *gorm.Session) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Session(config))
+}
+
+// Clauses ...
+func (g gatewayGroupRolloutStageDo) Clauses(conds ...clause.Expression) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Clauses(conds...))
+}
+
+// Returning ...
+func (g gatewayGroupRolloutStageDo) Returning(value interface{}, columns ...string) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Returning(value, columns...))
+}
+
+// Not ...
+func (g gatewayGroupRolloutStageDo) Not(conds ...gen.Condition) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Not(conds...))
+}
+
+// Or ...
+func (g gatewayGroupRolloutStageDo) Or(conds ...gen.Condition) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Or(conds...))
+}
+
+// Select ...
+func (g gatewayGroupRolloutStageDo) Select(conds ...field.Expr) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Select(conds...))
+}
+
+// Where ...
+func (g gatewayGroupRolloutStageDo) Where(conds ...gen.Condition) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Where(conds...))
+}
+
+// Order ...
+func (g gatewayGroupRolloutStageDo) Order(conds ...field.Expr) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Order(conds...))
+}
+
+// Distinct ...
+func (g gatewayGroupRolloutStageDo) Distinct(cols ...field.Expr) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Distinct(cols...))
+}
+
+// Omit ...
+func (g gatewayGroupRolloutStageDo) Omit(cols ...field.Expr) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Omit(cols...))
+}
+
+// Join ...
+func (g gatewayGroupRolloutStageDo) Join(table schema.Tabler, on ...field.Expr) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Join(table, on...))
+}
+
+// LeftJoin ...
+func (g gatewayGroupRolloutStageDo) LeftJoin(table schema.Tabler, on ...field.Expr) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.LeftJoin(table, on...))
+}
+
+// RightJoin ...
+func (g gatewayGroupRolloutStageDo) RightJoin(table schema.Tabler, on ...field.Expr) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.RightJoin(table, on...))
+}
+
+// Group ...
+func (g gatewayGroupRolloutStageDo) Group(cols ...field.Expr) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Group(cols...))
+}
+
+// Having ...
+func (g gatewayGroupRolloutStageDo) Having(conds ...gen.Condition) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Having(conds...))
+}
+
+// Limit ...
+func (g gatewayGroupRolloutStageDo) Limit(limit int) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Limit(limit))
+}
+
+// Offset ...
+func (g gatewayGroupRolloutStageDo) Offset(offset int) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Offset(offset))
+}
+
+// Scopes ...
+func (g gatewayGroupRolloutStageDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Scopes(funcs...))
+}
+
+// Unscoped ...
+func (g gatewayGroupRolloutStageDo) Unscoped() IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Unscoped())
+}
+
+// Create ...
+func (g gatewayGroupRolloutStageDo) Create(values ...*model.GatewayGroupRolloutStage) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return g.DO.Create(values)
+}
+
+// CreateInBatches ...
+func (g gatewayGroupRolloutStageDo) CreateInBatches(values []*model.GatewayGroupRolloutStage, batchSize int) error {
+	return g.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (g gatewayGroupRolloutStageDo) Save(values ...*model.GatewayGroupRolloutStage) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return g.DO.Save(values)
+}
+
+// First ...
+func (g gatewayGroupRolloutStageDo) First() (*model.GatewayGroupRolloutStage, error) {
+	if result, err := g.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayGroupRolloutStage), nil
+	}
+}
+
+// Take ...
+func (g gatewayGroupRolloutStageDo) Take() (*model.GatewayGroupRolloutStage, error) {
+	if result, err := g.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayGroupRolloutStage), nil
+	}
+}
+
+// Last ...
+func (g gatewayGroupRolloutStageDo) Last() (*model.GatewayGroupRolloutStage, error) {
+	if result, err := g.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayGroupRolloutStage), nil
+	}
+}
+
+// Find ...
+func (g gatewayGroupRolloutStageDo) Find() ([]*model.GatewayGroupRolloutStage, error) {
+	result, err := g.DO.Find()
+	return result.([]*model.GatewayGroupRolloutStage), err
+}
+
+// FindInBatch ...
+func (g gatewayGroupRolloutStageDo) FindInBatch(
+	batchSize int,
+	fc func(tx gen.Dao, batch int) error,
+) (results []*model.GatewayGroupRolloutStage, err error) {
+	buf := make([]*model.GatewayGroupRolloutStage, 0, batchSize)
+	err = g.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+// FindInBatches ...
+func (g gatewayGroupRolloutStageDo) FindInBatches(
+	result *[]*model.GatewayGroupRolloutStage,
+	batchSize int,
+	fc func(tx gen.Dao, batch int) error,
+) error {
+	return g.DO.FindInBatches(result, batchSize, fc)
+}
+
+// Attrs ...
+func (g gatewayGroupRolloutStageDo) Attrs(attrs ...field.AssignExpr) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Attrs(attrs...))
+}
+
+// Assign ...
+func (g gatewayGroupRolloutStageDo) Assign(attrs ...field.AssignExpr) IGatewayGroupRolloutStageDo {
+	return g.withDO(g.DO.Assign(attrs...))
+}
+
+// Joins ...
+func (g gatewayGroupRolloutStageDo) Joins(fields ...field.RelationField) IGatewayGroupRolloutStageDo {
+	for _, _f := range fields {
+		g = *g.withDO(g.DO.Joins(_f))
+	}
+	return &g
+}
+
+// Preload ...
+func (g gatewayGroupRolloutStageDo) Preload(fields ...field.RelationField) IGatewayGroupRolloutStageDo {
+	for _, _f := range fields {
+		g = *g.withDO(g.DO.Preload(_f))
+	}
+	return &g
+}
+
+// FirstOrInit ...
+func (g gatewayGroupRolloutStageDo) FirstOrInit() (*model.GatewayGroupRolloutStage, error) {
+	if result, err := g.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayGroupRolloutStage), nil
+	}
+}
+
+// FirstOrCreate ...
+func (g gatewayGroupRolloutStageDo) FirstOrCreate() (*model.GatewayGroupRolloutStage, error) {
+	if result, err := g.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*model.GatewayGroupRolloutStage), nil
+	}
+}
+
+// FindByPage ...
+func (g gatewayGroupRolloutStageDo) FindByPage(
+	offset int,
+	limit int,
+) (result []*model.GatewayGroupRolloutStage, count int64, err error) {
+	result, err = g.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = g.Offset(-1).Limit(-1).Count()
+	return
+}
+
+// ScanByPage ...
+func (g gatewayGroupRolloutStageDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = g.Count()
+	if err != nil {
+		return
+	}
+
+	err = g.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+// Scan ...
+func (g gatewayGroupRolloutStageDo) Scan(result interface{}) (err error) {
+	return g.DO.Scan(result)
+}
+
+// Delete ...
+func (g gatewayGroupRolloutStageDo) Delete(
+	models ...*model.GatewayGroupRolloutStage,
+) (result gen.ResultInfo, err error) {
+	return g.DO.Delete(models)
+}
+
+func (g *gatewayGroupRolloutStageDo) withDO(do gen.Dao) *gatewayGroupRolloutStageDo {
+	g.DO = *do.(*gen.DO)
+	return g
+}
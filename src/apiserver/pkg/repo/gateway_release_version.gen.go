@@ -46,9 +46,13 @@ func newGatewayReleaseVersion(db *gorm.DB, opts ...gen.DOOption) gatewayReleaseV
 	tableName := _gatewayReleaseVersion.gatewayReleaseVersionDo.TableName()
 	_gatewayReleaseVersion.ALL = field.NewAsterisk(tableName)
 	_gatewayReleaseVersion.ID = field.NewInt64(tableName, "id")
-	_gatewayReleaseVersion.GatewayID = field.NewString(tableName, "gateway_id")
+	_gatewayReleaseVersion.GatewayID = field.NewInt(tableName, "gateway_id")
 	_gatewayReleaseVersion.ReleaseData = field.NewField(tableName, "release_data")
 	_gatewayReleaseVersion.Version = field.NewString(tableName, "version")
+	_gatewayReleaseVersion.TriggerSource = field.NewString(tableName, "trigger_source")
+	_gatewayReleaseVersion.Changelog = field.NewString(tableName, "changelog")
+	_gatewayReleaseVersion.ResourceCount = field.NewInt(tableName, "resource_count")
+	_gatewayReleaseVersion.RollbackFromID = field.NewInt64(tableName, "rollback_from_id")
 	_gatewayReleaseVersion.Creator = field.NewString(tableName, "creator")
 	_gatewayReleaseVersion.Updater = field.NewString(tableName, "updater")
 	_gatewayReleaseVersion.CreatedAt = field.NewTime(tableName, "created_at")
@@ -62,15 +66,19 @@ func newGatewayReleaseVersion(db *gorm.DB, opts ...gen.DOOption) gatewayReleaseV
 type gatewayReleaseVersion struct {
 	gatewayReleaseVersionDo gatewayReleaseVersionDo
 
-	ALL         field.Asterisk
-	ID          field.Int64
-	GatewayID   field.String
-	ReleaseData field.Field
-	Version     field.String
-	Creator     field.String
-	Updater     field.String
-	CreatedAt   field.Time
-	UpdatedAt   field.Time
+	ALL            field.Asterisk
+	ID             field.Int64
+	GatewayID      field.Int
+	ReleaseData    field.Field
+	Version        field.String
+	TriggerSource  field.String
+	Changelog      field.String
+	ResourceCount  field.Int
+	RollbackFromID field.Int64
+	Creator        field.String
+	Updater        field.String
+	CreatedAt      field.Time
+	UpdatedAt      field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -90,9 +98,13 @@ func (g gatewayReleaseVersion) As(alias string) *gatewayReleaseVersion {
 func (g *gatewayReleaseVersion) updateTableName(table string) *gatewayReleaseVersion {
 	g.ALL = field.NewAsterisk(table)
 	g.ID = field.NewInt64(table, "id")
-	g.GatewayID = field.NewString(table, "gateway_id")
+	g.GatewayID = field.NewInt(table, "gateway_id")
 	g.ReleaseData = field.NewField(table, "release_data")
 	g.Version = field.NewString(table, "version")
+	g.TriggerSource = field.NewString(table, "trigger_source")
+	g.Changelog = field.NewString(table, "changelog")
+	g.ResourceCount = field.NewInt(table, "resource_count")
+	g.RollbackFromID = field.NewInt64(table, "rollback_from_id")
 	g.Creator = field.NewString(table, "creator")
 	g.Updater = field.NewString(table, "updater")
 	g.CreatedAt = field.NewTime(table, "created_at")
@@ -130,11 +142,15 @@ func (g *gatewayReleaseVersion) GetFieldByName(fieldName string) (field.OrderExp
 }
 
 func (g *gatewayReleaseVersion) fillFieldMap() {
-	g.fieldMap = make(map[string]field.Expr, 8)
+	g.fieldMap = make(map[string]field.Expr, 12)
 	g.fieldMap["id"] = g.ID
 	g.fieldMap["gateway_id"] = g.GatewayID
 	g.fieldMap["release_data"] = g.ReleaseData
 	g.fieldMap["version"] = g.Version
+	g.fieldMap["trigger_source"] = g.TriggerSource
+	g.fieldMap["changelog"] = g.Changelog
+	g.fieldMap["resource_count"] = g.ResourceCount
+	g.fieldMap["rollback_from_id"] = g.RollbackFromID
 	g.fieldMap["creator"] = g.Creator
 	g.fieldMap["updater"] = g.Updater
 	g.fieldMap["created_at"] = g.CreatedAt
@@ -51,6 +51,8 @@ func newGatewaySyncData(db *gorm.DB, opts ...gen.DOOption) gatewaySyncData {
 	_gatewaySyncData.Type = field.NewString(tableName, "type")
 	_gatewaySyncData.Config = field.NewField(tableName, "config")
 	_gatewaySyncData.ModRevision = field.NewInt(tableName, "mod_revision")
+	_gatewaySyncData.ValidityStart = field.NewInt64(tableName, "validity_start")
+	_gatewaySyncData.ValidityEnd = field.NewInt64(tableName, "validity_end")
 	_gatewaySyncData.CreatedAt = field.NewTime(tableName, "created_at")
 	_gatewaySyncData.UpdatedAt = field.NewTime(tableName, "updated_at")
 
@@ -62,15 +64,17 @@ func newGatewaySyncData(db *gorm.DB, opts ...gen.DOOption) gatewaySyncData {
 type gatewaySyncData struct {
 	gatewaySyncDataDo gatewaySyncDataDo
 
-	ALL         field.Asterisk
-	AutoID      field.Int
-	ID          field.String
-	GatewayID   field.Int
-	Type        field.String
-	Config      field.Field
-	ModRevision field.Int
-	CreatedAt   field.Time
-	UpdatedAt   field.Time
+	ALL           field.Asterisk
+	AutoID        field.Int
+	ID            field.String
+	GatewayID     field.Int
+	Type          field.String
+	Config        field.Field
+	ModRevision   field.Int
+	ValidityStart field.Int64
+	ValidityEnd   field.Int64
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -95,6 +99,8 @@ func (g *gatewaySyncData) updateTableName(table string) *gatewaySyncData {
 	g.Type = field.NewString(table, "type")
 	g.Config = field.NewField(table, "config")
 	g.ModRevision = field.NewInt(table, "mod_revision")
+	g.ValidityStart = field.NewInt64(table, "validity_start")
+	g.ValidityEnd = field.NewInt64(table, "validity_end")
 	g.CreatedAt = field.NewTime(table, "created_at")
 	g.UpdatedAt = field.NewTime(table, "updated_at")
 
@@ -130,13 +136,15 @@ func (g *gatewaySyncData) GetFieldByName(fieldName string) (field.OrderExpr, boo
 }
 
 func (g *gatewaySyncData) fillFieldMap() {
-	g.fieldMap = make(map[string]field.Expr, 8)
+	g.fieldMap = make(map[string]field.Expr, 10)
 	g.fieldMap["auto_id"] = g.AutoID
 	g.fieldMap["id"] = g.ID
 	g.fieldMap["gateway_id"] = g.GatewayID
 	g.fieldMap["type"] = g.Type
 	g.fieldMap["config"] = g.Config
 	g.fieldMap["mod_revision"] = g.ModRevision
+	g.fieldMap["validity_start"] = g.ValidityStart
+	g.fieldMap["validity_end"] = g.ValidityEnd
 	g.fieldMap["created_at"] = g.CreatedAt
 	g.fieldMap["updated_at"] = g.UpdatedAt
 }
@@ -38,11 +38,28 @@ var (
 	Q                                = new(Query)
 	Consumer                         *consumer
 	ConsumerGroup                    *consumerGroup
+	Credential                       *credential
 	Gateway                          *gateway
+	GatewayChangeRequest             *gatewayChangeRequest
+	GatewayChangeRequestEvent        *gatewayChangeRequestEvent
 	GatewayCustomPluginSchema        *gatewayCustomPluginSchema
+	GatewayDataPlaneInstance         *gatewayDataPlaneInstance
+	GatewayDriftRecord               *gatewayDriftRecord
+	GatewayGroup                     *gatewayGroup
+	GatewayGroupMember               *gatewayGroupMember
+	GatewayGroupRollout              *gatewayGroupRollout
+	GatewayGroupRolloutStage         *gatewayGroupRolloutStage
+	GatewayMaintenanceWindow         *gatewayMaintenanceWindow
+	GatewayMember                    *gatewayMember
+	GatewayPublishJournal            *gatewayPublishJournal
+	GatewayPublishPolicy             *gatewayPublishPolicy
 	GatewayReleaseVersion            *gatewayReleaseVersion
 	GatewayResourceSchemaAssociation *gatewayResourceSchemaAssociation
+	GatewayScheduledPublish          *gatewayScheduledPublish
 	GatewaySyncData                  *gatewaySyncData
+	GatewayVariable                  *gatewayVariable
+	GatewayWebhook                   *gatewayWebhook
+	GatewayWebhookDelivery           *gatewayWebhookDelivery
 	GlobalRule                       *globalRule
 	OperationAuditLog                *operationAuditLog
 	PluginConfig                     *pluginConfig
@@ -50,9 +67,12 @@ var (
 	Proto                            *proto
 	Route                            *route
 	SSL                              *sSL
+	SSLExpiryAlert                   *sSLExpiryAlert
+	Secret                           *secret
 	Service                          *service
 	StreamRoute                      *streamRoute
 	SystemConfig                     *systemConfig
+	Task                             *task
 	Upstream                         *upstream
 )
 
@@ -61,11 +81,28 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	Consumer = &Q.Consumer
 	ConsumerGroup = &Q.ConsumerGroup
+	Credential = &Q.Credential
 	Gateway = &Q.Gateway
+	GatewayChangeRequest = &Q.GatewayChangeRequest
+	GatewayChangeRequestEvent = &Q.GatewayChangeRequestEvent
 	GatewayCustomPluginSchema = &Q.GatewayCustomPluginSchema
+	GatewayDataPlaneInstance = &Q.GatewayDataPlaneInstance
+	GatewayDriftRecord = &Q.GatewayDriftRecord
+	GatewayGroup = &Q.GatewayGroup
+	GatewayGroupMember = &Q.GatewayGroupMember
+	GatewayGroupRollout = &Q.GatewayGroupRollout
+	GatewayGroupRolloutStage = &Q.GatewayGroupRolloutStage
+	GatewayMaintenanceWindow = &Q.GatewayMaintenanceWindow
+	GatewayMember = &Q.GatewayMember
+	GatewayPublishJournal = &Q.GatewayPublishJournal
+	GatewayPublishPolicy = &Q.GatewayPublishPolicy
 	GatewayReleaseVersion = &Q.GatewayReleaseVersion
 	GatewayResourceSchemaAssociation = &Q.GatewayResourceSchemaAssociation
+	GatewayScheduledPublish = &Q.GatewayScheduledPublish
 	GatewaySyncData = &Q.GatewaySyncData
+	GatewayVariable = &Q.GatewayVariable
+	GatewayWebhook = &Q.GatewayWebhook
+	GatewayWebhookDelivery = &Q.GatewayWebhookDelivery
 	GlobalRule = &Q.GlobalRule
 	OperationAuditLog = &Q.OperationAuditLog
 	PluginConfig = &Q.PluginConfig
@@ -73,9 +110,12 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	Proto = &Q.Proto
 	Route = &Q.Route
 	SSL = &Q.SSL
+	SSLExpiryAlert = &Q.SSLExpiryAlert
+	Secret = &Q.Secret
 	Service = &Q.Service
 	StreamRoute = &Q.StreamRoute
 	SystemConfig = &Q.SystemConfig
+	Task = &Q.Task
 	Upstream = &Q.Upstream
 }
 
@@ -85,11 +125,28 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		db:                               db,
 		Consumer:                         newConsumer(db, opts...),
 		ConsumerGroup:                    newConsumerGroup(db, opts...),
+		Credential:                       newCredential(db, opts...),
 		Gateway:                          newGateway(db, opts...),
+		GatewayChangeRequest:             newGatewayChangeRequest(db, opts...),
+		GatewayChangeRequestEvent:        newGatewayChangeRequestEvent(db, opts...),
 		GatewayCustomPluginSchema:        newGatewayCustomPluginSchema(db, opts...),
+		GatewayDataPlaneInstance:         newGatewayDataPlaneInstance(db, opts...),
+		GatewayDriftRecord:               newGatewayDriftRecord(db, opts...),
+		GatewayGroup:                     newGatewayGroup(db, opts...),
+		GatewayGroupMember:               newGatewayGroupMember(db, opts...),
+		GatewayGroupRollout:              newGatewayGroupRollout(db, opts...),
+		GatewayGroupRolloutStage:         newGatewayGroupRolloutStage(db, opts...),
+		GatewayMaintenanceWindow:         newGatewayMaintenanceWindow(db, opts...),
+		GatewayMember:                    newGatewayMember(db, opts...),
+		GatewayPublishJournal:            newGatewayPublishJournal(db, opts...),
+		GatewayPublishPolicy:             newGatewayPublishPolicy(db, opts...),
 		GatewayReleaseVersion:            newGatewayReleaseVersion(db, opts...),
 		GatewayResourceSchemaAssociation: newGatewayResourceSchemaAssociation(db, opts...),
+		GatewayScheduledPublish:          newGatewayScheduledPublish(db, opts...),
 		GatewaySyncData:                  newGatewaySyncData(db, opts...),
+		GatewayVariable:                  newGatewayVariable(db, opts...),
+		GatewayWebhook:                   newGatewayWebhook(db, opts...),
+		GatewayWebhookDelivery:           newGatewayWebhookDelivery(db, opts...),
 		GlobalRule:                       newGlobalRule(db, opts...),
 		OperationAuditLog:                newOperationAuditLog(db, opts...),
 		PluginConfig:                     newPluginConfig(db, opts...),
@@ -97,9 +154,12 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		Proto:                            newProto(db, opts...),
 		Route:                            newRoute(db, opts...),
 		SSL:                              newSSL(db, opts...),
+		SSLExpiryAlert:                   newSSLExpiryAlert(db, opts...),
+		Secret:                           newSecret(db, opts...),
 		Service:                          newService(db, opts...),
 		StreamRoute:                      newStreamRoute(db, opts...),
 		SystemConfig:                     newSystemConfig(db, opts...),
+		Task:                             newTask(db, opts...),
 		Upstream:                         newUpstream(db, opts...),
 	}
 }
@@ -110,11 +170,28 @@ type Query struct {
 
 	Consumer                         consumer
 	ConsumerGroup                    consumerGroup
+	Credential                       credential
 	Gateway                          gateway
+	GatewayChangeRequest             gatewayChangeRequest
+	GatewayChangeRequestEvent        gatewayChangeRequestEvent
 	GatewayCustomPluginSchema        gatewayCustomPluginSchema
+	GatewayDataPlaneInstance         gatewayDataPlaneInstance
+	GatewayDriftRecord               gatewayDriftRecord
+	GatewayGroup                     gatewayGroup
+	GatewayGroupMember               gatewayGroupMember
+	GatewayGroupRollout              gatewayGroupRollout
+	GatewayGroupRolloutStage         gatewayGroupRolloutStage
+	GatewayMaintenanceWindow         gatewayMaintenanceWindow
+	GatewayMember                    gatewayMember
+	GatewayPublishJournal            gatewayPublishJournal
+	GatewayPublishPolicy             gatewayPublishPolicy
 	GatewayReleaseVersion            gatewayReleaseVersion
 	GatewayResourceSchemaAssociation gatewayResourceSchemaAssociation
+	GatewayScheduledPublish          gatewayScheduledPublish
 	GatewaySyncData                  gatewaySyncData
+	GatewayVariable                  gatewayVariable
+	GatewayWebhook                   gatewayWebhook
+	GatewayWebhookDelivery           gatewayWebhookDelivery
 	GlobalRule                       globalRule
 	OperationAuditLog                operationAuditLog
 	PluginConfig                     pluginConfig
@@ -122,9 +199,12 @@ type Query struct {
 	Proto                            proto
 	Route                            route
 	SSL                              sSL
+	SSLExpiryAlert                   sSLExpiryAlert
+	Secret                           secret
 	Service                          service
 	StreamRoute                      streamRoute
 	SystemConfig                     systemConfig
+	Task                             task
 	Upstream                         upstream
 }
 
@@ -136,11 +216,28 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		db:                               db,
 		Consumer:                         q.Consumer.clone(db),
 		ConsumerGroup:                    q.ConsumerGroup.clone(db),
+		Credential:                       q.Credential.clone(db),
 		Gateway:                          q.Gateway.clone(db),
+		GatewayChangeRequest:             q.GatewayChangeRequest.clone(db),
+		GatewayChangeRequestEvent:        q.GatewayChangeRequestEvent.clone(db),
 		GatewayCustomPluginSchema:        q.GatewayCustomPluginSchema.clone(db),
+		GatewayDataPlaneInstance:         q.GatewayDataPlaneInstance.clone(db),
+		GatewayDriftRecord:               q.GatewayDriftRecord.clone(db),
+		GatewayGroup:                     q.GatewayGroup.clone(db),
+		GatewayGroupMember:               q.GatewayGroupMember.clone(db),
+		GatewayGroupRollout:              q.GatewayGroupRollout.clone(db),
+		GatewayGroupRolloutStage:         q.GatewayGroupRolloutStage.clone(db),
+		GatewayMaintenanceWindow:         q.GatewayMaintenanceWindow.clone(db),
+		GatewayMember:                    q.GatewayMember.clone(db),
+		GatewayPublishJournal:            q.GatewayPublishJournal.clone(db),
+		GatewayPublishPolicy:             q.GatewayPublishPolicy.clone(db),
 		GatewayReleaseVersion:            q.GatewayReleaseVersion.clone(db),
 		GatewayResourceSchemaAssociation: q.GatewayResourceSchemaAssociation.clone(db),
+		GatewayScheduledPublish:          q.GatewayScheduledPublish.clone(db),
 		GatewaySyncData:                  q.GatewaySyncData.clone(db),
+		GatewayVariable:                  q.GatewayVariable.clone(db),
+		GatewayWebhook:                   q.GatewayWebhook.clone(db),
+		GatewayWebhookDelivery:           q.GatewayWebhookDelivery.clone(db),
 		GlobalRule:                       q.GlobalRule.clone(db),
 		OperationAuditLog:                q.OperationAuditLog.clone(db),
 		PluginConfig:                     q.PluginConfig.clone(db),
@@ -148,9 +245,12 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		Proto:                            q.Proto.clone(db),
 		Route:                            q.Route.clone(db),
 		SSL:                              q.SSL.clone(db),
+		SSLExpiryAlert:                   q.SSLExpiryAlert.clone(db),
+		Secret:                           q.Secret.clone(db),
 		Service:                          q.Service.clone(db),
 		StreamRoute:                      q.StreamRoute.clone(db),
 		SystemConfig:                     q.SystemConfig.clone(db),
+		Task:                             q.Task.clone(db),
 		Upstream:                         q.Upstream.clone(db),
 	}
 }
@@ -171,11 +271,28 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		db:                               db,
 		Consumer:                         q.Consumer.replaceDB(db),
 		ConsumerGroup:                    q.ConsumerGroup.replaceDB(db),
+		Credential:                       q.Credential.replaceDB(db),
 		Gateway:                          q.Gateway.replaceDB(db),
+		GatewayChangeRequest:             q.GatewayChangeRequest.replaceDB(db),
+		GatewayChangeRequestEvent:        q.GatewayChangeRequestEvent.replaceDB(db),
 		GatewayCustomPluginSchema:        q.GatewayCustomPluginSchema.replaceDB(db),
+		GatewayDataPlaneInstance:         q.GatewayDataPlaneInstance.replaceDB(db),
+		GatewayDriftRecord:               q.GatewayDriftRecord.replaceDB(db),
+		GatewayGroup:                     q.GatewayGroup.replaceDB(db),
+		GatewayGroupMember:               q.GatewayGroupMember.replaceDB(db),
+		GatewayGroupRollout:              q.GatewayGroupRollout.replaceDB(db),
+		GatewayGroupRolloutStage:         q.GatewayGroupRolloutStage.replaceDB(db),
+		GatewayMaintenanceWindow:         q.GatewayMaintenanceWindow.replaceDB(db),
+		GatewayMember:                    q.GatewayMember.replaceDB(db),
+		GatewayPublishJournal:            q.GatewayPublishJournal.replaceDB(db),
+		GatewayPublishPolicy:             q.GatewayPublishPolicy.replaceDB(db),
 		GatewayReleaseVersion:            q.GatewayReleaseVersion.replaceDB(db),
 		GatewayResourceSchemaAssociation: q.GatewayResourceSchemaAssociation.replaceDB(db),
+		GatewayScheduledPublish:          q.GatewayScheduledPublish.replaceDB(db),
 		GatewaySyncData:                  q.GatewaySyncData.replaceDB(db),
+		GatewayVariable:                  q.GatewayVariable.replaceDB(db),
+		GatewayWebhook:                   q.GatewayWebhook.replaceDB(db),
+		GatewayWebhookDelivery:           q.GatewayWebhookDelivery.replaceDB(db),
 		GlobalRule:                       q.GlobalRule.replaceDB(db),
 		OperationAuditLog:                q.OperationAuditLog.replaceDB(db),
 		PluginConfig:                     q.PluginConfig.replaceDB(db),
@@ -183,9 +300,12 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		Proto:                            q.Proto.replaceDB(db),
 		Route:                            q.Route.replaceDB(db),
 		SSL:                              q.SSL.replaceDB(db),
+		SSLExpiryAlert:                   q.SSLExpiryAlert.replaceDB(db),
+		Secret:                           q.Secret.replaceDB(db),
 		Service:                          q.Service.replaceDB(db),
 		StreamRoute:                      q.StreamRoute.replaceDB(db),
 		SystemConfig:                     q.SystemConfig.replaceDB(db),
+		Task:                             q.Task.replaceDB(db),
 		Upstream:                         q.Upstream.replaceDB(db),
 	}
 }
@@ -193,11 +313,28 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 type queryCtx struct {
 	Consumer                         IConsumerDo
 	ConsumerGroup                    IConsumerGroupDo
+	Credential                       ICredentialDo
 	Gateway                          IGatewayDo
+	GatewayChangeRequest             IGatewayChangeRequestDo
+	GatewayChangeRequestEvent        IGatewayChangeRequestEventDo
 	GatewayCustomPluginSchema        IGatewayCustomPluginSchemaDo
+	GatewayDataPlaneInstance         IGatewayDataPlaneInstanceDo
+	GatewayDriftRecord               IGatewayDriftRecordDo
+	GatewayGroup                     IGatewayGroupDo
+	GatewayGroupMember               IGatewayGroupMemberDo
+	GatewayGroupRollout              IGatewayGroupRolloutDo
+	GatewayGroupRolloutStage         IGatewayGroupRolloutStageDo
+	GatewayMaintenanceWindow         IGatewayMaintenanceWindowDo
+	GatewayMember                    IGatewayMemberDo
+	GatewayPublishJournal            IGatewayPublishJournalDo
+	GatewayPublishPolicy             IGatewayPublishPolicyDo
 	GatewayReleaseVersion            IGatewayReleaseVersionDo
 	GatewayResourceSchemaAssociation IGatewayResourceSchemaAssociationDo
+	GatewayScheduledPublish          IGatewayScheduledPublishDo
 	GatewaySyncData                  IGatewaySyncDataDo
+	GatewayVariable                  IGatewayVariableDo
+	GatewayWebhook                   IGatewayWebhookDo
+	GatewayWebhookDelivery           IGatewayWebhookDeliveryDo
 	GlobalRule                       IGlobalRuleDo
 	OperationAuditLog                IOperationAuditLogDo
 	PluginConfig                     IPluginConfigDo
@@ -205,9 +342,12 @@ type queryCtx struct {
 	Proto                            IProtoDo
 	Route                            IRouteDo
 	SSL                              ISSLDo
+	SSLExpiryAlert                   ISSLExpiryAlertDo
+	Secret                           ISecretDo
 	Service                          IServiceDo
 	StreamRoute                      IStreamRouteDo
 	SystemConfig                     ISystemConfigDo
+	Task                             ITaskDo
 	Upstream                         IUpstreamDo
 }
 
@@ -216,11 +356,28 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
 		Consumer:                         q.Consumer.WithContext(ctx),
 		ConsumerGroup:                    q.ConsumerGroup.WithContext(ctx),
+		Credential:                       q.Credential.WithContext(ctx),
 		Gateway:                          q.Gateway.WithContext(ctx),
+		GatewayChangeRequest:             q.GatewayChangeRequest.WithContext(ctx),
+		GatewayChangeRequestEvent:        q.GatewayChangeRequestEvent.WithContext(ctx),
 		GatewayCustomPluginSchema:        q.GatewayCustomPluginSchema.WithContext(ctx),
+		GatewayDataPlaneInstance:         q.GatewayDataPlaneInstance.WithContext(ctx),
+		GatewayDriftRecord:               q.GatewayDriftRecord.WithContext(ctx),
+		GatewayGroup:                     q.GatewayGroup.WithContext(ctx),
+		GatewayGroupMember:               q.GatewayGroupMember.WithContext(ctx),
+		GatewayGroupRollout:              q.GatewayGroupRollout.WithContext(ctx),
+		GatewayGroupRolloutStage:         q.GatewayGroupRolloutStage.WithContext(ctx),
+		GatewayMaintenanceWindow:         q.GatewayMaintenanceWindow.WithContext(ctx),
+		GatewayMember:                    q.GatewayMember.WithContext(ctx),
+		GatewayPublishJournal:            q.GatewayPublishJournal.WithContext(ctx),
+		GatewayPublishPolicy:             q.GatewayPublishPolicy.WithContext(ctx),
 		GatewayReleaseVersion:            q.GatewayReleaseVersion.WithContext(ctx),
 		GatewayResourceSchemaAssociation: q.GatewayResourceSchemaAssociation.WithContext(ctx),
+		GatewayScheduledPublish:          q.GatewayScheduledPublish.WithContext(ctx),
 		GatewaySyncData:                  q.GatewaySyncData.WithContext(ctx),
+		GatewayVariable:                  q.GatewayVariable.WithContext(ctx),
+		GatewayWebhook:                   q.GatewayWebhook.WithContext(ctx),
+		GatewayWebhookDelivery:           q.GatewayWebhookDelivery.WithContext(ctx),
 		GlobalRule:                       q.GlobalRule.WithContext(ctx),
 		OperationAuditLog:                q.OperationAuditLog.WithContext(ctx),
 		PluginConfig:                     q.PluginConfig.WithContext(ctx),
@@ -228,9 +385,12 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		Proto:                            q.Proto.WithContext(ctx),
 		Route:                            q.Route.WithContext(ctx),
 		SSL:                              q.SSL.WithContext(ctx),
+		SSLExpiryAlert:                   q.SSLExpiryAlert.WithContext(ctx),
+		Secret:                           q.Secret.WithContext(ctx),
 		Service:                          q.Service.WithContext(ctx),
 		StreamRoute:                      q.StreamRoute.WithContext(ctx),
 		SystemConfig:                     q.SystemConfig.WithContext(ctx),
+		Task:                             q.Task.WithContext(ctx),
 		Upstream:                         q.Upstream.WithContext(ctx),
 	}
 }
@@ -46,6 +46,8 @@ func newSSL(db *gorm.DB, opts ...gen.DOOption) sSL {
 	tableName := _sSL.sSLDo.TableName()
 	_sSL.ALL = field.NewAsterisk(tableName)
 	_sSL.Name = field.NewString(tableName, "name")
+	_sSL.ValidityStart = field.NewInt64(tableName, "validity_start")
+	_sSL.ValidityEnd = field.NewInt64(tableName, "validity_end")
 	_sSL.Creator = field.NewString(tableName, "creator")
 	_sSL.Updater = field.NewString(tableName, "updater")
 	_sSL.CreatedAt = field.NewTime(tableName, "created_at")
@@ -64,17 +66,19 @@ func newSSL(db *gorm.DB, opts ...gen.DOOption) sSL {
 type sSL struct {
 	sSLDo sSLDo
 
-	ALL       field.Asterisk
-	Name      field.String
-	Creator   field.String
-	Updater   field.String
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	AutoID    field.Int
-	ID        field.String
-	GatewayID field.Int
-	Config    field.Field
-	Status    field.String
+	ALL           field.Asterisk
+	Name          field.String
+	ValidityStart field.Int64
+	ValidityEnd   field.Int64
+	Creator       field.String
+	Updater       field.String
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
+	AutoID        field.Int
+	ID            field.String
+	GatewayID     field.Int
+	Config        field.Field
+	Status        field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -94,6 +98,8 @@ func (s sSL) As(alias string) *sSL {
 func (s *sSL) updateTableName(table string) *sSL {
 	s.ALL = field.NewAsterisk(table)
 	s.Name = field.NewString(table, "name")
+	s.ValidityStart = field.NewInt64(table, "validity_start")
+	s.ValidityEnd = field.NewInt64(table, "validity_end")
 	s.Creator = field.NewString(table, "creator")
 	s.Updater = field.NewString(table, "updater")
 	s.CreatedAt = field.NewTime(table, "created_at")
@@ -132,8 +138,10 @@ func (s *sSL) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *sSL) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 10)
+	s.fieldMap = make(map[string]field.Expr, 12)
 	s.fieldMap["name"] = s.Name
+	s.fieldMap["validity_start"] = s.ValidityStart
+	s.fieldMap["validity_end"] = s.ValidityEnd
 	s.fieldMap["creator"] = s.Creator
 	s.fieldMap["updater"] = s.Updater
 	s.fieldMap["created_at"] = s.CreatedAt
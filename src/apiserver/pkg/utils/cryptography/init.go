@@ -22,23 +22,53 @@ import (
 	"fmt"
 )
 
-var commonCrypto *AESGcm
+var commonKeyring *Keyring
 
-// Init ...
+// Init 初始化加解密，encryptKey 为 ID 为 DefaultKeyID 的主密钥，nonce 仅用于解密旧版本的密文
 func Init(encryptKey, nonce string) (err error) {
-	commonCrypto, err = NewAESGcm([]byte(encryptKey), []byte(nonce))
+	return InitKeyring(encryptKey, nonce, DefaultKeyID, nil)
+}
+
+// InitKeyring 初始化多密钥加解密
+//
+// encryptKey 为 ID 为 DefaultKeyID 的密钥，keys 为其他可用密钥，使用 primaryKeyID 对应的密钥加密；
+// nonce 为空时不支持解密旧版本使用固定 nonce 加密的密文
+func InitKeyring(encryptKey, nonce, primaryKeyID string, keys []Key) (err error) {
+	var legacy *AESGcm
+	if nonce != "" {
+		legacy, err = NewAESGcm([]byte(encryptKey), []byte(nonce))
+		if err != nil {
+			return fmt.Errorf("cryptos[id=app_secret_key] key error: %w", err)
+		}
+	}
+	if primaryKeyID == "" {
+		primaryKeyID = DefaultKeyID
+	}
+	allKeys := append([]Key{{ID: DefaultKeyID, Secret: encryptKey}}, keys...)
+	keyring, err := NewKeyring(primaryKeyID, allKeys, legacy)
 	if err != nil {
 		return fmt.Errorf("cryptos[id=app_secret_key] key error: %w", err)
 	}
+	commonKeyring = keyring
 	return nil
 }
 
 // DecryptSecret ...
 func DecryptSecret(encryptedSecret string) (string, error) {
-	return commonCrypto.DecryptFromBase64(encryptedSecret)
+	return commonKeyring.Decrypt(encryptedSecret)
 }
 
 // EncryptSecret ...
 func EncryptSecret(plainSecret string) string {
-	return commonCrypto.EncryptToBase64(plainSecret)
+	return commonKeyring.Encrypt(plainSecret)
+}
+
+// NeedsRotation 判断密文是否需要使用当前主密钥重新加密
+func NeedsRotation(encryptedSecret string) bool {
+	return commonKeyring.NeedsRotation(encryptedSecret)
+}
+
+// PrimaryKeyID 当前用于加密的密钥 ID
+func PrimaryKeyID() string {
+	return commonKeyring.PrimaryKeyID()
 }
@@ -0,0 +1,167 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cryptography
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// DefaultKeyID 配置项 Crypto.Key 对应的密钥 ID
+	DefaultKeyID = "default"
+
+	// envelopeVersion 密文格式版本，密文格式为 v1:{keyID}:{base64(nonce + 密文)}
+	envelopeVersion = "v1"
+	envelopeSep     = ":"
+)
+
+// keyIDRegexp 密钥 ID 格式，不能包含密文格式的分隔符
+var keyIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,32}$`)
+
+// KeyringErrors 定义多密钥加解密相关的错误
+var (
+	ErrInvalidKeyID       = errors.New("invalid key id, should match ^[a-zA-Z0-9_-]{1,32}$")
+	ErrUnknownKeyID       = errors.New("unknown key id")
+	ErrInvalidCiphertext  = errors.New("invalid ciphertext")
+	ErrLegacyNotSupported = errors.New("legacy ciphertext found but nonce is not configured")
+)
+
+// Key 加密密钥
+type Key struct {
+	ID     string
+	Secret string
+}
+
+// Keyring 多密钥加解密：使用主密钥及随机 nonce 加密，按密文中的密钥 ID 选择密钥解密；
+// 配置了 legacy 时兼容解密旧版本使用固定 nonce 加密的密文
+type Keyring struct {
+	primaryKeyID string
+	keys         map[string]cipher.AEAD
+	legacy       *AESGcm
+}
+
+// NewKeyring 创建 Keyring，primaryKeyID 必须在 keys 中，legacy 可为空
+func NewKeyring(primaryKeyID string, keys []Key, legacy *AESGcm) (*Keyring, error) {
+	keyring := &Keyring{
+		primaryKeyID: primaryKeyID,
+		keys:         make(map[string]cipher.AEAD, len(keys)),
+		legacy:       legacy,
+	}
+	for _, key := range keys {
+		if !keyIDRegexp.MatchString(key.ID) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidKeyID, key.ID)
+		}
+		if _, ok := keyring.keys[key.ID]; ok {
+			return nil, fmt.Errorf("duplicate key id: %s", key.ID)
+		}
+		aead, err := newAEAD([]byte(key.Secret))
+		if err != nil {
+			return nil, fmt.Errorf("key[id=%s] error: %w", key.ID, err)
+		}
+		keyring.keys[key.ID] = aead
+	}
+	if _, ok := keyring.keys[primaryKeyID]; !ok {
+		return nil, fmt.Errorf("%w: primary key %s", ErrUnknownKeyID, primaryKeyID)
+	}
+	return keyring, nil
+}
+
+// newAEAD 创建 AES-GCM AEAD
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != ValidAES128KeySize && len(key) != ValidAES256KeySize {
+		return nil, errors.New("invalid key, should be 16 or 32 bytes")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// PrimaryKeyID 当前用于加密的密钥 ID
+func (k *Keyring) PrimaryKeyID() string {
+	return k.primaryKeyID
+}
+
+// Encrypt 使用主密钥及随机 nonce 加密
+func (k *Keyring) Encrypt(plaintext string) string {
+	aead := k.keys[k.primaryKeyID]
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	// crypto/rand.Read 不会返回错误，读取失败时进程直接退出
+	_, _ = rand.Read(nonce)
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return strings.Join([]string{envelopeVersion, k.primaryKeyID, base64.StdEncoding.EncodeToString(sealed)},
+		envelopeSep)
+}
+
+// Decrypt 解密 Encrypt 生成的密文，以及旧版本使用固定 nonce 加密的密文
+func (k *Keyring) Decrypt(ciphertext string) (string, error) {
+	keyID, payload, ok := parseEnvelope(ciphertext)
+	if !ok {
+		if k.legacy == nil {
+			return "", ErrLegacyNotSupported
+		}
+		return k.legacy.DecryptFromBase64(ciphertext)
+	}
+	aead, found := k.keys[keyID]
+	if !found {
+		return "", fmt.Errorf("%w: %s", ErrUnknownKeyID, keyID)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidCiphertext, err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", ErrInvalidCiphertext
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation 判断密文是否需要使用主密钥重新加密：旧版本格式或非主密钥加密
+func (k *Keyring) NeedsRotation(ciphertext string) bool {
+	keyID, _, ok := parseEnvelope(ciphertext)
+	return !ok || keyID != k.primaryKeyID
+}
+
+// KeyID 返回密文使用的密钥 ID，旧版本格式的密文返回空
+func KeyID(ciphertext string) string {
+	keyID, _, _ := parseEnvelope(ciphertext)
+	return keyID
+}
+
+// parseEnvelope 解析密文格式，返回密钥 ID 及 base64 编码的 nonce + 密文；
+// 旧版本密文为纯 base64，不含分隔符
+func parseEnvelope(ciphertext string) (keyID string, payload string, ok bool) {
+	parts := strings.SplitN(ciphertext, envelopeSep, 3)
+	if len(parts) != 3 || parts[0] != envelopeVersion {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
@@ -0,0 +1,130 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cryptography_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+)
+
+const (
+	testKey    = "jxi18GX5w2qgHwfZCFpn07q8FScXJOd3"
+	testNonce  = "k2dbCGetyusW"
+	rotatedKey = "AES256Key32Characters12345678901"
+)
+
+var _ = Describe("Keyring", func() {
+	var legacy *cryptography.AESGcm
+
+	BeforeEach(func() {
+		var err error
+		legacy, err = cryptography.NewAESGcm([]byte(testKey), []byte(testNonce))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should encrypt with a random nonce and the primary key id", func() {
+		keyring, err := cryptography.NewKeyring(cryptography.DefaultKeyID,
+			[]cryptography.Key{{ID: cryptography.DefaultKeyID, Secret: testKey}}, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		first := keyring.Encrypt("secret")
+		second := keyring.Encrypt("secret")
+		Expect(first).NotTo(Equal(second))
+		Expect(strings.HasPrefix(first, "v1:default:")).To(BeTrue())
+		Expect(cryptography.KeyID(first)).To(Equal(cryptography.DefaultKeyID))
+
+		plain, err := keyring.Decrypt(first)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plain).To(Equal("secret"))
+		Expect(keyring.NeedsRotation(first)).To(BeFalse())
+	})
+
+	It("should decrypt legacy ciphertext only when legacy nonce is configured", func() {
+		ciphertext := legacy.EncryptToBase64("secret")
+		Expect(cryptography.KeyID(ciphertext)).To(BeEmpty())
+
+		keyring, err := cryptography.NewKeyring(cryptography.DefaultKeyID,
+			[]cryptography.Key{{ID: cryptography.DefaultKeyID, Secret: testKey}}, legacy)
+		Expect(err).NotTo(HaveOccurred())
+		plain, err := keyring.Decrypt(ciphertext)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plain).To(Equal("secret"))
+		Expect(keyring.NeedsRotation(ciphertext)).To(BeTrue())
+
+		keyring, err = cryptography.NewKeyring(cryptography.DefaultKeyID,
+			[]cryptography.Key{{ID: cryptography.DefaultKeyID, Secret: testKey}}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = keyring.Decrypt(ciphertext)
+		Expect(err).To(MatchError(cryptography.ErrLegacyNotSupported))
+	})
+
+	It("should decrypt values encrypted by an old key after rotation", func() {
+		oldKeyring, err := cryptography.NewKeyring(cryptography.DefaultKeyID,
+			[]cryptography.Key{{ID: cryptography.DefaultKeyID, Secret: testKey}}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		ciphertext := oldKeyring.Encrypt("secret")
+
+		keyring, err := cryptography.NewKeyring("key2026", []cryptography.Key{
+			{ID: cryptography.DefaultKeyID, Secret: testKey},
+			{ID: "key2026", Secret: rotatedKey},
+		}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keyring.NeedsRotation(ciphertext)).To(BeTrue())
+		plain, err := keyring.Decrypt(ciphertext)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plain).To(Equal("secret"))
+
+		rotated := keyring.Encrypt(plain)
+		Expect(cryptography.KeyID(rotated)).To(Equal("key2026"))
+		Expect(keyring.NeedsRotation(rotated)).To(BeFalse())
+
+		// 旧密钥移除后无法解密新密钥加密的值
+		_, err = oldKeyring.Decrypt(rotated)
+		Expect(err).To(MatchError(cryptography.ErrUnknownKeyID))
+	})
+
+	It("should reject invalid keyring config", func() {
+		_, err := cryptography.NewKeyring("missing",
+			[]cryptography.Key{{ID: cryptography.DefaultKeyID, Secret: testKey}}, nil)
+		Expect(err).To(MatchError(cryptography.ErrUnknownKeyID))
+
+		_, err = cryptography.NewKeyring("bad:id", []cryptography.Key{{ID: "bad:id", Secret: testKey}}, nil)
+		Expect(err).To(MatchError(cryptography.ErrInvalidKeyID))
+
+		_, err = cryptography.NewKeyring(cryptography.DefaultKeyID, []cryptography.Key{
+			{ID: cryptography.DefaultKeyID, Secret: testKey},
+			{ID: cryptography.DefaultKeyID, Secret: rotatedKey},
+		}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject tampered ciphertext", func() {
+		keyring, err := cryptography.NewKeyring(cryptography.DefaultKeyID,
+			[]cryptography.Key{{ID: cryptography.DefaultKeyID, Secret: testKey}}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = keyring.Decrypt("v1:default:AAAA")
+		Expect(err).To(MatchError(cryptography.ErrInvalidCiphertext))
+		_, err = keyring.Decrypt("v1:default:" + strings.Repeat("A", 40))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -27,19 +27,27 @@ import (
 	"github.com/pkg/errors"
 )
 
-// ReadFileToObject 读取文件内容到对象中
-func ReadFileToObject(fileHeader *multipart.FileHeader, obj any) error {
+// ReadFile 读取上传文件的内容
+func ReadFile(fileHeader *multipart.FileHeader) ([]byte, error) {
 	file, err := fileHeader.Open()
 	if err != nil {
-		return errors.Wrap(err, "open file failed")
+		return nil, errors.Wrap(err, "open file failed")
 	}
 	defer file.Close() //nolint:errcheck
 	buf := new(bytes.Buffer)
 	_, err = buf.ReadFrom(file)
 	if err != nil {
-		return errors.Wrap(err, "read file failed")
+		return nil, errors.Wrap(err, "read file failed")
+	}
+	return buf.Bytes(), nil
+}
+
+// ReadFileToObject 读取文件内容到对象中
+func ReadFileToObject(fileHeader *multipart.FileHeader, obj any) error {
+	rawData, err := ReadFile(fileHeader)
+	if err != nil {
+		return err
 	}
-	rawData := buf.Bytes()
 	if err := json.Unmarshal(rawData, obj); err != nil {
 		return errors.Wrap(err, "unmarshal file failed")
 	}
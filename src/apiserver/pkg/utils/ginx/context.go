@@ -128,6 +128,20 @@ func SetGatewayInfoToContext(c context.Context, gatewayInfo *model.Gateway) cont
 	return context.WithValue(c, constant.GatewayInfoKey, gatewayInfo)
 }
 
+// GetGatewayRole 获取当前用户在网关中的角色
+func GetGatewayRole(c *gin.Context) constant.GatewayRole {
+	role, ok := c.Request.Context().Value(constant.GatewayRoleKey).(constant.GatewayRole)
+	if !ok {
+		return ""
+	}
+	return role
+}
+
+// SetGatewayRole 设置当前用户在网关中的角色
+func SetGatewayRole(c *gin.Context, role constant.GatewayRole) {
+	c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), constant.GatewayRoleKey, role))
+}
+
 // SetResourceType ...
 func SetResourceType(c *gin.Context, resourceType constant.APISIXResource) {
 	c.Request = c.Request.WithContext(
@@ -20,6 +20,8 @@
 package idx
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"os"
@@ -35,6 +37,8 @@ var resourceIDResourceTypePrefixMap = map[constant.APISIXResource]string{
 	constant.Upstream:       "u",
 	constant.Service:        "s",
 	constant.Consumer:       "c",
+	constant.Credential:     "cr",
+	constant.Secret:         "sc",
 	constant.ConsumerGroup:  "cg",
 	constant.GlobalRule:     "gr",
 	constant.PluginConfig:   "pc",
@@ -103,3 +107,10 @@ func GenResourceID(resourceType constant.APISIXResource) string {
 	prefix := resourceIDResourceTypePrefixMap[resourceType]
 	return fmt.Sprintf("bk.%s.%s", prefix, strconv.FormatUint(uid, 36))
 }
+
+// GenStableResourceID 根据 seed 生成稳定的资源 ID，相同的 seed 总是得到相同的 ID
+func GenStableResourceID(resourceType constant.APISIXResource, seed string) string {
+	sum := sha256.Sum256([]byte(seed))
+	prefix := resourceIDResourceTypePrefixMap[resourceType]
+	return fmt.Sprintf("bk.%s.%s", prefix, hex.EncodeToString(sum[:])[:16])
+}
@@ -46,3 +46,10 @@ func TestGenResourceIDCaseFoldedUnique(t *testing.T) {
 		seen[folded] = id
 	}
 }
+
+func TestGenStableResourceID(t *testing.T) {
+	id := GenStableResourceID(constant.Route, "GET /users/{id}")
+	assert.Regexp(t, `^bk\.r\.[0-9a-f]{16}$`, id)
+	assert.Equal(t, id, GenStableResourceID(constant.Route, "GET /users/{id}"))
+	assert.NotEqual(t, id, GenStableResourceID(constant.Route, "POST /users/{id}"))
+}
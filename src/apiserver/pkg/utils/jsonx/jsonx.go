@@ -21,6 +21,7 @@ package jsonx
 
 import (
 	"encoding/json"
+	"reflect"
 	"strconv"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
@@ -46,6 +47,18 @@ func IsJSONEmpty(raw json.RawMessage) bool {
 	}
 }
 
+// IsJSONEqual 判断两个 json 是否语义相等（忽略 key 顺序）
+func IsJSONEqual(a, b json.RawMessage) bool {
+	var aParsed, bParsed any
+	if err := json.Unmarshal(a, &aParsed); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &bParsed); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(aParsed, bParsed)
+}
+
 // MergeJson ...
 func MergeJson(doc, patch []byte) ([]byte, error) {
 	out, err := jsonpatch.MergePatch(doc, patch)
@@ -92,6 +92,11 @@ func GetResourceSchema(version constant.APISIXVersion, name string) any {
 	return schemaVersionMap[version].Get("main." + name).Value()
 }
 
+// SupportLabels 判断资源的 schema 是否支持 labels
+func SupportLabels(version constant.APISIXVersion, name string) bool {
+	return schemaVersionMap[version].Get("main." + name + ".properties.labels").Exists()
+}
+
 // GetMetadataPluginSchema 获取 metadata 插件类型的 schema
 func GetMetadataPluginSchema(version constant.APISIXVersion, path string) any {
 	// 查找 apisix 插件
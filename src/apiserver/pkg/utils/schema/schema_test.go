@@ -68,7 +68,7 @@ func TestGetResourceSchema(t *testing.T) {
 		for _, resource := range constant.ResourceTypeList {
 			t.Run(tt.name, func(t *testing.T) {
 				result := GetResourceSchema(tt.version, resource.String())
-				if tt.shouldFail {
+				if tt.shouldFail || !constant.ResourceSupportedForVersion(resource, tt.version) {
 					assert.Nil(t, result)
 				} else {
 					assert.NotNil(t, result)
@@ -78,6 +78,13 @@ func TestGetResourceSchema(t *testing.T) {
 	}
 }
 
+func TestSupportLabels(t *testing.T) {
+	assert.True(t, SupportLabels(constant.APISIXVersion32, constant.Route.String()))
+	assert.False(t, SupportLabels(constant.APISIXVersion32, constant.GlobalRule.String()))
+	assert.False(t, SupportLabels(constant.APISIXVersion32, constant.StreamRoute.String()))
+	assert.True(t, SupportLabels(constant.APISIXVersion313, constant.StreamRoute.String()))
+}
+
 func TestGet317OfficialAssets(t *testing.T) {
 	assert.NotNil(t, GetResourceSchema(constant.APISIXVersion317, constant.Route.String()))
 	assert.NotNil(t, GetPluginSchema(constant.APISIXVersion317, "jwt-auth", ""))
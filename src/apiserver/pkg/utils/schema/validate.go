@@ -146,6 +146,9 @@ func getPlugins(reqBody any) (map[string]any, string) {
 	case *entity.ConsumerGroup:
 		log.Infof("type of reqBody: %#v", bodyType)
 		return bodyType.Plugins, "consumer_schema"
+	case *entity.Credential:
+		log.Infof("type of reqBody: %#v", bodyType)
+		return bodyType.Plugins, "consumer_schema"
 	case *entity.PluginConfig:
 		log.Infof("type of reqBody: %#v", bodyType)
 		return bodyType.Plugins, "schema"
@@ -301,8 +304,8 @@ func validateVarItem(item []any) error {
 	return nil
 }
 
-// checkVars 校验 vars
-func checkVars(vars []any) error {
+// CheckVars 校验路由的 vars 表达式
+func CheckVars(vars []any) error {
 	if len(vars) == 0 {
 		return nil
 	}
@@ -327,7 +330,7 @@ func (v *APISIXJsonSchemaValidator) checkConf(reqBody any) error {
 		if err := checkRemoteAddr(body.RemoteAddrs); err != nil {
 			return err
 		}
-		if err := checkVars(body.Vars); err != nil {
+		if err := CheckVars(body.Vars); err != nil {
 			return err
 		}
 
@@ -398,6 +401,9 @@ func (v *APISIXJsonSchemaValidator) Validate(rawConfig json.RawMessage) error {
 	case constant.ConsumerGroup:
 		obj = &entity.ConsumerGroup{}
 		_ = json.Unmarshal(rawConfig, obj)
+	case constant.Credential:
+		obj = &entity.Credential{}
+		_ = json.Unmarshal(rawConfig, obj)
 	case constant.GlobalRule:
 		obj = &entity.GlobalRule{}
 		_ = json.Unmarshal(rawConfig, obj)
@@ -1193,7 +1193,7 @@ func TestCheckVars(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := checkVars(tt.vars)
+			err := CheckVars(tt.vars)
 			if tt.shouldFail {
 				assert.Error(t, err)
 			} else {
@@ -0,0 +1,266 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package sensitive 处理资源配置中的敏感字段：加密存储、发布时解密及接口返回时脱敏
+package sensitive
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/jsonx"
+)
+
+// EncryptedValuePrefix 加密后的敏感字段值前缀
+const EncryptedValuePrefix = "$bk-encrypted:"
+
+// ErrMaskedValue 敏感字段为脱敏占位值且无法还原为原值
+var ErrMaskedValue = errors.New("敏感字段为脱敏占位值，无法还原原值，请填写真实值")
+
+// variableReferenceRegexp 网关变量引用 ${NAME}，与 biz/variable 中的引用格式一致
+var variableReferenceRegexp = regexp.MustCompile(`^\$\{[A-Z][A-Z0-9_]{0,63}\}$`)
+
+// IsEncrypted 判断值是否已加密
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, EncryptedValuePrefix)
+}
+
+// isReference 判断值是否为引用：$secret://、$env:// 及网关变量引用在发布时才解析，本身不是敏感信息
+func isReference(value string) bool {
+	return strings.HasPrefix(value, constant.SecretReferencePrefix) ||
+		strings.HasPrefix(value, constant.EnvReferencePrefix) ||
+		variableReferenceRegexp.MatchString(value)
+}
+
+// Paths 返回配置中存在的敏感字段路径（gjson 路径），只包含字符串类型的值
+func Paths(resourceType constant.APISIXResource, config []byte) []string {
+	var paths []string
+	for _, field := range constant.ResourceSensitiveFieldMap[resourceType] {
+		paths = expandPath(config, "", strings.Split(field, "."), paths)
+	}
+	gjson.GetBytes(config, "plugins").ForEach(func(name, _ gjson.Result) bool {
+		for _, field := range constant.PluginSensitiveFieldMap[name.String()] {
+			paths = expandPath(config, "plugins."+gjson.Escape(name.String()), strings.Split(field, "."), paths)
+		}
+		return true
+	})
+	return paths
+}
+
+// expandPath 将含 # 的字段路径展开为配置中实际存在的路径
+func expandPath(config []byte, prefix string, segments []string, paths []string) []string {
+	if len(segments) == 0 {
+		if gjson.GetBytes(config, prefix).Type == gjson.String {
+			paths = append(paths, prefix)
+		}
+		return paths
+	}
+	join := func(segment string) string {
+		if prefix == "" {
+			return segment
+		}
+		return prefix + "." + segment
+	}
+	if segments[0] != "#" {
+		return expandPath(config, join(segments[0]), segments[1:], paths)
+	}
+	items := gjson.GetBytes(config, prefix)
+	if !items.IsArray() {
+		return paths
+	}
+	for i := range items.Array() {
+		paths = expandPath(config, join(strconv.Itoa(i)), segments[1:], paths)
+	}
+	return paths
+}
+
+// Encrypt 加密配置中的敏感字段，已加密、脱敏占位及引用类的值保持不变
+func Encrypt(resourceType constant.APISIXResource, config []byte) ([]byte, error) {
+	var err error
+	for _, path := range Paths(resourceType, config) {
+		value := gjson.GetBytes(config, path).String()
+		if value == "" || IsEncrypted(value) || value == constant.SensitiveInfoFiledDisplay || isReference(value) {
+			continue
+		}
+		config, err = sjson.SetBytes(config, path, EncryptedValuePrefix+cryptography.EncryptSecret(value))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// EncryptValue 加密单个值，空值及已加密的值保持不变
+func EncryptValue(value string) string {
+	if value == "" || IsEncrypted(value) {
+		return value
+	}
+	return EncryptedValuePrefix + cryptography.EncryptSecret(value)
+}
+
+// DecryptValue 解密单个值，未加密的值保持不变
+func DecryptValue(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	return cryptography.DecryptSecret(strings.TrimPrefix(value, EncryptedValuePrefix))
+}
+
+// Decrypt 解密配置中所有已加密的值，不依赖敏感字段目录，避免目录调整后密文被发布
+func Decrypt(config []byte) ([]byte, error) {
+	for _, path := range encryptedPaths(gjson.ParseBytes(config), "", nil) {
+		value := gjson.GetBytes(config, path).String()
+		plain, err := cryptography.DecryptSecret(strings.TrimPrefix(value, EncryptedValuePrefix))
+		if err != nil {
+			return nil, fmt.Errorf("decrypt sensitive field %s failed: %w", path, err)
+		}
+		if config, err = sjson.SetBytes(config, path, plain); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// Rotate 使用当前主密钥重新加密配置中需要轮换的已加密值，返回重新加密的值的数量
+func Rotate(config []byte) ([]byte, int, error) {
+	count := 0
+	for _, path := range encryptedPaths(gjson.ParseBytes(config), "", nil) {
+		value := strings.TrimPrefix(gjson.GetBytes(config, path).String(), EncryptedValuePrefix)
+		if !cryptography.NeedsRotation(value) {
+			continue
+		}
+		plain, err := cryptography.DecryptSecret(value)
+		if err != nil {
+			return nil, 0, fmt.Errorf("decrypt sensitive field %s failed: %w", path, err)
+		}
+		config, err = sjson.SetBytes(config, path, EncryptedValuePrefix+cryptography.EncryptSecret(plain))
+		if err != nil {
+			return nil, 0, err
+		}
+		count++
+	}
+	return config, count, nil
+}
+
+// Verify 校验配置中所有已加密的值均可解密，返回值总数及其中需要轮换的数量
+func Verify(config []byte) (total int, pending int, err error) {
+	for _, path := range encryptedPaths(gjson.ParseBytes(config), "", nil) {
+		value := strings.TrimPrefix(gjson.GetBytes(config, path).String(), EncryptedValuePrefix)
+		if _, err := cryptography.DecryptSecret(value); err != nil {
+			return total, pending, fmt.Errorf("decrypt sensitive field %s failed: %w", path, err)
+		}
+		total++
+		if cryptography.NeedsRotation(value) {
+			pending++
+		}
+	}
+	return total, pending, nil
+}
+
+// IsEqual 解密后判断两份配置是否语义相等，解密失败时视为不相等
+func IsEqual(a, b []byte) bool {
+	a, err := Decrypt(a)
+	if err != nil {
+		return false
+	}
+	b, err = Decrypt(b)
+	if err != nil {
+		return false
+	}
+	return jsonx.IsJSONEqual(a, b)
+}
+
+// encryptedPaths 递归查找配置中已加密的值的路径
+func encryptedPaths(value gjson.Result, prefix string, paths []string) []string {
+	switch {
+	case value.IsObject() || value.IsArray():
+		index := 0
+		isArray := value.IsArray()
+		value.ForEach(func(key, item gjson.Result) bool {
+			segment := gjson.Escape(key.String())
+			if isArray {
+				segment = strconv.Itoa(index)
+				index++
+			}
+			if prefix != "" {
+				segment = prefix + "." + segment
+			}
+			paths = encryptedPaths(item, segment, paths)
+			return true
+		})
+	case value.Type == gjson.String && IsEncrypted(value.String()):
+		paths = append(paths, prefix)
+	}
+	return paths
+}
+
+// Mask 脱敏配置中的敏感字段及所有已加密的值，引用类的值保持不变
+func Mask(resourceType constant.APISIXResource, config []byte) []byte {
+	if len(config) == 0 {
+		return config
+	}
+	paths := encryptedPaths(gjson.ParseBytes(config), "", Paths(resourceType, config))
+	for _, path := range paths {
+		value := gjson.GetBytes(config, path).String()
+		if value == "" || isReference(value) {
+			continue
+		}
+		if masked, err := sjson.SetBytes(config, path, constant.SensitiveInfoFiledDisplay); err == nil {
+			config = masked
+		}
+	}
+	return config
+}
+
+// HasMasked 判断配置的敏感字段中是否有脱敏占位值
+func HasMasked(resourceType constant.APISIXResource, config []byte) bool {
+	for _, path := range Paths(resourceType, config) {
+		if gjson.GetBytes(config, path).String() == constant.SensitiveInfoFiledDisplay {
+			return true
+		}
+	}
+	return false
+}
+
+// RestoreMasked 将 config 敏感字段中的脱敏占位值还原为 origin 中同一路径的值，
+// 用于编辑时前端回传脱敏后的配置；origin 中不存在该字段时保留占位值，由调用方拒绝写入
+func RestoreMasked(resourceType constant.APISIXResource, config, origin []byte) ([]byte, error) {
+	var err error
+	for _, path := range Paths(resourceType, config) {
+		if gjson.GetBytes(config, path).String() != constant.SensitiveInfoFiledDisplay {
+			continue
+		}
+		originValue := gjson.GetBytes(origin, path)
+		if originValue.Type != gjson.String {
+			continue
+		}
+		config, err = sjson.SetBytes(config, path, originValue.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
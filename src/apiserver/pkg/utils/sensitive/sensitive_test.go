@@ -0,0 +1,105 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 微网关 (BlueKing - Micro APIGateway) available.
+ * Copyright (C) 2025 Tencent. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ *     http://opensource.org/licenses/MIT
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package sensitive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/constant"
+	"github.com/TencentBlueKing/blueking-micro-apigateway/apiserver/pkg/utils/cryptography"
+)
+
+func init() {
+	_ = cryptography.Init("jxi18GX5w2qgHwfZCFpn07q8FScXJOd3", "k2dbCGetyusW")
+}
+
+const consumerConfig = `{
+	"username": "alice",
+	"plugins": {
+		"key-auth": {"key": "alice-key"},
+		"basic-auth": {"username": "alice", "password": "$secret://vault/s1/password"},
+		"kafka-logger": {"brokers": [
+			{"host": "127.0.0.1", "port": 9092, "sasl_config": {"user": "u", "password": "p1"}},
+			{"host": "127.0.0.2", "port": 9092}
+		]},
+		"limit-count": {"count": 1, "time_window": 60, "redis_password": "${REDIS_PASSWORD}"}
+	}
+}`
+
+func TestPaths(t *testing.T) {
+	paths := Paths(constant.Consumer, []byte(consumerConfig))
+	assert.ElementsMatch(t, []string{
+		"plugins.key-auth.key",
+		"plugins.basic-auth.password",
+		"plugins.kafka-logger.brokers.0.sasl_config.password",
+		"plugins.limit-count.redis_password",
+	}, paths)
+
+	paths = Paths(constant.Secret, []byte(`{"uri":"http://127.0.0.1:8200","prefix":"kv","token":"t"}`))
+	assert.Equal(t, []string{"token"}, paths)
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	encrypted, err := Encrypt(constant.Consumer, []byte(consumerConfig))
+	assert.NoError(t, err)
+
+	key := gjson.GetBytes(encrypted, "plugins.key-auth.key").String()
+	assert.True(t, IsEncrypted(key))
+	password := gjson.GetBytes(encrypted, "plugins.kafka-logger.brokers.0.sasl_config.password").String()
+	assert.True(t, IsEncrypted(password))
+	// 引用不加密
+	assert.Equal(t, "$secret://vault/s1/password", gjson.GetBytes(encrypted, "plugins.basic-auth.password").String())
+	assert.Equal(t, "${REDIS_PASSWORD}", gjson.GetBytes(encrypted, "plugins.limit-count.redis_password").String())
+
+	// 重复加密不改变已加密的值
+	again, err := Encrypt(constant.Consumer, encrypted)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(encrypted), string(again))
+
+	decrypted, err := Decrypt(encrypted)
+	assert.NoError(t, err)
+	assert.JSONEq(t, consumerConfig, string(decrypted))
+
+	_, err = Decrypt([]byte(`{"plugins":{"key-auth":{"key":"` + EncryptedValuePrefix + `invalid"}}}`))
+	assert.Error(t, err)
+}
+
+func TestMaskAndRestore(t *testing.T) {
+	encrypted, err := Encrypt(constant.Consumer, []byte(consumerConfig))
+	assert.NoError(t, err)
+
+	masked := Mask(constant.Consumer, encrypted)
+	assert.Equal(t, constant.SensitiveInfoFiledDisplay, gjson.GetBytes(masked, "plugins.key-auth.key").String())
+	assert.Equal(t, constant.SensitiveInfoFiledDisplay,
+		gjson.GetBytes(masked, "plugins.kafka-logger.brokers.0.sasl_config.password").String())
+	assert.Equal(t, "$secret://vault/s1/password", gjson.GetBytes(masked, "plugins.basic-auth.password").String())
+	assert.Equal(t, "alice", gjson.GetBytes(masked, "username").String())
+	assert.True(t, HasMasked(constant.Consumer, masked))
+	// 不在目录中的加密值同样脱敏
+	assert.Equal(t, constant.SensitiveInfoFiledDisplay,
+		gjson.GetBytes(Mask(constant.Route, []byte(`{"desc":"`+EncryptedValuePrefix+`abc"}`)), "desc").String())
+
+	restored, err := RestoreMasked(constant.Consumer, masked, encrypted)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(encrypted), string(restored))
+	assert.False(t, HasMasked(constant.Consumer, restored))
+}
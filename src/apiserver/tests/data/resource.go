@@ -309,6 +309,44 @@ func ConsumerGroup1WithNoRelation(gateway *model.Gateway, status constant.Resour
 	}
 }
 
+// Credential1 ...
+func Credential1(gateway *model.Gateway, consumerID string, status constant.ResourceStatus) *model.Credential {
+	return &model.Credential{
+		Name:       "credential1",
+		ConsumerID: consumerID,
+		ResourceCommonModel: model.ResourceCommonModel{
+			GatewayID: gateway.ID,
+			ID:        idx.GenResourceID(constant.Credential),
+			Config: datatypes.JSON(`{
+				"plugins": {
+					"key-auth": {
+						"key": "credential-key-1"
+					}
+				}
+			}`),
+			Status: status,
+		},
+	}
+}
+
+// Secret1 ...
+func Secret1(gateway *model.Gateway, status constant.ResourceStatus) *model.Secret {
+	return &model.Secret{
+		Name:    "secret1",
+		Manager: constant.SecretManagerVault.String(),
+		ResourceCommonModel: model.ResourceCommonModel{
+			GatewayID: gateway.ID,
+			ID:        idx.GenResourceID(constant.Secret),
+			Config: datatypes.JSON(`{
+				"uri": "http://127.0.0.1:8200",
+				"prefix": "kv/apisix",
+				"token": "vault-token"
+			}`),
+			Status: status,
+		},
+	}
+}
+
 // SSL1 ...
 func SSL1(gateway *model.Gateway, status constant.ResourceStatus) *model.SSL {
 	return &model.SSL{
@@ -80,6 +80,25 @@ func InitEmbedDb() {
 			model.GlobalRule{},
 			model.GatewaySyncData{},
 			model.GatewayReleaseVersion{},
+			model.GatewayDriftRecord{},
+			model.GatewayMember{},
+			model.GatewayPublishPolicy{},
+			model.GatewayChangeRequest{},
+			model.GatewayChangeRequestEvent{},
+			model.GatewayMaintenanceWindow{},
+			model.GatewayScheduledPublish{},
+			model.GatewayGroup{},
+			model.GatewayGroupMember{},
+			model.GatewayGroupRollout{},
+			model.GatewayGroupRolloutStage{},
+			model.GatewayVariable{},
+			model.GatewayWebhook{},
+			model.GatewayWebhookDelivery{},
+			model.SSLExpiryAlert{},
+			model.GatewayDataPlaneInstance{},
+			model.GatewayPublishJournal{},
+			model.Credential{},
+			model.Secret{},
 			model.OperationAuditLog{},
 			model.Proto{},
 			model.SSL{},
@@ -88,6 +107,7 @@ func InitEmbedDb() {
 			model.GatewayResourceSchemaAssociation{},
 			model.StreamRoute{},
 			model.MCPAccessToken{},
+			model.Task{},
 		}
 		for _, m := range models {
 			// 执行迁移